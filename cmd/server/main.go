@@ -1,7 +1,16 @@
 package main
 
-import "github.com/superplanehq/superplane/pkg/server"
+import (
+	"os"
+
+	"github.com/superplanehq/superplane/pkg/server"
+)
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "reencrypt" {
+		server.ReEncrypt(os.Args[2:])
+		return
+	}
+
 	server.Start()
 }
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE workflow_nodes ADD COLUMN concurrency_group character varying(128);
+ALTER TABLE workflow_nodes ADD COLUMN concurrency_policy character varying(32);
+
+CREATE INDEX idx_workflow_nodes_concurrency_group ON workflow_nodes (concurrency_group) WHERE concurrency_group IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE workflow_nodes ADD COLUMN retry_policy jsonb;
+
+ALTER TABLE workflow_node_executions ADD COLUMN attempt integer DEFAULT 1 NOT NULL;
+ALTER TABLE workflow_node_executions ADD COLUMN retry_of_execution_id uuid;
+ALTER TABLE workflow_node_executions
+  ADD CONSTRAINT workflow_node_executions_retry_of_execution_id_fkey
+  FOREIGN KEY (retry_of_execution_id) REFERENCES workflow_node_executions(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_workflow_node_executions_retry_of_execution_id ON workflow_node_executions (retry_of_execution_id);
+
+COMMIT;
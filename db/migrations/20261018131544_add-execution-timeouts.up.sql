@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE workflow_nodes ADD COLUMN execution_timeout_seconds integer;
+
+ALTER TABLE workflow_node_executions ADD COLUMN deadline_at timestamp without time zone;
+
+COMMIT;
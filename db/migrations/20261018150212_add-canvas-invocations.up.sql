@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE workflow_events ADD COLUMN invoked_by_execution_id uuid;
+ALTER TABLE workflow_events
+  ADD CONSTRAINT workflow_events_invoked_by_execution_id_fkey
+  FOREIGN KEY (invoked_by_execution_id) REFERENCES workflow_node_executions(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_workflow_events_invoked_by_execution_id ON workflow_events (invoked_by_execution_id);
+
+COMMIT;
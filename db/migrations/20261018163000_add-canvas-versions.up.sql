@@ -0,0 +1,34 @@
+BEGIN;
+
+CREATE TABLE workflow_versions (
+  id uuid NOT NULL DEFAULT uuid_generate_v4(),
+  workflow_id uuid NOT NULL,
+  version integer NOT NULL,
+  name character varying(128) NOT NULL,
+  description text,
+  nodes jsonb NOT NULL DEFAULT '[]'::jsonb,
+  edges jsonb NOT NULL DEFAULT '[]'::jsonb,
+  message text,
+  created_by uuid,
+  created_at timestamp without time zone NOT NULL,
+
+  PRIMARY KEY (id),
+  UNIQUE (workflow_id, version),
+  FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE
+);
+
+--
+-- The current state of every existing canvas becomes its first version.
+--
+INSERT INTO workflow_versions (workflow_id, version, name, description, nodes, edges, message, created_by, created_at)
+SELECT id, 1, name, description, nodes, edges, 'Initial version', created_by, updated_at
+FROM workflows;
+
+ALTER TABLE workflow_node_executions ADD COLUMN workflow_version_id uuid;
+ALTER TABLE workflow_node_executions
+  ADD CONSTRAINT workflow_node_executions_workflow_version_id_fkey
+  FOREIGN KEY (workflow_version_id) REFERENCES workflow_versions(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_workflow_node_executions_workflow_version_id ON workflow_node_executions (workflow_version_id);
+
+COMMIT;
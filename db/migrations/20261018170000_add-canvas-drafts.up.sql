@@ -0,0 +1,45 @@
+BEGIN;
+
+CREATE TABLE workflow_drafts (
+  workflow_id uuid NOT NULL,
+  base_version_id uuid,
+  name character varying(128) NOT NULL,
+  description text,
+  nodes jsonb NOT NULL DEFAULT '[]'::jsonb,
+  edges jsonb NOT NULL DEFAULT '[]'::jsonb,
+  updated_by uuid,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+
+  PRIMARY KEY (workflow_id),
+  FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE,
+  FOREIGN KEY (base_version_id) REFERENCES workflow_versions(id) ON DELETE SET NULL
+);
+
+CREATE TABLE workflow_change_requests (
+  id uuid NOT NULL DEFAULT uuid_generate_v4(),
+  workflow_id uuid NOT NULL,
+  base_version_id uuid,
+  name character varying(128) NOT NULL,
+  description text,
+  nodes jsonb NOT NULL DEFAULT '[]'::jsonb,
+  edges jsonb NOT NULL DEFAULT '[]'::jsonb,
+  message text,
+  approval_role character varying(255) NOT NULL,
+  state character varying(32) NOT NULL,
+  requested_by uuid,
+  reviewed_by uuid,
+  reviewed_at timestamp without time zone,
+  published_version_id uuid,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+
+  PRIMARY KEY (id),
+  FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE,
+  FOREIGN KEY (base_version_id) REFERENCES workflow_versions(id) ON DELETE SET NULL,
+  FOREIGN KEY (published_version_id) REFERENCES workflow_versions(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_workflow_change_requests_workflow_id_state ON workflow_change_requests (workflow_id, state);
+
+COMMIT;
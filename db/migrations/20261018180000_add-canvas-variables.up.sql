@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE workflow_variables (
+  workflow_id uuid NOT NULL,
+  name character varying(128) NOT NULL,
+  value jsonb NOT NULL,
+  version integer NOT NULL DEFAULT 1,
+  updated_by uuid,
+  updated_by_execution_id uuid,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+
+  PRIMARY KEY (workflow_id, name),
+  FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE
+);
+
+COMMIT;
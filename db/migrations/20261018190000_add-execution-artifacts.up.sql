@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE workflow_node_execution_artifacts (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  workflow_id uuid NOT NULL,
+  node_id character varying(128) NOT NULL,
+  execution_id uuid NOT NULL,
+  name character varying(255) NOT NULL,
+  content_type character varying(255) NOT NULL,
+  size bigint NOT NULL,
+  sha256 character varying(64) NOT NULL,
+  storage character varying(32) NOT NULL,
+  storage_key text NOT NULL,
+  created_at timestamp without time zone NOT NULL,
+
+  PRIMARY KEY (id),
+  UNIQUE (execution_id, name),
+  FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE,
+  FOREIGN KEY (execution_id) REFERENCES workflow_node_executions(id) ON DELETE CASCADE
+);
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+--
+-- Log lines are written outside of the transaction that processes the execution,
+-- so they can be tailed while the execution runs. That transaction holds a lock
+-- on the execution row, so there is no foreign key to it here. The lines are
+-- removed together with the canvas by the canvas cleanup worker.
+--
+CREATE TABLE workflow_node_execution_logs (
+  id bigserial NOT NULL,
+  workflow_id uuid NOT NULL,
+  node_id character varying(128) NOT NULL,
+  execution_id uuid NOT NULL,
+  stream character varying(16) NOT NULL,
+  level character varying(16) NOT NULL,
+  message text NOT NULL,
+  created_at timestamp without time zone NOT NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_workflow_node_execution_logs_execution ON workflow_node_execution_logs(execution_id, id);
+CREATE INDEX idx_workflow_node_execution_logs_workflow_node ON workflow_node_execution_logs(workflow_id, node_id);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE workflow_events ADD COLUMN traceparent CHARACTER VARYING(55);
+ALTER TABLE workflow_node_queue_items ADD COLUMN traceparent CHARACTER VARYING(55);
+ALTER TABLE workflow_node_executions ADD COLUMN traceparent CHARACTER VARYING(55);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE workflow_node_executions ADD COLUMN finished_at TIMESTAMP WITHOUT TIME ZONE;
+
+UPDATE workflow_node_executions SET finished_at = updated_at WHERE state = 'finished';
+
+CREATE INDEX idx_workflow_node_executions_finished_at ON workflow_node_executions(finished_at);
+
+COMMIT;
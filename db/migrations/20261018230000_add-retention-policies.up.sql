@@ -0,0 +1,35 @@
+BEGIN;
+
+CREATE TABLE retention_policies (
+  id                uuid NOT NULL DEFAULT uuid_generate_v4(),
+  organization_id   uuid NOT NULL,
+  workflow_id       uuid,
+  max_age_days      integer NOT NULL DEFAULT 0,
+  max_runs          integer NOT NULL DEFAULT 0,
+  archive           boolean NOT NULL DEFAULT false,
+  created_at        TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+  updated_at        TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+
+  PRIMARY KEY (id),
+  FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+  FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_retention_policies_organization_unique ON retention_policies(organization_id) WHERE workflow_id IS NULL;
+CREATE UNIQUE INDEX idx_retention_policies_workflow_unique ON retention_policies(workflow_id) WHERE workflow_id IS NOT NULL;
+
+CREATE TABLE workflow_retention_stats (
+  workflow_id          uuid NOT NULL,
+  last_enforced_at     TIMESTAMP WITHOUT TIME ZONE,
+  deleted_runs         bigint NOT NULL DEFAULT 0,
+  deleted_executions   bigint NOT NULL DEFAULT 0,
+  archived_executions  bigint NOT NULL DEFAULT 0,
+  updated_at           TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+
+  PRIMARY KEY (workflow_id),
+  FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_workflow_events_root_created_at ON workflow_events(workflow_id, created_at) WHERE execution_id IS NULL;
+
+COMMIT;
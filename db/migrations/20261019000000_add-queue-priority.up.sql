@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE workflow_events ADD COLUMN priority integer NOT NULL DEFAULT 0;
+ALTER TABLE workflow_node_queue_items ADD COLUMN priority integer NOT NULL DEFAULT 0;
+ALTER TABLE workflow_node_executions ADD COLUMN priority integer NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_workflow_node_queue_items_priority ON workflow_node_queue_items(workflow_id, node_id, priority DESC, created_at);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE workflow_nodes ADD COLUMN queue_policy jsonb;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE workflow_nodes ADD COLUMN rate_limit jsonb;
+ALTER TABLE app_installations ADD COLUMN rate_limit jsonb;
+ALTER TABLE workflow_node_executions ADD COLUMN run_after timestamp without time zone;
+
+CREATE TABLE rate_limit_buckets (
+  key           character varying(255) NOT NULL PRIMARY KEY,
+  tokens        double precision NOT NULL,
+  refilled_at   timestamp without time zone NOT NULL,
+  blocked_until timestamp without time zone,
+  updated_at    timestamp without time zone NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE secrets ADD COLUMN workflow_id uuid;
+ALTER TABLE secrets ADD COLUMN node_id character varying(128);
+ALTER TABLE secrets ADD COLUMN access_policy jsonb;
+
+ALTER TABLE secrets
+  ADD CONSTRAINT secrets_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_secrets_workflow_id ON secrets(workflow_id);
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE secret_access_events (
+  id               uuid NOT NULL DEFAULT uuid_generate_v4(),
+  organization_id  uuid NOT NULL,
+  secret_id        uuid NOT NULL,
+  secret_name      CHARACTER VARYING(128) NOT NULL,
+  action           CHARACTER VARYING(32) NOT NULL,
+  key_name         CHARACTER VARYING(255),
+  actor_type       CHARACTER VARYING(32) NOT NULL,
+  actor_id         uuid,
+  workflow_id      uuid,
+  node_id          CHARACTER VARYING(128),
+  execution_id     uuid,
+  created_at       TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+
+  PRIMARY KEY (id),
+  FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_secret_access_events_secret ON secret_access_events(organization_id, secret_id, created_at DESC);
+CREATE INDEX idx_secret_access_events_secret_name ON secret_access_events(organization_id, secret_name, created_at DESC);
+
+COMMIT;
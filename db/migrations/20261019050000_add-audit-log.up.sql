@@ -0,0 +1,39 @@
+BEGIN;
+
+CREATE TABLE audit_log_entries (
+  id               uuid NOT NULL DEFAULT uuid_generate_v4(),
+  organization_id  uuid NOT NULL,
+  actor_type       CHARACTER VARYING(32) NOT NULL,
+  actor_id         uuid,
+  method           CHARACTER VARYING(255) NOT NULL,
+  resource         CHARACTER VARYING(64) NOT NULL,
+  action           CHARACTER VARYING(32) NOT NULL,
+  resource_id      CHARACTER VARYING(255),
+  request          jsonb NOT NULL DEFAULT '{}',
+  result           CHARACTER VARYING(32) NOT NULL,
+  error            TEXT,
+  created_at       TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_audit_log_entries_organization ON audit_log_entries(organization_id, created_at DESC);
+CREATE INDEX idx_audit_log_entries_actor ON audit_log_entries(organization_id, actor_id, created_at DESC);
+
+--
+-- Audit log entries are never updated or deleted,
+-- not even when the organization is deleted.
+--
+CREATE FUNCTION prevent_audit_log_entries_changes() RETURNS trigger
+  LANGUAGE plpgsql
+  AS $$
+BEGIN
+  RAISE EXCEPTION 'audit log entries are append-only';
+END;
+$$;
+
+CREATE TRIGGER audit_log_entries_append_only
+  BEFORE UPDATE OR DELETE ON audit_log_entries
+  FOR EACH ROW EXECUTE FUNCTION prevent_audit_log_entries_changes();
+
+COMMIT;
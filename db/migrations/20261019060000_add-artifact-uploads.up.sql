@@ -0,0 +1,21 @@
+BEGIN;
+
+--
+-- Artifact objects are written to the storage while the execution
+-- transaction is still open, so if it rolls back, the object is left
+-- without an artifact row referencing it. Uploads are recorded here,
+-- outside of that transaction, so those objects can be found and deleted.
+--
+CREATE TABLE artifact_uploads (
+  id          uuid NOT NULL DEFAULT gen_random_uuid(),
+  storage     CHARACTER VARYING(32) NOT NULL,
+  storage_key TEXT NOT NULL,
+  created_at  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_artifact_uploads_created_at ON artifact_uploads(created_at);
+CREATE INDEX idx_workflow_node_execution_artifacts_storage_key ON workflow_node_execution_artifacts(storage_key);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+--
+-- The key of the queue policy of the node, resolved when the item is queued,
+-- so the queue worker can group the items without resolving it on every tick.
+--
+ALTER TABLE workflow_node_queue_items ADD COLUMN queue_key TEXT;
+
+COMMIT;
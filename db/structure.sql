@@ -33,6 +33,19 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
 COMMENT ON EXTENSION "uuid-ossp" IS 'generate universally unique identifiers (UUIDs)';
 
 
+--
+-- Name: prevent_audit_log_entries_changes(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.prevent_audit_log_entries_changes() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+  RAISE EXCEPTION 'audit log entries are append-only';
+END;
+$$;
+
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
@@ -146,7 +159,40 @@ CREATE TABLE public.app_installations (
     browser_action jsonb,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    deleted_at timestamp with time zone
+    deleted_at timestamp with time zone,
+    rate_limit jsonb
+);
+
+
+--
+-- Name: artifact_uploads; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.artifact_uploads (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    storage character varying(32) NOT NULL,
+    storage_key text NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: audit_log_entries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_log_entries (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    actor_type character varying(32) NOT NULL,
+    actor_id uuid,
+    method character varying(255) NOT NULL,
+    resource character varying(64) NOT NULL,
+    action character varying(32) NOT NULL,
+    resource_id character varying(255),
+    request jsonb DEFAULT '{}'::jsonb NOT NULL,
+    result character varying(32) NOT NULL,
+    error text,
+    created_at timestamp without time zone NOT NULL
 );
 
 
@@ -310,6 +356,35 @@ CREATE TABLE public.organizations (
 );
 
 
+--
+-- Name: rate_limit_buckets; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.rate_limit_buckets (
+    key character varying(255) NOT NULL,
+    tokens double precision NOT NULL,
+    refilled_at timestamp without time zone NOT NULL,
+    blocked_until timestamp without time zone,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: retention_policies; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.retention_policies (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    workflow_id uuid,
+    max_age_days integer DEFAULT 0 NOT NULL,
+    max_runs integer DEFAULT 0 NOT NULL,
+    archive boolean DEFAULT false NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: role_metadata; Type: TABLE; Schema: public; Owner: -
 --
@@ -336,6 +411,26 @@ CREATE TABLE public.schema_migrations (
 );
 
 
+--
+-- Name: secret_access_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.secret_access_events (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    secret_id uuid NOT NULL,
+    secret_name character varying(128) NOT NULL,
+    action character varying(32) NOT NULL,
+    key_name character varying(255),
+    actor_type character varying(32) NOT NULL,
+    actor_id uuid,
+    workflow_id uuid,
+    node_id character varying(128),
+    execution_id uuid,
+    created_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: secrets; Type: TABLE; Schema: public; Owner: -
 --
@@ -349,7 +444,10 @@ CREATE TABLE public.secrets (
     provider character varying(64) NOT NULL,
     data bytea NOT NULL,
     domain_type character varying(64) NOT NULL,
-    domain_id character varying(64) NOT NULL
+    domain_id character varying(64) NOT NULL,
+    workflow_id uuid,
+    node_id character varying(128),
+    access_policy jsonb
 );
 
 
@@ -392,6 +490,47 @@ CREATE TABLE public.webhooks (
 );
 
 
+--
+-- Name: workflow_change_requests; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_change_requests (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    workflow_id uuid NOT NULL,
+    base_version_id uuid,
+    name character varying(128) NOT NULL,
+    description text,
+    nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    edges jsonb DEFAULT '[]'::jsonb NOT NULL,
+    message text,
+    approval_role character varying(255) NOT NULL,
+    state character varying(32) NOT NULL,
+    requested_by uuid,
+    reviewed_by uuid,
+    reviewed_at timestamp without time zone,
+    published_version_id uuid,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: workflow_drafts; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_drafts (
+    workflow_id uuid NOT NULL,
+    base_version_id uuid,
+    name character varying(128) NOT NULL,
+    description text,
+    nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    edges jsonb DEFAULT '[]'::jsonb NOT NULL,
+    updated_by uuid,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: workflow_events; Type: TABLE; Schema: public; Owner: -
 --
@@ -405,7 +544,29 @@ CREATE TABLE public.workflow_events (
     state character varying(32) NOT NULL,
     execution_id uuid,
     created_at timestamp without time zone NOT NULL,
-    custom_name text
+    custom_name text,
+    invoked_by_execution_id uuid,
+    traceparent character varying(55),
+    priority integer DEFAULT 0 NOT NULL
+);
+
+
+--
+-- Name: workflow_node_execution_artifacts; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_artifacts (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    execution_id uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    content_type character varying(255) NOT NULL,
+    size bigint NOT NULL,
+    sha256 character varying(64) NOT NULL,
+    storage character varying(32) NOT NULL,
+    storage_key text NOT NULL,
+    created_at timestamp without time zone NOT NULL
 );
 
 
@@ -424,6 +585,41 @@ CREATE TABLE public.workflow_node_execution_kvs (
 );
 
 
+--
+-- Name: workflow_node_execution_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_logs (
+    id bigint NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    execution_id uuid NOT NULL,
+    stream character varying(16) NOT NULL,
+    level character varying(16) NOT NULL,
+    message text NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: workflow_node_execution_logs_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE public.workflow_node_execution_logs_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: workflow_node_execution_logs_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE public.workflow_node_execution_logs_id_seq OWNED BY public.workflow_node_execution_logs.id;
+
+
 --
 -- Name: workflow_node_executions; Type: TABLE; Schema: public; Owner: -
 --
@@ -444,7 +640,15 @@ CREATE TABLE public.workflow_node_executions (
     configuration jsonb DEFAULT '{}'::jsonb NOT NULL,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    cancelled_by uuid
+    cancelled_by uuid,
+    attempt integer DEFAULT 1 NOT NULL,
+    retry_of_execution_id uuid,
+    deadline_at timestamp without time zone,
+    workflow_version_id uuid,
+    traceparent character varying(55),
+    finished_at timestamp without time zone,
+    priority integer DEFAULT 0 NOT NULL,
+    run_after timestamp without time zone
 );
 
 
@@ -458,7 +662,10 @@ CREATE TABLE public.workflow_node_queue_items (
     node_id character varying(128) NOT NULL,
     root_event_id uuid,
     event_id uuid,
-    created_at timestamp without time zone NOT NULL
+    created_at timestamp without time zone NOT NULL,
+    traceparent character varying(55),
+    priority integer DEFAULT 0 NOT NULL,
+    queue_key text
 );
 
 
@@ -501,7 +708,61 @@ CREATE TABLE public.workflow_nodes (
     parent_node_id character varying(128),
     deleted_at timestamp with time zone,
     app_installation_id uuid,
-    state_reason character varying(255) DEFAULT NULL::character varying
+    state_reason character varying(255) DEFAULT NULL::character varying,
+    concurrency_group character varying(128),
+    concurrency_policy character varying(32),
+    retry_policy jsonb,
+    execution_timeout_seconds integer,
+    queue_policy jsonb,
+    rate_limit jsonb
+);
+
+
+--
+-- Name: workflow_retention_stats; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_retention_stats (
+    workflow_id uuid NOT NULL,
+    last_enforced_at timestamp without time zone,
+    deleted_runs bigint DEFAULT 0 NOT NULL,
+    deleted_executions bigint DEFAULT 0 NOT NULL,
+    archived_executions bigint DEFAULT 0 NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: workflow_variables; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_variables (
+    workflow_id uuid NOT NULL,
+    name character varying(128) NOT NULL,
+    value jsonb NOT NULL,
+    version integer DEFAULT 1 NOT NULL,
+    updated_by uuid,
+    updated_by_execution_id uuid,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: workflow_versions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_versions (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    workflow_id uuid NOT NULL,
+    version integer NOT NULL,
+    name character varying(128) NOT NULL,
+    description text,
+    nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    edges jsonb DEFAULT '[]'::jsonb NOT NULL,
+    message text,
+    created_by uuid,
+    created_at timestamp without time zone NOT NULL
 );
 
 
@@ -531,6 +792,13 @@ CREATE TABLE public.workflows (
 ALTER TABLE ONLY public.casbin_rule ALTER COLUMN id SET DEFAULT nextval('public.casbin_rule_id_seq'::regclass);
 
 
+--
+-- Name: workflow_node_execution_logs id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs ALTER COLUMN id SET DEFAULT nextval('public.workflow_node_execution_logs_id_seq'::regclass);
+
+
 --
 -- Name: account_password_auth account_password_auth_account_id_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -635,6 +903,22 @@ ALTER TABLE ONLY public.blueprints
     ADD CONSTRAINT blueprints_organization_id_name_key UNIQUE (organization_id, name);
 
 
+--
+-- Name: artifact_uploads artifact_uploads_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.artifact_uploads
+    ADD CONSTRAINT artifact_uploads_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: audit_log_entries audit_log_entries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_log_entries
+    ADD CONSTRAINT audit_log_entries_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: blueprints blueprints_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -739,6 +1023,22 @@ ALTER TABLE ONLY public.organizations
     ADD CONSTRAINT organizations_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: rate_limit_buckets rate_limit_buckets_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.rate_limit_buckets
+    ADD CONSTRAINT rate_limit_buckets_pkey PRIMARY KEY (key);
+
+
+--
+-- Name: retention_policies retention_policies_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_policies
+    ADD CONSTRAINT retention_policies_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: role_metadata role_metadata_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -763,6 +1063,14 @@ ALTER TABLE ONLY public.secrets
     ADD CONSTRAINT secrets_domain_id_name_key UNIQUE (domain_type, domain_id, name);
 
 
+--
+-- Name: secret_access_events secret_access_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.secret_access_events
+    ADD CONSTRAINT secret_access_events_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: secrets secrets_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -803,6 +1111,22 @@ ALTER TABLE ONLY public.webhooks
     ADD CONSTRAINT webhooks_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_change_requests workflow_change_requests_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_change_requests
+    ADD CONSTRAINT workflow_change_requests_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: workflow_drafts workflow_drafts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_drafts
+    ADD CONSTRAINT workflow_drafts_pkey PRIMARY KEY (workflow_id);
+
+
 --
 -- Name: workflow_events workflow_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -811,6 +1135,22 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_node_execution_artifacts workflow_node_execution_artifacts_execution_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_artifacts
+    ADD CONSTRAINT workflow_node_execution_artifacts_execution_id_name_key UNIQUE (execution_id, name);
+
+
+--
+-- Name: workflow_node_execution_artifacts workflow_node_execution_artifacts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_artifacts
+    ADD CONSTRAINT workflow_node_execution_artifacts_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: workflow_node_execution_kvs workflow_node_execution_kvs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -819,6 +1159,14 @@ ALTER TABLE ONLY public.workflow_node_execution_kvs
     ADD CONSTRAINT workflow_node_execution_kvs_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_node_execution_logs workflow_node_execution_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs
+    ADD CONSTRAINT workflow_node_execution_logs_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: workflow_node_requests workflow_node_execution_requests_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -851,6 +1199,38 @@ ALTER TABLE ONLY public.workflow_nodes
     ADD CONSTRAINT workflow_nodes_pkey PRIMARY KEY (workflow_id, node_id);
 
 
+--
+-- Name: workflow_retention_stats workflow_retention_stats_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_retention_stats
+    ADD CONSTRAINT workflow_retention_stats_pkey PRIMARY KEY (workflow_id);
+
+
+--
+-- Name: workflow_variables workflow_variables_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_variables
+    ADD CONSTRAINT workflow_variables_pkey PRIMARY KEY (workflow_id, name);
+
+
+--
+-- Name: workflow_versions workflow_versions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_versions
+    ADD CONSTRAINT workflow_versions_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: workflow_versions workflow_versions_workflow_id_version_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_versions
+    ADD CONSTRAINT workflow_versions_workflow_id_version_key UNIQUE (workflow_id, version);
+
+
 --
 -- Name: workflows workflows_organization_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -958,6 +1338,27 @@ CREATE UNIQUE INDEX idx_app_installations_org_name_unique ON public.app_installa
 CREATE INDEX idx_app_installations_organization_id ON public.app_installations USING btree (organization_id);
 
 
+--
+-- Name: idx_artifact_uploads_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_artifact_uploads_created_at ON public.artifact_uploads USING btree (created_at);
+
+
+--
+-- Name: idx_audit_log_entries_actor; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_log_entries_actor ON public.audit_log_entries USING btree (organization_id, actor_id, created_at DESC);
+
+
+--
+-- Name: idx_audit_log_entries_organization; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_log_entries_organization ON public.audit_log_entries USING btree (organization_id, created_at DESC);
+
+
 --
 -- Name: idx_blueprints_organization_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1014,6 +1415,20 @@ CREATE INDEX idx_node_requests_state_run_at ON public.workflow_node_requests USI
 CREATE INDEX idx_organizations_deleted_at ON public.organizations USING btree (deleted_at);
 
 
+--
+-- Name: idx_retention_policies_organization_unique; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_retention_policies_organization_unique ON public.retention_policies USING btree (organization_id) WHERE (workflow_id IS NULL);
+
+
+--
+-- Name: idx_retention_policies_workflow_unique; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_retention_policies_workflow_unique ON public.retention_policies USING btree (workflow_id) WHERE (workflow_id IS NOT NULL);
+
+
 --
 -- Name: idx_role_metadata_lookup; Type: INDEX; Schema: public; Owner: -
 --
@@ -1021,6 +1436,27 @@ CREATE INDEX idx_organizations_deleted_at ON public.organizations USING btree (d
 CREATE INDEX idx_role_metadata_lookup ON public.role_metadata USING btree (role_name, domain_type, domain_id);
 
 
+--
+-- Name: idx_secret_access_events_secret; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_secret_access_events_secret ON public.secret_access_events USING btree (organization_id, secret_id, created_at DESC);
+
+
+--
+-- Name: idx_secret_access_events_secret_name; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_secret_access_events_secret_name ON public.secret_access_events USING btree (organization_id, secret_name, created_at DESC);
+
+
+--
+-- Name: idx_secrets_workflow_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_secrets_workflow_id ON public.secrets USING btree (workflow_id);
+
+
 --
 -- Name: idx_webhooks_app_installation_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1035,6 +1471,13 @@ CREATE INDEX idx_webhooks_app_installation_id ON public.webhooks USING btree (ap
 CREATE INDEX idx_webhooks_deleted_at ON public.webhooks USING btree (deleted_at);
 
 
+--
+-- Name: idx_workflow_change_requests_workflow_id_state; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_change_requests_workflow_id_state ON public.workflow_change_requests USING btree (workflow_id, state);
+
+
 --
 -- Name: idx_workflow_events_execution_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1042,6 +1485,20 @@ CREATE INDEX idx_webhooks_deleted_at ON public.webhooks USING btree (deleted_at)
 CREATE INDEX idx_workflow_events_execution_id ON public.workflow_events USING btree (execution_id);
 
 
+--
+-- Name: idx_workflow_events_invoked_by_execution_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_events_invoked_by_execution_id ON public.workflow_events USING btree (invoked_by_execution_id);
+
+
+--
+-- Name: idx_workflow_events_root_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_events_root_created_at ON public.workflow_events USING btree (workflow_id, created_at) WHERE (execution_id IS NULL);
+
+
 --
 -- Name: idx_workflow_events_state; Type: INDEX; Schema: public; Owner: -
 --
@@ -1056,6 +1513,13 @@ CREATE INDEX idx_workflow_events_state ON public.workflow_events USING btree (st
 CREATE INDEX idx_workflow_events_workflow_node_id ON public.workflow_events USING btree (workflow_id, node_id);
 
 
+--
+-- Name: idx_workflow_node_execution_artifacts_storage_key; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_artifacts_storage_key ON public.workflow_node_execution_artifacts USING btree (storage_key);
+
+
 --
 -- Name: idx_workflow_node_execution_kvs_ekv; Type: INDEX; Schema: public; Owner: -
 --
@@ -1070,6 +1534,20 @@ CREATE INDEX idx_workflow_node_execution_kvs_ekv ON public.workflow_node_executi
 CREATE INDEX idx_workflow_node_execution_kvs_workflow_node_key_value ON public.workflow_node_execution_kvs USING btree (workflow_id, node_id, key, value);
 
 
+--
+-- Name: idx_workflow_node_execution_logs_execution; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_execution ON public.workflow_node_execution_logs USING btree (execution_id, id);
+
+
+--
+-- Name: idx_workflow_node_execution_logs_workflow_node; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_workflow_node ON public.workflow_node_execution_logs USING btree (workflow_id, node_id);
+
+
 --
 -- Name: idx_workflow_node_executions_event_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1077,6 +1555,13 @@ CREATE INDEX idx_workflow_node_execution_kvs_workflow_node_key_value ON public.w
 CREATE INDEX idx_workflow_node_executions_event_id ON public.workflow_node_executions USING btree (event_id);
 
 
+--
+-- Name: idx_workflow_node_executions_finished_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_finished_at ON public.workflow_node_executions USING btree (finished_at);
+
+
 --
 -- Name: idx_workflow_node_executions_parent_execution_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1098,6 +1583,13 @@ CREATE INDEX idx_workflow_node_executions_parent_state ON public.workflow_node_e
 CREATE INDEX idx_workflow_node_executions_previous_execution_id ON public.workflow_node_executions USING btree (previous_execution_id);
 
 
+--
+-- Name: idx_workflow_node_executions_retry_of_execution_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_retry_of_execution_id ON public.workflow_node_executions USING btree (retry_of_execution_id);
+
+
 --
 -- Name: idx_workflow_node_executions_root_event_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1119,6 +1611,13 @@ CREATE INDEX idx_workflow_node_executions_state_created_at ON public.workflow_no
 CREATE INDEX idx_workflow_node_executions_workflow_node_id ON public.workflow_node_executions USING btree (workflow_id, node_id);
 
 
+--
+-- Name: idx_workflow_node_executions_workflow_version_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_workflow_version_id ON public.workflow_node_executions USING btree (workflow_version_id);
+
+
 --
 -- Name: idx_workflow_node_installation_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1126,6 +1625,13 @@ CREATE INDEX idx_workflow_node_executions_workflow_node_id ON public.workflow_no
 CREATE INDEX idx_workflow_node_installation_id ON public.workflow_nodes USING btree (app_installation_id);
 
 
+--
+-- Name: idx_workflow_node_queue_items_priority; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_queue_items_priority ON public.workflow_node_queue_items USING btree (workflow_id, node_id, priority DESC, created_at);
+
+
 --
 -- Name: idx_workflow_node_queue_items_root_event_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1140,6 +1646,13 @@ CREATE INDEX idx_workflow_node_queue_items_root_event_id ON public.workflow_node
 CREATE INDEX idx_workflow_node_requests_execution_id ON public.workflow_node_requests USING btree (execution_id);
 
 
+--
+-- Name: idx_workflow_nodes_concurrency_group; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_nodes_concurrency_group ON public.workflow_nodes USING btree (concurrency_group) WHERE (concurrency_group IS NOT NULL);
+
+
 --
 -- Name: idx_workflow_nodes_deleted_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -1196,6 +1709,13 @@ CREATE UNIQUE INDEX unique_human_user_in_organization ON public.users USING btre
 CREATE UNIQUE INDEX unique_service_account_in_organization ON public.users USING btree (organization_id, name) WHERE ((type)::text = 'service_account'::text);
 
 
+--
+-- Name: audit_log_entries audit_log_entries_append_only; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER audit_log_entries_append_only BEFORE DELETE OR UPDATE ON public.audit_log_entries FOR EACH ROW EXECUTE FUNCTION public.prevent_audit_log_entries_changes();
+
+
 --
 -- Name: account_password_auth account_password_auth_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1340,6 +1860,38 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: retention_policies retention_policies_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_policies
+    ADD CONSTRAINT retention_policies_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: retention_policies retention_policies_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_policies
+    ADD CONSTRAINT retention_policies_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
+--
+-- Name: secret_access_events secret_access_events_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.secret_access_events
+    ADD CONSTRAINT secret_access_events_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: secrets secrets_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.secrets
+    ADD CONSTRAINT secrets_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
 --
 -- Name: users users_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1372,6 +1924,46 @@ ALTER TABLE ONLY public.webhooks
     ADD CONSTRAINT webhooks_app_installation_id_fkey FOREIGN KEY (app_installation_id) REFERENCES public.app_installations(id);
 
 
+--
+-- Name: workflow_change_requests workflow_change_requests_base_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_change_requests
+    ADD CONSTRAINT workflow_change_requests_base_version_id_fkey FOREIGN KEY (base_version_id) REFERENCES public.workflow_versions(id) ON DELETE SET NULL;
+
+
+--
+-- Name: workflow_change_requests workflow_change_requests_published_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_change_requests
+    ADD CONSTRAINT workflow_change_requests_published_version_id_fkey FOREIGN KEY (published_version_id) REFERENCES public.workflow_versions(id) ON DELETE SET NULL;
+
+
+--
+-- Name: workflow_change_requests workflow_change_requests_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_change_requests
+    ADD CONSTRAINT workflow_change_requests_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workflow_drafts workflow_drafts_base_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_drafts
+    ADD CONSTRAINT workflow_drafts_base_version_id_fkey FOREIGN KEY (base_version_id) REFERENCES public.workflow_versions(id) ON DELETE SET NULL;
+
+
+--
+-- Name: workflow_drafts workflow_drafts_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_drafts
+    ADD CONSTRAINT workflow_drafts_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_events workflow_events_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1380,6 +1972,14 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_execution_id_fkey FOREIGN KEY (execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE CASCADE;
 
 
+--
+-- Name: workflow_events workflow_events_invoked_by_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_events
+    ADD CONSTRAINT workflow_events_invoked_by_execution_id_fkey FOREIGN KEY (invoked_by_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
+
+
 --
 -- Name: workflow_events workflow_events_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1388,6 +1988,22 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id);
 
 
+--
+-- Name: workflow_node_execution_artifacts workflow_node_execution_artifacts_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_artifacts
+    ADD CONSTRAINT workflow_node_execution_artifacts_execution_id_fkey FOREIGN KEY (execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workflow_node_execution_artifacts workflow_node_execution_artifacts_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_artifacts
+    ADD CONSTRAINT workflow_node_execution_artifacts_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_node_execution_kvs workflow_node_execution_kvs_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1420,6 +2036,22 @@ ALTER TABLE ONLY public.workflow_node_executions
     ADD CONSTRAINT workflow_node_executions_previous_execution_id_fkey FOREIGN KEY (previous_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
 
 
+--
+-- Name: workflow_node_executions workflow_node_executions_retry_of_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_executions
+    ADD CONSTRAINT workflow_node_executions_retry_of_execution_id_fkey FOREIGN KEY (retry_of_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
+
+
+--
+-- Name: workflow_node_executions workflow_node_executions_workflow_version_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_executions
+    ADD CONSTRAINT workflow_node_executions_workflow_version_id_fkey FOREIGN KEY (workflow_version_id) REFERENCES public.workflow_versions(id) ON DELETE SET NULL;
+
+
 --
 -- Name: workflow_node_executions workflow_node_executions_root_event_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1500,6 +2132,30 @@ ALTER TABLE ONLY public.workflow_nodes
     ADD CONSTRAINT workflow_nodes_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id);
 
 
+--
+-- Name: workflow_retention_stats workflow_retention_stats_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_retention_stats
+    ADD CONSTRAINT workflow_retention_stats_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workflow_variables workflow_variables_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_variables
+    ADD CONSTRAINT workflow_variables_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workflow_versions workflow_versions_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_versions
+    ADD CONSTRAINT workflow_versions_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
 --
 -- PostgreSQL database dump complete
 --
@@ -1532,7 +2188,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20260216151135	f
+20261019070000	f
 \.
 
 
@@ -0,0 +1,47 @@
+package artifacts
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__LocalStorage(t *testing.T) {
+	storage, err := NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	key := "canvas/execution/report.txt"
+
+	t.Run("stored artifact can be read back", func(t *testing.T) {
+		require.NoError(t, storage.Put(ctx, key, strings.NewReader("all tests passed"), 16, "text/plain"))
+
+		reader, err := storage.Get(ctx, key)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "all tests passed", string(content))
+	})
+
+	t.Run("deleted artifact is not found", func(t *testing.T) {
+		require.NoError(t, storage.Delete(ctx, key))
+		require.NoError(t, storage.Delete(ctx, key))
+
+		_, err := storage.Get(ctx, key)
+		require.ErrorIs(t, err, ErrArtifactNotFound)
+	})
+
+	t.Run("keys cannot escape the directory", func(t *testing.T) {
+		err := storage.Put(ctx, "../outside.txt", strings.NewReader("x"), 1, "text/plain")
+		require.ErrorContains(t, err, "invalid artifact key")
+
+		_, err = storage.Get(ctx, "/etc/passwd")
+		require.ErrorContains(t, err, "invalid artifact key")
+	})
+}
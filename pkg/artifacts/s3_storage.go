@@ -0,0 +1,197 @@
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const DefaultS3Region = "us-east-1"
+
+// Hash of an empty payload, used to sign requests without a body.
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+type S3Options struct {
+	Bucket          string
+	Region          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+
+	//
+	// Endpoint of an S3-compatible service, like MinIO.
+	// When set, path-style URLs are used: <endpoint>/<bucket>/<key>.
+	// Otherwise, virtual-hosted URLs for AWS S3 are used.
+	//
+	Endpoint string
+
+	HTTPClient *http.Client
+}
+
+// S3Storage keeps artifacts as objects in an S3 bucket.
+// Requests are signed with SigV4, so any S3-compatible service works.
+type S3Storage struct {
+	options     S3Options
+	credentials aws.Credentials
+	signer      *v4.Signer
+	client      *http.Client
+}
+
+func NewS3Storage(options S3Options) (*S3Storage, error) {
+	if options.Bucket == "" {
+		return nil, fmt.Errorf("S3 bucket is required")
+	}
+
+	if options.AccessKeyID == "" || options.SecretAccessKey == "" {
+		return nil, fmt.Errorf("S3 credentials are required")
+	}
+
+	if options.Region == "" {
+		options.Region = DefaultS3Region
+	}
+
+	options.Endpoint = strings.TrimSuffix(options.Endpoint, "/")
+	options.Prefix = strings.Trim(options.Prefix, "/")
+
+	client := options.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+
+	return &S3Storage{
+		options: options,
+		credentials: aws.Credentials{
+			AccessKeyID:     options.AccessKeyID,
+			SecretAccessKey: options.SecretAccessKey,
+		},
+		signer: v4.NewSigner(),
+		client: client,
+	}, nil
+}
+
+func (s *S3Storage) Name() string {
+	return StorageS3
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	//
+	// SigV4 needs the hash of the payload before sending it,
+	// so the content is read in full. Artifacts are size-limited.
+	//
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(data)
+	req, err := s.newRequest(ctx, http.MethodPut, key, bytes.NewReader(data), hex.EncodeToString(hash[:]))
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = int64(len(data))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	response, err := s.do(req, hex.EncodeToString(hash[:]))
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+	return checkResponse(response, http.StatusOK)
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		response.Body.Close()
+		return nil, ErrArtifactNotFound
+	}
+
+	err = checkResponse(response, http.StatusOK)
+	if err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+
+	return response.Body, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+	return checkResponse(response, http.StatusOK, http.StatusNoContent, http.StatusNotFound)
+}
+
+func (s *S3Storage) objectURL(key string) string {
+	if s.options.Prefix != "" {
+		key = s.options.Prefix + "/" + key
+	}
+
+	escaped := (&url.URL{Path: key}).EscapedPath()
+	if s.options.Endpoint != "" {
+		return fmt.Sprintf("%s/%s/%s", s.options.Endpoint, s.options.Bucket, escaped)
+	}
+
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.options.Bucket, s.options.Region, escaped)
+}
+
+func (s *S3Storage) newRequest(ctx context.Context, method, key string, body io.Reader, payloadHash string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	return req, nil
+}
+
+func (s *S3Storage) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	err := s.signer.SignHTTP(req.Context(), s.credentials, req, payloadHash, "s3", s.options.Region, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error signing request: %v", err)
+	}
+
+	return s.client.Do(req)
+}
+
+func checkResponse(response *http.Response, expected ...int) error {
+	for _, code := range expected {
+		if response.StatusCode == code {
+			return nil
+		}
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	return fmt.Errorf("S3 request failed with %d: %s", response.StatusCode, strings.TrimSpace(string(body)))
+}
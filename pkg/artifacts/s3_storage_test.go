@@ -0,0 +1,120 @@
+package artifacts
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is a minimal S3-compatible server, which keeps objects in memory
+// and checks that requests are signed, and that the payload hash is correct.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key-id/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		hash := sha256.Sum256(data)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(hash[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		f.objects[r.URL.Path] = data
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write(data)
+
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func Test__S3Storage(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}, types: map[string]string{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	storage, err := NewS3Storage(S3Options{
+		Bucket:          "artifacts",
+		Endpoint:        server.URL,
+		Prefix:          "superplane",
+		AccessKeyID:     "key-id",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	key := "canvas/execution/sbom.json"
+
+	t.Run("stored artifact can be read back", func(t *testing.T) {
+		require.NoError(t, storage.Put(ctx, key, strings.NewReader(`{"packages":[]}`), 15, "application/json"))
+		assert.Equal(t, "application/json", fake.types["/artifacts/superplane/canvas/execution/sbom.json"])
+
+		reader, err := storage.Get(ctx, key)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, `{"packages":[]}`, string(content))
+	})
+
+	t.Run("deleted artifact is not found", func(t *testing.T) {
+		require.NoError(t, storage.Delete(ctx, key))
+
+		_, err := storage.Get(ctx, key)
+		require.ErrorIs(t, err, ErrArtifactNotFound)
+	})
+
+	t.Run("errors from the server are returned", func(t *testing.T) {
+		storage, err := NewS3Storage(S3Options{
+			Bucket:          "artifacts",
+			Endpoint:        server.URL,
+			AccessKeyID:     "other-key-id",
+			SecretAccessKey: "secret",
+		})
+		require.NoError(t, err)
+
+		err = storage.Put(ctx, key, strings.NewReader("x"), 1, "text/plain")
+		require.ErrorContains(t, err, "S3 request failed with 403")
+	})
+
+	t.Run("bucket and credentials are required", func(t *testing.T) {
+		_, err := NewS3Storage(S3Options{AccessKeyID: "key-id", SecretAccessKey: "secret"})
+		require.ErrorContains(t, err, "bucket is required")
+
+		_, err = NewS3Storage(S3Options{Bucket: "artifacts"})
+		require.ErrorContains(t, err, "credentials are required")
+	})
+}
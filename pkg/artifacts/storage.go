@@ -0,0 +1,91 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	StorageLocal = "local"
+	StorageS3    = "s3"
+
+	// Artifacts are read in full by the API when downloaded,
+	// so they are kept well below what fits in a single gRPC message.
+	DefaultMaxSize int64 = 25 * 1024 * 1024
+)
+
+var ErrArtifactNotFound = errors.New("artifact not found")
+
+// Storage is where the content of execution artifacts is kept.
+// Artifact metadata lives in the database, and references objects here by key.
+type Storage interface {
+	Name() string
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+/*
+ * Builds the artifact storage from the environment:
+ *
+ * - ARTIFACTS_STORAGE: "local" (default) or "s3".
+ * - ARTIFACTS_LOCAL_DIR: directory used by the local storage.
+ *   It should be on a volume shared by every process, and kept across restarts.
+ *   Without it, a temporary directory is used, which is only fit for development.
+ * - ARTIFACTS_S3_BUCKET, ARTIFACTS_S3_REGION, ARTIFACTS_S3_ACCESS_KEY_ID and ARTIFACTS_S3_SECRET_ACCESS_KEY.
+ * - ARTIFACTS_S3_ENDPOINT: optional, for S3-compatible services like MinIO.
+ * - ARTIFACTS_S3_PREFIX: optional prefix for all object keys.
+ */
+func NewStorageFromEnv() (Storage, error) {
+	switch os.Getenv("ARTIFACTS_STORAGE") {
+	case "", StorageLocal:
+		dir := os.Getenv("ARTIFACTS_LOCAL_DIR")
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "superplane", "artifacts")
+			log.Warnf(
+				"ARTIFACTS_LOCAL_DIR is not set - artifacts are stored in %s, "+
+					"lost on restart, and not readable by other processes. "+
+					"Set ARTIFACTS_LOCAL_DIR to a shared volume, or use ARTIFACTS_STORAGE=s3.",
+				dir,
+			)
+		}
+
+		return NewLocalStorage(dir)
+
+	case StorageS3:
+		return NewS3Storage(S3Options{
+			Bucket:          os.Getenv("ARTIFACTS_S3_BUCKET"),
+			Region:          os.Getenv("ARTIFACTS_S3_REGION"),
+			Endpoint:        os.Getenv("ARTIFACTS_S3_ENDPOINT"),
+			Prefix:          os.Getenv("ARTIFACTS_S3_PREFIX"),
+			AccessKeyID:     os.Getenv("ARTIFACTS_S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("ARTIFACTS_S3_SECRET_ACCESS_KEY"),
+		})
+
+	default:
+		return nil, fmt.Errorf("artifact storage not supported: %s", os.Getenv("ARTIFACTS_STORAGE"))
+	}
+}
+
+// MaxSizeFromEnv returns the maximum artifact size,
+// which can be lowered with ARTIFACTS_MAX_SIZE, in bytes.
+func MaxSizeFromEnv() int64 {
+	value := os.Getenv("ARTIFACTS_MAX_SIZE")
+	if value == "" {
+		return DefaultMaxSize
+	}
+
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size <= 0 || size > DefaultMaxSize {
+		return DefaultMaxSize
+	}
+
+	return size
+}
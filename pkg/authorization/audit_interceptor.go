@@ -0,0 +1,463 @@
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/models"
+	pbMe "github.com/superplanehq/superplane/pkg/protos/me"
+	pbOrganization "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gorm.io/datatypes"
+)
+
+const RedactedValue = "[REDACTED]"
+
+// Fields holding the values of secrets.
+// Keys of maps are kept, so the entry still shows what was changed.
+var secretValueFields = map[protoreflect.FullName]bool{
+	"Superplane.Secrets.Secret.Local.data":         true,
+	"Superplane.Secrets.SetSecretKeyRequest.value": true,
+}
+
+// Configuration fields are redacted if they are marked as sensitive.
+// For values not described by any configuration field, e.g. the configuration
+// of a component that is no longer registered, values of fields containing
+// any of these, or ending with "key", are redacted too.
+var sensitiveFieldParts = []string{
+	"password",
+	"passphrase",
+	"token",
+	"secret",
+	"credential",
+}
+
+// References to secrets are not sensitive themselves,
+// and neither are the keys used by queue policies.
+var allowedSensitiveFields = map[string]bool{
+	"secret":     true,
+	"secretid":   true,
+	"secretname": true,
+	"key":        true,
+	"publickey":  true,
+}
+
+// Mutating calls that do not require a role in an organization,
+// so the authorization interceptor has no rules for them.
+var auditedMethodsWithoutRules = map[string]AuthorizationRule{
+	pbMe.Me_RegenerateToken_FullMethodName:                       {Resource: "tokens", Action: "update"},
+	pbOrganization.Organizations_AcceptInviteLink_FullMethodName: {Resource: "members", Action: "create"},
+}
+
+// The first one of these set in the request
+// is recorded as the ID of the resource being changed.
+var resourceIDFields = []protoreflect.Name{
+	"canvas_id",
+	"integration_id",
+	"id_or_name",
+	"group_name",
+	"role_name",
+	"id",
+}
+
+// AuditInterceptor records every mutating call to the audit log.
+// A call is mutating if its authorization rule requires anything other than read access,
+// or if it is one of the mutating calls without authorization rules.
+// Calls rejected by the authorization interceptor are recorded too.
+type AuditInterceptor struct {
+	registry *registry.Registry
+	methods  map[string]AuthorizationRule
+}
+
+func NewAuditInterceptor(authorizationInterceptor *AuthorizationInterceptor, registry *registry.Registry) *AuditInterceptor {
+	methods := map[string]AuthorizationRule{}
+	for method, rule := range authorizationInterceptor.rules {
+		if rule.Action != "read" {
+			methods[method] = rule
+		}
+	}
+
+	for method, rule := range auditedMethodsWithoutRules {
+		methods[method] = rule
+	}
+
+	return &AuditInterceptor{
+		registry: registry,
+		methods:  methods,
+	}
+}
+
+// Audits returns true if calls to the method are recorded.
+func (a *AuditInterceptor) Audits(method string) bool {
+	_, ok := a.methods[method]
+	return ok
+}
+
+func (a *AuditInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		rule, ok := a.methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+		a.record(ctx, info.FullMethod, rule, req, err)
+		return resp, err
+	}
+}
+
+// The call has already been handled at this point,
+// so failing to record it is logged, but does not fail the call.
+func (a *AuditInterceptor) record(ctx context.Context, method string, rule AuthorizationRule, req interface{}, callErr error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	organizationID, err := findOrganizationID(md, req)
+	if err != nil {
+		return
+	}
+
+	entry := models.NewAuditLogEntry(organizationID, findUserID(md, organizationID))
+	entry.Method = method
+	entry.Resource = rule.Resource
+	entry.Action = rule.Action
+	entry.Result = status.Code(callErr).String()
+
+	if callErr != nil {
+		message := status.Convert(callErr).Message()
+		entry.Error = &message
+	}
+
+	if message, ok := req.(proto.Message); ok {
+		entry.Request = datatypes.NewJSONType(a.RedactRequest(organizationID, message))
+		if resourceID := findResourceID(message); resourceID != "" {
+			entry.ResourceID = &resourceID
+		}
+	}
+
+	err = entry.Create()
+	if err != nil {
+		log.Errorf("failed to record audit log entry for %s in organization %s: %v", method, organizationID, err)
+	}
+}
+
+// Invite links are accepted by accounts that are not members of the organization yet,
+// so the organization comes from the invite link instead of the metadata.
+func findOrganizationID(md metadata.MD, req interface{}) (uuid.UUID, error) {
+	if inviteLink, ok := req.(*pbOrganization.InviteLink); ok {
+		link, err := models.FindInviteLinkByToken(inviteLink.Token)
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		return link.OrganizationID, nil
+	}
+
+	return uuid.Parse(firstMetadataValue(md, "x-organization-id"))
+}
+
+func findUserID(md metadata.MD, organizationID uuid.UUID) string {
+	userID := firstMetadataValue(md, "x-user-id")
+	if userID != "" {
+		return userID
+	}
+
+	accountID := firstMetadataValue(md, "x-account-id")
+	if accountID == "" {
+		return ""
+	}
+
+	account, err := models.FindAccountByID(accountID)
+	if err != nil {
+		return ""
+	}
+
+	user, err := models.FindActiveUserByEmail(organizationID.String(), account.Email)
+	if err != nil {
+		return ""
+	}
+
+	return user.ID.String()
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// RedactRequest returns the request as JSON, with sensitive values redacted.
+// Requests carry the changes being made, so the redacted request is recorded as the diff of the call.
+func (a *AuditInterceptor) RedactRequest(organizationID uuid.UUID, message proto.Message) map[string]any {
+	message = proto.Clone(message)
+	redactSecretValues(message.ProtoReflect())
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return map[string]any{}
+	}
+
+	request := map[string]any{}
+	err = json.Unmarshal(data, &request)
+	if err != nil {
+		return map[string]any{}
+	}
+
+	a.redactConfigurations(organizationID, request)
+	for key, value := range request {
+		request[key] = redactField(key, value)
+	}
+
+	return request
+}
+
+func redactSecretValues(m protoreflect.Message) {
+	fields := []protoreflect.FieldDescriptor{}
+	m.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, field)
+		return true
+	})
+
+	for _, field := range fields {
+		value := m.Get(field)
+		switch {
+		case secretValueFields[field.FullName()]:
+			redactSecretValue(m, field)
+		case field.IsMap():
+			if field.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, item protoreflect.Value) bool {
+					redactSecretValues(item.Message())
+					return true
+				})
+			}
+		case field.IsList():
+			if field.Kind() == protoreflect.MessageKind {
+				for i := 0; i < value.List().Len(); i++ {
+					redactSecretValues(value.List().Get(i).Message())
+				}
+			}
+		case field.Kind() == protoreflect.MessageKind:
+			redactSecretValues(value.Message())
+		}
+	}
+}
+
+func redactSecretValue(m protoreflect.Message, field protoreflect.FieldDescriptor) {
+	if !field.IsMap() {
+		m.Set(field, protoreflect.ValueOfString(RedactedValue))
+		return
+	}
+
+	values := m.Mutable(field).Map()
+	keys := []protoreflect.MapKey{}
+	values.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	for _, key := range keys {
+		values.Set(key, protoreflect.ValueOfString(RedactedValue))
+	}
+}
+
+// Redacts the sensitive fields in every configuration in the request,
+// e.g. the configuration of the nodes in a canvas, or the configuration of an integration.
+func (a *AuditInterceptor) redactConfigurations(organizationID uuid.UUID, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		if config, ok := v["configuration"].(map[string]any); ok {
+			redactSensitiveFields(a.findConfigurationFields(organizationID, v), config)
+		}
+
+		for _, item := range v {
+			a.redactConfigurations(organizationID, item)
+		}
+	case []any:
+		for _, item := range v {
+			a.redactConfigurations(organizationID, item)
+		}
+	}
+}
+
+// Finds the configuration fields describing the configuration of an object in the request.
+// Objects are either canvas and blueprint nodes, referencing what they run,
+// or requests to create or update integrations.
+func (a *AuditInterceptor) findConfigurationFields(organizationID uuid.UUID, object map[string]any) []configuration.Field {
+	if a.registry == nil {
+		return nil
+	}
+
+	if name := nestedString(object, "component", "name"); name != "" {
+		component, err := a.registry.GetComponent(name)
+		if err != nil {
+			return nil
+		}
+
+		return component.Configuration()
+	}
+
+	if name := nestedString(object, "trigger", "name"); name != "" {
+		trigger, err := a.registry.GetTrigger(name)
+		if err != nil {
+			return nil
+		}
+
+		return trigger.Configuration()
+	}
+
+	if id := nestedString(object, "blueprint", "id"); id != "" {
+		blueprint, err := models.FindBlueprint(organizationID.String(), id)
+		if err != nil {
+			return nil
+		}
+
+		return blueprint.Configuration
+	}
+
+	integrationName, _ := object["integration_name"].(string)
+	if integrationID, ok := object["integration_id"].(string); ok && integrationName == "" {
+		id, err := uuid.Parse(integrationID)
+		if err != nil {
+			return nil
+		}
+
+		instance, err := models.FindIntegration(organizationID, id)
+		if err != nil {
+			return nil
+		}
+
+		integrationName = instance.AppName
+	}
+
+	if integrationName == "" {
+		return nil
+	}
+
+	integration, err := a.registry.GetIntegration(integrationName)
+	if err != nil {
+		return nil
+	}
+
+	return integration.Configuration()
+}
+
+func nestedString(object map[string]any, key, name string) string {
+	nested, ok := object[key].(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	value, _ := nested[name].(string)
+	return value
+}
+
+func redactSensitiveFields(fields []configuration.Field, config map[string]any) {
+	for _, field := range fields {
+		value, ok := config[field.Name]
+		if !ok {
+			continue
+		}
+
+		if field.Sensitive {
+			config[field.Name] = RedactedValue
+			continue
+		}
+
+		if field.TypeOptions == nil {
+			continue
+		}
+
+		if field.Type == configuration.FieldTypeObject && field.TypeOptions.Object != nil {
+			if object, ok := value.(map[string]any); ok {
+				redactSensitiveFields(field.TypeOptions.Object.Schema, object)
+			}
+
+			continue
+		}
+
+		if field.Type == configuration.FieldTypeList && field.TypeOptions.List != nil && field.TypeOptions.List.ItemDefinition != nil {
+			items, _ := value.([]any)
+			for _, item := range items {
+				if object, ok := item.(map[string]any); ok {
+					redactSensitiveFields(field.TypeOptions.List.ItemDefinition.Schema, object)
+				}
+			}
+		}
+	}
+}
+
+func redactField(name string, value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = redactField(key, item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactField(name, item)
+		}
+		return v
+	}
+
+	if isSensitiveField(normalizeFieldName(name)) {
+		return RedactedValue
+	}
+
+	return value
+}
+
+func isSensitiveField(normalized string) bool {
+	if allowedSensitiveFields[normalized] {
+		return false
+	}
+
+	if strings.HasSuffix(normalized, "key") {
+		return true
+	}
+
+	for _, part := range sensitiveFieldParts {
+		if strings.Contains(normalized, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Request fields use snake_case, but the configuration of
+// nodes and integrations can use any casing, e.g. apiToken or api-token.
+func normalizeFieldName(name string) string {
+	name = strings.ToLower(name)
+	name = strings.ReplaceAll(name, "_", "")
+	return strings.ReplaceAll(name, "-", "")
+}
+
+func findResourceID(message proto.Message) string {
+	m := message.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for _, name := range resourceIDFields {
+		field := fields.ByName(name)
+		if field == nil || field.IsList() || field.Kind() != protoreflect.StringKind {
+			continue
+		}
+
+		value := m.Get(field).String()
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,319 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	_ "github.com/superplanehq/superplane/pkg/grpc"
+	_ "github.com/superplanehq/superplane/pkg/integrations/cursor"
+	_ "github.com/superplanehq/superplane/pkg/integrations/render"
+	"github.com/superplanehq/superplane/pkg/models"
+	pbAuthorization "github.com/superplanehq/superplane/pkg/protos/authorization"
+	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
+	pbComponents "github.com/superplanehq/superplane/pkg/protos/components"
+	pbOrganizations "github.com/superplanehq/superplane/pkg/protos/organizations"
+	pbSecrets "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test__AuditInterceptor(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	authorizationInterceptor := authorization.NewAuthorizationInterceptor(r.AuthService)
+	auditInterceptor := authorization.NewAuditInterceptor(authorizationInterceptor, r.Registry)
+
+	call := func(userID, method string, req any, handler grpc.UnaryHandler) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", userID, "x-organization-id", orgID))
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		_, err := auditInterceptor.UnaryInterceptor()(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return authorizationInterceptor.UnaryInterceptor()(ctx, req, info, handler)
+		})
+
+		return err
+	}
+
+	ok := func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	}
+
+	listEntries := func(method string) []models.AuditLogEntry {
+		entries, err := models.ListAuditLogEntries(r.Organization.ID, models.AuditLogEntryFilter{Method: method}, 10)
+		require.NoError(t, err)
+		return entries
+	}
+
+	t.Run("mutating call is recorded with a redacted request", func(t *testing.T) {
+		err := call(r.User.String(), pbSecrets.Secrets_SetSecretKey_FullMethodName, &pbSecrets.SetSecretKeyRequest{
+			IdOrName:   "my-secret",
+			KeyName:    "password",
+			Value:      "hello",
+			DomainType: pbAuthorization.DomainType_DOMAIN_TYPE_ORGANIZATION,
+			DomainId:   orgID,
+		}, ok)
+		require.NoError(t, err)
+
+		entries := listEntries("SetSecretKey")
+		require.Len(t, entries, 1)
+		entry := entries[0]
+		assert.Equal(t, pbSecrets.Secrets_SetSecretKey_FullMethodName, entry.Method)
+		assert.Equal(t, "secrets", entry.Resource)
+		assert.Equal(t, "update", entry.Action)
+		assert.Equal(t, "my-secret", *entry.ResourceID)
+		assert.Equal(t, models.AuditLogActorUser, entry.ActorType)
+		assert.Equal(t, r.User, *entry.ActorID)
+		assert.Equal(t, codes.OK.String(), entry.Result)
+		assert.Nil(t, entry.Error)
+
+		request := entry.Request.Data()
+		assert.Equal(t, "password", request["key_name"])
+		assert.Equal(t, authorization.RedactedValue, request["value"])
+	})
+
+	t.Run("read calls are not recorded", func(t *testing.T) {
+		err := call(r.User.String(), pbSecrets.Secrets_ListSecrets_FullMethodName, &pbSecrets.ListSecretsRequest{}, ok)
+		require.NoError(t, err)
+		assert.Empty(t, listEntries("ListSecrets"))
+	})
+
+	t.Run("failed calls are recorded with their error", func(t *testing.T) {
+		canvasID := uuid.NewString()
+		err := call(r.User.String(), pbCanvases.Canvases_CancelExecution_FullMethodName, &pbCanvases.CancelExecutionRequest{
+			CanvasId:    canvasID,
+			ExecutionId: uuid.NewString(),
+		}, func(ctx context.Context, req any) (any, error) {
+			return nil, status.Error(codes.FailedPrecondition, "execution already finished")
+		})
+		require.Error(t, err)
+
+		entries := listEntries("CancelExecution")
+		require.Len(t, entries, 1)
+		assert.Equal(t, canvasID, *entries[0].ResourceID)
+		assert.Equal(t, codes.FailedPrecondition.String(), entries[0].Result)
+		assert.Equal(t, "execution already finished", *entries[0].Error)
+	})
+
+	t.Run("calls rejected by authorization are recorded", func(t *testing.T) {
+		outsider := uuid.New()
+		err := call(outsider.String(), pbCanvases.Canvases_DeleteCanvas_FullMethodName, &pbCanvases.DeleteCanvasRequest{Id: uuid.NewString()}, ok)
+		require.Error(t, err)
+
+		entries := listEntries("DeleteCanvas")
+		require.Len(t, entries, 1)
+		assert.Equal(t, outsider, *entries[0].ActorID)
+		assert.Equal(t, codes.NotFound.String(), entries[0].Result)
+	})
+
+	t.Run("accepted invite links are recorded in the organization of the invite link", func(t *testing.T) {
+		inviteLink, err := models.FindInviteLinkByOrganizationID(orgID)
+		require.NoError(t, err)
+		account, err := models.CreateAccount("new member", "new-member@example.com")
+		require.NoError(t, err)
+
+		//
+		// Accounts are not members of the organization before accepting the invite link,
+		// so calls to accept it do not carry the user or the organization.
+		//
+		var user *models.User
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-account-id", account.ID.String()))
+		info := &grpc.UnaryServerInfo{FullMethod: pbOrganizations.Organizations_AcceptInviteLink_FullMethodName}
+		_, err = auditInterceptor.UnaryInterceptor()(ctx, &pbOrganizations.InviteLink{Token: inviteLink.Token.String()}, info, func(ctx context.Context, req any) (any, error) {
+			user, err = models.CreateUser(r.Organization.ID, account.ID, account.Email, account.Name)
+			return nil, err
+		})
+		require.NoError(t, err)
+
+		entries := listEntries("AcceptInviteLink")
+		require.Len(t, entries, 1)
+		assert.Equal(t, "members", entries[0].Resource)
+		assert.Equal(t, "create", entries[0].Action)
+		assert.Equal(t, user.ID, *entries[0].ActorID)
+		assert.Equal(t, authorization.RedactedValue, entries[0].Request.Data()["token"])
+	})
+
+	t.Run("entries cannot be changed", func(t *testing.T) {
+		entries := listEntries("SetSecretKey")
+		require.Len(t, entries, 1)
+
+		entries[0].Result = codes.Internal.String()
+		require.Error(t, database.Conn().Save(&entries[0]).Error)
+		require.Error(t, database.Conn().Delete(&entries[0]).Error)
+	})
+}
+
+func Test__AuditInterceptor_AuditsEveryMutatingCall(t *testing.T) {
+	auditInterceptor := authorization.NewAuditInterceptor(authorization.NewAuthorizationInterceptor(nil), nil)
+
+	//
+	// These use POST only because their requests do not fit in a query string.
+	//
+	reads := map[string]bool{
+		pbCanvases.Canvases_ListChildExecutions_FullMethodName: true,
+		pbCanvases.Canvases_SimulateCanvas_FullMethodName:      true,
+	}
+
+	methods := 0
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		if !strings.HasPrefix(string(file.Package()), "Superplane") {
+			return true
+		}
+
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			for j := 0; j < service.Methods().Len(); j++ {
+				method := service.Methods().Get(j)
+				fullMethod := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+				rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+				if !ok || rule == nil || rule.GetGet() != "" || reads[fullMethod] {
+					continue
+				}
+
+				methods++
+				assert.True(t, auditInterceptor.Audits(fullMethod), "%s is not audited", fullMethod)
+			}
+		}
+
+		return true
+	})
+
+	require.NotZero(t, methods)
+}
+
+func Test__RedactRequest(t *testing.T) {
+	registry, err := registry.NewRegistry(&crypto.NoOpEncryptor{}, registry.HTTPOptions{})
+	require.NoError(t, err)
+
+	auditInterceptor := authorization.NewAuditInterceptor(authorization.NewAuthorizationInterceptor(nil), registry)
+	organizationID := uuid.New()
+
+	t.Run("sensitive integration configuration fields are redacted", func(t *testing.T) {
+		configuration, err := structpb.NewStruct(map[string]any{
+			"launchAgentKey": "abc",
+			"adminKey":       "def",
+		})
+		require.NoError(t, err)
+
+		request := auditInterceptor.RedactRequest(organizationID, &pbOrganizations.CreateIntegrationRequest{
+			Name:            "my-integration",
+			IntegrationName: "cursor",
+			Configuration:   configuration,
+		})
+
+		assert.Equal(t, "my-integration", request["name"])
+		assert.Equal(t, "cursor", request["integration_name"])
+		assert.Equal(t, map[string]any{
+			"launchAgentKey": authorization.RedactedValue,
+			"adminKey":       authorization.RedactedValue,
+		}, request["configuration"])
+	})
+
+	t.Run("sensitive node configuration fields are redacted", func(t *testing.T) {
+		request := auditInterceptor.RedactRequest(organizationID, &pbCanvases.CreateCanvasRequest{
+			Canvas: &pbCanvases.Canvas{
+				Spec: &pbCanvases.Canvas_Spec{
+					Nodes: []*pbComponents.Node{
+						{
+							Id:        "update-env-var",
+							Type:      pbComponents.Node_TYPE_COMPONENT,
+							Component: &pbComponents.Node_ComponentRef{Name: "render.updateEnvVar"},
+							Configuration: newStruct(t, map[string]any{
+								"valueStrategy": "set",
+								"value":         "hello",
+							}),
+						},
+						{
+							Id:        "unknown",
+							Type:      pbComponents.Node_TYPE_COMPONENT,
+							Component: &pbComponents.Node_ComponentRef{Name: "unknown"},
+							Configuration: newStruct(t, map[string]any{
+								"url":        "https://example.com",
+								"data":       "hello",
+								"value":      "world",
+								"signingKey": "abc",
+								"webhookKey": "def",
+								"auth": map[string]any{
+									"username":    "root",
+									"private-key": "key",
+									"password":    map[string]any{"secret": "my-secret", "key": "password"},
+								},
+							}),
+						},
+					},
+				},
+			},
+		})
+
+		nodes := request["canvas"].(map[string]any)["spec"].(map[string]any)["nodes"].([]any)
+		require.Len(t, nodes, 2)
+
+		assert.Equal(t, map[string]any{
+			"valueStrategy": "set",
+			"value":         authorization.RedactedValue,
+		}, nodes[0].(map[string]any)["configuration"])
+
+		//
+		// Configuration of components that are not registered
+		// is redacted based on the names of its fields.
+		//
+		assert.Equal(t, map[string]any{
+			"url":        "https://example.com",
+			"data":       "hello",
+			"value":      "world",
+			"signingKey": authorization.RedactedValue,
+			"webhookKey": authorization.RedactedValue,
+			"auth": map[string]any{
+				"username":    "root",
+				"private-key": authorization.RedactedValue,
+				"password":    map[string]any{"secret": "my-secret", "key": "password"},
+			},
+		}, nodes[1].(map[string]any)["configuration"])
+	})
+
+	t.Run("secret values are redacted", func(t *testing.T) {
+		message := &pbSecrets.CreateSecretRequest{
+			Secret: &pbSecrets.Secret{
+				Metadata: &pbSecrets.Secret_Metadata{Name: "my-secret"},
+				Spec: &pbSecrets.Secret_Spec{
+					Local: &pbSecrets.Secret_Local{Data: map[string]string{"password": "hello"}},
+				},
+			},
+		}
+
+		request := auditInterceptor.RedactRequest(organizationID, message)
+
+		secret := request["secret"].(map[string]any)
+		assert.Equal(t, "my-secret", secret["metadata"].(map[string]any)["name"])
+		local := secret["spec"].(map[string]any)["local"].(map[string]any)
+		assert.Equal(t, map[string]any{"password": authorization.RedactedValue}, local["data"])
+
+		//
+		// The request being handled is not changed.
+		//
+		assert.Equal(t, "hello", message.Secret.Spec.Local.Data["password"])
+	})
+}
+
+func newStruct(t *testing.T, value map[string]any) *structpb.Struct {
+	s, err := structpb.NewStruct(value)
+	require.NoError(t, err)
+	return s
+}
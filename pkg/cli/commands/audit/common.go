@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type filters struct {
+	actorID  string
+	resource string
+	action   string
+	method   string
+	result   string
+	before   string
+	since    string
+}
+
+func (f *filters) apply(request openapi_client.ApiAuditListAuditLogEntriesRequest) (openapi_client.ApiAuditListAuditLogEntriesRequest, error) {
+	if f.actorID != "" {
+		request = request.ActorId(f.actorID)
+	}
+
+	if f.resource != "" {
+		request = request.Resource(f.resource)
+	}
+
+	if f.action != "" {
+		request = request.Action(f.action)
+	}
+
+	if f.method != "" {
+		request = request.Method(f.method)
+	}
+
+	if f.result != "" {
+		request = request.Result(f.result)
+	}
+
+	if f.before != "" {
+		beforeTime, err := time.Parse(time.RFC3339, f.before)
+		if err != nil {
+			return request, fmt.Errorf("invalid --before value %q: expected RFC3339 timestamp", f.before)
+		}
+		request = request.Before(beforeTime)
+	}
+
+	if f.since != "" {
+		since, err := time.ParseDuration(f.since)
+		if err != nil || since <= 0 {
+			return request, fmt.Errorf("invalid --since value %q: expected a duration, like 24h", f.since)
+		}
+		request = request.After(time.Now().Add(-since))
+	}
+
+	return request, nil
+}
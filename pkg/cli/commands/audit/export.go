@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+// The API returns at most 100 entries per call.
+const exportPageSize = 100
+
+type exportCommand struct {
+	file    *string
+	filters *filters
+}
+
+// Execute writes every matching entry as one JSON object per line,
+// following the pages of the audit log until there are no more entries.
+func (c *exportCommand) Execute(ctx core.CommandContext) error {
+	if *c.file == "-" {
+		_, err := c.export(ctx, ctx.Cmd.OutOrStdout())
+		return err
+	}
+
+	// #nosec
+	file, err := os.Create(*c.file)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	count, err := c.export(ctx, file)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Exported %d audit log entries to %s\n", count, *c.file)
+		return err
+	})
+}
+
+func (c *exportCommand) export(ctx core.CommandContext, output io.Writer) (int, error) {
+	base, err := c.filters.apply(ctx.API.AuditAPI.AuditListAuditLogEntries(ctx.Context))
+	if err != nil {
+		return 0, err
+	}
+
+	encoder := json.NewEncoder(output)
+	count := 0
+
+	var before *time.Time
+	for {
+		request := base.Limit(exportPageSize)
+		if before != nil {
+			request = request.Before(*before)
+		}
+
+		response, _, err := request.Execute()
+		if err != nil {
+			return count, err
+		}
+
+		for _, entry := range response.GetEntries() {
+			err := encoder.Encode(entry)
+			if err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if !response.GetHasNextPage() || !response.HasLastTimestamp() {
+			return count, nil
+		}
+
+		lastTimestamp := response.GetLastTimestamp()
+		before = &lastTimestamp
+	}
+}
@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type listCommand struct {
+	limit   *int64
+	filters *filters
+}
+
+func (c *listCommand) Execute(ctx core.CommandContext) error {
+	request, err := c.filters.apply(ctx.API.AuditAPI.AuditListAuditLogEntries(ctx.Context))
+	if err != nil {
+		return err
+	}
+
+	if c.limit != nil && *c.limit > 0 {
+		request = request.Limit(*c.limit)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "CREATED_AT\tACTOR_TYPE\tACTOR_ID\tMETHOD\tRESOURCE\tRESOURCE_ID\tRESULT")
+		for _, entry := range response.GetEntries() {
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				entry.GetCreatedAt().Format(time.RFC3339),
+				entry.GetActorType(),
+				entry.GetActorId(),
+				entry.GetMethod(),
+				entry.GetResource(),
+				entry.GetResourceId(),
+				entry.GetResult(),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
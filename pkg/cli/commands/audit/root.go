@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	root := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect the organization audit log",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit log entries",
+		Args:  cobra.NoArgs,
+	}
+	var listLimit int64
+	var listFilters filters
+	listCmd.Flags().Int64Var(&listLimit, "limit", 20, "maximum number of items to return")
+	bindFilterFlags(listCmd, &listFilters)
+	core.Bind(listCmd, &listCommand{limit: &listLimit, filters: &listFilters}, options)
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export audit log entries as JSON lines",
+		Args:  cobra.NoArgs,
+	}
+	var exportFile string
+	var exportFilters filters
+	exportCmd.Flags().StringVarP(&exportFile, "output-file", "f", "-", "file to write the entries to, or - for stdout")
+	bindFilterFlags(exportCmd, &exportFilters)
+	core.Bind(exportCmd, &exportCommand{file: &exportFile, filters: &exportFilters}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(exportCmd)
+
+	return root
+}
+
+func bindFilterFlags(cmd *cobra.Command, f *filters) {
+	cmd.Flags().StringVar(&f.actorID, "actor-id", "", "only return calls made by this user or service account")
+	cmd.Flags().StringVar(&f.resource, "resource", "", "only return calls on this resource, like canvases or secrets")
+	cmd.Flags().StringVar(&f.action, "action", "", "only return calls with this action: create, update or delete")
+	cmd.Flags().StringVar(&f.method, "method", "", "only return calls to this API method, like CancelExecution")
+	cmd.Flags().StringVar(&f.result, "result", "", "only return calls with this result, like OK or NotFound")
+	cmd.Flags().StringVar(&f.before, "before", "", "return items before this timestamp (RFC3339)")
+	cmd.Flags().StringVar(&f.since, "since", "", "return items from this long ago, like 720h")
+}
@@ -44,14 +44,35 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	core.Bind(createCmd, &createCommand{file: &createFile}, options)
 
 	var updateFile string
+	var updateMessage string
+	var updateCanvasID string
+	var updateDraft bool
+	var updatePublish bool
+	var updateApprovalRole string
 	updateCmd := &cobra.Command{
 		Use:   "update",
 		Short: "Update a canvas from a file",
-		Args:  cobra.NoArgs,
+		Long: `Update a canvas from a file.
+
+With --draft, the file is saved as the canvas draft, and the running canvas is not changed.
+With --publish, the canvas draft replaces the running canvas. If a file is also given, it is saved as the draft first.
+If --approval-role is given, publishing creates a change request that must be approved by a user with that role.`,
+		Args: cobra.NoArgs,
 	}
 	updateCmd.Flags().StringVarP(&updateFile, "file", "f", "", "filename, directory, or URL to files to use to update the resource")
-	_ = updateCmd.MarkFlagRequired("file")
-	core.Bind(updateCmd, &updateCommand{file: &updateFile}, options)
+	updateCmd.Flags().StringVarP(&updateMessage, "message", "m", "", "message describing the changes in the new canvas version")
+	updateCmd.Flags().StringVar(&updateCanvasID, "canvas-id", "", "canvas ID, used when publishing a draft without a file")
+	updateCmd.Flags().BoolVar(&updateDraft, "draft", false, "save the changes as the canvas draft instead of updating the running canvas")
+	updateCmd.Flags().BoolVar(&updatePublish, "publish", false, "publish the canvas draft")
+	updateCmd.Flags().StringVar(&updateApprovalRole, "approval-role", "", "role required to approve the published changes")
+	core.Bind(updateCmd, &updateCommand{
+		file:         &updateFile,
+		message:      &updateMessage,
+		canvasID:     &updateCanvasID,
+		draft:        &updateDraft,
+		publish:      &updatePublish,
+		approvalRole: &updateApprovalRole,
+	}, options)
 
 	root.AddCommand(listCmd)
 	root.AddCommand(getCmd)
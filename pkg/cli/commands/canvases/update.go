@@ -2,6 +2,7 @@ package canvases
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
@@ -10,7 +11,12 @@ import (
 )
 
 type updateCommand struct {
-	file *string
+	file         *string
+	message      *string
+	canvasID     *string
+	draft        *bool
+	publish      *bool
+	approvalRole *string
 }
 
 func (c *updateCommand) Execute(ctx core.CommandContext) error {
@@ -18,13 +24,32 @@ func (c *updateCommand) Execute(ctx core.CommandContext) error {
 	if c.file != nil {
 		filePath = *c.file
 	}
-	if filePath == "" {
-		return fmt.Errorf("--file is required")
-	}
 	if len(ctx.Args) > 0 {
 		return fmt.Errorf("update does not accept positional arguments")
 	}
 
+	draft := c.draft != nil && *c.draft
+	publish := c.publish != nil && *c.publish
+	if c.approvalRole != nil && *c.approvalRole != "" && !publish {
+		return fmt.Errorf("--approval-role can only be used with --publish")
+	}
+
+	//
+	// Publishing without a file publishes the draft already saved for the canvas.
+	//
+	if filePath == "" {
+		if !publish {
+			return fmt.Errorf("--file is required")
+		}
+
+		canvasID, err := core.ResolveCanvasID(ctx, *c.canvasID)
+		if err != nil {
+			return err
+		}
+
+		return c.publishDraft(ctx, canvasID)
+	}
+
 	// #nosec
 	data, err := os.ReadFile(filePath)
 	if err != nil {
@@ -47,8 +72,15 @@ func (c *updateCommand) Execute(ctx core.CommandContext) error {
 		}
 
 		canvas := models.CanvasFromCanvas(*resource)
+		if draft || publish {
+			return c.updateDraft(ctx, resource.Metadata.GetId(), canvas, publish)
+		}
+
 		body := openapi_client.CanvasesUpdateCanvasBody{}
 		body.SetCanvas(canvas)
+		if c.message != nil && *c.message != "" {
+			body.SetVersionMessage(*c.message)
+		}
 
 		_, _, err = ctx.API.CanvasAPI.
 			CanvasesUpdateCanvas(ctx.Context, resource.Metadata.GetId()).
@@ -59,3 +91,98 @@ func (c *updateCommand) Execute(ctx core.CommandContext) error {
 		return fmt.Errorf("unsupported resource kind %q for update", kind)
 	}
 }
+
+// updateDraft saves the canvas as the canvas draft, leaving the running canvas unchanged.
+// If publish is set, the draft is published right after being saved.
+func (c *updateCommand) updateDraft(ctx core.CommandContext, canvasID string, canvas openapi_client.CanvasesCanvas, publish bool) error {
+	body := openapi_client.CanvasesUpdateCanvasDraftBody{}
+	body.SetCanvas(canvas)
+
+	response, _, err := ctx.API.CanvasDraftAPI.
+		CanvasesUpdateCanvasDraft(ctx.Context, canvasID).
+		Body(body).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	draft := response.GetDraft()
+	validationErrors := draft.GetValidationErrors()
+
+	if !publish || len(validationErrors) > 0 {
+		if !ctx.Renderer.IsText() {
+			err = ctx.Renderer.Render(response)
+		} else {
+			err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+				return renderDraft(stdout, draft)
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return fmt.Errorf("draft has %d validation error(s)", len(validationErrors))
+	}
+
+	if !publish {
+		return nil
+	}
+
+	return c.publishDraft(ctx, canvasID)
+}
+
+func (c *updateCommand) publishDraft(ctx core.CommandContext, canvasID string) error {
+	body := openapi_client.CanvasesPublishCanvasDraftBody{}
+	if c.message != nil && *c.message != "" {
+		body.SetMessage(*c.message)
+	}
+	if c.approvalRole != nil && *c.approvalRole != "" {
+		body.SetApprovalRole(*c.approvalRole)
+	}
+
+	response, _, err := ctx.API.CanvasDraftAPI.
+		CanvasesPublishCanvasDraft(ctx.Context, canvasID).
+		Body(body).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		if response.HasChangeRequest() {
+			request := response.GetChangeRequest()
+			_, err := fmt.Fprintf(stdout, "Change request %s created, waiting for approval from %s\n", request.GetId(), request.GetApprovalRole())
+			return err
+		}
+
+		version := response.GetVersion()
+		_, err := fmt.Fprintf(stdout, "Draft published as version %d\n", version.GetVersion())
+		return err
+	})
+}
+
+func renderDraft(stdout io.Writer, draft openapi_client.CanvasesCanvasDraft) error {
+	diff := draft.GetDiff()
+	_, err := fmt.Fprintf(stdout, "Draft saved: %d node change(s), %d edge change(s)\n", len(diff.GetNodes()), len(diff.GetEdges()))
+	if err != nil {
+		return err
+	}
+
+	for _, validationError := range draft.GetValidationErrors() {
+		_, err := fmt.Fprintf(stdout, "  node %s: %s\n", validationError.GetNodeId(), validationError.GetMessage())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package executions
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type ListArtifactsCommand struct {
+	CanvasID    *string
+	ExecutionID *string
+}
+
+func (c *ListArtifactsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasNodeExecutionAPI.
+		CanvasesListExecutionArtifacts(ctx.Context, canvasID, *c.ExecutionID).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "ID\tNAME\tCONTENT_TYPE\tSIZE\tCREATED_AT")
+		for _, artifact := range response.GetArtifacts() {
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\t%s\n",
+				artifact.GetId(),
+				artifact.GetName(),
+				artifact.GetContentType(),
+				artifact.GetSize(),
+				artifact.GetCreatedAt().Format(time.RFC3339),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
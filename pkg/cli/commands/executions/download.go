@@ -0,0 +1,80 @@
+package executions
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type DownloadArtifactCommand struct {
+	CanvasID    *string
+	ExecutionID *string
+	ArtifactID  *string
+	Output      *string
+}
+
+func (c *DownloadArtifactCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	content, response, err := ctx.API.CanvasNodeExecutionAPI.
+		CanvasesDownloadExecutionArtifact(ctx.Context, canvasID, *c.ExecutionID, *c.ArtifactID).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(content.Name())
+	defer content.Close()
+
+	if *c.Output == "-" {
+		_, err = io.Copy(ctx.Cmd.OutOrStdout(), content)
+		return err
+	}
+
+	output := *c.Output
+	if output == "" {
+		output = filenameFromHeader(response.Header.Get("Content-Disposition"), *c.ArtifactID)
+	}
+
+	// #nosec
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	size, err := io.Copy(file, content)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Artifact saved to %s (%d bytes)\n", output, size)
+		return err
+	})
+}
+
+// filenameFromHeader uses the artifact name sent by the API,
+// without any directories, so downloads always land in the current one.
+func filenameFromHeader(header, fallback string) string {
+	_, params, err := mime.ParseMediaType(header)
+	if err != nil {
+		return fallback
+	}
+
+	name := filepath.Base(params["filename"])
+	if name == "." || name == "/" || name == ".." {
+		return fallback
+	}
+
+	return name
+}
@@ -0,0 +1,91 @@
+package executions
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const logsPollInterval = 2 * time.Second
+
+type LogsCommand struct {
+	CanvasID    *string
+	ExecutionID *string
+	Follow      *bool
+}
+
+func (c *LogsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		lines, err := c.readLines(ctx, canvasID, "0")
+		if err != nil {
+			return err
+		}
+
+		return ctx.Renderer.Render(openapi_client.CanvasesGetExecutionLogsResponse{Lines: lines})
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		after := "0"
+		for {
+			lines, err := c.readLines(ctx, canvasID, after)
+			if err != nil {
+				return err
+			}
+
+			for _, line := range lines {
+				_, _ = fmt.Fprintf(
+					stdout,
+					"%s  %-6s  %s\n",
+					line.GetCreatedAt().Format(time.RFC3339),
+					line.GetStream(),
+					line.GetMessage(),
+				)
+			}
+
+			if len(lines) > 0 {
+				after = lines[len(lines)-1].GetId()
+			}
+
+			if c.Follow == nil || !*c.Follow {
+				return nil
+			}
+
+			select {
+			case <-ctx.Context.Done():
+				return nil
+			case <-time.After(logsPollInterval):
+			}
+		}
+	})
+}
+
+// readLines reads all the lines after the given one, page by page.
+func (c *LogsCommand) readLines(ctx core.CommandContext, canvasID, after string) ([]openapi_client.CanvasesExecutionLogLine, error) {
+	lines := []openapi_client.CanvasesExecutionLogLine{}
+	for {
+		response, _, err := ctx.API.CanvasNodeExecutionAPI.
+			CanvasesGetExecutionLogs(ctx.Context, canvasID, *c.ExecutionID).
+			After(after).
+			Execute()
+
+		if err != nil {
+			return nil, err
+		}
+
+		page := response.GetLines()
+		lines = append(lines, page...)
+		if !response.GetHasMore() || len(page) == 0 {
+			return lines, nil
+		}
+
+		after = page[len(page)-1].GetId()
+	}
+}
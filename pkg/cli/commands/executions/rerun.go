@@ -0,0 +1,39 @@
+package executions
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type RerunExecutionCommand struct {
+	CanvasID    *string
+	ExecutionID *string
+}
+
+func (c *RerunExecutionCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasNodeExecutionAPI.
+		CanvasesRerunExecution(ctx.Context, canvasID, *c.ExecutionID).
+		Body(map[string]any{}).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		queueItem := response.GetQueueItem()
+		_, err := fmt.Fprintf(stdout, "Execution queued for rerun: %s\n", queueItem.GetId())
+		return err
+	})
+}
@@ -11,6 +11,9 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var executionID string
 	var limit int64
 	var before string
+	var artifactID string
+	var output string
+	var follow bool
 
 	root := &cobra.Command{
 		Use:     "executions",
@@ -48,8 +51,71 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		ExecutionID: &executionID,
 	}, options)
 
+	rerunCmd := &cobra.Command{
+		Use:   "rerun",
+		Short: "Rerun an execution with the same input",
+		Args:  cobra.NoArgs,
+	}
+	rerunCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	rerunCmd.Flags().StringVar(&executionID, "execution-id", "", "execution ID")
+	_ = rerunCmd.MarkFlagRequired("execution-id")
+	core.Bind(rerunCmd, &RerunExecutionCommand{
+		CanvasID:    &canvasID,
+		ExecutionID: &executionID,
+	}, options)
+
+	artifactsCmd := &cobra.Command{
+		Use:   "artifacts",
+		Short: "List the artifacts of an execution",
+		Args:  cobra.NoArgs,
+	}
+	artifactsCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	artifactsCmd.Flags().StringVar(&executionID, "execution-id", "", "execution ID")
+	_ = artifactsCmd.MarkFlagRequired("execution-id")
+	core.Bind(artifactsCmd, &ListArtifactsCommand{
+		CanvasID:    &canvasID,
+		ExecutionID: &executionID,
+	}, options)
+
+	downloadCmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download an execution artifact",
+		Args:  cobra.NoArgs,
+	}
+	downloadCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	downloadCmd.Flags().StringVar(&executionID, "execution-id", "", "execution ID")
+	downloadCmd.Flags().StringVar(&artifactID, "artifact-id", "", "artifact ID")
+	downloadCmd.Flags().StringVarP(&output, "output-file", "f", "", "file to save the artifact to, or - for stdout (defaults to the artifact name)")
+	_ = downloadCmd.MarkFlagRequired("execution-id")
+	_ = downloadCmd.MarkFlagRequired("artifact-id")
+	core.Bind(downloadCmd, &DownloadArtifactCommand{
+		CanvasID:    &canvasID,
+		ExecutionID: &executionID,
+		ArtifactID:  &artifactID,
+		Output:      &output,
+	}, options)
+
+	logsCmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Show the log of an execution",
+		Args:  cobra.NoArgs,
+	}
+	logsCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	logsCmd.Flags().StringVar(&executionID, "execution-id", "", "execution ID")
+	logsCmd.Flags().BoolVarP(&follow, "follow", "F", false, "keep polling for new log lines")
+	_ = logsCmd.MarkFlagRequired("execution-id")
+	core.Bind(logsCmd, &LogsCommand{
+		CanvasID:    &canvasID,
+		ExecutionID: &executionID,
+		Follow:      &follow,
+	}, options)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(cancelCmd)
+	root.AddCommand(rerunCmd)
+	root.AddCommand(artifactsCmd)
+	root.AddCommand(downloadCmd)
+	root.AddCommand(logsCmd)
 
 	return root
 }
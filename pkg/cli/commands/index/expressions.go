@@ -0,0 +1,55 @@
+package index
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func newExpressionsCommand(options core.BindOptions) *cobra.Command {
+	var category string
+
+	cmd := &cobra.Command{
+		Use:   "expressions",
+		Short: "List functions available in expressions",
+		Args:  cobra.NoArgs,
+	}
+	cmd.Flags().StringVar(&category, "category", "", "function category")
+	core.Bind(cmd, &expressionsCommand{category: &category}, options)
+
+	return cmd
+}
+
+type expressionsCommand struct {
+	category *string
+}
+
+func (c *expressionsCommand) Execute(ctx core.CommandContext) error {
+	request := ctx.API.ExpressionAPI.ExpressionsListExpressionFunctions(ctx.Context)
+	if category := strings.TrimSpace(*c.category); category != "" {
+		request = request.Category(category)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	functions := response.GetFunctions()
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(functions)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "CATEGORY\tSIGNATURE\tDESCRIPTION")
+		for _, function := range functions {
+			_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\n", function.GetCategory(), function.GetSignature(), function.GetDescription())
+		}
+		return writer.Flush()
+	})
+}
@@ -8,12 +8,13 @@ import (
 func NewCommand(options core.BindOptions) *cobra.Command {
 	root := &cobra.Command{
 		Use:   "index",
-		Short: "Discover available integrations, triggers, and components",
+		Short: "Discover available integrations, triggers, components, and expression functions",
 	}
 
 	root.AddCommand(newIntegrationsCommand(options))
 	root.AddCommand(newTriggersCommand(options))
 	root.AddCommand(newComponentsCommand(options))
+	root.AddCommand(newExpressionsCommand(options))
 
 	return root
 }
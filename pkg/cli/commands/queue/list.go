@@ -34,13 +34,14 @@ func (c *ListQueueItemsCommand) Execute(ctx core.CommandContext) error {
 
 	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
 		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
-		_, _ = fmt.Fprintln(writer, "ID\tCREATED_AT\tROOT_EVENT_ID\tSOURCE")
+		_, _ = fmt.Fprintln(writer, "ID\tPRIORITY\tCREATED_AT\tROOT_EVENT_ID\tSOURCE")
 
 		for _, item := range response.GetItems() {
 			_, _ = fmt.Fprintf(
 				writer,
-				"%s\t%s\t%s\t%s\n",
+				"%s\t%d\t%s\t%s\t%s\n",
 				item.GetId(),
+				item.GetPriority(),
 				item.GetCreatedAt().Format(time.RFC3339),
 				*item.RootEvent.Id,
 				*item.RootEvent.NodeId,
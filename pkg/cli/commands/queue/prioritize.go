@@ -0,0 +1,45 @@
+package queue
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type PrioritizeQueueItemCommand struct {
+	CanvasID *string
+	NodeID   *string
+	ItemID   *string
+	Priority *int32
+}
+
+func (c *PrioritizeQueueItemCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.NewCanvasesUpdateNodeQueueItemBody()
+	body.SetPriority(*c.Priority)
+
+	response, _, err := ctx.API.CanvasNodeAPI.
+		CanvasesUpdateNodeQueueItem(ctx.Context, canvasID, *c.NodeID, *c.ItemID).
+		Body(*body).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		item := response.GetItem()
+		_, err := fmt.Fprintf(stdout, "Queue item %s priority set to %d\n", item.GetId(), item.GetPriority())
+		return err
+	})
+}
@@ -9,6 +9,7 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var canvasID string
 	var nodeID string
 	var itemID string
+	var priority int32
 
 	root := &cobra.Command{
 		Use:   "queue",
@@ -47,8 +48,30 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		ItemID:   &itemID,
 	}, options)
 
+	prioritizeCmd := &cobra.Command{
+		Use:   "prioritize",
+		Short: "Change the priority of an item in a node queue",
+		Long:  "Items with a higher priority are dequeued first. Priorities go from -100 to 100, and default to 0.",
+		Args:  cobra.NoArgs,
+	}
+
+	prioritizeCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	prioritizeCmd.Flags().StringVar(&nodeID, "node-id", "", "node ID")
+	prioritizeCmd.Flags().StringVar(&itemID, "item-id", "", "queue item ID")
+	prioritizeCmd.Flags().Int32Var(&priority, "priority", 0, "new priority for the item")
+	_ = prioritizeCmd.MarkFlagRequired("node-id")
+	_ = prioritizeCmd.MarkFlagRequired("item-id")
+	_ = prioritizeCmd.MarkFlagRequired("priority")
+	core.Bind(prioritizeCmd, &PrioritizeQueueItemCommand{
+		CanvasID: &canvasID,
+		NodeID:   &nodeID,
+		ItemID:   &itemID,
+		Priority: &priority,
+	}, options)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(deleteCmd)
+	root.AddCommand(prioritizeCmd)
 
 	return root
 }
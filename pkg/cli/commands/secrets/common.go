@@ -126,6 +126,28 @@ func renderSecretText(stdout io.Writer, item openapi_client.SecretsSecret) error
 		_, _ = fmt.Fprintf(stdout, "CreatedAt: %s\n", metadata.GetCreatedAt().Format(time.RFC3339))
 	}
 
+	if scope, ok := spec.GetScopeOk(); ok {
+		_, _ = fmt.Fprintf(stdout, "ScopeCanvas: %s\n", scope.GetCanvasId())
+		if scope.HasNodeId() {
+			_, _ = fmt.Fprintf(stdout, "ScopeNode: %s\n", scope.GetNodeId())
+		}
+	}
+
+	if policy, ok := spec.GetAccessPolicyOk(); ok {
+		if len(policy.GetCanvasIds()) > 0 {
+			_, _ = fmt.Fprintf(stdout, "AllowedCanvases: %s\n", strings.Join(policy.GetCanvasIds(), ", "))
+		}
+		if len(policy.GetComponents()) > 0 {
+			_, _ = fmt.Fprintf(stdout, "AllowedComponents: %s\n", strings.Join(policy.GetComponents(), ", "))
+		}
+	}
+
+	if vault, ok := spec.GetVaultOk(); ok {
+		_, _ = fmt.Fprintf(stdout, "VaultMount: %s\n", vault.GetMount())
+		_, _ = fmt.Fprintf(stdout, "VaultPath: %s\n", vault.GetPath())
+		return nil
+	}
+
 	_, _ = fmt.Fprintln(stdout, "Keys:")
 	keys := make([]string, 0)
 	if local, ok := spec.GetLocalOk(); ok && local.HasData() {
@@ -140,3 +162,20 @@ func renderSecretText(stdout io.Writer, item openapi_client.SecretsSecret) error
 
 	return nil
 }
+
+func renderSecretReferencesText(stdout io.Writer, references []openapi_client.SecretsSecretReference) error {
+	_, _ = fmt.Fprintln(stdout, "References:")
+	for _, reference := range references {
+		_, _ = fmt.Fprintf(
+			stdout,
+			"- %s (%s) / %s (%s): %s\n",
+			reference.GetCanvasName(),
+			reference.GetCanvasId(),
+			reference.GetNodeName(),
+			reference.GetNodeId(),
+			reference.GetKey(),
+		)
+	}
+
+	return nil
+}
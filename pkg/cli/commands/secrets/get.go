@@ -29,6 +29,11 @@ func (c *getCommand) Execute(ctx core.CommandContext) error {
 	}
 
 	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
-		return renderSecretText(stdout, secret)
+		err := renderSecretText(stdout, secret)
+		if err != nil {
+			return err
+		}
+
+		return renderSecretReferencesText(stdout, response.GetReferences())
 	})
 }
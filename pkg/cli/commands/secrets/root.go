@@ -53,11 +53,25 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	}
 	core.Bind(deleteCmd, &deleteCommand{}, options)
 
+	usageCmd := &cobra.Command{
+		Use:   "usage <id-or-name>",
+		Short: "List the reads and writes of a secret",
+		Args:  cobra.ExactArgs(1),
+	}
+	var usageLimit int64
+	var usageBefore string
+	var usageSince string
+	usageCmd.Flags().Int64Var(&usageLimit, "limit", 20, "maximum number of items to return")
+	usageCmd.Flags().StringVar(&usageBefore, "before", "", "return items before this timestamp (RFC3339)")
+	usageCmd.Flags().StringVar(&usageSince, "since", "", "return items from this long ago, like 720h")
+	core.Bind(usageCmd, &usageCommand{limit: &usageLimit, before: &usageBefore, since: &usageSince}, options)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(getCmd)
 	root.AddCommand(createCmd)
 	root.AddCommand(updateCmd)
 	root.AddCommand(deleteCmd)
+	root.AddCommand(usageCmd)
 
 	return root
 }
@@ -0,0 +1,77 @@
+package secrets
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type usageCommand struct {
+	limit  *int64
+	before *string
+	since  *string
+}
+
+func (c *usageCommand) Execute(ctx core.CommandContext) error {
+	organizationID, err := resolveOrganizationID(ctx)
+	if err != nil {
+		return err
+	}
+
+	request := ctx.API.SecretAPI.
+		SecretsListSecretAccessEvents(ctx.Context, ctx.Args[0]).
+		DomainType(string(organizationDomainType())).
+		DomainId(organizationID)
+
+	if c.limit != nil && *c.limit > 0 {
+		request = request.Limit(*c.limit)
+	}
+
+	if c.before != nil && *c.before != "" {
+		beforeTime, err := time.Parse(time.RFC3339, *c.before)
+		if err != nil {
+			return fmt.Errorf("invalid --before value %q: expected RFC3339 timestamp", *c.before)
+		}
+		request = request.Before(beforeTime)
+	}
+
+	if c.since != nil && *c.since != "" {
+		since, err := time.ParseDuration(*c.since)
+		if err != nil || since <= 0 {
+			return fmt.Errorf("invalid --since value %q: expected a duration, like 24h", *c.since)
+		}
+		request = request.After(time.Now().Add(-since))
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "CREATED_AT\tACTION\tKEY\tACTOR_TYPE\tACTOR_ID\tCANVAS_ID\tNODE_ID")
+		for _, event := range response.GetEvents() {
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				event.GetCreatedAt().Format(time.RFC3339),
+				event.GetAction(),
+				event.GetKeyName(),
+				event.GetActorType(),
+				event.GetActorId(),
+				event.GetCanvasId(),
+				event.GetNodeId(),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
@@ -0,0 +1,39 @@
+package variables
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type DeleteVariableCommand struct {
+	CanvasID        *string
+	ExpectedVersion *int64
+}
+
+func (c *DeleteVariableCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	request := ctx.API.CanvasVariableAPI.CanvasesDeleteCanvasVariable(ctx.Context, canvasID, ctx.Args[0])
+	if ctx.Cmd.Flags().Changed("expected-version") {
+		request = request.ExpectedVersion(*c.ExpectedVersion)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Variable deleted: %s\n", ctx.Args[0])
+		return err
+	})
+}
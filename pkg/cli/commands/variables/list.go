@@ -0,0 +1,57 @@
+package variables
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type ListVariablesCommand struct {
+	CanvasID *string
+}
+
+func (c *ListVariablesCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasVariableAPI.CanvasesListCanvasVariables(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "NAME\tVALUE\tVERSION\tUPDATED_AT")
+		for _, variable := range response.GetVariables() {
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%d\t%s\n",
+				variable.GetName(),
+				formatValue(variable.GetValue()),
+				variable.GetVersion(),
+				variable.GetUpdatedAt().Format(time.RFC3339),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
+
+func formatValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(data)
+}
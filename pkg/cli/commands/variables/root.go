@@ -0,0 +1,56 @@
+package variables
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	var canvasID string
+	var expectedVersion int64
+
+	root := &cobra.Command{
+		Use:     "variables",
+		Short:   "Manage canvas variables",
+		Aliases: []string{"variable", "vars"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List variables of a canvas",
+		Args:  cobra.NoArgs,
+	}
+	listCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	core.Bind(listCmd, &ListVariablesCommand{CanvasID: &canvasID}, options)
+
+	setCmd := &cobra.Command{
+		Use:   "set <name> <value>",
+		Short: "Create or update a canvas variable",
+		Long:  "Create or update a canvas variable. The value is parsed as JSON, and stored as a string if it is not valid JSON.",
+		Args:  cobra.ExactArgs(2),
+	}
+	setCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	setCmd.Flags().Int64Var(&expectedVersion, "expected-version", 0, "only update the variable if it is at this version (0 only creates it)")
+	core.Bind(setCmd, &SetVariableCommand{
+		CanvasID:        &canvasID,
+		ExpectedVersion: &expectedVersion,
+	}, options)
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a canvas variable",
+		Args:  cobra.ExactArgs(1),
+	}
+	deleteCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	deleteCmd.Flags().Int64Var(&expectedVersion, "expected-version", 0, "only delete the variable if it is at this version")
+	core.Bind(deleteCmd, &DeleteVariableCommand{
+		CanvasID:        &canvasID,
+		ExpectedVersion: &expectedVersion,
+	}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(setCmd)
+	root.AddCommand(deleteCmd)
+
+	return root
+}
@@ -0,0 +1,58 @@
+package variables
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type SetVariableCommand struct {
+	CanvasID        *string
+	ExpectedVersion *int64
+}
+
+func (c *SetVariableCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.NewCanvasesSetCanvasVariableBody()
+	body.SetValue(parseValue(ctx.Args[1]))
+	if ctx.Cmd.Flags().Changed("expected-version") {
+		body.SetExpectedVersion(*c.ExpectedVersion)
+	}
+
+	response, _, err := ctx.API.CanvasVariableAPI.
+		CanvasesSetCanvasVariable(ctx.Context, canvasID, ctx.Args[0]).
+		Body(*body).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		variable := response.GetVariable()
+		_, err := fmt.Fprintf(stdout, "Variable %s set to %s (version %d)\n", variable.GetName(), formatValue(variable.GetValue()), variable.GetVersion())
+		return err
+	})
+}
+
+// parseValue reads the value as JSON, so numbers, booleans,
+// lists and objects keep their type. Anything else is a string.
+func parseValue(raw string) any {
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return raw
+	}
+
+	return value
+}
@@ -0,0 +1,71 @@
+package versions
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type DiffVersionsCommand struct {
+	CanvasID      *string
+	VersionID     *string
+	FromVersionID *string
+}
+
+func (c *DiffVersionsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	request := ctx.API.CanvasVersionAPI.CanvasesDiffCanvasVersions(ctx.Context, canvasID, *c.VersionID)
+	if c.FromVersionID != nil && *c.FromVersionID != "" {
+		request = request.FromVersionId(*c.FromVersionID)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		diff := response.GetDiff()
+		for _, field := range diff.GetChangedFields() {
+			_, _ = fmt.Fprintf(stdout, "~ canvas %s\n", field)
+		}
+
+		for _, change := range diff.GetNodes() {
+			line := fmt.Sprintf("%s node %s (%s)", changeSymbol(change.GetType()), change.GetNodeName(), change.GetNodeId())
+			if len(change.GetChangedFields()) > 0 {
+				line += ": " + strings.Join(change.GetChangedFields(), ", ")
+			}
+
+			_, _ = fmt.Fprintln(stdout, line)
+		}
+
+		for _, change := range diff.GetEdges() {
+			edge := change.GetEdge()
+			_, _ = fmt.Fprintf(stdout, "%s edge %s -> %s [%s]\n", changeSymbol(change.GetType()), edge.GetSourceId(), edge.GetTargetId(), edge.GetChannel())
+		}
+
+		return nil
+	})
+}
+
+func changeSymbol(changeType openapi_client.CanvasVersionDiffChangeType) string {
+	switch changeType {
+	case openapi_client.CANVASVERSIONDIFFCHANGETYPE_CHANGE_TYPE_ADDED:
+		return "+"
+	case openapi_client.CANVASVERSIONDIFFCHANGETYPE_CHANGE_TYPE_REMOVED:
+		return "-"
+	default:
+		return "~"
+	}
+}
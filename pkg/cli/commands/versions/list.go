@@ -0,0 +1,68 @@
+package versions
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type ListVersionsCommand struct {
+	CanvasID *string
+	Limit    *int64
+	Before   *int64
+}
+
+func (c *ListVersionsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	request := ctx.API.CanvasVersionAPI.CanvasesListCanvasVersions(ctx.Context, canvasID)
+	if c.Limit != nil && *c.Limit > 0 {
+		request = request.Limit(*c.Limit)
+	}
+
+	if c.Before != nil && *c.Before > 0 {
+		request = request.Before(*c.Before)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "ID\tVERSION\tMESSAGE\tCREATED_BY\tCREATED_AT")
+		for _, version := range response.GetVersions() {
+			createdBy := version.GetCreatedBy()
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%d\t%s\t%s\t%s\n",
+				version.GetId(),
+				version.GetVersion(),
+				stringOrDash(version.GetMessage()),
+				stringOrDash(createdBy.GetName()),
+				version.GetCreatedAt().Format(time.RFC3339),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
+
+func stringOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
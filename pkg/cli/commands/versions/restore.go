@@ -0,0 +1,39 @@
+package versions
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type RestoreVersionCommand struct {
+	CanvasID  *string
+	VersionID *string
+}
+
+func (c *RestoreVersionCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasVersionAPI.
+		CanvasesRestoreCanvasVersion(ctx.Context, canvasID, *c.VersionID).
+		Body(map[string]any{}).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		version := response.GetVersion()
+		_, err := fmt.Fprintf(stdout, "Canvas restored as version %d: %s\n", version.GetVersion(), version.GetMessage())
+		return err
+	})
+}
@@ -0,0 +1,68 @@
+package versions
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	var canvasID string
+	var versionID string
+	var fromVersionID string
+	var limit int64
+	var before int64
+
+	root := &cobra.Command{
+		Use:     "versions",
+		Short:   "Manage canvas versions",
+		Aliases: []string{"version"},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List versions of a canvas",
+		Args:  cobra.NoArgs,
+	}
+	listCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	listCmd.Flags().Int64Var(&limit, "limit", 20, "maximum number of items to return")
+	listCmd.Flags().Int64Var(&before, "before", 0, "return versions before this version number")
+	core.Bind(listCmd, &ListVersionsCommand{
+		CanvasID: &canvasID,
+		Limit:    &limit,
+		Before:   &before,
+	}, options)
+
+	diffCmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the changes in a canvas version",
+		Args:  cobra.NoArgs,
+	}
+	diffCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	diffCmd.Flags().StringVar(&versionID, "version-id", "", "version ID")
+	diffCmd.Flags().StringVar(&fromVersionID, "from-version-id", "", "version ID to compare with (defaults to the previous version)")
+	_ = diffCmd.MarkFlagRequired("version-id")
+	core.Bind(diffCmd, &DiffVersionsCommand{
+		CanvasID:      &canvasID,
+		VersionID:     &versionID,
+		FromVersionID: &fromVersionID,
+	}, options)
+
+	restoreCmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a canvas to a previous version",
+		Args:  cobra.NoArgs,
+	}
+	restoreCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	restoreCmd.Flags().StringVar(&versionID, "version-id", "", "version ID")
+	_ = restoreCmd.MarkFlagRequired("version-id")
+	core.Bind(restoreCmd, &RestoreVersionCommand{
+		CanvasID:  &canvasID,
+		VersionID: &versionID,
+	}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(diffCmd)
+	root.AddCommand(restoreCmd)
+
+	return root
+}
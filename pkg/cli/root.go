@@ -10,6 +10,7 @@ import (
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	audit "github.com/superplanehq/superplane/pkg/cli/commands/audit"
 	canvases "github.com/superplanehq/superplane/pkg/cli/commands/canvases"
 	events "github.com/superplanehq/superplane/pkg/cli/commands/events"
 	executions "github.com/superplanehq/superplane/pkg/cli/commands/executions"
@@ -17,6 +18,8 @@ import (
 	integrations "github.com/superplanehq/superplane/pkg/cli/commands/integrations"
 	queue "github.com/superplanehq/superplane/pkg/cli/commands/queue"
 	secrets "github.com/superplanehq/superplane/pkg/cli/commands/secrets"
+	variables "github.com/superplanehq/superplane/pkg/cli/commands/variables"
+	versions "github.com/superplanehq/superplane/pkg/cli/commands/versions"
 	"github.com/superplanehq/superplane/pkg/cli/core"
 )
 
@@ -51,6 +54,7 @@ func init() {
 	RootCmd.PersistentFlags().StringVarP(&OutputFormat, "output", "o", "", "output format: text|json|yaml (overrides config output)")
 
 	options := defaultBindOptions()
+	RootCmd.AddCommand(audit.NewCommand(options))
 	RootCmd.AddCommand(canvases.NewCommand(options))
 	RootCmd.AddCommand(executions.NewCommand(options))
 	RootCmd.AddCommand(events.NewCommand(options))
@@ -58,6 +62,8 @@ func init() {
 	RootCmd.AddCommand(integrations.NewCommand(options))
 	RootCmd.AddCommand(queue.NewCommand(options))
 	RootCmd.AddCommand(secrets.NewCommand(options))
+	RootCmd.AddCommand(variables.NewCommand(options))
+	RootCmd.AddCommand(versions.NewCommand(options))
 }
 
 func initConfig() {
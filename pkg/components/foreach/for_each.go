@@ -0,0 +1,426 @@
+package foreach
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/expressions"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "forEach"
+
+const (
+	ChannelNameDefault = "default"
+	ChannelNameEmpty   = "empty"
+
+	ItemPayloadType  = "forEach.item"
+	EmptyPayloadType = "forEach.empty"
+
+	//
+	// Action used to emit the next item,
+	// when a previous item is gathered.
+	//
+	ActionReleaseItem = "releaseItem"
+)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &ForEach{})
+}
+
+type ForEach struct{}
+
+type Spec struct {
+	Items          string `json:"items" mapstructure:"items"`
+	MaxConcurrency int    `json:"maxConcurrency" mapstructure:"maxConcurrency"`
+}
+
+//
+// The execution metadata holds the list of items,
+// so items can be emitted later on, without evaluating the expression again.
+//
+
+type ExecutionMetadata struct {
+	Batch          string `json:"batch" mapstructure:"batch"`
+	Items          []any  `json:"items" mapstructure:"items"`
+	MaxConcurrency int    `json:"maxConcurrency" mapstructure:"maxConcurrency"`
+
+	// Next is the index of the next item to emit
+	Next int `json:"next" mapstructure:"next"`
+
+	// Released holds the indexes of the emitted items whose slot was freed,
+	// either because they were gathered, or because their branch stopped before that.
+	Released []int `json:"released" mapstructure:"released"`
+
+	// Failed holds the indexes of the items whose branch stopped before being gathered
+	Failed []int `json:"failed" mapstructure:"failed"`
+}
+
+//
+// Item is the payload emitted for each item in the list.
+//
+
+type Item struct {
+	// Batch is the ID of the For Each execution that emitted the item
+	Batch string `json:"batch" mapstructure:"batch"`
+	Index int    `json:"index" mapstructure:"index"`
+	Total int    `json:"total" mapstructure:"total"`
+	Item  any    `json:"item" mapstructure:"item"`
+}
+
+func (f *ForEach) Name() string {
+	return ComponentName
+}
+
+func (f *ForEach) Label() string {
+	return "For Each"
+}
+
+func (f *ForEach) Description() string {
+	return "Run the next nodes once for each item in a list"
+}
+
+func (f *ForEach) Documentation() string {
+	return `The For Each component evaluates an expression yielding a list, and emits one event for each item in it.
+
+## Use Cases
+
+- **Multi-target operations**: Deploy every service, or update every repository
+- **Multi-region rollouts**: Run the same steps for each region
+- **Batch processing**: Process each entry returned by a previous node
+
+## How It Works
+
+1. The component evaluates the items expression against the incoming event data
+2. It emits one event per item on the default channel, with the item, its index and the total number of items
+3. The nodes connected to the default channel run once for each item
+4. Use a Gather node at the end of the item branch to wait for all items and collect their results
+
+## Configuration
+
+- **Items**: Expression yielding a list, e.g. ` + "`$[\"List Services\"].data.services`" + `
+- **Max Concurrency**: Maximum number of items being processed at the same time. Use 0 for no limit.
+
+## Output Channels
+
+- **Default**: Emits one event per item, with ` + "`item`" + `, ` + "`index`" + ` and ` + "`total`" + `
+- **Empty**: Emitted once when the list has no items
+
+## Notes
+
+- Max Concurrency requires a Gather node at the end of the item branch, since the next items are only emitted when previous ones are gathered
+- If the branch of an item stops without reaching the Gather node, e.g. because a node in it failed, the item is counted as failed, and its slot is freed for the next item
+- The execution finishes once every item has been emitted`
+}
+
+func (f *ForEach) Icon() string {
+	return "repeat"
+}
+
+func (f *ForEach) Color() string {
+	return "gray"
+}
+
+func (f *ForEach) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ChannelNameDefault, Label: "Default", Description: "One event for each item"},
+		{Name: ChannelNameEmpty, Label: "Empty", Description: "The list has no items"},
+	}
+}
+
+func (f *ForEach) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "items",
+			Label:       "Items",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Expression yielding the list of items",
+			Placeholder: "e.g. $[\"List Services\"].data.services",
+			Required:    true,
+		},
+		{
+			Name:        "maxConcurrency",
+			Label:       "Max Concurrency",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Maximum number of items processed at the same time. Use 0 for no limit.",
+			Default:     0,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 0; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (f *ForEach) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	if spec.Items == "" {
+		return fmt.Errorf("items is required")
+	}
+
+	if spec.MaxConcurrency < 0 {
+		return fmt.Errorf("maxConcurrency must be >= 0")
+	}
+
+	return nil
+}
+
+func (f *ForEach) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (f *ForEach) Execute(ctx core.ExecutionContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	items, err := evaluateItems(ctx, spec.Items)
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		return ctx.ExecutionState.Emit(
+			ChannelNameEmpty,
+			EmptyPayloadType,
+			[]any{map[string]any{"total": 0}},
+		)
+	}
+
+	metadata := &ExecutionMetadata{
+		Batch:          ctx.ID.String(),
+		Items:          items,
+		MaxConcurrency: spec.MaxConcurrency,
+		Released:       []int{},
+		Failed:         []int{},
+	}
+
+	count := len(items)
+	if spec.MaxConcurrency > 0 && spec.MaxConcurrency < count {
+		count = spec.MaxConcurrency
+
+		//
+		// The remaining items are emitted as previous ones are gathered,
+		// so if every branch of the run stops before that, the engine needs to tell us.
+		//
+		err = ctx.ExecutionState.SetKV(models.ExecutionKVWaitingForBranches, models.WaitingToEmit)
+		if err != nil {
+			return fmt.Errorf("error setting KV: %w", err)
+		}
+	}
+
+	return emitItems(metadata.Batch, ctx.Metadata, ctx.ExecutionState, metadata, count)
+}
+
+func (f *ForEach) Actions() []core.Action {
+	return []core.Action{
+		{Name: ActionReleaseItem},
+		{Name: models.ActionBranchesStopped},
+	}
+}
+
+func (f *ForEach) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case ActionReleaseItem:
+		return f.releaseItem(ctx)
+	case models.ActionBranchesStopped:
+		return f.failItemsInFlight(ctx)
+	default:
+		return fmt.Errorf("forEach does not support action: %s", ctx.Name)
+	}
+}
+
+type releaseParameters struct {
+	Batch string `mapstructure:"batch"`
+	Index *int   `mapstructure:"index"`
+}
+
+func (f *ForEach) releaseItem(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := &ExecutionMetadata{}
+	err := mapstructure.Decode(ctx.Metadata.Get(), metadata)
+	if err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	params := releaseParameters{}
+	err = mapstructure.Decode(ctx.Parameters, &params)
+	if err != nil {
+		return fmt.Errorf("failed to decode parameters: %w", err)
+	}
+
+	//
+	// Items counted as failed already freed their slot,
+	// so a result for them arriving later does not free another one.
+	//
+	if params.Index != nil {
+		if slices.Contains(metadata.Released, *params.Index) {
+			return nil
+		}
+
+		metadata.Released = append(metadata.Released, *params.Index)
+	}
+
+	return emitItems(params.Batch, ctx.Metadata, ctx.ExecutionState, metadata, 1)
+}
+
+// failItemsInFlight is called by the engine when every branch of the run stopped,
+// so the items emitted, but not gathered yet, never will be.
+// They are counted as failed, and their slots are used for the next items.
+func (f *ForEach) failItemsInFlight(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := &ExecutionMetadata{}
+	err := mapstructure.Decode(ctx.Metadata.Get(), metadata)
+	if err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	for index := 0; index < metadata.Next; index++ {
+		if !slices.Contains(metadata.Released, index) {
+			metadata.Released = append(metadata.Released, index)
+			metadata.Failed = append(metadata.Failed, index)
+		}
+	}
+
+	count := metadata.MaxConcurrency
+	if count == 0 {
+		count = len(metadata.Items)
+	}
+
+	return emitItems(metadata.Batch, ctx.Metadata, ctx.ExecutionState, metadata, count)
+}
+
+// emitItems emits the next items in the list, without finishing the execution,
+// and passes the execution once all items have been emitted.
+func emitItems(batch string, metadataCtx core.MetadataContext, state core.ExecutionStateContext, metadata *ExecutionMetadata, count int) error {
+	total := len(metadata.Items)
+	payloads := []any{}
+	for count > 0 && metadata.Next < total {
+		payloads = append(payloads, Item{
+			Batch: batch,
+			Index: metadata.Next,
+			Total: total,
+			Item:  metadata.Items[metadata.Next],
+		})
+
+		metadata.Next++
+		count--
+	}
+
+	err := metadataCtx.Set(metadata)
+	if err != nil {
+		return fmt.Errorf("error setting metadata: %w", err)
+	}
+
+	if len(payloads) > 0 {
+		err = state.Stream(ChannelNameDefault, ItemPayloadType, payloads)
+		if err != nil {
+			return err
+		}
+	}
+
+	if metadata.Next < total {
+		return nil
+	}
+
+	return state.Pass()
+}
+
+func evaluateItems(ctx core.ExecutionContext, expression string) ([]any, error) {
+	env, err := expressionEnv(ctx, expression)
+	if err != nil {
+		return nil, err
+	}
+
+	vm, err := expr.Compile(expression, expressionOptions(env)...)
+	if err != nil {
+		return nil, fmt.Errorf("expression compilation failed: %w", err)
+	}
+
+	output, err := expr.Run(vm, env)
+	if err != nil {
+		return nil, fmt.Errorf("expression evaluation failed: %w", err)
+	}
+
+	if output == nil {
+		return []any{}, nil
+	}
+
+	items, ok := output.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expression must evaluate to a list, got %T", output)
+	}
+
+	return items, nil
+}
+
+func expressionEnv(ctx core.ExecutionContext, expression string) (map[string]any, error) {
+	if ctx.ExpressionEnv != nil {
+		return ctx.ExpressionEnv(expression)
+	}
+
+	return buildExpressionEnv(ctx.Data, ctx.SourceNodeID), nil
+}
+
+func buildExpressionEnv(input any, sourceNodeID string) map[string]any {
+	if sourceNodeID == "" {
+		return map[string]any{"$": input}
+	}
+
+	if inputMap, ok := input.(map[string]any); ok {
+		envData := make(map[string]any, len(inputMap)+1)
+		for key, value := range inputMap {
+			envData[key] = value
+		}
+		if _, exists := envData[sourceNodeID]; !exists {
+			envData[sourceNodeID] = input
+		}
+		return map[string]any{"$": envData}
+	}
+
+	return map[string]any{"$": map[string]any{sourceNodeID: input}}
+}
+
+func expressionOptions(env map[string]any) []expr.Option {
+	options := []expr.Option{
+		expr.Env(env),
+		expr.WithContext("ctx"),
+		expr.Timezone(time.UTC.String()),
+	}
+
+	return append(options, expressions.EnvOptions(env)...)
+}
+
+func (f *ForEach) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (f *ForEach) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (f *ForEach) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
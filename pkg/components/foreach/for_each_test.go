@@ -0,0 +1,255 @@
+package foreach
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ForEach_Setup(t *testing.T) {
+	component := &ForEach{}
+
+	t.Run("items is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{Configuration: map[string]any{}})
+		require.ErrorContains(t, err, "items is required")
+	})
+
+	t.Run("max concurrency cannot be negative", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"items": "$.items", "maxConcurrency": -1},
+		})
+
+		require.ErrorContains(t, err, "maxConcurrency must be >= 0")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"items": "$.items", "maxConcurrency": 2},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__ForEach_Execute(t *testing.T) {
+	component := &ForEach{}
+	input := map[string]any{"items": []any{"a", "b", "c"}}
+
+	t.Run("emits every item and passes without max concurrency", func(t *testing.T) {
+		id := uuid.New()
+		state := &contexts.ExecutionStateContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			ID:             id,
+			Data:           input,
+			Configuration:  map[string]any{"items": "$.items"},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, state.Finished)
+		assert.True(t, state.Passed)
+		assert.Equal(t, ChannelNameDefault, state.Channel)
+		assert.Equal(t, ItemPayloadType, state.Type)
+		require.Len(t, state.Payloads, 3)
+		assert.Equal(t, Item{Batch: id.String(), Index: 2, Total: 3, Item: "c"}, state.Payloads[2].(map[string]any)["data"])
+	})
+
+	t.Run("emits items up to max concurrency and releases the rest", func(t *testing.T) {
+		id := uuid.New()
+		state := &contexts.ExecutionStateContext{}
+		metadata := &contexts.MetadataContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			ID:             id,
+			Data:           input,
+			Configuration:  map[string]any{"items": "$.items", "maxConcurrency": 2},
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, state.Finished)
+		require.Len(t, state.Payloads, 2)
+
+		err = component.HandleAction(core.ActionContext{
+			Name:           ActionReleaseItem,
+			Parameters:     map[string]any{"batch": id.String()},
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, state.Finished)
+		require.Len(t, state.Payloads, 3)
+		assert.Equal(t, Item{Batch: id.String(), Index: 2, Total: 3, Item: "c"}, state.Payloads[2].(map[string]any)["data"])
+	})
+
+	t.Run("execution waits for branches when items are held back", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			ID:             uuid.New(),
+			Data:           input,
+			Configuration:  map[string]any{"items": "$.items", "maxConcurrency": 2},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.WaitingToEmit, state.KVs[models.ExecutionKVWaitingForBranches])
+	})
+
+	t.Run("release of an item already counted as failed is ignored", func(t *testing.T) {
+		id := uuid.New()
+		state := &contexts.ExecutionStateContext{}
+		metadata := &contexts.MetadataContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			ID:             id,
+			Data:           input,
+			Configuration:  map[string]any{"items": "$.items", "maxConcurrency": 1},
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, state.Payloads, 1)
+
+		err = component.HandleAction(core.ActionContext{
+			Name:           models.ActionBranchesStopped,
+			Parameters:     map[string]any{},
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, state.Payloads, 2)
+
+		err = component.HandleAction(core.ActionContext{
+			Name:           ActionReleaseItem,
+			Parameters:     map[string]any{"batch": id.String(), "index": 0},
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, state.Finished)
+		require.Len(t, state.Payloads, 2)
+	})
+
+	t.Run("released items are recorded by index", func(t *testing.T) {
+		id := uuid.New()
+		state := &contexts.ExecutionStateContext{}
+		metadata := &contexts.MetadataContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			ID:             id,
+			Data:           input,
+			Configuration:  map[string]any{"items": "$.items", "maxConcurrency": 2},
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, state.Payloads, 2)
+
+		err = component.HandleAction(core.ActionContext{
+			Name:           ActionReleaseItem,
+			Parameters:     map[string]any{"batch": id.String(), "index": 1},
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, state.Payloads, 3)
+		assert.True(t, state.Finished)
+
+		stored := ExecutionMetadata{}
+		require.NoError(t, mapstructure.Decode(metadata.Get(), &stored))
+		assert.Equal(t, []int{1}, stored.Released)
+		assert.Empty(t, stored.Failed)
+	})
+
+	t.Run("remaining items are emitted when branches stop", func(t *testing.T) {
+		id := uuid.New()
+		state := &contexts.ExecutionStateContext{}
+		metadata := &contexts.MetadataContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			ID:             id,
+			Data:           input,
+			Configuration:  map[string]any{"items": "$.items", "maxConcurrency": 2},
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, state.Payloads, 2)
+
+		err = component.HandleAction(core.ActionContext{
+			Name:           models.ActionBranchesStopped,
+			Parameters:     map[string]any{},
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, state.Finished)
+		assert.True(t, state.Passed)
+		require.Len(t, state.Payloads, 3)
+		assert.Equal(t, Item{Batch: id.String(), Index: 2, Total: 3, Item: "c"}, state.Payloads[2].(map[string]any)["data"])
+
+		stored := ExecutionMetadata{}
+		require.NoError(t, mapstructure.Decode(metadata.Get(), &stored))
+		assert.ElementsMatch(t, []int{0, 1}, stored.Failed)
+	})
+
+	t.Run("release is ignored once the execution is finished", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{Finished: true}
+
+		err := component.HandleAction(core.ActionContext{
+			Name:           ActionReleaseItem,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, state.Payloads)
+	})
+
+	t.Run("empty list is emitted on the empty channel", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			Data:           map[string]any{"items": []any{}},
+			Configuration:  map[string]any{"items": "$.items"},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, state.Finished)
+		assert.Equal(t, ChannelNameEmpty, state.Channel)
+		assert.Equal(t, EmptyPayloadType, state.Type)
+	})
+
+	t.Run("expression must yield a list", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			Data:           map[string]any{"items": "a"},
+			Configuration:  map[string]any{"items": "$.items"},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.ErrorContains(t, err, "expression must evaluate to a list")
+	})
+}
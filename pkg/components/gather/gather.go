@@ -0,0 +1,368 @@
+package gather
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/components/foreach"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "gather"
+
+const (
+	ChannelNameDefault = "default"
+	ChannelNameFailed  = "failed"
+	PayloadType        = "gather.finished"
+)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &Gather{})
+}
+
+type Gather struct{}
+
+//
+// The execution metadata holds the results
+// gathered so far for a For Each execution.
+//
+
+type ExecutionMetadata struct {
+	// Batch is the ID of the For Each execution which emitted the items
+	Batch   string   `json:"batch" mapstructure:"batch"`
+	Total   int      `json:"total" mapstructure:"total"`
+	Results []Result `json:"results" mapstructure:"results"`
+}
+
+type Result struct {
+	Index  int  `json:"index" mapstructure:"index"`
+	Item   any  `json:"item" mapstructure:"item"`
+	Output any  `json:"output" mapstructure:"output"`
+	Failed bool `json:"failed,omitempty" mapstructure:"failed"`
+}
+
+func (g *Gather) Name() string {
+	return ComponentName
+}
+
+func (g *Gather) Label() string {
+	return "Gather"
+}
+
+func (g *Gather) Description() string {
+	return "Wait for every item of a For Each and collect the results"
+}
+
+func (g *Gather) Documentation() string {
+	return `The Gather component waits for the branches started by a For Each node, and emits a single event with the results of all of them.
+
+## Use Cases
+
+- **Fan-in after fan-out**: Continue the workflow only after every item was processed
+- **Result aggregation**: Collect the outputs of each item into a single list
+- **Concurrency control**: Release the next items of a For Each with a max concurrency
+
+## How It Works
+
+1. Each event reaching the Gather node is matched to the For Each item that led to it
+2. The event data is recorded as the output for that item
+3. Once every item has an output, the results are emitted on the default channel, ordered by item index
+4. If the branch of an item stops without reaching the Gather node, e.g. because a node in it failed, the item is counted as failed once nothing else is running for the event which started the For Each
+
+## Output Channels
+
+- **Default**: Emitted when all items are gathered, with ` + "`total`" + ` and the list of ` + "`items`" + `, each with its ` + "`index`" + `, ` + "`item`" + ` and ` + "`output`" + `
+- **Failed**: Emitted instead of the default channel when some items failed, with the number of ` + "`failed`" + ` items. Failed items have ` + "`failed`" + ` set, and no ` + "`output`" + `
+
+## Notes
+
+- Events which are not downstream of a For Each node are rejected with a failed execution
+- If no item reaches the Gather node, nothing is emitted
+- Only the first event for each item is used`
+}
+
+func (g *Gather) Icon() string {
+	return "list-checks"
+}
+
+func (g *Gather) Color() string {
+	return "gray"
+}
+
+func (g *Gather) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ChannelNameDefault, Label: "Default", Description: "Every item was gathered"},
+		{Name: ChannelNameFailed, Label: "Failed", Description: "Some items failed"},
+	}
+}
+
+func (g *Gather) Configuration() []configuration.Field {
+	return []configuration.Field{}
+}
+
+func (g *Gather) Setup(ctx core.SetupContext) error {
+	return nil
+}
+
+func (g *Gather) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	upstream, err := ctx.FindUpstreamEvent(foreach.ItemPayloadType)
+	if err != nil {
+		return nil, fmt.Errorf("error finding For Each item: %v", err)
+	}
+
+	if upstream == nil || upstream.ExecutionID == nil {
+		return g.reject(ctx, "event is not part of a For Each item branch")
+	}
+
+	item, err := decodeItem(upstream.Data)
+	if err != nil {
+		return g.reject(ctx, err.Error())
+	}
+
+	executionCtx, err := g.findOrCreateExecution(ctx, item)
+	if err != nil {
+		return nil, fmt.Errorf("error finding or creating execution: %v", err)
+	}
+
+	if err := ctx.DequeueItem(); err != nil {
+		return nil, fmt.Errorf("error dequeuing item: %v", err)
+	}
+
+	if executionCtx.ExecutionState.IsFinished() {
+		return nil, nil
+	}
+
+	if err := ctx.UpdateNodeState(models.CanvasNodeStateReady); err != nil {
+		return nil, fmt.Errorf("error updating node state: %v", err)
+	}
+
+	md, added, err := g.addResultToMetadata(executionCtx, item, ctx.Input)
+	if err != nil {
+		return nil, fmt.Errorf("error adding result to metadata: %v", err)
+	}
+
+	if !added {
+		return nil, nil
+	}
+
+	//
+	// A result for an item frees up a slot
+	// for the next item in the For Each execution.
+	//
+	err = ctx.ScheduleExecutionAction(*upstream.ExecutionID, foreach.ActionReleaseItem, map[string]any{
+		"batch": item.Batch,
+		"index": item.Index,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error releasing next item: %v", err)
+	}
+
+	if len(md.Results) < md.Total {
+		return nil, nil
+	}
+
+	return &executionCtx.ID, emitResults(executionCtx.ExecutionState, md)
+}
+
+func emitResults(state core.ExecutionStateContext, md *ExecutionMetadata) error {
+	sort.Slice(md.Results, func(i, j int) bool {
+		return md.Results[i].Index < md.Results[j].Index
+	})
+
+	failed := 0
+	for _, result := range md.Results {
+		if result.Failed {
+			failed++
+		}
+	}
+
+	channel := ChannelNameDefault
+	if failed > 0 {
+		channel = ChannelNameFailed
+	}
+
+	return state.Emit(
+		channel,
+		PayloadType,
+		[]any{map[string]any{
+			"batch":  md.Batch,
+			"total":  md.Total,
+			"failed": failed,
+			"items":  md.Results,
+		}},
+	)
+}
+
+// reject records a failed execution for an event which cannot be gathered,
+// and removes it from the queue, so the next events for the node are still processed.
+func (g *Gather) reject(ctx core.ProcessQueueContext, message string) (*uuid.UUID, error) {
+	executionCtx, err := ctx.CreateExecution()
+	if err != nil {
+		return nil, fmt.Errorf("error creating execution: %v", err)
+	}
+
+	if err := ctx.DequeueItem(); err != nil {
+		return nil, fmt.Errorf("error dequeuing item: %v", err)
+	}
+
+	return &executionCtx.ID, executionCtx.ExecutionState.Fail(models.CanvasNodeExecutionResultReasonError, message)
+}
+
+func decodeItem(data any) (*foreach.Item, error) {
+	event, ok := data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid For Each item event")
+	}
+
+	item := &foreach.Item{}
+	err := mapstructure.Decode(event["data"], item)
+	if err != nil {
+		return nil, fmt.Errorf("invalid For Each item: %v", err)
+	}
+
+	if item.Batch == "" {
+		return nil, fmt.Errorf("invalid For Each item: missing batch")
+	}
+
+	return item, nil
+}
+
+func (g *Gather) findOrCreateExecution(ctx core.ProcessQueueContext, item *foreach.Item) (*core.ExecutionContext, error) {
+	executionCtx, err := ctx.FindExecutionByKV("gather_batch", item.Batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if executionCtx != nil {
+		return executionCtx, nil
+	}
+
+	executionCtx, err = ctx.CreateExecution()
+	if err != nil {
+		return nil, err
+	}
+
+	err = executionCtx.ExecutionState.SetKV("gather_batch", item.Batch)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// If the branches of some items stop before reaching us,
+	// the engine tells us once nothing else is running for the event.
+	//
+	err = executionCtx.ExecutionState.SetKV(models.ExecutionKVWaitingForBranches, models.WaitingToCollect)
+	if err != nil {
+		return nil, err
+	}
+
+	err = executionCtx.Metadata.Set(&ExecutionMetadata{
+		Batch:   item.Batch,
+		Total:   item.Total,
+		Results: []Result{},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executionCtx, nil
+}
+
+func (g *Gather) addResultToMetadata(executionCtx *core.ExecutionContext, item *foreach.Item, output any) (*ExecutionMetadata, bool, error) {
+	md := &ExecutionMetadata{}
+	err := mapstructure.Decode(executionCtx.Metadata.Get(), md)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, result := range md.Results {
+		if result.Index == item.Index {
+			return md, false, nil
+		}
+	}
+
+	md.Results = append(md.Results, Result{
+		Index:  item.Index,
+		Item:   item.Item,
+		Output: output,
+	})
+
+	err = executionCtx.Metadata.Set(md)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return md, true, nil
+}
+
+func (g *Gather) Execute(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (g *Gather) Actions() []core.Action {
+	return []core.Action{
+		{Name: models.ActionBranchesStopped},
+	}
+}
+
+func (g *Gather) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case models.ActionBranchesStopped:
+		return g.failMissingItems(ctx)
+	default:
+		return fmt.Errorf("gather does not support action: %s", ctx.Name)
+	}
+}
+
+// failMissingItems is called by the engine when every branch of the run stopped,
+// so the items not gathered yet never will be.
+// They are counted as failed, and the results are emitted.
+func (g *Gather) failMissingItems(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	md := &ExecutionMetadata{}
+	err := mapstructure.Decode(ctx.Metadata.Get(), md)
+	if err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	gathered := map[int]bool{}
+	for _, result := range md.Results {
+		gathered[result.Index] = true
+	}
+
+	for index := 0; index < md.Total; index++ {
+		if !gathered[index] {
+			md.Results = append(md.Results, Result{Index: index, Failed: true})
+		}
+	}
+
+	err = ctx.Metadata.Set(md)
+	if err != nil {
+		return fmt.Errorf("error setting metadata: %w", err)
+	}
+
+	return emitResults(ctx.ExecutionState, md)
+}
+
+func (g *Gather) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (g *Gather) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (g *Gather) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,220 @@
+package gather
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/components/foreach"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+type scheduledAction struct {
+	ExecutionID uuid.UUID
+	Name        string
+	Parameters  map[string]any
+}
+
+type gatherTestContext struct {
+	forEachExecutionID uuid.UUID
+	execution          *core.ExecutionContext
+	state              *contexts.ExecutionStateContext
+	actions            []scheduledAction
+	dequeued           int
+}
+
+func newGatherTestContext() *gatherTestContext {
+	return &gatherTestContext{forEachExecutionID: uuid.New()}
+}
+
+func (c *gatherTestContext) queueContext(upstream *core.UpstreamEvent, input any) core.ProcessQueueContext {
+	return core.ProcessQueueContext{
+		Input: input,
+		FindUpstreamEvent: func(payloadType string) (*core.UpstreamEvent, error) {
+			return upstream, nil
+		},
+		FindExecutionByKV: func(key, value string) (*core.ExecutionContext, error) {
+			return c.execution, nil
+		},
+		CreateExecution: func() (*core.ExecutionContext, error) {
+			c.state = &contexts.ExecutionStateContext{KVs: map[string]string{}}
+			c.execution = &core.ExecutionContext{
+				ID:             uuid.New(),
+				Metadata:       &contexts.MetadataContext{},
+				ExecutionState: c.state,
+			}
+
+			return c.execution, nil
+		},
+		DequeueItem: func() error {
+			c.dequeued++
+			return nil
+		},
+		UpdateNodeState: func(state string) error {
+			return nil
+		},
+		ScheduleExecutionAction: func(executionID uuid.UUID, actionName string, parameters map[string]any) error {
+			c.actions = append(c.actions, scheduledAction{ExecutionID: executionID, Name: actionName, Parameters: parameters})
+			return nil
+		},
+	}
+}
+
+func (c *gatherTestContext) itemEvent(index, total int, item any) *core.UpstreamEvent {
+	return &core.UpstreamEvent{
+		ExecutionID: &c.forEachExecutionID,
+		Data: map[string]any{
+			"type": foreach.ItemPayloadType,
+			"data": map[string]any{
+				"batch": c.forEachExecutionID.String(),
+				"index": index,
+				"total": total,
+				"item":  item,
+			},
+		},
+	}
+}
+
+func Test__Gather_ProcessQueueItem(t *testing.T) {
+	component := &Gather{}
+
+	t.Run("waits for every item and emits results ordered by index", func(t *testing.T) {
+		ctx := newGatherTestContext()
+
+		id, err := component.ProcessQueueItem(ctx.queueContext(ctx.itemEvent(1, 2, "b"), map[string]any{"result": "second"}))
+		require.NoError(t, err)
+		assert.Nil(t, id)
+		assert.False(t, ctx.state.Finished)
+		assert.Equal(t, ctx.forEachExecutionID.String(), ctx.state.KVs["gather_batch"])
+		assert.Equal(t, models.WaitingToCollect, ctx.state.KVs[models.ExecutionKVWaitingForBranches])
+
+		id, err = component.ProcessQueueItem(ctx.queueContext(ctx.itemEvent(0, 2, "a"), map[string]any{"result": "first"}))
+		require.NoError(t, err)
+		require.NotNil(t, id)
+		assert.Equal(t, ctx.execution.ID, *id)
+		assert.True(t, ctx.state.Finished)
+		assert.Equal(t, PayloadType, ctx.state.Type)
+		assert.Equal(t, 2, ctx.dequeued)
+
+		require.Len(t, ctx.state.Payloads, 1)
+		output := ctx.state.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, 2, output["total"])
+		assert.Equal(t, []Result{
+			{Index: 0, Item: "a", Output: map[string]any{"result": "first"}},
+			{Index: 1, Item: "b", Output: map[string]any{"result": "second"}},
+		}, output["items"])
+
+		require.Len(t, ctx.actions, 2)
+		assert.Equal(t, ctx.forEachExecutionID, ctx.actions[0].ExecutionID)
+		assert.Equal(t, foreach.ActionReleaseItem, ctx.actions[0].Name)
+		assert.Equal(t, map[string]any{"batch": ctx.forEachExecutionID.String(), "index": 1}, ctx.actions[0].Parameters)
+	})
+
+	t.Run("only the first result for an item is used", func(t *testing.T) {
+		ctx := newGatherTestContext()
+
+		_, err := component.ProcessQueueItem(ctx.queueContext(ctx.itemEvent(0, 2, "a"), "first"))
+		require.NoError(t, err)
+
+		id, err := component.ProcessQueueItem(ctx.queueContext(ctx.itemEvent(0, 2, "a"), "again"))
+		require.NoError(t, err)
+		assert.Nil(t, id)
+		assert.False(t, ctx.state.Finished)
+		assert.Len(t, ctx.actions, 1)
+		assert.Equal(t, 2, ctx.dequeued)
+	})
+
+	t.Run("events outside of a For Each item branch are rejected", func(t *testing.T) {
+		ctx := newGatherTestContext()
+
+		id, err := component.ProcessQueueItem(ctx.queueContext(nil, "data"))
+		require.NoError(t, err)
+		require.NotNil(t, id)
+		assert.Equal(t, ctx.execution.ID, *id)
+		assert.True(t, ctx.state.Finished)
+		assert.False(t, ctx.state.Passed)
+		assert.Equal(t, "event is not part of a For Each item branch", ctx.state.FailureMessage)
+		assert.Equal(t, 1, ctx.dequeued)
+	})
+
+	t.Run("items after a rejected event are still processed", func(t *testing.T) {
+		ctx := newGatherTestContext()
+
+		invalid := &core.UpstreamEvent{
+			ExecutionID: &ctx.forEachExecutionID,
+			Data:        map[string]any{"type": foreach.ItemPayloadType, "data": map[string]any{"index": 0}},
+		}
+
+		id, err := component.ProcessQueueItem(ctx.queueContext(invalid, "data"))
+		require.NoError(t, err)
+		require.NotNil(t, id)
+		assert.Contains(t, ctx.state.FailureMessage, "missing batch")
+
+		//
+		// The rejected event gets its own execution,
+		// so the next item starts a new one for its batch.
+		//
+		ctx.execution = nil
+		id, err = component.ProcessQueueItem(ctx.queueContext(ctx.itemEvent(0, 1, "a"), "first"))
+		require.NoError(t, err)
+		require.NotNil(t, id)
+		assert.Equal(t, ctx.execution.ID, *id)
+		assert.True(t, ctx.state.Passed)
+		assert.Equal(t, 2, ctx.dequeued)
+	})
+}
+
+func Test__Gather_HandleAction(t *testing.T) {
+	component := &Gather{}
+
+	t.Run("missing items are counted as failed when branches stop", func(t *testing.T) {
+		ctx := newGatherTestContext()
+
+		_, err := component.ProcessQueueItem(ctx.queueContext(ctx.itemEvent(1, 3, "b"), "second"))
+		require.NoError(t, err)
+		assert.False(t, ctx.state.Finished)
+
+		err = component.HandleAction(core.ActionContext{
+			Name:           models.ActionBranchesStopped,
+			Parameters:     map[string]any{},
+			Metadata:       ctx.execution.Metadata,
+			ExecutionState: ctx.state,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, ctx.state.Finished)
+		assert.Equal(t, ChannelNameFailed, ctx.state.Channel)
+
+		require.Len(t, ctx.state.Payloads, 1)
+		output := ctx.state.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, 3, output["total"])
+		assert.Equal(t, 2, output["failed"])
+		assert.Equal(t, []Result{
+			{Index: 0, Failed: true},
+			{Index: 1, Item: "b", Output: "second"},
+			{Index: 2, Failed: true},
+		}, output["items"])
+	})
+
+	t.Run("branches stopping after the results are emitted is ignored", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{Finished: true}
+
+		err := component.HandleAction(core.ActionContext{
+			Name:           models.ActionBranchesStopped,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, state.Payloads)
+	})
+
+	t.Run("unknown actions are rejected", func(t *testing.T) {
+		err := component.HandleAction(core.ActionContext{Name: "unknown"})
+		require.ErrorContains(t, err, "gather does not support action: unknown")
+	})
+}
@@ -0,0 +1,165 @@
+package getvariable
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "getVariable"
+
+const (
+	ChannelNameDefault  = "default"
+	ChannelNameNotFound = "notFound"
+
+	PayloadType         = "variable.get"
+	NotFoundPayloadType = "variable.notFound"
+)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &GetVariable{})
+}
+
+type GetVariable struct{}
+
+type Spec struct {
+	Name string `json:"name" mapstructure:"name"`
+}
+
+type Output struct {
+	Name    string `json:"name" mapstructure:"name"`
+	Value   any    `json:"value" mapstructure:"value"`
+	Version int    `json:"version" mapstructure:"version"`
+}
+
+func (g *GetVariable) Name() string {
+	return ComponentName
+}
+
+func (g *GetVariable) Label() string {
+	return "Get Variable"
+}
+
+func (g *GetVariable) Description() string {
+	return "Read the current value and version of a canvas variable"
+}
+
+func (g *GetVariable) Documentation() string {
+	return `The Get Variable component reads a canvas variable, and emits its value and version.
+
+Variables are also available in every expression as ` + "`vars.<name>`" + `. Use this component
+when the next nodes need the value as it was at this point of the run, or its version,
+to update the variable later with a Set Variable node, only if no one else changed it in between.
+
+## Output Channels
+
+- **Default**: The variable exists. Emits its name, value and version
+- **Not Found**: The variable does not exist`
+}
+
+func (g *GetVariable) Icon() string {
+	return "database"
+}
+
+func (g *GetVariable) Color() string {
+	return "gray"
+}
+
+func (g *GetVariable) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ChannelNameDefault, Label: "Default", Description: "The variable exists"},
+		{Name: ChannelNameNotFound, Label: "Not Found", Description: "The variable does not exist"},
+	}
+}
+
+func (g *GetVariable) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "name",
+			Label:       "Name",
+			Type:        configuration.FieldTypeString,
+			Description: "Name of the variable",
+			Placeholder: "e.g. last_deployed_version",
+			Required:    true,
+		},
+	}
+}
+
+func (g *GetVariable) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(spec.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	return nil
+}
+
+func (g *GetVariable) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (g *GetVariable) Execute(ctx core.ExecutionContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	name := strings.TrimSpace(spec.Name)
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if ctx.Variables == nil {
+		return fmt.Errorf("variables are not available")
+	}
+
+	variable, err := ctx.Variables.Get(name)
+	if err != nil {
+		if errors.Is(err, core.ErrVariableNotFound) {
+			return ctx.ExecutionState.Emit(ChannelNameNotFound, NotFoundPayloadType, []any{map[string]any{"name": name}})
+		}
+
+		return fmt.Errorf("failed to get variable: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(ChannelNameDefault, PayloadType, []any{
+		Output{
+			Name:    variable.Name,
+			Value:   variable.Value,
+			Version: variable.Version,
+		},
+	})
+}
+
+func (g *GetVariable) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (g *GetVariable) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("getVariable does not support actions")
+}
+
+func (g *GetVariable) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (g *GetVariable) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (g *GetVariable) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,43 @@
+package getvariable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetVariable_Execute(t *testing.T) {
+	component := &GetVariable{}
+	variables := &contexts.VariablesContext{
+		Variables: map[string]*core.Variable{"deployed": {Name: "deployed", Value: "1.4.1", Version: 3}},
+	}
+
+	t.Run("existing variable is emitted with its version", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"name": "deployed"},
+			ExecutionState: state,
+			Variables:      variables,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, ChannelNameDefault, state.Channel)
+		assert.Equal(t, Output{Name: "deployed", Value: "1.4.1", Version: 3}, state.Payloads[0].(map[string]any)["data"])
+	})
+
+	t.Run("missing variable is emitted on not found", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"name": "missing"},
+			ExecutionState: state,
+			Variables:      variables,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, ChannelNameNotFound, state.Channel)
+	})
+}
@@ -61,6 +61,10 @@ func (c *NoOp) Configuration() []configuration.Field {
 	return []configuration.Field{}
 }
 
+func (c *NoOp) Simulatable() bool {
+	return true
+}
+
 func (c *NoOp) Execute(ctx core.ExecutionContext) error {
 	return ctx.ExecutionState.Emit(
 		core.DefaultOutputChannel.Name,
@@ -0,0 +1,206 @@
+package runcanvas
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "runCanvas"
+
+const (
+	ChannelPassed = models.CanvasInvocationChannelPassed
+	ChannelFailed = models.CanvasInvocationChannelFailed
+)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &RunCanvas{})
+}
+
+type RunCanvas struct{}
+
+type Spec struct {
+	Canvas  string `json:"canvas" mapstructure:"canvas"`
+	Trigger string `json:"trigger" mapstructure:"trigger"`
+	Payload any    `json:"payload" mapstructure:"payload"`
+}
+
+type ExecutionMetadata struct {
+	CanvasID    string `json:"canvasId" mapstructure:"canvasId"`
+	RootEventID string `json:"rootEventId" mapstructure:"rootEventId"`
+}
+
+func (c *RunCanvas) Name() string {
+	return ComponentName
+}
+
+func (c *RunCanvas) Label() string {
+	return "Run Canvas"
+}
+
+func (c *RunCanvas) Description() string {
+	return "Run another canvas and wait for its result"
+}
+
+func (c *RunCanvas) Documentation() string {
+	return `The Run Canvas component starts a new run of another canvas in the same organization, and waits for it to finish.
+
+## Use Cases
+
+- **Reusable workflows**: Keep shared workflows in their own canvas, and run them from other canvases
+- **Workflow composition**: Split large workflows into smaller canvases
+- **Cross-team workflows**: Trigger workflows owned by other teams and react to their result
+
+## How It Works
+
+1. The component emits an event from a trigger node of the target canvas, starting a new run of it
+2. The execution stays running while the target canvas processes the event
+3. When nothing else is running or queued for that run, the execution finishes
+
+## Configuration
+
+- **Canvas**: The ID of the canvas to run
+- **Trigger**: The ID of the trigger node in the target canvas which emits the event
+- **Payload**: The data for the emitted event. If not set, the input of this node is used.
+
+## Output Channels
+
+- **Passed**: Every execution in the target canvas run passed
+- **Failed**: At least one execution in the target canvas run failed or was cancelled
+
+Both channels emit the outputs of the terminal nodes of the target canvas, grouped by node ID, and the failures, if any.
+
+## Notes
+
+- A canvas cannot run itself, or any canvas which is already running it, directly or indirectly
+- Cancelling this execution does not cancel the run of the target canvas`
+}
+
+func (c *RunCanvas) Icon() string {
+	return "workflow"
+}
+
+func (c *RunCanvas) Color() string {
+	return "purple"
+}
+
+func (c *RunCanvas) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ChannelPassed, Label: "Passed", Description: "The target canvas run passed"},
+		{Name: ChannelFailed, Label: "Failed", Description: "The target canvas run failed"},
+	}
+}
+
+func (c *RunCanvas) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "canvas",
+			Label:       "Canvas",
+			Type:        configuration.FieldTypeString,
+			Description: "ID of the canvas to run",
+			Required:    true,
+		},
+		{
+			Name:        "trigger",
+			Label:       "Trigger",
+			Type:        configuration.FieldTypeString,
+			Description: "ID of the trigger node in the target canvas which emits the event",
+			Required:    true,
+		},
+		{
+			Name:        "payload",
+			Label:       "Payload",
+			Type:        configuration.FieldTypeObject,
+			Description: "Data for the event emitted into the target canvas. Defaults to the input of this node.",
+		},
+	}
+}
+
+func (c *RunCanvas) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	return spec.Validate()
+}
+
+func (s *Spec) Validate() error {
+	if s.Canvas == "" {
+		return fmt.Errorf("canvas is required")
+	}
+
+	_, err := uuid.Parse(s.Canvas)
+	if err != nil {
+		return fmt.Errorf("canvas must be a valid canvas ID")
+	}
+
+	if s.Trigger == "" {
+		return fmt.Errorf("trigger is required")
+	}
+
+	return nil
+}
+
+func (c *RunCanvas) Execute(ctx core.ExecutionContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %v", err)
+	}
+
+	err = spec.Validate()
+	if err != nil {
+		return err
+	}
+
+	payload := spec.Payload
+	if payload == nil {
+		payload = ctx.Data
+	}
+
+	eventID, err := ctx.Canvases.Invoke(spec.Canvas, spec.Trigger, payload)
+	if err != nil {
+		return fmt.Errorf("failed to run canvas: %v", err)
+	}
+
+	//
+	// The execution is finished by the engine
+	// when the run of the target canvas is done.
+	//
+	return ctx.Metadata.Set(ExecutionMetadata{
+		CanvasID:    spec.Canvas,
+		RootEventID: eventID,
+	})
+}
+
+func (c *RunCanvas) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *RunCanvas) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *RunCanvas) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("runCanvas does not support actions")
+}
+
+func (c *RunCanvas) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *RunCanvas) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (c *RunCanvas) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,114 @@
+package runcanvas
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__RunCanvas_Setup(t *testing.T) {
+	component := &RunCanvas{}
+
+	t.Run("canvas is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"trigger": "trigger-1"},
+		})
+
+		require.ErrorContains(t, err, "canvas is required")
+	})
+
+	t.Run("canvas must be an ID", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"canvas": "my-canvas", "trigger": "trigger-1"},
+		})
+
+		require.ErrorContains(t, err, "canvas must be a valid canvas ID")
+	})
+
+	t.Run("trigger is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"canvas": uuid.NewString()},
+		})
+
+		require.ErrorContains(t, err, "trigger is required")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"canvas": uuid.NewString(), "trigger": "trigger-1"},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__RunCanvas_Execute(t *testing.T) {
+	component := &RunCanvas{}
+	canvasID := uuid.NewString()
+
+	t.Run("invokes canvas with configured payload and keeps execution running", func(t *testing.T) {
+		canvases := &contexts.CanvasesContext{}
+		metadata := &contexts.MetadataContext{}
+		state := &contexts.ExecutionStateContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			Data: map[string]any{"input": true},
+			Configuration: map[string]any{
+				"canvas":  canvasID,
+				"trigger": "trigger-1",
+				"payload": map[string]any{"version": "v1"},
+			},
+			Canvases:       canvases,
+			Metadata:       metadata,
+			ExecutionState: state,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, canvasID, canvases.CanvasID)
+		assert.Equal(t, "trigger-1", canvases.NodeID)
+		assert.Equal(t, map[string]any{"version": "v1"}, canvases.Payload)
+		assert.Equal(t, ExecutionMetadata{CanvasID: canvasID, RootEventID: canvases.EventID}, metadata.Get())
+		assert.False(t, state.Finished)
+	})
+
+	t.Run("uses input as payload when none is configured", func(t *testing.T) {
+		canvases := &contexts.CanvasesContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			Data:           map[string]any{"input": true},
+			Configuration:  map[string]any{"canvas": canvasID, "trigger": "trigger-1"},
+			Canvases:       canvases,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"input": true}, canvases.Payload)
+	})
+
+	t.Run("invocation error is returned", func(t *testing.T) {
+		canvases := &contexts.CanvasesContext{Err: errors.New("recursive canvas invocation")}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"canvas": canvasID, "trigger": "trigger-1"},
+			Canvases:       canvases,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.ErrorContains(t, err, "recursive canvas invocation")
+	})
+}
+
+func Test__RunCanvas_OutputChannels(t *testing.T) {
+	channels := (&RunCanvas{}).OutputChannels(nil)
+	require.Len(t, channels, 2)
+	assert.Equal(t, "passed", channels[0].Name)
+	assert.Equal(t, "failed", channels[1].Name)
+}
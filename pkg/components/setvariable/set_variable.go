@@ -0,0 +1,461 @@
+package setvariable
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/expressions"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "setVariable"
+
+const (
+	ChannelNameDefault  = "default"
+	ChannelNameConflict = "conflict"
+
+	PayloadType         = "variable.set"
+	ConflictPayloadType = "variable.conflict"
+
+	OperationSet       = "set"
+	OperationIncrement = "increment"
+	OperationAppend    = "append"
+
+	//
+	// Increment and append read the current value and write the new one
+	// only if the variable was not changed in between.
+	// If it was, they try again, up to this many times.
+	//
+	MaxAttempts = 5
+)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &SetVariable{})
+}
+
+type SetVariable struct{}
+
+type Spec struct {
+	Name            string `json:"name" mapstructure:"name"`
+	Operation       string `json:"operation" mapstructure:"operation"`
+	Value           string `json:"value" mapstructure:"value"`
+	ExpectedVersion string `json:"expectedVersion" mapstructure:"expectedVersion"`
+}
+
+type Output struct {
+	Name          string `json:"name" mapstructure:"name"`
+	Value         any    `json:"value" mapstructure:"value"`
+	PreviousValue any    `json:"previousValue" mapstructure:"previousValue"`
+	Version       int    `json:"version" mapstructure:"version"`
+}
+
+type Conflict struct {
+	Name            string `json:"name" mapstructure:"name"`
+	ExpectedVersion int    `json:"expectedVersion" mapstructure:"expectedVersion"`
+	CurrentValue    any    `json:"currentValue" mapstructure:"currentValue"`
+	CurrentVersion  int    `json:"currentVersion" mapstructure:"currentVersion"`
+}
+
+func (s *SetVariable) Name() string {
+	return ComponentName
+}
+
+func (s *SetVariable) Label() string {
+	return "Set Variable"
+}
+
+func (s *SetVariable) Description() string {
+	return "Store a value in a canvas variable, kept between runs"
+}
+
+func (s *SetVariable) Documentation() string {
+	return `The Set Variable component writes a canvas variable. Variables are kept between runs, and are available in every expression as ` + "`vars.<name>`" + `.
+
+## Use Cases
+
+- **Deployment state**: Remember the last deployed version
+- **Counters**: Count runs, failures or retries
+- **Rollouts**: Keep the current rollout percentage
+
+## Operations
+
+- **Set**: Replaces the value
+- **Increment**: Adds the value, which must be a number, to the current value. A missing variable counts as 0.
+- **Append**: Appends the value to the current list. A missing variable counts as an empty list.
+
+## Concurrency
+
+Every write increments the variable version. Increment and append only write
+if the variable was not changed since it was read, and try again otherwise,
+so parallel executions never lose updates.
+
+For set, use **Expected Version** to only write if the variable is still at the version you read,
+for example with a Get Variable node: ` + "`$[\"Get Version\"].data.version`" + `. Use 0 to only create the variable if it does not exist.
+
+## Output Channels
+
+- **Default**: The variable was written. Emits the name, the new value, the previous value and the new version
+- **Conflict**: The variable is not at the expected version. Emits the current value and version`
+}
+
+func (s *SetVariable) Icon() string {
+	return "database"
+}
+
+func (s *SetVariable) Color() string {
+	return "gray"
+}
+
+func (s *SetVariable) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ChannelNameDefault, Label: "Default", Description: "The variable was written"},
+		{Name: ChannelNameConflict, Label: "Conflict", Description: "The variable is not at the expected version"},
+	}
+}
+
+func (s *SetVariable) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "name",
+			Label:       "Name",
+			Type:        configuration.FieldTypeString,
+			Description: "Name of the variable",
+			Placeholder: "e.g. last_deployed_version",
+			Required:    true,
+		},
+		{
+			Name:     "operation",
+			Label:    "Operation",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  OperationSet,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Set", Value: OperationSet},
+						{Label: "Increment", Value: OperationIncrement},
+						{Label: "Append", Value: OperationAppend},
+					},
+				},
+			},
+		},
+		{
+			Name:        "value",
+			Label:       "Value",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Expression yielding the value. For increment, the number to add. For append, the item to append.",
+			Placeholder: "e.g. $[\"Deploy\"].data.version",
+			Required:    true,
+		},
+		{
+			Name:        "expectedVersion",
+			Label:       "Expected Version",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Only write if the variable is at this version. Use 0 to only create the variable if it does not exist.",
+			Placeholder: "e.g. $[\"Get Version\"].data.version",
+			Required:    false,
+			Togglable:   true,
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "operation", Values: []string{OperationSet}},
+			},
+		},
+	}
+}
+
+func (s *SetVariable) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	return validateSpec(spec)
+}
+
+func validateSpec(spec Spec) error {
+	if strings.TrimSpace(spec.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if spec.Value == "" {
+		return fmt.Errorf("value is required")
+	}
+
+	switch spec.Operation {
+	case "", OperationSet:
+		return nil
+	case OperationIncrement, OperationAppend:
+		if spec.ExpectedVersion != "" {
+			return fmt.Errorf("expectedVersion can only be used with the set operation")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("invalid operation %q", spec.Operation)
+	}
+}
+
+func (s *SetVariable) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (s *SetVariable) Execute(ctx core.ExecutionContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	err = validateSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Variables == nil {
+		return fmt.Errorf("variables are not available")
+	}
+
+	value, err := evaluate(ctx, spec.Value)
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(spec.Name)
+
+	switch spec.Operation {
+	case OperationIncrement:
+		return update(ctx, name, func(current any) (any, error) { return increment(current, value) })
+	case OperationAppend:
+		return update(ctx, name, func(current any) (any, error) { return appendItem(current, value) })
+	default:
+		return set(ctx, name, value, spec.ExpectedVersion)
+	}
+}
+
+func set(ctx core.ExecutionContext, name string, value any, expectedVersionExpression string) error {
+	var expectedVersion *int
+	if expectedVersionExpression != "" {
+		version, err := evaluateVersion(ctx, expectedVersionExpression)
+		if err != nil {
+			return err
+		}
+
+		expectedVersion = &version
+	}
+
+	var previousValue any
+	previous, err := ctx.Variables.Get(name)
+	if err == nil {
+		previousValue = previous.Value
+	} else if !errors.Is(err, core.ErrVariableNotFound) {
+		return fmt.Errorf("failed to get variable: %w", err)
+	}
+
+	variable, err := ctx.Variables.Set(name, value, expectedVersion)
+	if err == nil {
+		return emit(ctx, variable, previousValue)
+	}
+
+	if !errors.Is(err, core.ErrVariableVersionConflict) {
+		return fmt.Errorf("failed to set variable: %w", err)
+	}
+
+	conflict := Conflict{Name: name, ExpectedVersion: *expectedVersion}
+	current, err := ctx.Variables.Get(name)
+	if err == nil {
+		conflict.CurrentValue = current.Value
+		conflict.CurrentVersion = current.Version
+	} else if !errors.Is(err, core.ErrVariableNotFound) {
+		return fmt.Errorf("failed to get variable: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(ChannelNameConflict, ConflictPayloadType, []any{conflict})
+}
+
+// update writes the value computed from the current one,
+// only if the variable was not changed since it was read.
+func update(ctx core.ExecutionContext, name string, compute func(current any) (any, error)) error {
+	for attempt := 0; attempt < MaxAttempts; attempt++ {
+		var current any
+		version := 0
+
+		variable, err := ctx.Variables.Get(name)
+		if err == nil {
+			current = variable.Value
+			version = variable.Version
+		} else if !errors.Is(err, core.ErrVariableNotFound) {
+			return fmt.Errorf("failed to get variable: %w", err)
+		}
+
+		value, err := compute(current)
+		if err != nil {
+			return err
+		}
+
+		updated, err := ctx.Variables.Set(name, value, &version)
+		if err == nil {
+			return emit(ctx, updated, current)
+		}
+
+		if !errors.Is(err, core.ErrVariableVersionConflict) {
+			return fmt.Errorf("failed to set variable: %w", err)
+		}
+	}
+
+	return fmt.Errorf("variable %s was changed concurrently %d times in a row", name, MaxAttempts)
+}
+
+func emit(ctx core.ExecutionContext, variable *core.Variable, previousValue any) error {
+	return ctx.ExecutionState.Emit(ChannelNameDefault, PayloadType, []any{
+		Output{
+			Name:          variable.Name,
+			Value:         variable.Value,
+			PreviousValue: previousValue,
+			Version:       variable.Version,
+		},
+	})
+}
+
+func increment(current, value any) (any, error) {
+	delta, ok := toFloat(value)
+	if !ok {
+		return nil, fmt.Errorf("increment value must be a number, got %T", value)
+	}
+
+	if current == nil {
+		return delta, nil
+	}
+
+	base, ok := toFloat(current)
+	if !ok {
+		return nil, fmt.Errorf("cannot increment variable holding %T", current)
+	}
+
+	return base + delta, nil
+}
+
+func appendItem(current, value any) (any, error) {
+	if current == nil {
+		return []any{value}, nil
+	}
+
+	list, ok := current.([]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot append to variable holding %T", current)
+	}
+
+	result := make([]any, 0, len(list)+1)
+	result = append(result, list...)
+	return append(result, value), nil
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func evaluateVersion(ctx core.ExecutionContext, expression string) (int, error) {
+	output, err := evaluate(ctx, expression)
+	if err != nil {
+		return 0, err
+	}
+
+	version, ok := toFloat(output)
+	if !ok || version != float64(int(version)) || version < 0 {
+		return 0, fmt.Errorf("expected version must be a non-negative integer, got %v", output)
+	}
+
+	return int(version), nil
+}
+
+func evaluate(ctx core.ExecutionContext, expression string) (any, error) {
+	env, err := expressionEnv(ctx, expression)
+	if err != nil {
+		return nil, err
+	}
+
+	vm, err := expr.Compile(expression, expressionOptions(env)...)
+	if err != nil {
+		return nil, fmt.Errorf("expression compilation failed: %w", err)
+	}
+
+	output, err := expr.Run(vm, env)
+	if err != nil {
+		return nil, fmt.Errorf("expression evaluation failed: %w", err)
+	}
+
+	return output, nil
+}
+
+func expressionEnv(ctx core.ExecutionContext, expression string) (map[string]any, error) {
+	if ctx.ExpressionEnv != nil {
+		return ctx.ExpressionEnv(expression)
+	}
+
+	return buildExpressionEnv(ctx.Data, ctx.SourceNodeID), nil
+}
+
+func buildExpressionEnv(input any, sourceNodeID string) map[string]any {
+	if sourceNodeID == "" {
+		return map[string]any{"$": input}
+	}
+
+	if inputMap, ok := input.(map[string]any); ok {
+		envData := make(map[string]any, len(inputMap)+1)
+		for key, value := range inputMap {
+			envData[key] = value
+		}
+		if _, exists := envData[sourceNodeID]; !exists {
+			envData[sourceNodeID] = input
+		}
+		return map[string]any{"$": envData}
+	}
+
+	return map[string]any{"$": map[string]any{sourceNodeID: input}}
+}
+
+func expressionOptions(env map[string]any) []expr.Option {
+	options := []expr.Option{
+		expr.Env(env),
+		expr.WithContext("ctx"),
+		expr.Timezone(time.UTC.String()),
+	}
+
+	return append(options, expressions.EnvOptions(env)...)
+}
+
+func (s *SetVariable) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (s *SetVariable) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("setVariable does not support actions")
+}
+
+func (s *SetVariable) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (s *SetVariable) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (s *SetVariable) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
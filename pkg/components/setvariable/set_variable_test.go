@@ -0,0 +1,128 @@
+package setvariable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__SetVariable_Setup(t *testing.T) {
+	component := &SetVariable{}
+
+	t.Run("name is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{Configuration: map[string]any{"value": "1"}})
+		require.ErrorContains(t, err, "name is required")
+	})
+
+	t.Run("invalid operation", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"name": "count", "value": "1", "operation": "multiply"},
+		})
+
+		require.ErrorContains(t, err, "invalid operation")
+	})
+
+	t.Run("expected version is only for set", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"name": "count", "value": "1", "operation": OperationIncrement, "expectedVersion": "1"},
+		})
+
+		require.ErrorContains(t, err, "expectedVersion can only be used with the set operation")
+	})
+}
+
+func Test__SetVariable_Execute(t *testing.T) {
+	component := &SetVariable{}
+	input := map[string]any{"version": "1.4.2"}
+
+	execute := func(variables *contexts.VariablesContext, configuration map[string]any) (*contexts.ExecutionStateContext, error) {
+		state := &contexts.ExecutionStateContext{}
+		err := component.Execute(core.ExecutionContext{
+			Data:           input,
+			Configuration:  configuration,
+			ExecutionState: state,
+			Variables:      variables,
+		})
+
+		return state, err
+	}
+
+	t.Run("set stores the value and emits the previous one", func(t *testing.T) {
+		variables := &contexts.VariablesContext{
+			Variables: map[string]*core.Variable{"deployed": {Name: "deployed", Value: "1.4.1", Version: 3}},
+		}
+
+		state, err := execute(variables, map[string]any{"name": "deployed", "value": "$.version"})
+		require.NoError(t, err)
+		assert.Equal(t, ChannelNameDefault, state.Channel)
+		assert.Equal(t, "1.4.2", variables.Variables["deployed"].Value)
+
+		output := state.Payloads[0].(map[string]any)["data"].(Output)
+		assert.Equal(t, "1.4.1", output.PreviousValue)
+		assert.Equal(t, 4, output.Version)
+	})
+
+	t.Run("set with a stale expected version emits a conflict", func(t *testing.T) {
+		variables := &contexts.VariablesContext{
+			Variables: map[string]*core.Variable{"deployed": {Name: "deployed", Value: "1.4.1", Version: 3}},
+		}
+
+		state, err := execute(variables, map[string]any{"name": "deployed", "value": "$.version", "expectedVersion": "2"})
+		require.NoError(t, err)
+		assert.Equal(t, ChannelNameConflict, state.Channel)
+		assert.Equal(t, "1.4.1", variables.Variables["deployed"].Value)
+
+		conflict := state.Payloads[0].(map[string]any)["data"].(Conflict)
+		assert.Equal(t, 2, conflict.ExpectedVersion)
+		assert.Equal(t, 3, conflict.CurrentVersion)
+	})
+
+	t.Run("set with expected version 0 only creates the variable", func(t *testing.T) {
+		variables := &contexts.VariablesContext{}
+
+		state, err := execute(variables, map[string]any{"name": "deployed", "value": "$.version", "expectedVersion": "0"})
+		require.NoError(t, err)
+		assert.Equal(t, ChannelNameDefault, state.Channel)
+		assert.Equal(t, 1, variables.Variables["deployed"].Version)
+	})
+
+	t.Run("increment retries on conflicts", func(t *testing.T) {
+		variables := &contexts.VariablesContext{
+			Variables: map[string]*core.Variable{"count": {Name: "count", Value: float64(2), Version: 1}},
+			Conflicts: 2,
+		}
+
+		state, err := execute(variables, map[string]any{"name": "count", "operation": OperationIncrement, "value": "3"})
+		require.NoError(t, err)
+		assert.Equal(t, ChannelNameDefault, state.Channel)
+		assert.Equal(t, float64(5), variables.Variables["count"].Value)
+	})
+
+	t.Run("increment gives up after too many conflicts", func(t *testing.T) {
+		variables := &contexts.VariablesContext{Conflicts: MaxAttempts}
+
+		_, err := execute(variables, map[string]any{"name": "count", "operation": OperationIncrement, "value": "1"})
+		require.ErrorContains(t, err, "changed concurrently")
+	})
+
+	t.Run("increment requires a number", func(t *testing.T) {
+		_, err := execute(&contexts.VariablesContext{}, map[string]any{"name": "count", "operation": OperationIncrement, "value": "$.version"})
+		require.ErrorContains(t, err, "must be a number")
+	})
+
+	t.Run("append adds to the list", func(t *testing.T) {
+		variables := &contexts.VariablesContext{}
+
+		_, err := execute(variables, map[string]any{"name": "releases", "operation": OperationAppend, "value": "$.version"})
+		require.NoError(t, err)
+		_, err = execute(variables, map[string]any{"name": "releases", "operation": OperationAppend, "value": `"1.4.3"`})
+		require.NoError(t, err)
+
+		assert.Equal(t, []any{"1.4.2", "1.4.3"}, variables.Variables["releases"].Value)
+		assert.Equal(t, 2, variables.Variables["releases"].Version)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -35,6 +36,9 @@ type CommandResult struct {
 	Stdout   string `json:"stdout"`
 	Stderr   string `json:"stderr"`
 	ExitCode int    `json:"exitCode"`
+
+	// Truncated is set when the full output was uploaded as an artifact.
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 func NewClientKey(host string, port int, username string, privateKey, passphrase []byte) *Client {
@@ -138,7 +142,10 @@ func parseSigner(keyBytes []byte, passphrase []byte) (ssh.Signer, error) {
 	return ssh.ParsePrivateKey(keyBytes)
 }
 
-func (c *Client) ExecuteCommand(command string, timeout time.Duration) (*CommandResult, error) {
+// ExecuteCommand runs the command and returns its output.
+// If stdoutStream and stderrStream are not nil, the output
+// is also streamed to them while the command runs.
+func (c *Client) ExecuteCommand(command string, timeout time.Duration, stdoutStream, stderrStream io.Writer) (*CommandResult, error) {
 	conn, err := c.Connect()
 	if err != nil {
 		return nil, err
@@ -153,6 +160,12 @@ func (c *Client) ExecuteCommand(command string, timeout time.Duration) (*Command
 	var stdout, stderr bytes.Buffer
 	session.Stdout = &stdout
 	session.Stderr = &stderr
+	if stdoutStream != nil {
+		session.Stdout = io.MultiWriter(&stdout, stdoutStream)
+	}
+	if stderrStream != nil {
+		session.Stderr = io.MultiWriter(&stderr, stderrStream)
+	}
 
 	if timeout > 0 {
 		go func() {
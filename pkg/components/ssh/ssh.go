@@ -3,8 +3,10 @@ package ssh
 import (
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
@@ -16,6 +18,10 @@ import (
 const (
 	channelSuccess = "success"
 	channelFailed  = "failed"
+
+	// Output longer than this is uploaded as an artifact,
+	// and only its tail is kept in the emitted result.
+	MaxInlineOutputSize = 16 * 1024
 )
 
 func init() {
@@ -105,6 +111,8 @@ Choose **SSH key** or **Password**, then select the organization Secret and the
 
 - **success**: Exit code 0
 - **failed**: Non-zero exit code
+
+The output is streamed to the execution log while the command runs. Output over 16KB is uploaded as a ` + "`stdout.txt`" + ` or ` + "`stderr.txt`" + ` execution artifact, and only its last 16KB is kept in the result.
 `
 }
 func (c *SSHCommand) Icon() string  { return "terminal" }
@@ -363,6 +371,8 @@ func (c *SSHCommand) Execute(ctx core.ExecutionContext) error {
 		requestsCtx:  ctx.Requests,
 		stateCtx:     ctx.ExecutionState,
 		metadataCtx:  ctx.Metadata,
+		artifactsCtx: ctx.Artifacts,
+		logsCtx:      ctx.Logs,
 		execMetadata: metadata,
 	}
 
@@ -386,6 +396,8 @@ func (c *SSHCommand) HandleAction(ctx core.ActionContext) error {
 			requestsCtx:  ctx.Requests,
 			stateCtx:     ctx.ExecutionState,
 			metadataCtx:  ctx.Metadata,
+			artifactsCtx: ctx.Artifacts,
+			logsCtx:      ctx.Logs,
 			execMetadata: metadata,
 		}
 
@@ -396,10 +408,12 @@ func (c *SSHCommand) HandleAction(ctx core.ActionContext) error {
 }
 
 type ExecuteSSHContext struct {
-	secretsCtx  core.SecretsContext
-	requestsCtx core.RequestContext
-	stateCtx    core.ExecutionStateContext
-	metadataCtx core.MetadataContext
+	secretsCtx   core.SecretsContext
+	requestsCtx  core.RequestContext
+	stateCtx     core.ExecutionStateContext
+	metadataCtx  core.MetadataContext
+	artifactsCtx core.ArtifactsContext
+	logsCtx      core.LogsContext
 
 	execMetadata ExecutionMetadata
 }
@@ -411,7 +425,14 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 	}
 	defer client.Close()
 
-	result, err := client.ExecuteCommand(ctx.execMetadata.Command, time.Duration(ctx.execMetadata.Timeout)*time.Second)
+	var stdoutStream, stderrStream io.Writer
+	if ctx.logsCtx != nil {
+		stdoutStream = ctx.logsCtx.Writer(core.LogStreamStdout)
+		stderrStream = ctx.logsCtx.Writer(core.LogStreamStderr)
+	}
+
+	timeout := time.Duration(ctx.execMetadata.Timeout) * time.Second
+	result, err := client.ExecuteCommand(ctx.execMetadata.Command, timeout, stdoutStream, stderrStream)
 	if c.isConnectError(err) {
 		if c.shouldRetry(ctx.execMetadata.ConnectionRetry, ctx.metadataCtx) {
 			err = c.incrementRetryCount(ctx.metadataCtx)
@@ -442,6 +463,11 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 		return err
 	}
 
+	err = c.storeLargeOutput(ctx.artifactsCtx, result)
+	if err != nil {
+		return err
+	}
+
 	err = c.setResultMetadata(ctx.metadataCtx, result)
 	if err != nil {
 		return err
@@ -509,6 +535,49 @@ func (c *SSHCommand) setResultMetadata(metadata core.MetadataContext, result *Co
 	return metadata.Set(current)
 }
 
+func (c *SSHCommand) storeLargeOutput(artifacts core.ArtifactsContext, result *CommandResult) error {
+	if artifacts == nil {
+		return nil
+	}
+
+	stdout, err := c.storeOutput(artifacts, "stdout.txt", result.Stdout)
+	if err != nil {
+		return err
+	}
+
+	stderr, err := c.storeOutput(artifacts, "stderr.txt", result.Stderr)
+	if err != nil {
+		return err
+	}
+
+	result.Truncated = stdout != result.Stdout || stderr != result.Stderr
+	result.Stdout = stdout
+	result.Stderr = stderr
+	return nil
+}
+
+func (c *SSHCommand) storeOutput(artifacts core.ArtifactsContext, name, output string) (string, error) {
+	if len(output) <= MaxInlineOutputSize {
+		return output, nil
+	}
+
+	_, err := artifacts.Upload(name, "text/plain; charset=utf-8", strings.NewReader(output))
+	if err != nil {
+		return "", fmt.Errorf("error uploading %s: %v", name, err)
+	}
+
+	//
+	// Keep the tail, since that is usually where errors are,
+	// without cutting a multi-byte character in half.
+	//
+	start := len(output) - MaxInlineOutputSize
+	for start < len(output) && !utf8.RuneStart(output[start]) {
+		start++
+	}
+
+	return output[start:], nil
+}
+
 func (c *SSHCommand) isConnectError(err error) bool {
 	if err == nil {
 		return false
@@ -1,12 +1,14 @@
 package ssh
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
 )
 
 func authConfig(method string, privateKey, password any) map[string]any {
@@ -116,3 +118,48 @@ func TestSSHCommand_Setup_ValidatesRequiredFields(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func Test__SSHCommand_StoreLargeOutput(t *testing.T) {
+	c := &SSHCommand{}
+
+	t.Run("no artifacts context -> output is kept", func(t *testing.T) {
+		result := &CommandResult{Stdout: strings.Repeat("a", MaxInlineOutputSize+1)}
+		require.NoError(t, c.storeLargeOutput(nil, result))
+		assert.Len(t, result.Stdout, MaxInlineOutputSize+1)
+		assert.False(t, result.Truncated)
+	})
+
+	t.Run("small output -> nothing is uploaded", func(t *testing.T) {
+		artifacts := &contexts.ArtifactsContext{}
+		result := &CommandResult{Stdout: "hello\n", Stderr: "warning\n"}
+		require.NoError(t, c.storeLargeOutput(artifacts, result))
+		assert.Empty(t, artifacts.Artifacts)
+		assert.Equal(t, "hello\n", result.Stdout)
+		assert.False(t, result.Truncated)
+	})
+
+	t.Run("large output -> uploaded and tail is kept", func(t *testing.T) {
+		artifacts := &contexts.ArtifactsContext{}
+		stdout := strings.Repeat("a", MaxInlineOutputSize) + "the end"
+		result := &CommandResult{Stdout: stdout, Stderr: "warning\n"}
+		require.NoError(t, c.storeLargeOutput(artifacts, result))
+
+		require.Len(t, artifacts.Artifacts, 1)
+		assert.Equal(t, "stdout.txt", artifacts.Artifacts[0].Name)
+		assert.Equal(t, stdout, string(artifacts.Contents["stdout.txt"]))
+		assert.Len(t, result.Stdout, MaxInlineOutputSize)
+		assert.True(t, strings.HasSuffix(result.Stdout, "the end"))
+		assert.Equal(t, "warning\n", result.Stderr)
+		assert.True(t, result.Truncated)
+	})
+
+	t.Run("multi-byte characters are not split", func(t *testing.T) {
+		artifacts := &contexts.ArtifactsContext{}
+		result := &CommandResult{Stderr: strings.Repeat("é", MaxInlineOutputSize)}
+		require.NoError(t, c.storeLargeOutput(artifacts, result))
+
+		require.Len(t, artifacts.Artifacts, 1)
+		assert.Equal(t, "stderr.txt", artifacts.Artifacts[0].Name)
+		assert.Equal(t, strings.Repeat("é", MaxInlineOutputSize/2), result.Stderr)
+	})
+}
@@ -0,0 +1,379 @@
+package switchp
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/expressions"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "switch"
+const ChannelNameDefault = "default"
+const PayloadType = "switch.executed"
+
+const (
+	ModeFirstMatch = "first"
+	ModeAllMatches = "all"
+)
+
+var caseNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &Switch{})
+}
+
+type Switch struct{}
+
+type Spec struct {
+	Cases []Case `json:"cases" mapstructure:"cases"`
+	Mode  string `json:"mode" mapstructure:"mode"`
+}
+
+type Case struct {
+	Name       string `json:"name" mapstructure:"name"`
+	Expression string `json:"expression" mapstructure:"expression"`
+}
+
+func (s *Switch) Name() string {
+	return ComponentName
+}
+
+func (s *Switch) Label() string {
+	return "Switch"
+}
+
+func (s *Switch) Description() string {
+	return "Route events to one of many channels"
+}
+
+func (s *Switch) Documentation() string {
+	return `The Switch component evaluates a list of cases, and routes events to the output channels of the matching cases.
+
+## Use Cases
+
+- **Multi-way branching**: Route incidents by severity, or deployments by environment
+- **Replacing nested Ifs**: Express several conditions in a single node
+- **Broadcasting**: Send an event to every path whose condition matches
+
+## How It Works
+
+1. Each case has a name and a boolean expression. The name of the case is the name of its output channel.
+2. The cases are evaluated in order against the incoming event data
+3. In **First Match** mode, the event is emitted on the channel of the first matching case
+4. In **All Matches** mode, the event is emitted on the channels of every matching case
+5. If no case matches, the event is emitted on the Default channel
+
+## Output Channels
+
+- One channel for each case, named after it
+- **Default**: Events where no case matches
+
+## Expression Environment
+
+The expression has access to:
+- **$**: The run context data
+- **root()**: Access to the root event data
+- **previous()**: Access to previous node outputs (optionally with depth parameter)
+
+## Examples
+
+- ` + "`critical`" + `: ` + "`$[\"Incident\"].severity == \"critical\"`" + `
+- ` + "`production`" + `: ` + "`$[\"Deploy\"].environment == \"production\"`"
+}
+
+func (s *Switch) Icon() string {
+	return "route"
+}
+
+func (s *Switch) Color() string {
+	return "red"
+}
+
+// OutputChannels returns one channel for each configured case,
+// followed by the default channel.
+func (s *Switch) OutputChannels(configuration any) []core.OutputChannel {
+	channels := []core.OutputChannel{}
+
+	spec := Spec{}
+	err := mapstructure.Decode(configuration, &spec)
+	if err == nil {
+		for _, c := range spec.Cases {
+			if c.Name == "" || c.Name == ChannelNameDefault {
+				continue
+			}
+
+			channels = append(channels, core.OutputChannel{Name: c.Name, Label: c.Name})
+		}
+	}
+
+	return append(channels, core.OutputChannel{
+		Name:        ChannelNameDefault,
+		Label:       "Default",
+		Description: "No case matches",
+	})
+}
+
+func (s *Switch) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "cases",
+			Label:       "Cases",
+			Type:        configuration.FieldTypeList,
+			Description: "Cases evaluated in order. Each case has its own output channel.",
+			Required:    true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Case",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:               "name",
+								Label:              "Channel",
+								Type:               configuration.FieldTypeString,
+								Description:        "Name of the output channel for this case",
+								Placeholder:        "critical",
+								Required:           true,
+								DisallowExpression: true,
+							},
+							{
+								Name:        "expression",
+								Label:       "Expression",
+								Type:        configuration.FieldTypeExpression,
+								Description: "Boolean expression to evaluate",
+								Placeholder: "e.g. $[\"Incident\"].severity == \"critical\"",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:     "mode",
+			Label:    "Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  ModeFirstMatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "First Match", Value: ModeFirstMatch},
+						{Label: "All Matches", Value: ModeAllMatches},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *Switch) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	return spec.Validate()
+}
+
+func (s *Spec) Validate() error {
+	if len(s.Cases) == 0 {
+		return fmt.Errorf("at least one case is required")
+	}
+
+	if s.Mode != "" && s.Mode != ModeFirstMatch && s.Mode != ModeAllMatches {
+		return fmt.Errorf("invalid mode %s", s.Mode)
+	}
+
+	names := map[string]bool{}
+	for i, c := range s.Cases {
+		if c.Name == "" {
+			return fmt.Errorf("case %d: name is required", i)
+		}
+
+		if !caseNameRegex.MatchString(c.Name) {
+			return fmt.Errorf("case %d: name can only contain letters, numbers, dashes and underscores", i)
+		}
+
+		if c.Name == ChannelNameDefault {
+			return fmt.Errorf("case %d: name %s is reserved", i, ChannelNameDefault)
+		}
+
+		if names[c.Name] {
+			return fmt.Errorf("case %d: duplicate name %s", i, c.Name)
+		}
+
+		if c.Expression == "" {
+			return fmt.Errorf("case %d: expression is required", i)
+		}
+
+		names[c.Name] = true
+	}
+
+	return nil
+}
+
+func (s *Switch) Simulatable() bool {
+	return true
+}
+
+func (s *Switch) Execute(ctx core.ExecutionContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	err = spec.Validate()
+	if err != nil {
+		return err
+	}
+
+	matches, err := matchingCases(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	// Store the matched cases in metadata so they can be retrieved later
+	// even if the node configuration changes
+	err = ctx.Metadata.Set(map[string]any{"matches": matches})
+	if err != nil {
+		return fmt.Errorf("error setting metadata: %w", err)
+	}
+
+	if len(matches) == 0 {
+		return ctx.ExecutionState.Emit(ChannelNameDefault, PayloadType, []any{map[string]any{}})
+	}
+
+	if len(matches) == 1 {
+		return ctx.ExecutionState.Emit(matches[0], PayloadType, []any{map[string]any{}})
+	}
+
+	//
+	// Emit on every matching channel before passing the execution.
+	//
+	for _, channel := range matches {
+		err = ctx.ExecutionState.Stream(channel, PayloadType, []any{map[string]any{}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return ctx.ExecutionState.Pass()
+}
+
+func matchingCases(ctx core.ExecutionContext, spec Spec) ([]string, error) {
+	matches := []string{}
+	for _, c := range spec.Cases {
+		matched, err := evaluate(ctx, c.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("case %s: %w", c.Name, err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		matches = append(matches, c.Name)
+		if spec.Mode != ModeAllMatches {
+			return matches, nil
+		}
+	}
+
+	return matches, nil
+}
+
+func evaluate(ctx core.ExecutionContext, expression string) (bool, error) {
+	env, err := expressionEnv(ctx, expression)
+	if err != nil {
+		return false, err
+	}
+
+	vm, err := expr.Compile(expression, expressionOptions(env)...)
+	if err != nil {
+		return false, fmt.Errorf("expression compilation failed: %w", err)
+	}
+
+	output, err := expr.Run(vm, env)
+	if err != nil {
+		return false, fmt.Errorf("expression evaluation failed: %w", err)
+	}
+
+	matches, ok := output.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to boolean, got %T", output)
+	}
+
+	return matches, nil
+}
+
+func expressionEnv(ctx core.ExecutionContext, expression string) (map[string]any, error) {
+	if ctx.ExpressionEnv != nil {
+		return ctx.ExpressionEnv(expression)
+	}
+
+	return buildExpressionEnv(ctx.Data, ctx.SourceNodeID), nil
+}
+
+func buildExpressionEnv(input any, sourceNodeID string) map[string]any {
+	if sourceNodeID == "" {
+		return map[string]any{"$": input}
+	}
+
+	if inputMap, ok := input.(map[string]any); ok {
+		envData := make(map[string]any, len(inputMap)+1)
+		for key, value := range inputMap {
+			envData[key] = value
+		}
+		if _, exists := envData[sourceNodeID]; !exists {
+			envData[sourceNodeID] = input
+		}
+		return map[string]any{"$": envData}
+	}
+
+	return map[string]any{"$": map[string]any{sourceNodeID: input}}
+}
+
+func expressionOptions(env map[string]any) []expr.Option {
+	options := []expr.Option{
+		expr.Env(env),
+		expr.AsBool(),
+		expr.WithContext("ctx"),
+		expr.Timezone(time.UTC.String()),
+	}
+
+	return append(options, expressions.EnvOptions(env)...)
+}
+
+func (s *Switch) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (s *Switch) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (s *Switch) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("switch does not support actions")
+}
+
+func (s *Switch) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (s *Switch) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (s *Switch) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,154 @@
+package switchp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func severityCases() []any {
+	return []any{
+		map[string]any{"name": "critical", "expression": `$.severity == "critical"`},
+		map[string]any{"name": "high", "expression": `$.severity in ["critical", "high"]`},
+	}
+}
+
+func Test__Switch_OutputChannels(t *testing.T) {
+	component := &Switch{}
+
+	t.Run("no configuration has only the default channel", func(t *testing.T) {
+		channels := component.OutputChannels(nil)
+		require.Len(t, channels, 1)
+		assert.Equal(t, ChannelNameDefault, channels[0].Name)
+	})
+
+	t.Run("one channel per case, followed by the default channel", func(t *testing.T) {
+		channels := component.OutputChannels(map[string]any{"cases": severityCases()})
+		require.Len(t, channels, 3)
+		assert.Equal(t, "critical", channels[0].Name)
+		assert.Equal(t, "high", channels[1].Name)
+		assert.Equal(t, ChannelNameDefault, channels[2].Name)
+	})
+}
+
+func Test__Switch_Setup(t *testing.T) {
+	component := &Switch{}
+
+	tests := []struct {
+		name  string
+		cases []any
+		mode  string
+		err   string
+	}{
+		{name: "cases are required", cases: []any{}, err: "at least one case is required"},
+		{name: "invalid mode", cases: severityCases(), mode: "some", err: "invalid mode some"},
+		{
+			name:  "name is required",
+			cases: []any{map[string]any{"expression": "true"}},
+			err:   "case 0: name is required",
+		},
+		{
+			name:  "name must be a valid channel name",
+			cases: []any{map[string]any{"name": "not valid", "expression": "true"}},
+			err:   "case 0: name can only contain",
+		},
+		{
+			name:  "default is reserved",
+			cases: []any{map[string]any{"name": "default", "expression": "true"}},
+			err:   "case 0: name default is reserved",
+		},
+		{
+			name: "names must be unique",
+			cases: []any{
+				map[string]any{"name": "a", "expression": "true"},
+				map[string]any{"name": "a", "expression": "false"},
+			},
+			err: "case 1: duplicate name a",
+		},
+		{
+			name:  "expression is required",
+			cases: []any{map[string]any{"name": "a"}},
+			err:   "case 0: expression is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := component.Setup(core.SetupContext{
+				Configuration: map[string]any{"cases": tt.cases, "mode": tt.mode},
+			})
+
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"cases": severityCases(), "mode": ModeAllMatches},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__Switch_Execute(t *testing.T) {
+	component := &Switch{}
+
+	tests := []struct {
+		name             string
+		mode             string
+		severity         string
+		expectedChannels []string
+	}{
+		{name: "first match emits on first matching case", mode: ModeFirstMatch, severity: "critical", expectedChannels: []string{"critical"}},
+		{name: "first match is the default mode", mode: "", severity: "critical", expectedChannels: []string{"critical"}},
+		{name: "all matches emits on every matching case", mode: ModeAllMatches, severity: "critical", expectedChannels: []string{"critical", "high"}},
+		{name: "all matches with a single match", mode: ModeAllMatches, severity: "high", expectedChannels: []string{"high"}},
+		{name: "no match emits on default channel", mode: ModeFirstMatch, severity: "low", expectedChannels: []string{ChannelNameDefault}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &contexts.ExecutionStateContext{}
+			metadata := &contexts.MetadataContext{}
+
+			err := component.Execute(core.ExecutionContext{
+				Data:           map[string]any{"severity": tt.severity},
+				Configuration:  map[string]any{"cases": severityCases(), "mode": tt.mode},
+				Metadata:       metadata,
+				ExecutionState: state,
+			})
+
+			require.NoError(t, err)
+			assert.True(t, state.Finished)
+			assert.True(t, state.Passed)
+			assert.Len(t, state.Payloads, len(tt.expectedChannels))
+			assert.Equal(t, tt.expectedChannels[len(tt.expectedChannels)-1], state.Channel)
+			assert.Equal(t, PayloadType, state.Type)
+
+			expectedMatches := tt.expectedChannels
+			if tt.expectedChannels[0] == ChannelNameDefault {
+				expectedMatches = []string{}
+			}
+
+			assert.Equal(t, map[string]any{"matches": expectedMatches}, metadata.Get())
+		})
+	}
+
+	t.Run("expression must evaluate to boolean", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			Data: map[string]any{"severity": "critical"},
+			Configuration: map[string]any{
+				"cases": []any{map[string]any{"name": "a", "expression": "$.severity"}},
+			},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.ErrorContains(t, err, "case a:")
+	})
+}
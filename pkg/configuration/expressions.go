@@ -0,0 +1,134 @@
+package configuration
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/superplanehq/superplane/pkg/expressions"
+)
+
+var expressionTemplateRegex = regexp.MustCompile(`(?s)\{\{(.*?)\}\}`)
+
+// CompileExpressions compiles every expression used in the configuration,
+// without evaluating them. Fields of the expression type are compiled as a whole,
+// and any other string value has its {{ }} templates compiled.
+// Since nothing is evaluated, only syntax errors, unknown variables
+// and unknown functions are reported.
+func CompileExpressions(fields []Field, config map[string]any) error {
+	fieldsByName := make(map[string]Field, len(fields))
+	for _, field := range fields {
+		fieldsByName[field.Name] = field
+	}
+
+	for key, value := range config {
+		field, ok := fieldsByName[key]
+		if !ok {
+			if err := compileTemplates(value); err != nil {
+				return fmt.Errorf("field '%s': %w", key, err)
+			}
+
+			continue
+		}
+
+		if err := compileFieldExpressions(field, value); err != nil {
+			return fmt.Errorf("field '%s': %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func compileFieldExpressions(field Field, value any) error {
+	if field.DisallowExpression || value == nil {
+		return nil
+	}
+
+	if field.Type == FieldTypeExpression {
+		text, ok := value.(string)
+		if !ok || expressionTemplateRegex.MatchString(text) {
+			return compileTemplates(value)
+		}
+
+		return compileExpression(text)
+	}
+
+	if field.TypeOptions != nil {
+		if field.TypeOptions.Object != nil && len(field.TypeOptions.Object.Schema) > 0 {
+			if obj, ok := value.(map[string]any); ok {
+				return CompileExpressions(field.TypeOptions.Object.Schema, obj)
+			}
+		}
+
+		itemDefinition := field.TypeOptions.List
+		if itemDefinition != nil && itemDefinition.ItemDefinition != nil && len(itemDefinition.ItemDefinition.Schema) > 0 {
+			if list, ok := value.([]any); ok {
+				for i, item := range list {
+					obj, ok := item.(map[string]any)
+					if !ok {
+						continue
+					}
+
+					if err := CompileExpressions(itemDefinition.ItemDefinition.Schema, obj); err != nil {
+						return fmt.Errorf("item %d: %w", i, err)
+					}
+				}
+
+				return nil
+			}
+		}
+	}
+
+	return compileTemplates(value)
+}
+
+func compileTemplates(value any) error {
+	switch v := value.(type) {
+	case string:
+		for _, match := range expressionTemplateRegex.FindAllStringSubmatch(v, -1) {
+			if err := compileExpression(match[1]); err != nil {
+				return err
+			}
+		}
+
+	case map[string]any:
+		for _, item := range v {
+			if err := compileTemplates(item); err != nil {
+				return err
+			}
+		}
+
+	case []any:
+		for _, item := range v {
+			if err := compileTemplates(item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func compileExpression(expression string) error {
+	if strings.TrimSpace(expression) == "" {
+		return fmt.Errorf("empty expression")
+	}
+
+	//
+	// The message chain is only known when the expression is evaluated,
+	// so we only declare the variables and functions available to it.
+	//
+	noop := func() (any, error) { return nil, nil }
+	previous := func(int) (any, error) { return nil, nil }
+	env := map[string]any{"$": map[string]any{}, "config": map[string]any{}, "vars": map[string]any{}}
+
+	options := append([]expr.Option{expr.Env(env)}, expressions.ContextOptions(noop, previous)...)
+	_, err := expr.Compile(expression, options...)
+
+	if err != nil {
+		return fmt.Errorf("invalid expression %q: %w", expression, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__CompileExpressions(t *testing.T) {
+	fields := []Field{
+		{Name: "expression", Type: FieldTypeExpression},
+		{Name: "message", Type: FieldTypeString},
+		{Name: "literal", Type: FieldTypeString, DisallowExpression: true},
+		{
+			Name: "cases",
+			Type: FieldTypeList,
+			TypeOptions: &TypeOptions{
+				List: &ListTypeOptions{
+					ItemDefinition: &ListItemDefinition{
+						Type: FieldTypeObject,
+						Schema: []Field{
+							{Name: "when", Type: FieldTypeExpression},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("valid expressions", func(t *testing.T) {
+		err := CompileExpressions(fields, map[string]any{
+			"expression": `$["Node 1"].data.status == "ok" && previous().ok`,
+			"message":    "Hello {{ root().data.name }}, {{ $['Node 1'].data.count + 1 }}",
+			"literal":    "{{ not an expression",
+			"cases":      []any{map[string]any{"when": "$['Node 1'].data.count > 1"}},
+			"extra":      map[string]any{"nested": []any{"{{ config.value }}"}},
+			"version":    `{{ semverCompare(regexExtract(root().data.ref, "v(.+)$"), "1.0.0") }}`,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid expression field", func(t *testing.T) {
+		err := CompileExpressions(fields, map[string]any{"expression": `$["Node 1"].data ==`})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field 'expression'")
+	})
+
+	t.Run("invalid template in string field", func(t *testing.T) {
+		err := CompileExpressions(fields, map[string]any{"message": "Hello {{ root( }}"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field 'message'")
+	})
+
+	t.Run("invalid expression in list item", func(t *testing.T) {
+		err := CompileExpressions(fields, map[string]any{
+			"cases": []any{
+				map[string]any{"when": "true"},
+				map[string]any{"when": "1 +"},
+			},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field 'cases': item 1: field 'when'")
+	})
+
+	t.Run("unknown function", func(t *testing.T) {
+		err := CompileExpressions(fields, map[string]any{"expression": "unknown(1, 2)"})
+		require.Error(t, err)
+	})
+
+	t.Run("empty template", func(t *testing.T) {
+		err := CompileExpressions(fields, map[string]any{"message": "{{ }}"})
+		require.Error(t, err)
+	})
+}
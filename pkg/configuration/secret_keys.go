@@ -0,0 +1,73 @@
+package configuration
+
+// SecretKeyReference is the value of a FieldTypeSecretKey field.
+type SecretKeyReference struct {
+	Secret string
+	Key    string
+}
+
+// SecretKeyReferences returns the secrets referenced by the FieldTypeSecretKey fields
+// of a configuration, including the ones inside objects and lists.
+func SecretKeyReferences(fields []Field, config map[string]any) []SecretKeyReference {
+	references := []SecretKeyReference{}
+	for _, field := range fields {
+		value, exists := config[field.Name]
+		if !exists || value == nil {
+			continue
+		}
+
+		references = append(references, secretKeyReferencesInValue(field.Type, field.TypeOptions, value)...)
+	}
+
+	return references
+}
+
+func secretKeyReferencesInValue(fieldType string, options *TypeOptions, value any) []SecretKeyReference {
+	switch fieldType {
+	case FieldTypeSecretKey:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		secret, _ := obj["secret"].(string)
+		key, _ := obj["key"].(string)
+		if secret == "" {
+			return nil
+		}
+
+		return []SecretKeyReference{{Secret: secret, Key: key}}
+
+	case FieldTypeObject:
+		obj, ok := value.(map[string]any)
+		if !ok || options == nil || options.Object == nil {
+			return nil
+		}
+
+		return SecretKeyReferences(options.Object.Schema, obj)
+
+	case FieldTypeList:
+		list, ok := value.([]any)
+		if !ok || options == nil || options.List == nil || options.List.ItemDefinition == nil {
+			return nil
+		}
+
+		itemDef := options.List.ItemDefinition
+		references := []SecretKeyReference{}
+		for _, item := range list {
+			if itemDef.Type == FieldTypeObject {
+				if obj, ok := item.(map[string]any); ok {
+					references = append(references, SecretKeyReferences(itemDef.Schema, obj)...)
+				}
+
+				continue
+			}
+
+			references = append(references, secretKeyReferencesInValue(itemDef.Type, nil, item)...)
+		}
+
+		return references
+	}
+
+	return nil
+}
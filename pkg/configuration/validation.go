@@ -578,6 +578,23 @@ func validateCron(_ Field, value any) error {
 	return nil
 }
 
+func validateSecretKey(_ Field, value any) error {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("must be an object with secret and key")
+	}
+
+	for _, name := range []string{"secret", "key"} {
+		if v, exists := obj[name]; exists && v != nil {
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("%s must be a string", name)
+			}
+		}
+	}
+
+	return nil
+}
+
 func validateFieldValue(field Field, value any) error {
 	switch field.Type {
 	case FieldTypeString:
@@ -676,6 +693,9 @@ func validateFieldValue(field Field, value any) error {
 
 	case FieldTypeTimezone:
 		return validateTimezone(field, value)
+
+	case FieldTypeSecretKey:
+		return validateSecretKey(field, value)
 	}
 
 	return nil
@@ -550,3 +550,71 @@ func TestValidateList_MaxItems(t *testing.T) {
 func ptrInt(v int) *int {
 	return &v
 }
+
+func Test__ValidateConfiguration_SecretKey(t *testing.T) {
+	fields := []Field{
+		{Name: "password", Type: FieldTypeSecretKey},
+	}
+
+	assert.NoError(t, ValidateConfiguration(fields, map[string]any{
+		"password": map[string]any{"secret": "ssh", "key": "password"},
+	}))
+
+	assert.NoError(t, ValidateConfiguration(fields, map[string]any{
+		"password": map[string]any{},
+	}))
+
+	err := ValidateConfiguration(fields, map[string]any{"password": "ssh/password"})
+	assert.ErrorContains(t, err, "must be an object with secret and key")
+
+	err = ValidateConfiguration(fields, map[string]any{
+		"password": map[string]any{"secret": "ssh", "key": 1},
+	})
+	assert.ErrorContains(t, err, "key must be a string")
+}
+
+func Test__SecretKeyReferences(t *testing.T) {
+	fields := []Field{
+		{Name: "token", Type: FieldTypeSecretKey},
+		{
+			Name: "authentication",
+			Type: FieldTypeObject,
+			TypeOptions: &TypeOptions{
+				Object: &ObjectTypeOptions{
+					Schema: []Field{
+						{Name: "password", Type: FieldTypeSecretKey},
+					},
+				},
+			},
+		},
+		{
+			Name: "hosts",
+			Type: FieldTypeList,
+			TypeOptions: &TypeOptions{
+				List: &ListTypeOptions{
+					ItemDefinition: &ListItemDefinition{
+						Type: FieldTypeObject,
+						Schema: []Field{
+							{Name: "key", Type: FieldTypeSecretKey},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	references := SecretKeyReferences(fields, map[string]any{
+		"token":          map[string]any{"secret": "api", "key": "token"},
+		"authentication": map[string]any{"password": map[string]any{"secret": "ssh", "key": "password"}},
+		"hosts": []any{
+			map[string]any{"key": map[string]any{"secret": "host-1", "key": "private_key"}},
+			map[string]any{"key": map[string]any{}},
+		},
+	})
+
+	assert.Equal(t, []SecretKeyReference{
+		{Secret: "api", Key: "token"},
+		{Secret: "ssh", Key: "password"},
+		{Secret: "host-1", Key: "private_key"},
+	}, references)
+}
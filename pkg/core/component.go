@@ -2,6 +2,7 @@ package core
 
 import (
 	"errors"
+	"io"
 	"net/http"
 	"time"
 
@@ -13,6 +14,9 @@ import (
 var DefaultOutputChannel = OutputChannel{Name: "default", Label: "Default"}
 
 var ErrSecretKeyNotFound = errors.New("secret or key not found")
+var ErrVariableNotFound = errors.New("variable not found")
+var ErrVariableVersionConflict = errors.New("variable version conflict")
+var ErrArtifactAlreadyExists = errors.New("artifact already exists")
 
 type Component interface {
 
@@ -89,6 +93,12 @@ type Component interface {
 	 *
 	 * Components should finish the execution or move it to waiting state.
 	 * Components can also implement async components by combining Execute() and HandleAction().
+	 *
+	 * If a request made through ctx.HTTP hits the rate limit of the integration,
+	 * and the component returns that error, wrapped with %w or not, everything done
+	 * by Execute() is rolled back, and Execute() runs again from the start later.
+	 * This only happens while no request with side effects, e.g. a POST,
+	 * was made by it. After that, the execution fails instead, so nothing is repeated.
 	 */
 	Execute(ctx ExecutionContext) error
 
@@ -101,6 +111,7 @@ type Component interface {
 	/*
 	 * Execution a custom action - defined in Actions() -
 	 * on a specific execution of the component.
+	 * Rate limited requests are handled the same way as in Execute().
 	 */
 	HandleAction(ctx ActionContext) error
 
@@ -123,6 +134,23 @@ type Component interface {
 	Cleanup(ctx SetupContext) error
 }
 
+/*
+ * SimulatableComponent is implemented by components
+ * whose Execute() has no side effects, only deciding
+ * where the event goes based on its configuration and input.
+ * These components are executed when a canvas is simulated.
+ * For all others, their ExampleOutput() is used instead.
+ */
+type SimulatableComponent interface {
+	Component
+
+	/*
+	 * Whether Execute() can be called in a simulation,
+	 * where only the ExecutionState and Metadata contexts are available.
+	 */
+	Simulatable() bool
+}
+
 type OutputChannel struct {
 	Name        string
 	Label       string
@@ -154,6 +182,10 @@ type ExecutionContext struct {
 	Notifications  NotificationContext
 	Secrets        SecretsContext
 	Webhook        NodeWebhookContext
+	Canvases       CanvasesContext
+	Variables      VariablesContext
+	Artifacts      ArtifactsContext
+	Logs           LogsContext
 }
 
 /*
@@ -203,6 +235,12 @@ type ExecutionStateContext interface {
 	 */
 	Emit(channel, payloadType string, payloads []any) error
 
+	/*
+	 * Emit payloads to the specified channel,
+	 * without finishing the execution.
+	 */
+	Stream(channel, payloadType string, payloads []any) error
+
 	/*
 	 * Pass the execution, without emitting any payloads from it.
 	 */
@@ -254,6 +292,8 @@ type ActionContext struct {
 	Integration    IntegrationContext
 	Notifications  NotificationContext
 	Secrets        SecretsContext
+	Artifacts      ArtifactsContext
+	Logs           LogsContext
 }
 
 /*
@@ -304,6 +344,27 @@ type ProcessQueueContext struct {
 	// same source)
 	//
 	CountDistinctIncomingSources func() (int, error)
+
+	//
+	// FindUpstreamEvent walks up the chain of events that led to this queue item,
+	// and returns the closest one with the specified payload type.
+	// Returns nil if no event in the chain has that payload type.
+	//
+	FindUpstreamEvent func(payloadType string) (*UpstreamEvent, error)
+
+	//
+	// ScheduleExecutionAction schedules an action call
+	// for another execution in the same canvas, to run as soon as possible.
+	//
+	ScheduleExecutionAction func(executionID uuid.UUID, actionName string, parameters map[string]any) error
+}
+
+/*
+ * UpstreamEvent is an event in the chain of events that led to a queue item.
+ */
+type UpstreamEvent struct {
+	ExecutionID *uuid.UUID
+	Data        any
 }
 
 type AuthContext interface {
@@ -327,6 +388,95 @@ type SecretsContext interface {
 	GetKey(secretName, keyName string) ([]byte, error)
 }
 
+/*
+ * CanvasesContext allows components to start
+ * executions in other canvases of the same organization.
+ */
+type CanvasesContext interface {
+
+	//
+	// Emits a root event from a trigger node of another canvas,
+	// linked to the current execution, and returns the ID of the event.
+	// The current execution is finished by the engine
+	// when all the executions started from that event are done.
+	//
+	Invoke(canvasID, nodeID string, payload any) (string, error)
+}
+
+/*
+ * VariablesContext allows components to read and write
+ * the variables of the canvas they are running in.
+ * Variables are kept between runs, and every write increments
+ * the variable version, which is used for optimistic concurrency.
+ */
+type VariablesContext interface {
+
+	//
+	// Returns ErrVariableNotFound if the variable does not exist.
+	//
+	Get(name string) (*Variable, error)
+
+	//
+	// Writes the variable. If expectedVersion is not nil, the variable
+	// is only written if its current version is expectedVersion,
+	// where 0 means the variable must not exist yet.
+	// Returns ErrVariableVersionConflict otherwise.
+	//
+	Set(name string, value any, expectedVersion *int) (*Variable, error)
+}
+
+type Variable struct {
+	Name    string
+	Value   any
+	Version int
+}
+
+/*
+ * ArtifactsContext allows components to store files produced by an execution,
+ * like logs, test reports or SBOMs, which are too big for event payloads.
+ * Events emitted by the execution carry references to its artifacts.
+ */
+type ArtifactsContext interface {
+
+	//
+	// Stores the content as an artifact of the current execution.
+	// Names are unique per execution.
+	//
+	Upload(name, contentType string, content io.Reader) (*Artifact, error)
+
+	//
+	// Returns the artifacts of the current execution.
+	//
+	List() ([]Artifact, error)
+}
+
+type Artifact struct {
+	ID          string `mapstructure:"id" json:"id"`
+	Name        string `mapstructure:"name" json:"name"`
+	ContentType string `mapstructure:"contentType" json:"contentType"`
+	Size        int64  `mapstructure:"size" json:"size"`
+	SHA256      string `mapstructure:"sha256" json:"sha256"`
+}
+
+const (
+	LogStreamStdout = "stdout"
+	LogStreamStderr = "stderr"
+)
+
+/*
+ * LogsContext allows components to stream output, like the stdout
+ * and stderr of a command, to the log of the current execution.
+ * Lines written through the execution Logger are captured there too.
+ */
+type LogsContext interface {
+
+	//
+	// Returns a writer for the given stream.
+	// Every line written to it becomes a line in the execution log.
+	//
+	Writer(stream string) io.Writer
+}
+
 type User struct {
 	ID    string `mapstructure:"id" json:"id"`
 	Name  string `mapstructure:"name" json:"name"`
@@ -5,6 +5,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"fmt"
 )
 
 type AESGCMEncryptor struct {
@@ -52,6 +53,10 @@ func (e *AESGCMEncryptor) Decrypt(ctx context.Context, cyphertext []byte, associ
 	// We know the nonce is prepended in the cyphertext
 	// and we know its size, so can easily separate the two.
 	nonceSize := gcm.NonceSize()
+	if len(cyphertext) < nonceSize {
+		return nil, fmt.Errorf("cyphertext is too short")
+	}
+
 	nonce := cyphertext[:nonceSize]
 	ciphertext := cyphertext[nonceSize:]
 
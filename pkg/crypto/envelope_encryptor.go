@@ -0,0 +1,170 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+)
+
+// Envelope ciphertexts are laid out as:
+//
+//	magic | version | key ID length | key ID | wrapped key length | wrapped key | nonce+ciphertext
+//
+// The data is encrypted with a random data key, which is wrapped
+// with the key-encryption key identified by the key ID.
+var envelopeMagic = []byte("SPENV")
+
+const (
+	envelopeVersion        = 1
+	envelopeDataKeySize    = 32
+	maxEnvelopeKeyIDLength = 255
+)
+
+// EnvelopeEncryptor encrypts every value with its own data key,
+// wrapped with the active key of a KeySource.
+//
+// Values encrypted before envelope encryption was enabled
+// do not have the envelope header, and are decrypted with the legacy encryptor.
+type EnvelopeEncryptor struct {
+	keys   KeySource
+	legacy Encryptor
+}
+
+func NewEnvelopeEncryptor(keys KeySource, legacy Encryptor) *EnvelopeEncryptor {
+	return &EnvelopeEncryptor{
+		keys:   keys,
+		legacy: legacy,
+	}
+}
+
+func (e *EnvelopeEncryptor) Encrypt(ctx context.Context, data []byte, associatedData []byte) ([]byte, error) {
+	dataKey := make([]byte, envelopeDataKeySize)
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := NewAESGCMEncryptor(dataKey).Encrypt(ctx, data, associatedData)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID := e.keys.ActiveKeyID()
+	wrappedKey, err := e.keys.WrapKey(ctx, keyID, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("error wrapping data key: %v", err)
+	}
+
+	if len(wrappedKey) > 0xFFFF {
+		return nil, fmt.Errorf("wrapped data key is too large")
+	}
+
+	var buf bytes.Buffer
+	buf.Write(envelopeMagic)
+	buf.WriteByte(envelopeVersion)
+	buf.WriteByte(byte(len(keyID)))
+	buf.WriteString(keyID)
+	_ = binary.Write(&buf, binary.BigEndian, uint16(len(wrappedKey)))
+	buf.Write(wrappedKey)
+	buf.Write(ciphertext)
+	return buf.Bytes(), nil
+}
+
+func (e *EnvelopeEncryptor) Decrypt(ctx context.Context, ciphertext []byte, associatedData []byte) ([]byte, error) {
+	envelope, ok := parseEnvelope(ciphertext)
+	if !ok {
+		return e.decryptLegacy(ctx, ciphertext, associatedData)
+	}
+
+	plaintext, err := e.decryptEnvelope(ctx, envelope, associatedData)
+	if err == nil {
+		return plaintext, nil
+	}
+
+	//
+	// Legacy ciphertexts start with a random nonce,
+	// so one could look like an envelope by chance.
+	//
+	if e.legacy != nil {
+		if plaintext, legacyErr := e.legacy.Decrypt(ctx, ciphertext, associatedData); legacyErr == nil {
+			return plaintext, nil
+		}
+	}
+
+	return nil, err
+}
+
+// NeedsRotation returns true if the ciphertext was not encrypted
+// with the active key, and should be re-encrypted.
+func (e *EnvelopeEncryptor) NeedsRotation(ciphertext []byte) bool {
+	keyID, ok := EnvelopeKeyID(ciphertext)
+	return !ok || keyID != e.keys.ActiveKeyID()
+}
+
+func (e *EnvelopeEncryptor) decryptEnvelope(ctx context.Context, envelope *envelope, associatedData []byte) ([]byte, error) {
+	dataKey, err := e.keys.UnwrapKey(ctx, envelope.keyID, envelope.wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping data key: %v", err)
+	}
+
+	return NewAESGCMEncryptor(dataKey).Decrypt(ctx, envelope.ciphertext, associatedData)
+}
+
+func (e *EnvelopeEncryptor) decryptLegacy(ctx context.Context, ciphertext []byte, associatedData []byte) ([]byte, error) {
+	if e.legacy == nil {
+		return nil, fmt.Errorf("ciphertext is not envelope encrypted")
+	}
+
+	return e.legacy.Decrypt(ctx, ciphertext, associatedData)
+}
+
+// EnvelopeKeyID returns the ID of the key that wrapped
+// the data key of an envelope-encrypted ciphertext.
+func EnvelopeKeyID(ciphertext []byte) (string, bool) {
+	envelope, ok := parseEnvelope(ciphertext)
+	if !ok {
+		return "", false
+	}
+
+	return envelope.keyID, true
+}
+
+type envelope struct {
+	keyID      string
+	wrappedKey []byte
+	ciphertext []byte
+}
+
+func parseEnvelope(data []byte) (*envelope, bool) {
+	if !bytes.HasPrefix(data, envelopeMagic) {
+		return nil, false
+	}
+
+	rest := data[len(envelopeMagic):]
+	if len(rest) < 2 || rest[0] != envelopeVersion {
+		return nil, false
+	}
+
+	keyIDLength := int(rest[1])
+	rest = rest[2:]
+	if keyIDLength == 0 || len(rest) < keyIDLength+2 {
+		return nil, false
+	}
+
+	keyID := string(rest[:keyIDLength])
+	rest = rest[keyIDLength:]
+
+	wrappedKeyLength := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if wrappedKeyLength == 0 || len(rest) < wrappedKeyLength {
+		return nil, false
+	}
+
+	return &envelope{
+		keyID:      keyID,
+		wrappedKey: rest[:wrappedKeyLength],
+		ciphertext: rest[wrappedKeyLength:],
+	}, true
+}
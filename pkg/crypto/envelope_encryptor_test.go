@@ -0,0 +1,136 @@
+package crypto
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test__EnvelopeEncryptor(t *testing.T) {
+	newKey := func() []byte {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		return key
+	}
+
+	ctx := context.Background()
+	data := []byte("testing encryption")
+	assocData := []byte("aaaa")
+	key1 := newKey()
+	key2 := newKey()
+	legacyKey := newKey()
+
+	t.Run("encrypts and decrypts properly", func(t *testing.T) {
+		keys, err := NewStaticKeySource("k1", map[string][]byte{"k1": key1})
+		require.NoError(t, err)
+		encryptor := NewEnvelopeEncryptor(keys, nil)
+
+		cyphertext, err := encryptor.Encrypt(ctx, data, assocData)
+		require.NoError(t, err)
+
+		keyID, ok := EnvelopeKeyID(cyphertext)
+		require.True(t, ok)
+		require.Equal(t, "k1", keyID)
+
+		plaintext, err := encryptor.Decrypt(ctx, cyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+
+		// wrong associated data fails
+		_, err = encryptor.Decrypt(ctx, cyphertext, []byte("bbbb"))
+		require.Error(t, err)
+	})
+
+	t.Run("decrypts with retired keys", func(t *testing.T) {
+		oldKeys, err := NewStaticKeySource("k1", map[string][]byte{"k1": key1})
+		require.NoError(t, err)
+		cyphertext, err := NewEnvelopeEncryptor(oldKeys, nil).Encrypt(ctx, data, assocData)
+		require.NoError(t, err)
+
+		keys, err := NewStaticKeySource("k2", map[string][]byte{"k1": key1, "k2": key2})
+		require.NoError(t, err)
+		encryptor := NewEnvelopeEncryptor(keys, nil)
+
+		plaintext, err := encryptor.Decrypt(ctx, cyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+		require.True(t, encryptor.NeedsRotation(cyphertext))
+
+		rotated, err := encryptor.Encrypt(ctx, plaintext, assocData)
+		require.NoError(t, err)
+		require.False(t, encryptor.NeedsRotation(rotated))
+
+		// once the key is removed, old ciphertexts can't be decrypted
+		newKeys, err := NewStaticKeySource("k2", map[string][]byte{"k2": key2})
+		require.NoError(t, err)
+		_, err = NewEnvelopeEncryptor(newKeys, nil).Decrypt(ctx, cyphertext, assocData)
+		require.Error(t, err)
+	})
+
+	t.Run("decrypts legacy ciphertexts", func(t *testing.T) {
+		legacy := NewAESGCMEncryptor(legacyKey)
+		cyphertext, err := legacy.Encrypt(ctx, data, assocData)
+		require.NoError(t, err)
+
+		keys, err := NewStaticKeySource("k1", map[string][]byte{"k1": key1})
+		require.NoError(t, err)
+
+		encryptor := NewEnvelopeEncryptor(keys, legacy)
+		plaintext, err := encryptor.Decrypt(ctx, cyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+		require.True(t, encryptor.NeedsRotation(cyphertext))
+
+		// without the legacy encryptor, decryption fails
+		_, err = NewEnvelopeEncryptor(keys, nil).Decrypt(ctx, cyphertext, assocData)
+		require.Error(t, err)
+	})
+
+	t.Run("truncated ciphertexts fail", func(t *testing.T) {
+		keys, err := NewStaticKeySource("k1", map[string][]byte{"k1": key1})
+		require.NoError(t, err)
+		encryptor := NewEnvelopeEncryptor(keys, NewAESGCMEncryptor(legacyKey))
+
+		cyphertext, err := encryptor.Encrypt(ctx, data, assocData)
+		require.NoError(t, err)
+
+		for _, n := range []int{0, 3, 8, 20, len(cyphertext) - 1} {
+			_, err = encryptor.Decrypt(ctx, cyphertext[:n], assocData)
+			require.Error(t, err)
+		}
+	})
+}
+
+func Test__StaticKeySource(t *testing.T) {
+	key := make([]byte, 32)
+
+	_, err := NewStaticKeySource("k2", map[string][]byte{"k1": key})
+	require.ErrorContains(t, err, "active key k2 not found")
+
+	_, err = NewStaticKeySource("k1", map[string][]byte{"k1": []byte("short")})
+	require.ErrorContains(t, err, "must have 16, 24 or 32 bytes")
+
+	_, err = NewStaticKeySource("k1", map[string][]byte{"k1": key})
+	require.NoError(t, err)
+}
+
+func Test__ParseKeys(t *testing.T) {
+	keys, err := ParseKeys("k1:0123456789abcdef0123456789abcdef, k2:abcdef0123456789abcdef0123456789")
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{
+		"k1": []byte("0123456789abcdef0123456789abcdef"),
+		"k2": []byte("abcdef0123456789abcdef0123456789"),
+	}, keys)
+
+	_, err = ParseKeys("")
+	require.ErrorContains(t, err, "no keys found")
+
+	_, err = ParseKeys("0123456789abcdef0123456789abcdef")
+	require.ErrorContains(t, err, "invalid key at position 1")
+	require.NotContains(t, err.Error(), "0123456789abcdef")
+
+	_, err = ParseKeys("k1:a,k1:b")
+	require.ErrorContains(t, err, "duplicate key k1")
+}
@@ -0,0 +1,100 @@
+package crypto
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// KeySource holds the key-encryption keys used to wrap
+// the data keys of envelope-encrypted ciphertexts.
+//
+// Keys are referenced by ID, so a source can keep retired keys around
+// for decryption while wrapping new data keys with the active one.
+// KMS backends implement this without ever exposing the keys themselves.
+type KeySource interface {
+	ActiveKeyID() string
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// StaticKeySource is a KeySource backed by keys held in memory.
+type StaticKeySource struct {
+	activeKeyID string
+	keys        map[string][]byte
+}
+
+func NewStaticKeySource(activeKeyID string, keys map[string][]byte) (*StaticKeySource, error) {
+	if _, ok := keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active key %s not found", activeKeyID)
+	}
+
+	for id, key := range keys {
+		if id == "" || len(id) > maxEnvelopeKeyIDLength {
+			return nil, fmt.Errorf("key ID must have between 1 and %d characters", maxEnvelopeKeyIDLength)
+		}
+
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return nil, fmt.Errorf("key %s must have 16, 24 or 32 bytes", id)
+		}
+	}
+
+	return &StaticKeySource{
+		activeKeyID: activeKeyID,
+		keys:        keys,
+	}, nil
+}
+
+func (s *StaticKeySource) ActiveKeyID() string {
+	return s.activeKeyID
+}
+
+func (s *StaticKeySource) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	key, ok := s.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", keyID)
+	}
+
+	return NewAESGCMEncryptor(key).Encrypt(ctx, dataKey, []byte(keyID))
+}
+
+func (s *StaticKeySource) UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	key, ok := s.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", keyID)
+	}
+
+	return NewAESGCMEncryptor(key).Decrypt(ctx, wrappedKey, []byte(keyID))
+}
+
+// ParseKeys parses a comma-separated list of <id>:<key> pairs,
+// the format used by the ENCRYPTION_KEYS environment variable.
+func ParseKeys(value string) (map[string][]byte, error) {
+	keys := map[string][]byte{}
+	for i, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		id, key, ok := strings.Cut(pair, ":")
+		if !ok || id == "" || key == "" {
+			// The pair is not included in the error, since it might be a key.
+			return nil, fmt.Errorf("invalid key at position %d, expected <id>:<key>", i+1)
+		}
+
+		if _, exists := keys[id]; exists {
+			return nil, fmt.Errorf("duplicate key %s", id)
+		}
+
+		keys[id] = []byte(key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keys found")
+	}
+
+	return keys, nil
+}
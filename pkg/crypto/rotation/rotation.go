@@ -0,0 +1,307 @@
+package rotation
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const DefaultBatchSize = 100
+
+// Result counts the ciphertexts of a table re-encrypted with the active key.
+// Ciphertexts that could not be decrypted are counted as failed, and left as they are.
+type Result struct {
+	Table       string
+	ReEncrypted int
+	Failed      int
+}
+
+// Rotator re-encrypts the ciphertexts stored in the database
+// that were not encrypted with the active key, so retired keys can be removed.
+type Rotator struct {
+	encryptor *crypto.EnvelopeEncryptor
+	registry  *registry.Registry
+	batchSize int
+	logger    *log.Entry
+}
+
+func NewRotator(encryptor *crypto.EnvelopeEncryptor, registry *registry.Registry, batchSize int) *Rotator {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	return &Rotator{
+		encryptor: encryptor,
+		registry:  registry,
+		batchSize: batchSize,
+		logger:    log.WithFields(log.Fields{"component": "KeyRotation"}),
+	}
+}
+
+func (r *Rotator) Run(ctx context.Context) ([]Result, error) {
+	steps := []struct {
+		table  string
+		rotate func(context.Context, *Result) error
+	}{
+		{"secrets", r.rotateSecrets},
+		{"app_installations", r.rotateIntegrationConfigurations},
+		{"app_installation_secrets", r.rotateIntegrationSecrets},
+		{"webhooks", r.rotateWebhooks},
+		{"account_providers", r.rotateAccountProviders},
+		{"email_settings", r.rotateEmailSettings},
+	}
+
+	results := []Result{}
+	for _, step := range steps {
+		result := Result{Table: step.table}
+		err := step.rotate(ctx, &result)
+		if err != nil {
+			return results, fmt.Errorf("error re-encrypting %s: %w", step.table, err)
+		}
+
+		r.logger.Infof("%s: %d re-encrypted, %d failed", step.table, result.ReEncrypted, result.Failed)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (r *Rotator) rotateSecrets(ctx context.Context, result *Result) error {
+	scope := func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("provider = ?", secrets.ProviderLocal)
+	}
+
+	return rotateTable(r, result, scope,
+		func(s *models.Secret) uuid.UUID { return s.ID },
+		func(s *models.Secret) (map[string]any, error) {
+			data, rotated, err := r.reencrypt(ctx, s.Data, []byte(s.Name))
+			if err != nil || !rotated {
+				return nil, err
+			}
+
+			return map[string]any{"data": data}, nil
+		},
+	)
+}
+
+// Sensitive configuration fields are stored base64-encoded,
+// and which fields are sensitive depends on the integration.
+func (r *Rotator) rotateIntegrationConfigurations(ctx context.Context, result *Result) error {
+	return rotateTable(r, result, unscoped,
+		func(i *models.Integration) uuid.UUID { return i.ID },
+		func(i *models.Integration) (map[string]any, error) {
+			impl, err := r.registry.GetIntegration(i.AppName)
+			if err != nil {
+				return nil, err
+			}
+
+			config := i.Configuration.Data()
+			changed := false
+			for _, field := range impl.Configuration() {
+				if !field.Sensitive {
+					continue
+				}
+
+				value, ok := config[field.Name].(string)
+				if !ok || value == "" {
+					continue
+				}
+
+				encoded, rotated, err := r.reencryptBase64(ctx, value, []byte(i.ID.String()))
+				if err != nil {
+					return nil, fmt.Errorf("field %s: %w", field.Name, err)
+				}
+
+				if rotated {
+					config[field.Name] = encoded
+					changed = true
+				}
+			}
+
+			if !changed {
+				return nil, nil
+			}
+
+			return map[string]any{"configuration": datatypes.NewJSONType(config)}, nil
+		},
+	)
+}
+
+func (r *Rotator) rotateIntegrationSecrets(ctx context.Context, result *Result) error {
+	return rotateTable(r, result, scoped,
+		func(s *models.IntegrationSecret) uuid.UUID { return s.ID },
+		func(s *models.IntegrationSecret) (map[string]any, error) {
+			value, rotated, err := r.reencrypt(ctx, s.Value, []byte(s.InstallationID.String()))
+			if err != nil || !rotated {
+				return nil, err
+			}
+
+			return map[string]any{"value": value}, nil
+		},
+	)
+}
+
+func (r *Rotator) rotateWebhooks(ctx context.Context, result *Result) error {
+	return rotateTable(r, result, unscoped,
+		func(w *models.Webhook) uuid.UUID { return w.ID },
+		func(w *models.Webhook) (map[string]any, error) {
+			secret, rotated, err := r.reencrypt(ctx, w.Secret, []byte(w.ID.String()))
+			if err != nil || !rotated {
+				return nil, err
+			}
+
+			return map[string]any{"secret": secret}, nil
+		},
+	)
+}
+
+// Access tokens are encrypted with the email returned by the provider,
+// which is the one stored in the account provider after normalization.
+func (r *Rotator) rotateAccountProviders(ctx context.Context, result *Result) error {
+	return rotateTable(r, result, scoped,
+		func(p *models.AccountProvider) uuid.UUID { return p.ID },
+		func(p *models.AccountProvider) (map[string]any, error) {
+			if p.AccessToken == "" {
+				return nil, nil
+			}
+
+			token, rotated, err := r.reencryptBase64(ctx, p.AccessToken, []byte(p.Email))
+			if err != nil || !rotated {
+				return nil, err
+			}
+
+			return map[string]any{"access_token": token}, nil
+		},
+	)
+}
+
+func (r *Rotator) rotateEmailSettings(ctx context.Context, result *Result) error {
+	return rotateTable(r, result, scoped,
+		func(s *models.EmailSettings) uuid.UUID { return s.ID },
+		func(s *models.EmailSettings) (map[string]any, error) {
+			password, rotated, err := r.reencrypt(ctx, s.SMTPPassword, []byte("smtp_password"))
+			if err != nil || !rotated {
+				return nil, err
+			}
+
+			return map[string]any{"smtp_password": password}, nil
+		},
+	)
+}
+
+func (r *Rotator) reencrypt(ctx context.Context, ciphertext, associatedData []byte) ([]byte, bool, error) {
+	if len(ciphertext) == 0 || !r.encryptor.NeedsRotation(ciphertext) {
+		return nil, false, nil
+	}
+
+	plaintext, err := r.encryptor.Decrypt(ctx, ciphertext, associatedData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	reencrypted, err := r.encryptor.Encrypt(ctx, plaintext, associatedData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return reencrypted, true, nil
+}
+
+func (r *Rotator) reencryptBase64(ctx context.Context, value string, associatedData []byte) (string, bool, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", false, err
+	}
+
+	reencrypted, rotated, err := r.reencrypt(ctx, ciphertext, associatedData)
+	if err != nil || !rotated {
+		return "", false, err
+	}
+
+	return base64.StdEncoding.EncodeToString(reencrypted), true, nil
+}
+
+func scoped(tx *gorm.DB) *gorm.DB {
+	return tx
+}
+
+// Soft-deleted records are re-encrypted too,
+// so they can still be restored after the old key is removed.
+func unscoped(tx *gorm.DB) *gorm.DB {
+	return tx.Unscoped()
+}
+
+// rotateTable goes through the rows of a table in batches, ordered by ID.
+// Each batch is locked and updated in its own transaction,
+// so concurrent updates are never overwritten with stale values.
+func rotateTable[T any](
+	r *Rotator,
+	result *Result,
+	scope func(*gorm.DB) *gorm.DB,
+	id func(*T) uuid.UUID,
+	rotate func(*T) (map[string]any, error),
+) error {
+	var lastID *uuid.UUID
+	for {
+		var rows []T
+		err := database.Conn().Transaction(func(tx *gorm.DB) error {
+			query := scope(tx).
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Order("id").
+				Limit(r.batchSize)
+
+			if lastID != nil {
+				query = query.Where("id > ?", *lastID)
+			}
+
+			err := query.Find(&rows).Error
+			if err != nil {
+				return err
+			}
+
+			for i := range rows {
+				updates, err := rotate(&rows[i])
+				if err != nil {
+					r.logger.Errorf("failed to re-encrypt %s: %v", id(&rows[i]), err)
+					result.Failed++
+					continue
+				}
+
+				if len(updates) == 0 {
+					continue
+				}
+
+				err = scope(tx).Model(&rows[i]).UpdateColumns(updates).Error
+				if err != nil {
+					return err
+				}
+
+				result.ReEncrypted++
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(rows) < r.batchSize {
+			return nil
+		}
+
+		last := id(&rows[len(rows)-1])
+		lastID = &last
+	}
+}
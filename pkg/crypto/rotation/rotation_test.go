@@ -0,0 +1,126 @@
+package rotation
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__Rotator(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	ctx := context.Background()
+	newKey := func() []byte {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		return key
+	}
+
+	legacy := crypto.NewAESGCMEncryptor(newKey())
+	key1 := newKey()
+	key2 := newKey()
+
+	//
+	// Values encrypted before envelope encryption was enabled.
+	//
+	secretName := support.RandomName("secret")
+	secretData, err := legacy.Encrypt(ctx, []byte(`{"key":"value"}`), []byte(secretName))
+	require.NoError(t, err)
+	secret, err := models.CreateSecret(secretName, secrets.ProviderLocal, r.User.String(), models.DomainTypeOrganization, r.Organization.ID, secretData)
+	require.NoError(t, err)
+
+	integration, err := models.CreateIntegration(uuid.New(), r.Organization.ID, "dummy", support.RandomName("integration"), map[string]any{})
+	require.NoError(t, err)
+	integrationSecretValue, err := legacy.Encrypt(ctx, []byte("token"), []byte(integration.ID.String()))
+	require.NoError(t, err)
+	integrationSecret := models.IntegrationSecret{
+		OrganizationID: r.Organization.ID,
+		InstallationID: integration.ID,
+		Name:           "token",
+		Value:          integrationSecretValue,
+	}
+	require.NoError(t, database.Conn().Create(&integrationSecret).Error)
+
+	keys1, err := crypto.NewStaticKeySource("k1", map[string][]byte{"k1": key1})
+	require.NoError(t, err)
+	encryptor1 := crypto.NewEnvelopeEncryptor(keys1, legacy)
+
+	t.Run("legacy values are re-encrypted with the active key", func(t *testing.T) {
+		results, err := NewRotator(encryptor1, r.Registry, 1).Run(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, find(results, "secrets").ReEncrypted)
+		assert.Equal(t, 1, find(results, "app_installation_secrets").ReEncrypted)
+
+		updated, err := models.FindSecretByID(models.DomainTypeOrganization, r.Organization.ID, secret.ID.String())
+		require.NoError(t, err)
+		keyID, ok := crypto.EnvelopeKeyID(updated.Data)
+		require.True(t, ok)
+		assert.Equal(t, "k1", keyID)
+
+		plaintext, err := encryptor1.Decrypt(ctx, updated.Data, []byte(secretName))
+		require.NoError(t, err)
+		assert.Equal(t, `{"key":"value"}`, string(plaintext))
+	})
+
+	t.Run("values already using the active key are skipped", func(t *testing.T) {
+		results, err := NewRotator(encryptor1, r.Registry, 1).Run(ctx)
+		require.NoError(t, err)
+		for _, result := range results {
+			assert.Zero(t, result.ReEncrypted, result.Table)
+			assert.Zero(t, result.Failed, result.Table)
+		}
+	})
+
+	t.Run("values are re-encrypted with a new key", func(t *testing.T) {
+		keys2, err := crypto.NewStaticKeySource("k2", map[string][]byte{"k1": key1, "k2": key2})
+		require.NoError(t, err)
+		encryptor2 := crypto.NewEnvelopeEncryptor(keys2, nil)
+
+		results, err := NewRotator(encryptor2, r.Registry, DefaultBatchSize).Run(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, find(results, "secrets").ReEncrypted)
+		assert.Equal(t, 1, find(results, "app_installation_secrets").ReEncrypted)
+
+		//
+		// The old key is no longer needed.
+		//
+		keys3, err := crypto.NewStaticKeySource("k2", map[string][]byte{"k2": key2})
+		require.NoError(t, err)
+
+		var updated models.IntegrationSecret
+		require.NoError(t, database.Conn().Where("id = ?", integrationSecret.ID).First(&updated).Error)
+		plaintext, err := crypto.NewEnvelopeEncryptor(keys3, nil).Decrypt(ctx, updated.Value, []byte(integration.ID.String()))
+		require.NoError(t, err)
+		assert.Equal(t, "token", string(plaintext))
+	})
+
+	t.Run("values that can't be decrypted are counted as failed", func(t *testing.T) {
+		other, err := crypto.NewStaticKeySource("k3", map[string][]byte{"k3": newKey()})
+		require.NoError(t, err)
+
+		results, err := NewRotator(crypto.NewEnvelopeEncryptor(other, nil), r.Registry, DefaultBatchSize).Run(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, find(results, "secrets").Failed)
+		assert.Zero(t, find(results, "secrets").ReEncrypted)
+	})
+}
+
+func find(results []Result, table string) Result {
+	for _, result := range results {
+		if result.Table == table {
+			return result
+		}
+	}
+
+	return Result{}
+}
@@ -91,6 +91,9 @@ func TruncateTables() error {
 	return Conn().Exec(`
 		truncate table
 			secrets,
+			secret_access_events,
+			audit_log_entries,
+			artifact_uploads,
 			account_password_auth,
 			accounts,
 			account_providers,
@@ -113,6 +116,7 @@ func TruncateTables() error {
 			workflow_node_executions,
 			workflow_node_queue_items,
 			workflow_node_requests,
+			rate_limit_buckets,
 			webhooks
 		restart identity cascade;
 	`).Error
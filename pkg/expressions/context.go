@@ -0,0 +1,96 @@
+package expressions
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/expr-lang/expr"
+)
+
+// ContextOptions returns the expr options for root() and previous(),
+// plus the function library. root and previous resolve the payloads,
+// since that depends on where the expression is evaluated.
+func ContextOptions(root func() (any, error), previous func(depth int) (any, error)) []expr.Option {
+	options := []expr.Option{
+		expr.Function("root", func(params ...any) (any, error) {
+			if len(params) != 0 {
+				return nil, fmt.Errorf("root() takes no arguments")
+			}
+
+			return root()
+		}),
+		expr.Function("previous", func(params ...any) (any, error) {
+			depth := 1
+			if len(params) > 1 {
+				return nil, fmt.Errorf("previous() accepts zero or one argument")
+			}
+			if len(params) == 1 {
+				parsedDepth, err := ParseDepth(params[0])
+				if err != nil {
+					return nil, err
+				}
+				depth = parsedDepth
+			}
+
+			return previous(depth)
+		}),
+	}
+
+	return append(options, Options()...)
+}
+
+// EnvOptions returns the same options as ContextOptions,
+// for components that put the root payload and the previous payloads
+// in the expression environment, under __root and __previousByDepth.
+func EnvOptions(env map[string]any) []expr.Option {
+	root := func() (any, error) {
+		rootPayload, ok := env["__root"]
+		if !ok {
+			return nil, fmt.Errorf("no root event found")
+		}
+		return rootPayload, nil
+	}
+
+	previous := func(depth int) (any, error) {
+		previousByDepth, ok := env["__previousByDepth"]
+		if !ok {
+			return nil, nil
+		}
+		if values, ok := previousByDepth.(map[string]any); ok {
+			return values[strconv.Itoa(depth)], nil
+		}
+		if values, ok := previousByDepth.(map[int]any); ok {
+			return values[depth], nil
+		}
+
+		return nil, nil
+	}
+
+	return ContextOptions(root, previous)
+}
+
+func ParseDepth(param any) (int, error) {
+	switch value := param.(type) {
+	case int:
+		if value < 1 {
+			return 0, fmt.Errorf("depth must be >= 1")
+		}
+		return value, nil
+	case int64:
+		if value < 1 {
+			return 0, fmt.Errorf("depth must be >= 1")
+		}
+		return int(value), nil
+	case float64:
+		parsed := int(value)
+		if value != float64(parsed) {
+			return 0, fmt.Errorf("depth must be an integer")
+		}
+		if parsed < 1 {
+			return 0, fmt.Errorf("depth must be >= 1")
+		}
+		return parsed, nil
+	default:
+		return 0, fmt.Errorf("depth must be an integer")
+	}
+}
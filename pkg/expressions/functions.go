@@ -0,0 +1,585 @@
+package expressions
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/expr-lang/expr"
+)
+
+const (
+	CategoryContext  = "context"
+	CategorySemver   = "semver"
+	CategoryTime     = "time"
+	CategoryRegex    = "regex"
+	CategoryJSON     = "json"
+	CategoryEncoding = "encoding"
+	CategoryLists    = "lists"
+)
+
+// Function describes a function available to every expression.
+// Builtin functions are provided by expr itself,
+// and are only listed here so they can be documented and autocompleted.
+type Function struct {
+	Name        string
+	Category    string
+	Signature   string
+	Description string
+	Example     string
+	Builtin     bool
+
+	fn func(params ...any) (any, error)
+}
+
+var functions = []Function{
+	{
+		Name:        "root",
+		Category:    CategoryContext,
+		Signature:   "root()",
+		Description: "Returns the payload of the event that started the run.",
+		Example:     `root().data.ref`,
+	},
+	{
+		Name:        "previous",
+		Category:    CategoryContext,
+		Signature:   "previous(depth?)",
+		Description: "Returns the output of the node that is depth steps upstream. depth defaults to 1.",
+		Example:     `previous(2).data.status`,
+	},
+	{
+		Name:        "semverParse",
+		Category:    CategorySemver,
+		Signature:   "semverParse(version)",
+		Description: "Parses a semantic version, with an optional v prefix, into its major, minor, patch, prerelease and build parts.",
+		Example:     `semverParse("v1.4.2-rc.1").minor == 4`,
+		fn:          semverParseFunc,
+	},
+	{
+		Name:        "semverCompare",
+		Category:    CategorySemver,
+		Signature:   "semverCompare(a, b)",
+		Description: "Compares two semantic versions. Returns -1 if a < b, 0 if they are equal and 1 if a > b.",
+		Example:     `semverCompare("1.10.0", "1.9.3") > 0`,
+		fn:          semverCompareFunc,
+	},
+	{
+		Name:        "semverValid",
+		Category:    CategorySemver,
+		Signature:   "semverValid(version)",
+		Description: "Returns true if the string is a valid semantic version.",
+		Example:     `semverValid($["GitHub"].data.ref)`,
+		fn:          semverValidFunc,
+	},
+	{
+		Name:        "formatDuration",
+		Category:    CategoryTime,
+		Signature:   "formatDuration(duration)",
+		Description: "Formats a duration, a number of seconds or a duration string, such as 90s, as a duration string.",
+		Example:     `formatDuration(5400) == "1h30m0s"`,
+		fn:          formatDurationFunc,
+	},
+	{
+		Name:        "formatTime",
+		Category:    CategoryTime,
+		Signature:   "formatTime(time, layout?, timezone?)",
+		Description: "Formats a time, an RFC 3339 string or a Unix timestamp in seconds. layout is a Go layout or one of RFC3339, RFC1123, DateTime, DateOnly, TimeOnly and Kitchen, and defaults to RFC3339. timezone defaults to UTC.",
+		Example:     `formatTime(root().timestamp, "DateOnly")`,
+		fn:          formatTimeFunc,
+	},
+	{
+		Name:        "regexMatch",
+		Category:    CategoryRegex,
+		Signature:   "regexMatch(text, pattern)",
+		Description: "Returns true if the text matches the regular expression.",
+		Example:     `regexMatch($["GitHub"].data.ref, "^refs/tags/v")`,
+		fn:          regexMatchFunc,
+	},
+	{
+		Name:        "regexExtract",
+		Category:    CategoryRegex,
+		Signature:   "regexExtract(text, pattern)",
+		Description: "Returns the first capture group of the first match, or the whole match if the pattern has no groups. Returns an empty string if there is no match.",
+		Example:     `regexExtract("refs/tags/v1.2.3", "v(.+)$") == "1.2.3"`,
+		fn:          regexExtractFunc,
+	},
+	{
+		Name:        "regexExtractAll",
+		Category:    CategoryRegex,
+		Signature:   "regexExtractAll(text, pattern)",
+		Description: "Returns every match, using the first capture group if the pattern has one.",
+		Example:     `regexExtractAll("a1 b2 c3", "[a-z](\\d)")`,
+		fn:          regexExtractAllFunc,
+	},
+	{
+		Name:        "regexReplace",
+		Category:    CategoryRegex,
+		Signature:   "regexReplace(text, pattern, replacement)",
+		Description: "Replaces every match of the regular expression. The replacement can reference groups with $1.",
+		Example:     `regexReplace("feature/login", "[^a-z0-9]+", "-")`,
+		fn:          regexReplaceFunc,
+	},
+	{
+		Name:        "jsonPath",
+		Category:    CategoryJSON,
+		Signature:   "jsonPath(value, path)",
+		Description: "Returns the value at a path such as $.items[0].name. value can be an object, a list or a JSON string. Returns nil if the path does not exist.",
+		Example:     `jsonPath($["HTTP"].data.body, "$.items[0].id")`,
+		fn:          jsonPathFunc,
+	},
+	{
+		Name:        "toJSON",
+		Category:    CategoryJSON,
+		Signature:   "toJSON(value)",
+		Description: "Encodes a value as JSON.",
+		Example:     `toJSON($["Start"].data)`,
+		Builtin:     true,
+	},
+	{
+		Name:        "fromJSON",
+		Category:    CategoryJSON,
+		Signature:   "fromJSON(text)",
+		Description: "Decodes a JSON string.",
+		Example:     `fromJSON($["HTTP"].data.body).id`,
+		Builtin:     true,
+	},
+	{
+		Name:        "sha256",
+		Category:    CategoryEncoding,
+		Signature:   "sha256(text)",
+		Description: "Returns the hex encoded SHA-256 digest of the text.",
+		Example:     `sha256("superplane")`,
+		fn:          sha256Func,
+	},
+	{
+		Name:        "toBase64",
+		Category:    CategoryEncoding,
+		Signature:   "toBase64(text)",
+		Description: "Encodes the text as standard base64.",
+		Example:     `toBase64("user:token")`,
+		Builtin:     true,
+	},
+	{
+		Name:        "fromBase64",
+		Category:    CategoryEncoding,
+		Signature:   "fromBase64(text)",
+		Description: "Decodes standard base64 text.",
+		Example:     `fromBase64($["GitHub"].data.content)`,
+		Builtin:     true,
+	},
+	{
+		Name:        "urlEncode",
+		Category:    CategoryEncoding,
+		Signature:   "urlEncode(text)",
+		Description: "Escapes the text so it can be used in a URL query.",
+		Example:     `"https://example.com/search?q=" + urlEncode("a b&c")`,
+		fn:          urlEncodeFunc,
+	},
+	{
+		Name:        "urlDecode",
+		Category:    CategoryEncoding,
+		Signature:   "urlDecode(text)",
+		Description: "Unescapes URL query encoded text.",
+		Example:     `urlDecode("a+b%26c") == "a b&c"`,
+		fn:          urlDecodeFunc,
+	},
+	{
+		Name:        "uniq",
+		Category:    CategoryLists,
+		Signature:   "uniq(list)",
+		Description: "Returns the list without duplicated items.",
+		Example:     `uniq(["a", "b", "a"])`,
+		Builtin:     true,
+	},
+	{
+		Name:        "groupBy",
+		Category:    CategoryLists,
+		Signature:   "groupBy(list, predicate)",
+		Description: "Groups the items of the list by the result of the predicate.",
+		Example:     `groupBy($["HTTP"].data.body.items, .status)`,
+		Builtin:     true,
+	},
+	{
+		Name:        "sortBy",
+		Category:    CategoryLists,
+		Signature:   "sortBy(list, predicate, order?)",
+		Description: "Sorts the list by the result of the predicate. order is asc or desc, and defaults to asc.",
+		Example:     `sortBy($["HTTP"].data.body.items, .createdAt, "desc")`,
+		Builtin:     true,
+	},
+	{
+		Name:        "map",
+		Category:    CategoryLists,
+		Signature:   "map(list, predicate)",
+		Description: "Returns the result of the predicate for every item of the list.",
+		Example:     `map($["HTTP"].data.body.items, .id)`,
+		Builtin:     true,
+	},
+	{
+		Name:        "filter",
+		Category:    CategoryLists,
+		Signature:   "filter(list, predicate)",
+		Description: "Returns the items of the list for which the predicate is true.",
+		Example:     `filter($["HTTP"].data.body.items, .enabled)`,
+		Builtin:     true,
+	},
+	{
+		Name:        "flatten",
+		Category:    CategoryLists,
+		Signature:   "flatten(list)",
+		Description: "Flattens nested lists into a single list.",
+		Example:     `flatten([[1, 2], [3]])`,
+		Builtin:     true,
+	},
+}
+
+// Functions returns every documented function, sorted by category and name.
+func Functions() []Function {
+	result := make([]Function, len(functions))
+	copy(result, functions)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Category != result[j].Category {
+			return result[i].Category < result[j].Category
+		}
+
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// Options returns the expr options for the function library.
+// root() and previous() depend on where the expression is evaluated,
+// so they are added separately, with ContextOptions or EnvOptions.
+func Options() []expr.Option {
+	options := []expr.Option{}
+	for _, function := range functions {
+		if function.fn == nil {
+			continue
+		}
+
+		options = append(options, expr.Function(function.Name, function.fn))
+	}
+
+	return options
+}
+
+func stringParam(function string, params []any, index int) (string, error) {
+	if index >= len(params) {
+		return "", fmt.Errorf("%s(): missing argument %d", function, index+1)
+	}
+
+	value, ok := params[index].(string)
+	if !ok {
+		return "", fmt.Errorf("%s(): argument %d must be a string, got %T", function, index+1, params[index])
+	}
+
+	return value, nil
+}
+
+func requireParams(function string, params []any, min, max int) error {
+	if len(params) < min || len(params) > max {
+		if min == max {
+			return fmt.Errorf("%s() takes %d argument(s), got %d", function, min, len(params))
+		}
+
+		return fmt.Errorf("%s() takes %d to %d arguments, got %d", function, min, max, len(params))
+	}
+
+	return nil
+}
+
+func semverParseFunc(params ...any) (any, error) {
+	if err := requireParams("semverParse", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	value, err := stringParam("semverParse", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := ParseSemver(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return version.Map(), nil
+}
+
+func semverCompareFunc(params ...any) (any, error) {
+	if err := requireParams("semverCompare", params, 2, 2); err != nil {
+		return nil, err
+	}
+
+	versions := make([]*Semver, 2)
+	for i := range versions {
+		value, err := stringParam("semverCompare", params, i)
+		if err != nil {
+			return nil, err
+		}
+
+		version, err := ParseSemver(value)
+		if err != nil {
+			return nil, err
+		}
+
+		versions[i] = version
+	}
+
+	return versions[0].Compare(versions[1]), nil
+}
+
+func semverValidFunc(params ...any) (any, error) {
+	if err := requireParams("semverValid", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	value, ok := params[0].(string)
+	if !ok {
+		return false, nil
+	}
+
+	_, err := ParseSemver(value)
+	return err == nil, nil
+}
+
+func formatDurationFunc(params ...any) (any, error) {
+	if err := requireParams("formatDuration", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	switch value := params[0].(type) {
+	case time.Duration:
+		return value.String(), nil
+	case int:
+		return (time.Duration(value) * time.Second).String(), nil
+	case int64:
+		return (time.Duration(value) * time.Second).String(), nil
+	case float64:
+		return time.Duration(value * float64(time.Second)).String(), nil
+	case string:
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("formatDuration(): %w", err)
+		}
+
+		return duration.String(), nil
+	default:
+		return nil, fmt.Errorf("formatDuration(): unsupported duration type %T", value)
+	}
+}
+
+var timeLayouts = map[string]string{
+	"RFC3339":  time.RFC3339,
+	"RFC1123":  time.RFC1123,
+	"DateTime": time.DateTime,
+	"DateOnly": time.DateOnly,
+	"TimeOnly": time.TimeOnly,
+	"Kitchen":  time.Kitchen,
+}
+
+func formatTimeFunc(params ...any) (any, error) {
+	if err := requireParams("formatTime", params, 1, 3); err != nil {
+		return nil, err
+	}
+
+	t, err := timeParam(params[0])
+	if err != nil {
+		return nil, fmt.Errorf("formatTime(): %w", err)
+	}
+
+	layout := time.RFC3339
+	if len(params) > 1 {
+		layout, err = stringParam("formatTime", params, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if named, ok := timeLayouts[layout]; ok {
+			layout = named
+		}
+	}
+
+	location := time.UTC
+	if len(params) > 2 {
+		timezone, err := stringParam("formatTime", params, 2)
+		if err != nil {
+			return nil, err
+		}
+
+		location, err = time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("formatTime(): %w", err)
+		}
+	}
+
+	return t.In(location).Format(layout), nil
+}
+
+func timeParam(value any) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case int:
+		return time.Unix(int64(v), 0), nil
+	case int64:
+		return time.Unix(v, 0), nil
+	case float64:
+		return time.Unix(0, int64(v*float64(time.Second))), nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("time must be in RFC 3339 format: %w", err)
+		}
+
+		return t, nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported time type %T", value)
+	}
+}
+
+func regexParams(function string, params []any, count int) ([]string, *regexp.Regexp, error) {
+	if err := requireParams(function, params, count, count); err != nil {
+		return nil, nil, err
+	}
+
+	values := make([]string, count)
+	for i := range values {
+		value, err := stringParam(function, params, i)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		values[i] = value
+	}
+
+	re, err := regexp.Compile(values[1])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s(): invalid pattern: %w", function, err)
+	}
+
+	return values, re, nil
+}
+
+func regexMatchFunc(params ...any) (any, error) {
+	values, re, err := regexParams("regexMatch", params, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	return re.MatchString(values[0]), nil
+}
+
+func regexExtractFunc(params ...any) (any, error) {
+	values, re, err := regexParams("regexExtract", params, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	match := re.FindStringSubmatch(values[0])
+	if match == nil {
+		return "", nil
+	}
+
+	if len(match) > 1 {
+		return match[1], nil
+	}
+
+	return match[0], nil
+}
+
+func regexExtractAllFunc(params ...any) (any, error) {
+	values, re, err := regexParams("regexExtractAll", params, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []any{}
+	for _, match := range re.FindAllStringSubmatch(values[0], -1) {
+		if len(match) > 1 {
+			result = append(result, match[1])
+		} else {
+			result = append(result, match[0])
+		}
+	}
+
+	return result, nil
+}
+
+func regexReplaceFunc(params ...any) (any, error) {
+	values, re, err := regexParams("regexReplace", params, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	return re.ReplaceAllString(values[0], values[2]), nil
+}
+
+func jsonPathFunc(params ...any) (any, error) {
+	if err := requireParams("jsonPath", params, 2, 2); err != nil {
+		return nil, err
+	}
+
+	path, err := stringParam("jsonPath", params, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := LookupJSONPath(params[0], path)
+	if err != nil {
+		return nil, fmt.Errorf("jsonPath(): %w", err)
+	}
+
+	return value, nil
+}
+
+func sha256Func(params ...any) (any, error) {
+	if err := requireParams("sha256", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	value, err := stringParam("sha256", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(digest[:]), nil
+}
+
+func urlEncodeFunc(params ...any) (any, error) {
+	if err := requireParams("urlEncode", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	value, err := stringParam("urlEncode", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return url.QueryEscape(value), nil
+}
+
+func urlDecodeFunc(params ...any) (any, error) {
+	if err := requireParams("urlDecode", params, 1, 1); err != nil {
+		return nil, err
+	}
+
+	value, err := stringParam("urlDecode", params, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := url.QueryUnescape(value)
+	if err != nil {
+		return nil, fmt.Errorf("urlDecode(): %w", err)
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,133 @@
+package expressions
+
+import (
+	"testing"
+
+	"github.com/expr-lang/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func eval(t *testing.T, expression string, env map[string]any) (any, error) {
+	t.Helper()
+
+	options := append([]expr.Option{expr.Env(env)}, EnvOptions(env)...)
+	vm, err := expr.Compile(expression, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return expr.Run(vm, env)
+}
+
+func Test__Functions(t *testing.T) {
+	env := map[string]any{
+		"$": map[string]any{
+			"HTTP": map[string]any{
+				"body": `{"items": [{"id": "a", "tags": ["x", "y"]}, {"id": "b"}]}`,
+			},
+		},
+		"__root":            map[string]any{"data": map[string]any{"ref": "refs/tags/v1.2.3"}},
+		"__previousByDepth": map[string]any{"1": "first", "2": "second"},
+	}
+
+	testCases := []struct {
+		expression string
+		expected   any
+	}{
+		{`root().data.ref`, "refs/tags/v1.2.3"},
+		{`previous()`, "first"},
+		{`previous(2)`, "second"},
+		{`semverParse("v1.4.2-rc.1+build.5").minor`, 4},
+		{`semverParse("1.4.2-rc.1+build.5").prerelease`, "rc.1"},
+		{`semverParse("1.4.2-rc.1+build.5").build`, "build.5"},
+		{`semverCompare("1.10.0", "1.9.3")`, 1},
+		{`semverCompare("1.0.0-alpha", "1.0.0")`, -1},
+		{`semverCompare("1.0.0-alpha.1", "1.0.0-alpha.beta")`, -1},
+		{`semverCompare("1.0.0-rc.11", "1.0.0-rc.2")`, 1},
+		{`semverCompare("v2.0.0", "2.0.0+build")`, 0},
+		{`semverValid("1.2")`, false},
+		{`semverValid("01.2.3")`, false},
+		{`semverValid(regexExtract(root().data.ref, "v(.+)$"))`, true},
+		{`formatDuration(5400)`, "1h30m0s"},
+		{`formatDuration("90s")`, "1m30s"},
+		{`formatDuration(duration("2h"))`, "2h0m0s"},
+		{`formatTime("2026-01-02T15:04:05Z", "DateOnly")`, "2026-01-02"},
+		{`formatTime(0)`, "1970-01-01T00:00:00Z"},
+		{`formatTime("2026-01-02T15:04:05Z", "15:04", "America/New_York")`, "10:04"},
+		{`regexMatch("refs/tags/v1.2.3", "^refs/tags/")`, true},
+		{`regexExtract("refs/heads/main", "v(.+)$")`, ""},
+		{`regexExtract("abc123", "[0-9]+")`, "123"},
+		{`regexExtractAll("a1 b2 c3", "[a-z](\\d)")`, []any{"1", "2", "3"}},
+		{`regexReplace("feature/Login", "[^a-z0-9]+", "-")`, "feature-ogin"},
+		{`jsonPath($["HTTP"].body, "$.items[0].id")`, "a"},
+		{`jsonPath($["HTTP"].body, "items[-1].id")`, "b"},
+		{`jsonPath($["HTTP"].body, "$.items[0]['tags'][1]")`, "y"},
+		{`jsonPath($["HTTP"].body, "$.items[5].id")`, nil},
+		{`jsonPath(fromJSON($["HTTP"].body), "$.items[1].missing")`, nil},
+		{`sha256("superplane")`, "2ff3070ec835170b57d54008c63f05c8be482c6d9ff7088f4e504968d6ae9db0"},
+		{`urlEncode("a b&c")`, "a+b%26c"},
+		{`urlDecode("a+b%26c")`, "a b&c"},
+		{`uniq(map(jsonPath($["HTTP"].body, "$.items"), .id))`, []any{"a", "b"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.expression, func(t *testing.T) {
+			output, err := eval(t, testCase.expression, env)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, output)
+		})
+	}
+}
+
+func Test__Functions__Errors(t *testing.T) {
+	env := map[string]any{}
+
+	testCases := []struct {
+		expression string
+		err        string
+	}{
+		{`root()`, "no root event found"},
+		{`previous(0)`, "depth must be >= 1"},
+		{`semverParse("1.2")`, "expected MAJOR.MINOR.PATCH"},
+		{`semverCompare("1.0.0")`, "takes 2 argument(s)"},
+		{`formatDuration("soon")`, "formatDuration()"},
+		{`formatTime("yesterday")`, "RFC 3339"},
+		{`regexMatch("a", "(")`, "invalid pattern"},
+		{`jsonPath("{", "$.a")`, "not valid JSON"},
+		{`jsonPath({}, "$.a[")`, "unclosed bracket"},
+		{`sha256(1)`, "must be a string"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.expression, func(t *testing.T) {
+			_, err := eval(t, testCase.expression, env)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.err)
+		})
+	}
+}
+
+func Test__FunctionsCatalog(t *testing.T) {
+	names := map[string]bool{}
+	for _, function := range Functions() {
+		assert.False(t, names[function.Name], "function %s is listed twice", function.Name)
+		names[function.Name] = true
+
+		assert.NotEmpty(t, function.Category)
+		assert.NotEmpty(t, function.Signature)
+		assert.NotEmpty(t, function.Description)
+		assert.NotEmpty(t, function.Example)
+	}
+
+	//
+	// Every example must compile, so the documentation stays in sync
+	// with the functions that are actually available.
+	//
+	env := map[string]any{"$": map[string]any{}}
+	for _, function := range Functions() {
+		options := append([]expr.Option{expr.Env(env)}, EnvOptions(env)...)
+		_, err := expr.Compile(function.Example, options...)
+		assert.NoError(t, err, "example for %s", function.Name)
+	}
+}
@@ -0,0 +1,132 @@
+package expressions
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LookupJSONPath returns the value at the given path.
+// The path supports the subset of JSONPath used to address a single value:
+// an optional $ root, .key, ["key"] and [index] segments.
+// Negative indexes count from the end of the list.
+// If the value is a string, it is decoded as JSON first.
+// A path that does not exist returns nil.
+func LookupJSONPath(value any, path string) (any, error) {
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if text, ok := value.(string); ok {
+		var decoded any
+		if err := json.Unmarshal([]byte(text), &decoded); err != nil {
+			return nil, fmt.Errorf("value is not valid JSON: %w", err)
+		}
+
+		value = decoded
+	}
+
+	current := value
+	for _, segment := range segments {
+		switch v := current.(type) {
+		case map[string]any:
+			if segment.isIndex {
+				return nil, nil
+			}
+
+			current = v[segment.key]
+
+		case []any:
+			if !segment.isIndex {
+				return nil, nil
+			}
+
+			index := segment.index
+			if index < 0 {
+				index += len(v)
+			}
+
+			if index < 0 || index >= len(v) {
+				return nil, nil
+			}
+
+			current = v[index]
+
+		default:
+			return nil, nil
+		}
+	}
+
+	return current, nil
+}
+
+type jsonPathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	path = strings.TrimSpace(path)
+	path = strings.TrimPrefix(path, "$")
+
+	segments := []jsonPathSegment{}
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			end := i + 1
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+
+			if end == i+1 {
+				return nil, fmt.Errorf("invalid path %q: empty key at position %d", path, i)
+			}
+
+			segments = append(segments, jsonPathSegment{key: path[i+1 : end]})
+			i = end
+
+		case '[':
+			end := strings.Index(path[i:], "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unclosed bracket at position %d", path, i)
+			}
+
+			inner := strings.TrimSpace(path[i+1 : i+end])
+			segment, err := parseJSONPathBracket(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: %w", path, err)
+			}
+
+			segments = append(segments, segment)
+			i += end + 1
+
+		default:
+			//
+			// Paths without the leading $ can start with a key directly.
+			//
+			if i != 0 {
+				return nil, fmt.Errorf("invalid path %q: unexpected %q at position %d", path, path[i], i)
+			}
+
+			path = "." + path
+		}
+	}
+
+	return segments, nil
+}
+
+func parseJSONPathBracket(inner string) (jsonPathSegment, error) {
+	if len(inner) >= 2 && (inner[0] == '"' || inner[0] == '\'') && inner[len(inner)-1] == inner[0] {
+		return jsonPathSegment{key: inner[1 : len(inner)-1]}, nil
+	}
+
+	index, err := strconv.Atoi(inner)
+	if err != nil {
+		return jsonPathSegment{}, fmt.Errorf("invalid index %q", inner)
+	}
+
+	return jsonPathSegment{index: index, isIndex: true}, nil
+}
@@ -0,0 +1,173 @@
+package expressions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Semver is a semantic version, as described in https://semver.org.
+type Semver struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease []string
+	Build      string
+}
+
+// ParseSemver parses a semantic version.
+// A leading v, as used in git tags, is accepted.
+func ParseSemver(value string) (*Semver, error) {
+	version := strings.TrimPrefix(strings.TrimSpace(value), "v")
+
+	build := ""
+	if i := strings.Index(version, "+"); i >= 0 {
+		build = version[i+1:]
+		version = version[:i]
+		if !validIdentifiers(build, false) {
+			return nil, fmt.Errorf("invalid semantic version %q: invalid build metadata", value)
+		}
+	}
+
+	var prerelease []string
+	if i := strings.Index(version, "-"); i >= 0 {
+		if !validIdentifiers(version[i+1:], true) {
+			return nil, fmt.Errorf("invalid semantic version %q: invalid prerelease", value)
+		}
+
+		prerelease = strings.Split(version[i+1:], ".")
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid semantic version %q: expected MAJOR.MINOR.PATCH", value)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		if !isNumeric(part) || (len(part) > 1 && part[0] == '0') {
+			return nil, fmt.Errorf("invalid semantic version %q: invalid number %q", value, part)
+		}
+
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semantic version %q: %w", value, err)
+		}
+
+		numbers[i] = n
+	}
+
+	return &Semver{
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		Prerelease: prerelease,
+		Build:      build,
+	}, nil
+}
+
+// Compare returns -1, 0 or 1 depending on whether v
+// has lower, equal or higher precedence than other.
+// Build metadata is ignored, as the specification requires.
+func (v *Semver) Compare(other *Semver) int {
+	for _, pair := range [][2]int{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			return compareInts(pair[0], pair[1])
+		}
+	}
+
+	//
+	// A version without prerelease has higher precedence than one with it.
+	//
+	if len(v.Prerelease) == 0 || len(other.Prerelease) == 0 {
+		return compareInts(len(other.Prerelease), len(v.Prerelease))
+	}
+
+	for i := 0; i < len(v.Prerelease) && i < len(other.Prerelease); i++ {
+		if result := compareIdentifiers(v.Prerelease[i], other.Prerelease[i]); result != 0 {
+			return result
+		}
+	}
+
+	return compareInts(len(v.Prerelease), len(other.Prerelease))
+}
+
+func (v *Semver) Map() map[string]any {
+	return map[string]any{
+		"major":      v.Major,
+		"minor":      v.Minor,
+		"patch":      v.Patch,
+		"prerelease": strings.Join(v.Prerelease, "."),
+		"build":      v.Build,
+	}
+}
+
+func compareIdentifiers(a, b string) int {
+	aNumeric := isNumeric(a)
+	bNumeric := isNumeric(b)
+
+	switch {
+	case aNumeric && bNumeric:
+		if len(a) != len(b) {
+			return compareInts(len(a), len(b))
+		}
+
+		return strings.Compare(a, b)
+
+	//
+	// Numeric identifiers have lower precedence than alphanumeric ones.
+	//
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func validIdentifiers(value string, disallowLeadingZeros bool) bool {
+	for _, identifier := range strings.Split(value, ".") {
+		if identifier == "" {
+			return false
+		}
+
+		for _, r := range identifier {
+			if !(r >= '0' && r <= '9') && !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && r != '-' {
+				return false
+			}
+		}
+
+		if disallowLeadingZeros && isNumeric(identifier) && len(identifier) > 1 && identifier[0] == '0' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isNumeric(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/audit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const MaxAuditLogEntriesLimit = 100
+
+func ListAuditLogEntries(ctx context.Context, req *pb.ListAuditLogEntriesRequest) (*pb.ListAuditLogEntriesResponse, error) {
+	orgID, orgIsSet := authentication.GetOrganizationIdFromMetadata(ctx)
+	if !orgIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	filter := models.AuditLogEntryFilter{
+		Resource: req.Resource,
+		Action:   req.Action,
+		Method:   req.Method,
+		Result:   req.Result,
+	}
+
+	if req.ActorId != "" {
+		actorID, err := uuid.Parse(req.ActorId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid actor id")
+		}
+
+		filter.ActorID = &actorID
+	}
+
+	if req.Before != nil {
+		t := req.Before.AsTime()
+		filter.Before = &t
+	}
+
+	if req.After != nil {
+		t := req.After.AsTime()
+		filter.After = &t
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > MaxAuditLogEntriesLimit {
+		limit = MaxAuditLogEntriesLimit
+	}
+
+	entries, err := models.ListAuditLogEntries(organizationID, filter, int(limit)+1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error listing audit log entries")
+	}
+
+	hasNextPage := len(entries) > int(limit)
+	if hasNextPage {
+		entries = entries[:limit]
+	}
+
+	response := &pb.ListAuditLogEntriesResponse{
+		Entries:     serializeAuditLogEntries(entries),
+		HasNextPage: hasNextPage,
+	}
+
+	if len(entries) > 0 {
+		response.LastTimestamp = timestamppb.New(*entries[len(entries)-1].CreatedAt)
+	}
+
+	return response, nil
+}
+
+func serializeAuditLogEntries(entries []models.AuditLogEntry) []*pb.AuditLogEntry {
+	result := make([]*pb.AuditLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, serializeAuditLogEntry(entry))
+	}
+
+	return result
+}
+
+func serializeAuditLogEntry(entry models.AuditLogEntry) *pb.AuditLogEntry {
+	e := &pb.AuditLogEntry{
+		Id:        entry.ID.String(),
+		ActorType: actorTypeToProto(entry.ActorType),
+		Method:    entry.Method,
+		Resource:  entry.Resource,
+		Action:    entry.Action,
+		Result:    entry.Result,
+		CreatedAt: timestamppb.New(*entry.CreatedAt),
+	}
+
+	if entry.ActorID != nil {
+		e.ActorId = entry.ActorID.String()
+	}
+
+	if entry.ResourceID != nil {
+		e.ResourceId = *entry.ResourceID
+	}
+
+	if entry.Error != nil {
+		e.Error = *entry.Error
+	}
+
+	request, err := structpb.NewStruct(entry.Request.Data())
+	if err == nil {
+		e.Request = request
+	}
+
+	return e
+}
+
+func actorTypeToProto(actorType string) pb.AuditActorType {
+	switch actorType {
+	case models.AuditLogActorUser:
+		return pb.AuditActorType_ACTOR_TYPE_USER
+	case models.AuditLogActorServiceAccount:
+		return pb.AuditActorType_ACTOR_TYPE_SERVICE_ACCOUNT
+	default:
+		return pb.AuditActorType_ACTOR_TYPE_UNKNOWN
+	}
+}
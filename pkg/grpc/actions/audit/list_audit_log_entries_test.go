@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/audit"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/datatypes"
+)
+
+func Test__ListAuditLogEntries(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", r.User.String(),
+		"x-organization-id", r.Organization.ID.String(),
+	))
+
+	record := func(method, resource, action, result string) {
+		entry := models.NewAuditLogEntry(r.Organization.ID, r.User.String())
+		entry.Method = method
+		entry.Resource = resource
+		entry.Action = action
+		entry.Result = result
+		entry.Request = datatypes.NewJSONType(map[string]any{"canvas_id": "canvas-1"})
+		require.NoError(t, entry.Create())
+	}
+
+	record("/Superplane.Canvases.Canvases/UpdateCanvas", "canvases", "update", codes.OK.String())
+	record("/Superplane.Roles.Roles/AssignRole", "members", "update", codes.OK.String())
+	record("/Superplane.Canvases.Canvases/CancelExecution", "canvases", "update", codes.NotFound.String())
+
+	t.Run("entries are listed most recent first", func(t *testing.T) {
+		response, err := ListAuditLogEntries(ctx, &pb.ListAuditLogEntriesRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Entries, 3)
+		assert.False(t, response.HasNextPage)
+
+		entry := response.Entries[0]
+		assert.Equal(t, "/Superplane.Canvases.Canvases/CancelExecution", entry.Method)
+		assert.Equal(t, "NotFound", entry.Result)
+		assert.Equal(t, pb.AuditActorType_ACTOR_TYPE_USER, entry.ActorType)
+		assert.Equal(t, r.User.String(), entry.ActorId)
+		assert.Equal(t, "canvas-1", entry.Request.AsMap()["canvas_id"])
+	})
+
+	t.Run("entries are filtered", func(t *testing.T) {
+		response, err := ListAuditLogEntries(ctx, &pb.ListAuditLogEntriesRequest{Resource: "canvases"})
+		require.NoError(t, err)
+		require.Len(t, response.Entries, 2)
+
+		response, err = ListAuditLogEntries(ctx, &pb.ListAuditLogEntriesRequest{Method: "AssignRole"})
+		require.NoError(t, err)
+		require.Len(t, response.Entries, 1)
+		assert.Equal(t, "members", response.Entries[0].Resource)
+
+		response, err = ListAuditLogEntries(ctx, &pb.ListAuditLogEntriesRequest{Result: "NotFound"})
+		require.NoError(t, err)
+		require.Len(t, response.Entries, 1)
+
+		response, err = ListAuditLogEntries(ctx, &pb.ListAuditLogEntriesRequest{After: timestamppb.New(time.Now().Add(time.Hour))})
+		require.NoError(t, err)
+		assert.Empty(t, response.Entries)
+	})
+
+	t.Run("entries are paginated", func(t *testing.T) {
+		response, err := ListAuditLogEntries(ctx, &pb.ListAuditLogEntriesRequest{Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, response.Entries, 2)
+		assert.True(t, response.HasNextPage)
+
+		response, err = ListAuditLogEntries(ctx, &pb.ListAuditLogEntriesRequest{Limit: 2, Before: response.LastTimestamp})
+		require.NoError(t, err)
+		require.Len(t, response.Entries, 1)
+		assert.False(t, response.HasNextPage)
+		assert.Equal(t, "/Superplane.Canvases.Canvases/UpdateCanvas", response.Entries[0].Method)
+	})
+
+	t.Run("invalid actor id", func(t *testing.T) {
+		_, err := ListAuditLogEntries(ctx, &pb.ListAuditLogEntriesRequest{ActorId: "not-a-uuid"})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+}
@@ -122,7 +122,7 @@ func validateOutputChannelReference(registry *registry.Registry, nodes []*compon
 		return err
 	}
 
-	for _, c := range component.OutputChannels(nil) {
+	for _, c := range component.OutputChannels(node.Configuration.AsMap()) {
 		if c.Name == outputChannel.NodeOutputChannel {
 			return nil
 		}
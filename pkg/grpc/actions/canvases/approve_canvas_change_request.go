@@ -0,0 +1,132 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// ApproveCanvasChangeRequest publishes the changes of a pending change request.
+// The change request must be approved by someone with its approval role,
+// other than the user who requested it.
+func ApproveCanvasChangeRequest(ctx context.Context, authService authorization.Authorization, encryptor crypto.Encryptor, registry *registry.Registry, organizationID string, canvasID, changeRequestID uuid.UUID, webhookBaseURL string) (*pb.ApproveCanvasChangeRequestResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	request, err := models.FindCanvasChangeRequest(canvasID, changeRequestID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "change request not found")
+	}
+
+	if request.State != models.CanvasChangeRequestStatePending {
+		return nil, status.Errorf(codes.FailedPrecondition, "change request is %s", request.State)
+	}
+
+	reviewerID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.RequestedBy != nil && *request.RequestedBy == reviewerID {
+		return nil, status.Error(codes.PermissionDenied, "change request cannot be approved by the user who requested it")
+	}
+
+	allowed, err := hasRole(authService, organizationID, reviewerID, request.ApprovalRole)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "change request must be approved by %s", request.ApprovalRole)
+	}
+
+	canvas, version, err := publishDraft(ctx, encryptor, registry, organizationID, request.Draft(), request.Message, webhookBaseURL, func(tx *gorm.DB, version *models.CanvasVersion) error {
+		locked, err := models.LockCanvasChangeRequestInTransaction(tx, canvasID, changeRequestID)
+		if err != nil {
+			return err
+		}
+
+		if locked.State != models.CanvasChangeRequestStatePending {
+			return status.Errorf(codes.FailedPrecondition, "change request is %s", locked.State)
+		}
+
+		err = locked.PublishInTransaction(tx, &reviewerID, version.ID)
+		if err != nil {
+			return err
+		}
+
+		request = locked
+
+		//
+		// The draft is only discarded if it was not changed after the change request was created,
+		// since those changes are not part of what was approved.
+		//
+		return models.DeleteCanvasDraftNotUpdatedSinceInTransaction(tx, canvasID, *request.CreatedAt)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	protoCanvas, err := SerializeCanvas(canvas, true)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	serializedVersions, err := SerializeCanvasVersions([]models.CanvasVersion{*version}, false)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	serializedRequests, err := serializeCanvasChangeRequests([]models.CanvasChangeRequest{*request})
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.ApproveCanvasChangeRequestResponse{
+		Canvas:        protoCanvas,
+		Version:       serializedVersions[0],
+		ChangeRequest: serializedRequests[0],
+	}, nil
+}
+
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	return id, nil
+}
+
+func hasRole(authService authorization.Authorization, organizationID string, userID uuid.UUID, role string) (bool, error) {
+	roles, err := authService.GetUserRolesForOrg(userID.String(), organizationID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range roles {
+		if r.Name == role {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
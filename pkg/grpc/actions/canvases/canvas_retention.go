@@ -0,0 +1,125 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func DescribeCanvasRetention(ctx context.Context, organizationID string, canvasID uuid.UUID) (*pb.DescribeCanvasRetentionResponse, error) {
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	policy, effectivePolicy, err := findCanvasRetentionPolicies(canvas)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := models.SummarizeCanvasRuns(canvas.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := models.FindCanvasRetentionStats(canvas.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DescribeCanvasRetentionResponse{
+		Policy:          policy,
+		EffectivePolicy: effectivePolicy,
+		Stats:           serializeCanvasRetentionStats(summary, stats),
+	}, nil
+}
+
+// UpdateCanvasRetentionPolicy sets the policy of the canvas,
+// or removes it, if none is given, so the organization policy applies.
+func UpdateCanvasRetentionPolicy(ctx context.Context, organizationID string, canvasID uuid.UUID, policy *pb.CanvasRetentionPolicy) (*pb.UpdateCanvasRetentionPolicyResponse, error) {
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	if policy == nil {
+		err = models.DeleteCanvasRetentionPolicy(canvas.ID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		maxAgeDays := int(policy.MaxAgeDays)
+		maxRuns := int(policy.MaxRuns)
+		if err := models.ValidateRetentionPolicy(maxAgeDays, maxRuns); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		_, err = models.SaveCanvasRetentionPolicy(canvas.OrganizationID, canvas.ID, maxAgeDays, maxRuns, policy.Archive)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	canvasPolicy, effectivePolicy, err := findCanvasRetentionPolicies(canvas)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCanvasRetentionPolicyResponse{
+		Policy:          canvasPolicy,
+		EffectivePolicy: effectivePolicy,
+	}, nil
+}
+
+// findCanvasRetentionPolicies returns the policy of the canvas itself, if any,
+// and the policy in effect for it, which might come from the organization.
+func findCanvasRetentionPolicies(canvas *models.Canvas) (*pb.CanvasRetentionPolicy, *pb.CanvasRetentionPolicy, error) {
+	policy, err := models.FindEffectiveRetentionPolicy(canvas.OrganizationID, canvas.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &pb.CanvasRetentionPolicy{}, nil
+		}
+
+		return nil, nil, err
+	}
+
+	effectivePolicy := &pb.CanvasRetentionPolicy{
+		MaxAgeDays: uint32(policy.MaxAgeDays),
+		MaxRuns:    uint32(policy.MaxRuns),
+		Archive:    policy.Archive,
+		Inherited:  policy.WorkflowID == nil,
+	}
+
+	if effectivePolicy.Inherited {
+		return nil, effectivePolicy, nil
+	}
+
+	return effectivePolicy, effectivePolicy, nil
+}
+
+func serializeCanvasRetentionStats(summary *models.CanvasRunsSummary, stats *models.CanvasRetentionStats) *pb.CanvasRetentionStats {
+	result := &pb.CanvasRetentionStats{
+		Runs:               summary.Runs,
+		Executions:         summary.Executions,
+		DeletedRuns:        stats.DeletedRuns,
+		DeletedExecutions:  stats.DeletedExecutions,
+		ArchivedExecutions: stats.ArchivedExecutions,
+	}
+
+	if summary.OldestRunAt != nil {
+		result.OldestRunAt = timestamppb.New(*summary.OldestRunAt)
+	}
+
+	if stats.LastEnforcedAt != nil {
+		result.LastEnforcedAt = timestamppb.New(*stats.LastEnforcedAt)
+	}
+
+	return result
+}
@@ -26,7 +26,8 @@ func CreateCanvas(ctx context.Context, registry *registry.Registry, organization
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	nodes, edges, err := ParseCanvas(registry, organizationID, pbCanvas)
+	canvasID := uuid.New()
+	nodes, edges, err := ParseCanvas(registry, organizationID, canvasID.String(), pbCanvas)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +47,7 @@ func CreateCanvas(ctx context.Context, registry *registry.Registry, organization
 	}
 
 	canvas := models.Canvas{
-		ID:             uuid.New(),
+		ID:             canvasID,
 		OrganizationID: targetOrganizationID,
 		IsTemplate:     isTemplate,
 		Name:           pbCanvas.Metadata.Name,
@@ -71,6 +72,11 @@ func CreateCanvas(ctx context.Context, registry *registry.Registry, organization
 			return err
 		}
 
+		_, err = models.CreateCanvasVersionInTransaction(tx, &canvas, &createdBy, "Initial version")
+		if err != nil {
+			return err
+		}
+
 		//
 		// Create the workflow node records (including internal blueprint nodes)
 		//
@@ -96,6 +102,11 @@ func CreateCanvas(ctx context.Context, registry *registry.Registry, organization
 				UpdatedAt:     &now,
 			}
 
+			canvasNode.SetConcurrencyGroup(node.ConcurrencyGroup)
+			canvasNode.SetRetryPolicy(node.RetryPolicy)
+			canvasNode.SetExecutionTimeout(node.ExecutionTimeoutSeconds)
+			canvasNode.SetQueuePolicy(node.QueuePolicy)
+			canvasNode.SetRateLimit(node.RateLimit)
 			if err := tx.Create(&canvasNode).Error; err != nil {
 				return err
 			}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"github.com/superplanehq/superplane/test/support"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestCreateCanvasDuplicateName(t *testing.T) {
@@ -34,3 +38,82 @@ func TestCreateCanvasDuplicateName(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, codes.AlreadyExists, status.Code(err))
 }
+
+func Test__CreateCanvasSecretAccess(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	newCanvas := func(secretName string) *pb.Canvas {
+		configuration, err := structpb.NewStruct(map[string]any{
+			"host":     "example.com",
+			"username": "root",
+			"command":  "echo hello",
+			"authentication": map[string]any{
+				"authMethod": "password",
+				"password":   map[string]any{"secret": secretName, "key": "password"},
+			},
+		})
+		require.NoError(t, err)
+
+		return &pb.Canvas{
+			Metadata: &pb.Canvas_Metadata{
+				Name: support.RandomName("canvas"),
+			},
+			Spec: &pb.Canvas_Spec{
+				Nodes: []*componentpb.Node{
+					{
+						Id:            "node-1",
+						Name:          "Node 1",
+						Type:          componentpb.Node_TYPE_COMPONENT,
+						Component:     &componentpb.Node_ComponentRef{Name: "ssh"},
+						Configuration: configuration,
+					},
+				},
+				Edges: []*componentpb.Edge{},
+			},
+		}
+	}
+
+	createSecret := func(policy *models.SecretAccessPolicy) *models.Secret {
+		secret, err := models.CreateSecretWithAccess(
+			support.RandomName("secret"),
+			secrets.ProviderLocal,
+			r.User.String(),
+			models.DomainTypeOrganization,
+			r.Organization.ID,
+			[]byte(`{"password":"hello"}`),
+			models.SecretAccess{Policy: policy},
+		)
+
+		require.NoError(t, err)
+		return secret
+	}
+
+	t.Run("secret allowed for component", func(t *testing.T) {
+		secret := createSecret(&models.SecretAccessPolicy{Components: []string{"ssh"}})
+		_, err := CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), newCanvas(secret.Name))
+		require.NoError(t, err)
+	})
+
+	t.Run("secret not allowed for component", func(t *testing.T) {
+		secret := createSecret(&models.SecretAccessPolicy{Components: []string{"http"}})
+		_, err := CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), newCanvas(secret.Name))
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "is not available to this node")
+	})
+
+	t.Run("secret not allowed for canvas", func(t *testing.T) {
+		secret := createSecret(&models.SecretAccessPolicy{Canvases: []string{uuid.NewString()}})
+		_, err := CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), newCanvas(secret.Name))
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("secret not found", func(t *testing.T) {
+		_, err := CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), newCanvas(support.RandomName("missing")))
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "not found")
+	})
+}
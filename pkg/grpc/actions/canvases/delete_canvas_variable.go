@@ -0,0 +1,41 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func DeleteCanvasVariable(ctx context.Context, organizationID string, canvasID uuid.UUID, name string, expectedVersion *uint32) (*pb.DeleteCanvasVariableResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	var expected *int
+	if expectedVersion != nil {
+		v := int(*expectedVersion)
+		expected = &v
+	}
+
+	err = models.DeleteCanvasVariable(canvasID, name, expected)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "variable not found")
+		}
+
+		if errors.Is(err, models.ErrCanvasVariableVersionConflict) {
+			return nil, status.Error(codes.Aborted, "variable was changed since the expected version")
+		}
+
+		return nil, err
+	}
+
+	return &pb.DeleteCanvasVariableResponse{}, nil
+}
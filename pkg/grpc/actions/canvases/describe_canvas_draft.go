@@ -0,0 +1,116 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func DescribeCanvasDraft(ctx context.Context, organizationID string, canvasID uuid.UUID) (*pb.DescribeCanvasDraftResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	draft, err := models.FindCanvasDraft(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas draft not found")
+	}
+
+	serialized, err := serializeCanvasDraft(draft)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.DescribeCanvasDraftResponse{
+		Draft: serialized,
+	}, nil
+}
+
+func serializeCanvasDraft(draft *models.CanvasDraft) (*pb.CanvasDraft, error) {
+	usersByID := map[uuid.UUID]models.User{}
+	if draft.UpdatedBy != nil {
+		users, err := models.FindMaybeDeletedUsersByIDs([]uuid.UUID{*draft.UpdatedBy})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range users {
+			usersByID[user.ID] = user
+		}
+	}
+
+	base, err := findBaseVersion(draft.WorkflowID, draft.BaseVersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	validationErrors := []*pb.CanvasDraft_ValidationError{}
+	for _, node := range draft.Nodes {
+		if node.ErrorMessage != nil {
+			validationErrors = append(validationErrors, &pb.CanvasDraft_ValidationError{
+				NodeId:  node.ID,
+				Message: *node.ErrorMessage,
+			})
+		}
+	}
+
+	result := &pb.CanvasDraft{
+		CanvasId:         draft.WorkflowID.String(),
+		Name:             draft.Name,
+		Description:      draft.Description,
+		UpdatedBy:        userRef(draft.UpdatedBy, usersByID),
+		CreatedAt:        timestamppb.New(*draft.CreatedAt),
+		UpdatedAt:        timestamppb.New(*draft.UpdatedAt),
+		ValidationErrors: validationErrors,
+		Diff:             diffCanvasVersions(base, draftVersion(draft)),
+		Spec: &pb.Canvas_Spec{
+			Nodes: actions.NodesToProto(draft.Nodes),
+			Edges: actions.EdgesToProto(draft.Edges),
+		},
+	}
+
+	if draft.BaseVersionID != nil {
+		result.BaseVersionId = draft.BaseVersionID.String()
+	}
+
+	return result, nil
+}
+
+// findBaseVersion finds the version a draft is based on.
+// If it no longer exists, nil is returned, and the draft is compared with an empty canvas.
+func findBaseVersion(canvasID uuid.UUID, baseVersionID *uuid.UUID) (*models.CanvasVersion, error) {
+	if baseVersionID == nil {
+		return nil, nil
+	}
+
+	base, err := models.FindCanvasVersion(canvasID, *baseVersionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return base, nil
+}
+
+// draftVersion returns the draft as a canvas version, so it can be compared with other versions.
+func draftVersion(draft *models.CanvasDraft) *models.CanvasVersion {
+	return &models.CanvasVersion{
+		WorkflowID:  draft.WorkflowID,
+		Name:        draft.Name,
+		Description: draft.Description,
+		Nodes:       draft.Nodes,
+		Edges:       draft.Edges,
+	}
+}
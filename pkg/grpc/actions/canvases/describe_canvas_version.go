@@ -0,0 +1,32 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func DescribeCanvasVersion(ctx context.Context, organizationID string, canvasID, versionID uuid.UUID) (*pb.DescribeCanvasVersionResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	version, err := models.FindCanvasVersion(canvasID, versionID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas version not found")
+	}
+
+	serialized, err := SerializeCanvasVersions([]models.CanvasVersion{*version}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DescribeCanvasVersionResponse{
+		Version: serialized[0],
+	}, nil
+}
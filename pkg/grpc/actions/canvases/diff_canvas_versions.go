@@ -0,0 +1,223 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func DiffCanvasVersions(ctx context.Context, organizationID string, canvasID, toVersionID uuid.UUID, fromVersionID *uuid.UUID) (*pb.DiffCanvasVersionsResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	to, err := models.FindCanvasVersion(canvasID, toVersionID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas version not found")
+	}
+
+	//
+	// If no base version is given, we compare with the previous version.
+	// The first version of a canvas is compared with an empty canvas.
+	//
+	var from *models.CanvasVersion
+	if fromVersionID != nil {
+		from, err = models.FindCanvasVersion(canvasID, *fromVersionID)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "base canvas version not found")
+		}
+	} else {
+		from, err = models.FindPreviousCanvasVersion(to)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+
+	versions := []models.CanvasVersion{*to}
+	if from != nil {
+		versions = append(versions, *from)
+	}
+
+	serialized, err := SerializeCanvasVersions(versions, false)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.DiffCanvasVersionsResponse{
+		To:   serialized[0],
+		Diff: diffCanvasVersions(from, to),
+	}
+
+	if from != nil {
+		response.From = serialized[1]
+	}
+
+	return response, nil
+}
+
+type nodeField struct {
+	name  string
+	value func(node *models.Node) any
+}
+
+// Fields compared between node versions.
+// Metadata, error and warning messages are not included,
+// since they are set by the system, not by whoever edits the canvas.
+var diffedNodeFields = []nodeField{
+	{name: "name", value: func(n *models.Node) any { return n.Name }},
+	{name: "type", value: func(n *models.Node) any { return n.Type }},
+	{name: "ref", value: func(n *models.Node) any { return n.Ref }},
+	{name: "configuration", value: func(n *models.Node) any { return nonNilMap(n.Configuration) }},
+	{name: "position", value: func(n *models.Node) any { return n.Position }},
+	{name: "isCollapsed", value: func(n *models.Node) any { return n.IsCollapsed }},
+	{name: "integrationId", value: func(n *models.Node) any { return stringValue(n.IntegrationID) }},
+	{name: "concurrencyGroup", value: func(n *models.Node) any { return n.ConcurrencyGroup }},
+	{name: "retryPolicy", value: func(n *models.Node) any { return n.RetryPolicy }},
+	{name: "executionTimeoutSeconds", value: func(n *models.Node) any { return n.ExecutionTimeoutSeconds }},
+	{name: "queuePolicy", value: func(n *models.Node) any { return n.QueuePolicy }},
+	{name: "rateLimit", value: func(n *models.Node) any { return n.RateLimit }},
+}
+
+// diffCanvasVersions returns the changes needed to go from one version to another.
+// A nil base version is treated as an empty canvas.
+func diffCanvasVersions(from, to *models.CanvasVersion) *pb.CanvasVersionDiff {
+	if from == nil {
+		from = &models.CanvasVersion{}
+	}
+
+	diff := &pb.CanvasVersionDiff{
+		ChangedFields: []string{},
+		Nodes:         []*pb.CanvasVersionDiff_NodeChange{},
+		Edges:         []*pb.CanvasVersionDiff_EdgeChange{},
+	}
+
+	if from.Name != to.Name {
+		diff.ChangedFields = append(diff.ChangedFields, "name")
+	}
+
+	if from.Description != to.Description {
+		diff.ChangedFields = append(diff.ChangedFields, "description")
+	}
+
+	fromNodes := versionNodes(from)
+	toNodes := versionNodes(to)
+
+	fromNodesByID := make(map[string]*models.Node, len(fromNodes))
+	for i := range fromNodes {
+		fromNodesByID[fromNodes[i].ID] = &fromNodes[i]
+	}
+
+	toNodesByID := make(map[string]*models.Node, len(toNodes))
+	for i := range toNodes {
+		toNodesByID[toNodes[i].ID] = &toNodes[i]
+	}
+
+	for i := range toNodes {
+		after := &toNodes[i]
+		before, ok := fromNodesByID[after.ID]
+		if !ok {
+			diff.Nodes = append(diff.Nodes, &pb.CanvasVersionDiff_NodeChange{
+				NodeId:   after.ID,
+				NodeName: after.Name,
+				Type:     pb.CanvasVersionDiff_CHANGE_TYPE_ADDED,
+				After:    nodeToProto(after),
+			})
+			continue
+		}
+
+		changedFields := []string{}
+		for _, field := range diffedNodeFields {
+			if !reflect.DeepEqual(field.value(before), field.value(after)) {
+				changedFields = append(changedFields, field.name)
+			}
+		}
+
+		if len(changedFields) == 0 {
+			continue
+		}
+
+		diff.Nodes = append(diff.Nodes, &pb.CanvasVersionDiff_NodeChange{
+			NodeId:        after.ID,
+			NodeName:      after.Name,
+			Type:          pb.CanvasVersionDiff_CHANGE_TYPE_MODIFIED,
+			ChangedFields: changedFields,
+			Before:        nodeToProto(before),
+			After:         nodeToProto(after),
+		})
+	}
+
+	for i := range fromNodes {
+		before := &fromNodes[i]
+		if _, ok := toNodesByID[before.ID]; ok {
+			continue
+		}
+
+		diff.Nodes = append(diff.Nodes, &pb.CanvasVersionDiff_NodeChange{
+			NodeId:   before.ID,
+			NodeName: before.Name,
+			Type:     pb.CanvasVersionDiff_CHANGE_TYPE_REMOVED,
+			Before:   nodeToProto(before),
+		})
+	}
+
+	fromEdges := make(map[models.Edge]bool, len(from.Edges))
+	for _, edge := range from.Edges {
+		fromEdges[edge] = true
+	}
+
+	toEdges := make(map[models.Edge]bool, len(to.Edges))
+	for _, edge := range to.Edges {
+		toEdges[edge] = true
+	}
+
+	for _, edge := range to.Edges {
+		if !fromEdges[edge] {
+			diff.Edges = append(diff.Edges, edgeChange(edge, pb.CanvasVersionDiff_CHANGE_TYPE_ADDED))
+		}
+	}
+
+	for _, edge := range from.Edges {
+		if !toEdges[edge] {
+			diff.Edges = append(diff.Edges, edgeChange(edge, pb.CanvasVersionDiff_CHANGE_TYPE_REMOVED))
+		}
+	}
+
+	return diff
+}
+
+func nonNilMap(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+
+	return m
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func nodeToProto(node *models.Node) *componentpb.Node {
+	return actions.NodesToProto([]models.Node{*node})[0]
+}
+
+func edgeChange(edge models.Edge, changeType pb.CanvasVersionDiff_ChangeType) *pb.CanvasVersionDiff_EdgeChange {
+	return &pb.CanvasVersionDiff_EdgeChange{
+		Type: changeType,
+		Edge: actions.EdgesToProto([]models.Edge{edge})[0],
+	}
+}
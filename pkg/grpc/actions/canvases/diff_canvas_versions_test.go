@@ -0,0 +1,104 @@
+package canvases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"gorm.io/datatypes"
+)
+
+func Test__DiffCanvasVersions(t *testing.T) {
+	noop := models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}
+
+	from := &models.CanvasVersion{
+		Name:        "canvas",
+		Description: "before",
+		Nodes: datatypes.NewJSONSlice([]models.Node{
+			{ID: "node-1", Name: "Node 1", Type: models.NodeTypeComponent, Ref: noop},
+			{ID: "node-2", Name: "Node 2", Type: models.NodeTypeComponent, Ref: noop, Configuration: map[string]any{"a": "b"}},
+			{ID: "node-3", Name: "Node 3", Type: models.NodeTypeComponent, Ref: noop},
+		}),
+		Edges: datatypes.NewJSONSlice([]models.Edge{
+			{SourceID: "node-1", TargetID: "node-2", Channel: "default"},
+			{SourceID: "node-2", TargetID: "node-3", Channel: "default"},
+		}),
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		diff := diffCanvasVersions(from, from)
+		assert.Empty(t, diff.ChangedFields)
+		assert.Empty(t, diff.Nodes)
+		assert.Empty(t, diff.Edges)
+	})
+
+	t.Run("added, removed and modified nodes and edges", func(t *testing.T) {
+		errorMessage := "setup failed"
+		to := &models.CanvasVersion{
+			Name:        "canvas",
+			Description: "after",
+			Nodes: datatypes.NewJSONSlice([]models.Node{
+				{ID: "node-1", Name: "Node 1", Type: models.NodeTypeComponent, Ref: noop, ErrorMessage: &errorMessage},
+				{ID: "node-2", Name: "Node 2", Type: models.NodeTypeComponent, Ref: noop, Configuration: map[string]any{"a": "c"}, Position: models.Position{X: 10}},
+				{ID: "node-4", Name: "Node 4", Type: models.NodeTypeComponent, Ref: noop},
+			}),
+			Edges: datatypes.NewJSONSlice([]models.Edge{
+				{SourceID: "node-1", TargetID: "node-2", Channel: "default"},
+				{SourceID: "node-2", TargetID: "node-4", Channel: "default"},
+			}),
+		}
+
+		diff := diffCanvasVersions(from, to)
+		assert.Equal(t, []string{"description"}, diff.ChangedFields)
+
+		//
+		// Error messages are set by the system, so node-1 is not modified.
+		//
+		require.Len(t, diff.Nodes, 3)
+		assert.Equal(t, "node-2", diff.Nodes[0].NodeId)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_MODIFIED, diff.Nodes[0].Type)
+		assert.Equal(t, []string{"configuration", "position"}, diff.Nodes[0].ChangedFields)
+		assert.Equal(t, "b", diff.Nodes[0].Before.Configuration.AsMap()["a"])
+		assert.Equal(t, "c", diff.Nodes[0].After.Configuration.AsMap()["a"])
+
+		assert.Equal(t, "node-4", diff.Nodes[1].NodeId)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_ADDED, diff.Nodes[1].Type)
+		assert.Nil(t, diff.Nodes[1].Before)
+		assert.NotNil(t, diff.Nodes[1].After)
+
+		assert.Equal(t, "node-3", diff.Nodes[2].NodeId)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_REMOVED, diff.Nodes[2].Type)
+		assert.NotNil(t, diff.Nodes[2].Before)
+		assert.Nil(t, diff.Nodes[2].After)
+
+		require.Len(t, diff.Edges, 2)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_ADDED, diff.Edges[0].Type)
+		assert.Equal(t, "node-4", diff.Edges[0].Edge.TargetId)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_REMOVED, diff.Edges[1].Type)
+		assert.Equal(t, "node-3", diff.Edges[1].Edge.TargetId)
+	})
+
+	t.Run("internal blueprint nodes are ignored", func(t *testing.T) {
+		to := &models.CanvasVersion{
+			Name:        from.Name,
+			Description: from.Description,
+			Nodes:       datatypes.NewJSONSlice(append(from.Nodes, models.Node{ID: "node-1:child", Name: "Child"})),
+			Edges:       from.Edges,
+		}
+
+		diff := diffCanvasVersions(from, to)
+		assert.Empty(t, diff.Nodes)
+	})
+
+	t.Run("first version is compared with an empty canvas", func(t *testing.T) {
+		diff := diffCanvasVersions(nil, from)
+		assert.Equal(t, []string{"name", "description"}, diff.ChangedFields)
+		require.Len(t, diff.Nodes, 3)
+		require.Len(t, diff.Edges, 2)
+		for _, change := range diff.Nodes {
+			assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_ADDED, change.Type)
+		}
+	})
+}
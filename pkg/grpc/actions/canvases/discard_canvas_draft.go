@@ -0,0 +1,33 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DiscardCanvasDraft deletes the draft of a canvas.
+// Change requests created from the draft are not affected.
+func DiscardCanvasDraft(ctx context.Context, organizationID string, canvasID uuid.UUID) (*pb.DiscardCanvasDraftResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	_, err = models.FindCanvasDraft(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas draft not found")
+	}
+
+	err = models.DeleteCanvasDraft(canvasID)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.DiscardCanvasDraftResponse{}, nil
+}
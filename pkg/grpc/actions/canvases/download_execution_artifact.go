@@ -0,0 +1,68 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func DownloadExecutionArtifact(ctx context.Context, storage artifacts.Storage, organizationID string, canvasID, executionID, artifactID uuid.UUID) (*httpbody.HttpBody, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	execution, err := models.FindNodeExecution(canvasID, executionID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "execution not found")
+	}
+
+	artifact, err := models.FindNodeExecutionArtifact(execution.ID, artifactID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "artifact not found")
+	}
+
+	if storage == nil || storage.Name() != artifact.Storage {
+		return nil, status.Errorf(codes.FailedPrecondition, "artifact is kept in %s storage, which is not configured", artifact.Storage)
+	}
+
+	reader, err := storage.Get(ctx, artifact.StorageKey)
+	if err != nil {
+		if errors.Is(err, artifacts.ErrArtifactNotFound) {
+			return nil, status.Error(codes.NotFound, "artifact content not found")
+		}
+
+		log.Errorf("error reading artifact %s: %v", artifact.ID, err)
+		return nil, status.Error(codes.Internal, "error reading artifact")
+	}
+
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		log.Errorf("error reading artifact %s: %v", artifact.ID, err)
+		return nil, status.Error(codes.Internal, "error reading artifact")
+	}
+
+	//
+	// The gateway forwards this header, so browsers
+	// save the artifact with its name instead of "download".
+	//
+	_ = grpc.SetHeader(ctx, metadata.Pairs("content-disposition", fmt.Sprintf("attachment; filename=%q", artifact.Name)))
+
+	return &httpbody.HttpBody{
+		ContentType: artifact.ContentType,
+		Data:        data,
+	}, nil
+}
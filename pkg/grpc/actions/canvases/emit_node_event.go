@@ -12,7 +12,9 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/datatypes"
 )
 
@@ -42,6 +44,10 @@ func EmitNodeEvent(
 		Data:       datatypes.NewJSONType[any](data),
 		State:      models.CanvasEventStatePending,
 		CreatedAt:  &now,
+		Traceparent: telemetry.StartTrace(nil, "event.emit",
+			attribute.String("canvas.id", canvas.ID.String()),
+			attribute.String("node.id", nodeID),
+		),
 	}
 
 	customName, err := resolveCustomName(node, data)
@@ -49,6 +55,11 @@ func EmitNodeEvent(
 		event.CustomName = customName
 	}
 
+	priority, err := contexts.ResolveRunPriority(database.Conn(), node, data)
+	if err == nil {
+		event.Priority = priority
+	}
+
 	if err := database.Conn().Create(&event).Error; err != nil {
 		log.Errorf("failed to publish workflow event: %v", err)
 		return nil, fmt.Errorf("failed to create workflow event: %w", err)
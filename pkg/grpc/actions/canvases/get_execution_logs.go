@@ -0,0 +1,68 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func GetExecutionLogs(ctx context.Context, organizationID string, canvasID, executionID uuid.UUID, after int64, limit uint32) (*pb.GetExecutionLogsResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	execution, err := models.FindNodeExecution(canvasID, executionID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "execution not found")
+	}
+
+	l := int(limit)
+	if l <= 0 || l > models.MaxExecutionLogLimit {
+		l = models.MaxExecutionLogLimit
+	}
+
+	//
+	// We ask for one more line than the limit,
+	// to know if there is more to read after this page.
+	//
+	logs, err := models.ListNodeExecutionLogs(execution.ID, after, l+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(logs) > l
+	if hasMore {
+		logs = logs[:l]
+	}
+
+	return &pb.GetExecutionLogsResponse{
+		Lines:   SerializeExecutionLogLines(logs),
+		HasMore: hasMore,
+	}, nil
+}
+
+func SerializeExecutionLogLines(logs []models.CanvasNodeExecutionLog) []*pb.ExecutionLogLine {
+	lines := make([]*pb.ExecutionLogLine, 0, len(logs))
+	for _, l := range logs {
+		line := &pb.ExecutionLogLine{
+			Id:      l.ID,
+			Stream:  l.Stream,
+			Level:   l.Level,
+			Message: l.Message,
+		}
+
+		if l.CreatedAt != nil {
+			line.CreatedAt = timestamppb.New(*l.CreatedAt)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -0,0 +1,88 @@
+package canvases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__GetExecutionLogs(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+	now := time.Now()
+	logs := []models.CanvasNodeExecutionLog{}
+	for i := 0; i < 5; i++ {
+		logs = append(logs, models.CanvasNodeExecutionLog{
+			WorkflowID:  canvas.ID,
+			NodeID:      "node-1",
+			ExecutionID: execution.ID,
+			Stream:      models.CanvasNodeExecutionLogStreamStdout,
+			Level:       "info",
+			Message:     fmt.Sprintf("line %d", i),
+			CreatedAt:   &now,
+		})
+	}
+
+	require.NoError(t, models.CreateNodeExecutionLogs(logs))
+
+	t.Run("lines are paged after the given line", func(t *testing.T) {
+		response, err := GetExecutionLogs(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID, 0, 3)
+		require.NoError(t, err)
+		require.Len(t, response.Lines, 3)
+		assert.True(t, response.HasMore)
+		assert.Equal(t, "line 0", response.Lines[0].Message)
+		assert.Equal(t, models.CanvasNodeExecutionLogStreamStdout, response.Lines[0].Stream)
+
+		response, err = GetExecutionLogs(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID, response.Lines[2].Id, 3)
+		require.NoError(t, err)
+		require.Len(t, response.Lines, 2)
+		assert.False(t, response.HasMore)
+		assert.Equal(t, "line 3", response.Lines[0].Message)
+		assert.Equal(t, "line 4", response.Lines[1].Message)
+	})
+
+	t.Run("execution from another canvas is not found", func(t *testing.T) {
+		other, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		_, err := GetExecutionLogs(context.Background(), r.Organization.ID.String(), other.ID, execution.ID, 0, 0)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("canvas from another organization is not found", func(t *testing.T) {
+		_, err := GetExecutionLogs(context.Background(), uuid.NewString(), canvas.ID, execution.ID, 0, 0)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -0,0 +1,131 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListCanvasChangeRequests(ctx context.Context, organizationID string, canvasID uuid.UUID, pbStates []pb.CanvasChangeRequestState) (*pb.ListCanvasChangeRequestsResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	states := []string{}
+	for _, s := range pbStates {
+		state, err := ProtoToChangeRequestState(s)
+		if err != nil {
+			return nil, err
+		}
+
+		states = append(states, state)
+	}
+
+	requests, err := models.ListCanvasChangeRequests(canvasID, states)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	serialized, err := serializeCanvasChangeRequests(requests)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.ListCanvasChangeRequestsResponse{
+		ChangeRequests: serialized,
+	}, nil
+}
+
+func serializeCanvasChangeRequests(requests []models.CanvasChangeRequest) ([]*pb.CanvasChangeRequest, error) {
+	userIDs := []uuid.UUID{}
+	for _, request := range requests {
+		if request.RequestedBy != nil {
+			userIDs = append(userIDs, *request.RequestedBy)
+		}
+
+		if request.ReviewedBy != nil {
+			userIDs = append(userIDs, *request.ReviewedBy)
+		}
+	}
+
+	users, err := models.FindMaybeDeletedUsersByIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByID := make(map[uuid.UUID]models.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+
+	result := make([]*pb.CanvasChangeRequest, 0, len(requests))
+	for _, request := range requests {
+		base, err := findBaseVersion(request.WorkflowID, request.BaseVersionID)
+		if err != nil {
+			return nil, err
+		}
+
+		pbRequest := &pb.CanvasChangeRequest{
+			Id:           request.ID.String(),
+			CanvasId:     request.WorkflowID.String(),
+			Name:         request.Name,
+			Description:  request.Description,
+			Message:      request.Message,
+			ApprovalRole: request.ApprovalRole,
+			State:        ChangeRequestStateToProto(request.State),
+			RequestedBy:  userRef(request.RequestedBy, usersByID),
+			ReviewedBy:   userRef(request.ReviewedBy, usersByID),
+			CreatedAt:    timestamppb.New(*request.CreatedAt),
+			Diff:         diffCanvasVersions(base, draftVersion(request.Draft())),
+		}
+
+		if request.BaseVersionID != nil {
+			pbRequest.BaseVersionId = request.BaseVersionID.String()
+		}
+
+		if request.PublishedVersionID != nil {
+			pbRequest.PublishedVersionId = request.PublishedVersionID.String()
+		}
+
+		if request.ReviewedAt != nil {
+			pbRequest.ReviewedAt = timestamppb.New(*request.ReviewedAt)
+		}
+
+		result = append(result, pbRequest)
+	}
+
+	return result, nil
+}
+
+func ChangeRequestStateToProto(state string) pb.CanvasChangeRequestState {
+	switch state {
+	case models.CanvasChangeRequestStatePending:
+		return pb.CanvasChangeRequestState_STATE_PENDING
+	case models.CanvasChangeRequestStatePublished:
+		return pb.CanvasChangeRequestState_STATE_PUBLISHED
+	case models.CanvasChangeRequestStateRejected:
+		return pb.CanvasChangeRequestState_STATE_REJECTED
+	default:
+		return pb.CanvasChangeRequestState_STATE_UNKNOWN
+	}
+}
+
+func ProtoToChangeRequestState(state pb.CanvasChangeRequestState) (string, error) {
+	switch state {
+	case pb.CanvasChangeRequestState_STATE_PENDING:
+		return models.CanvasChangeRequestStatePending, nil
+	case pb.CanvasChangeRequestState_STATE_PUBLISHED:
+		return models.CanvasChangeRequestStatePublished, nil
+	case pb.CanvasChangeRequestState_STATE_REJECTED:
+		return models.CanvasChangeRequestStateRejected, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid change request state: %v", state)
+	}
+}
@@ -0,0 +1,76 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListCanvasVariables(ctx context.Context, organizationID string, canvasID uuid.UUID) (*pb.ListCanvasVariablesResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	variables, err := models.ListCanvasVariables(canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := SerializeCanvasVariables(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListCanvasVariablesResponse{Variables: serialized}, nil
+}
+
+func SerializeCanvasVariables(variables []models.CanvasVariable) ([]*pb.CanvasVariable, error) {
+	updatedByIDs := []uuid.UUID{}
+	for _, variable := range variables {
+		if variable.UpdatedBy != nil {
+			updatedByIDs = append(updatedByIDs, *variable.UpdatedBy)
+		}
+	}
+
+	users, err := models.FindMaybeDeletedUsersByIDs(updatedByIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByID := make(map[uuid.UUID]models.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+
+	result := make([]*pb.CanvasVariable, 0, len(variables))
+	for _, variable := range variables {
+		value, err := structpb.NewValue(variable.Value.Data())
+		if err != nil {
+			return nil, err
+		}
+
+		pbVariable := &pb.CanvasVariable{
+			Name:      variable.Name,
+			Value:     value,
+			Version:   uint32(variable.Version),
+			UpdatedBy: userRef(variable.UpdatedBy, usersByID),
+			CreatedAt: timestamppb.New(*variable.CreatedAt),
+			UpdatedAt: timestamppb.New(*variable.UpdatedAt),
+		}
+
+		if variable.UpdatedByExecutionID != nil {
+			pbVariable.UpdatedByExecutionId = variable.UpdatedByExecutionID.String()
+		}
+
+		result = append(result, pbVariable)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,111 @@
+package canvases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListCanvasVersions(ctx context.Context, organizationID string, canvasID uuid.UUID, limit uint32, before uint32) (*pb.ListCanvasVersionsResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	limit = getLimit(limit)
+
+	var beforeVersion *int
+	if before > 0 {
+		v := int(before)
+		beforeVersion = &v
+	}
+
+	versions, err := models.ListCanvasVersions(canvasID, int(limit), beforeVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := models.CountCanvasVersions(canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := SerializeCanvasVersions(versions, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListCanvasVersionsResponse{
+		Versions:    serialized,
+		TotalCount:  uint32(totalCount),
+		HasNextPage: hasNextPage(len(versions), int(limit), totalCount),
+	}, nil
+}
+
+// SerializeCanvasVersions serializes canvas versions,
+// only including their nodes and edges if includeSpec is set.
+func SerializeCanvasVersions(versions []models.CanvasVersion, includeSpec bool) ([]*pb.CanvasVersion, error) {
+	createdByIDs := []uuid.UUID{}
+	for _, version := range versions {
+		if version.CreatedBy != nil {
+			createdByIDs = append(createdByIDs, *version.CreatedBy)
+		}
+	}
+
+	users, err := models.FindMaybeDeletedUsersByIDs(createdByIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByID := make(map[uuid.UUID]models.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+
+	result := make([]*pb.CanvasVersion, 0, len(versions))
+	for _, version := range versions {
+		pbVersion := &pb.CanvasVersion{
+			Id:          version.ID.String(),
+			CanvasId:    version.WorkflowID.String(),
+			Version:     uint32(version.Version),
+			Name:        version.Name,
+			Description: version.Description,
+			Message:     version.Message,
+			CreatedBy:   userRef(version.CreatedBy, usersByID),
+			CreatedAt:   timestamppb.New(*version.CreatedAt),
+		}
+
+		if includeSpec {
+			pbVersion.Spec = &pb.Canvas_Spec{
+				Nodes: actions.NodesToProto(versionNodes(&version)),
+				Edges: actions.EdgesToProto(version.Edges),
+			}
+		}
+
+		result = append(result, pbVersion)
+	}
+
+	return result, nil
+}
+
+// versionNodes returns the nodes of a canvas version,
+// without the internal nodes of blueprint nodes, which are expanded on every update.
+func versionNodes(version *models.CanvasVersion) []models.Node {
+	nodes := []models.Node{}
+	for _, node := range version.Nodes {
+		if strings.Contains(node.ID, ":") {
+			continue
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
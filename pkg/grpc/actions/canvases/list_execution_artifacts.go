@@ -0,0 +1,60 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListExecutionArtifacts(ctx context.Context, organizationID string, canvasID, executionID uuid.UUID) (*pb.ListExecutionArtifactsResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	execution, err := models.FindNodeExecution(canvasID, executionID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "execution not found")
+	}
+
+	artifacts, err := models.ListNodeExecutionArtifacts(execution.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListExecutionArtifactsResponse{
+		Artifacts: SerializeExecutionArtifacts(artifacts),
+	}, nil
+}
+
+func SerializeExecutionArtifacts(artifacts []models.CanvasNodeExecutionArtifact) []*pb.ExecutionArtifact {
+	serialized := make([]*pb.ExecutionArtifact, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		serialized = append(serialized, SerializeExecutionArtifact(&artifact))
+	}
+
+	return serialized
+}
+
+func SerializeExecutionArtifact(artifact *models.CanvasNodeExecutionArtifact) *pb.ExecutionArtifact {
+	serialized := &pb.ExecutionArtifact{
+		Id:          artifact.ID.String(),
+		ExecutionId: artifact.ExecutionID.String(),
+		NodeId:      artifact.NodeID,
+		Name:        artifact.Name,
+		ContentType: artifact.ContentType,
+		Size:        artifact.Size,
+		Sha256:      artifact.SHA256,
+	}
+
+	if artifact.CreatedAt != nil {
+		serialized.CreatedAt = timestamppb.New(*artifact.CreatedAt)
+	}
+
+	return serialized
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -199,7 +200,18 @@ func SerializeNodeExecutions(executions []models.CanvasNodeExecution, childExecu
 			Input:               input,
 			Outputs:             outputs,
 			RootEvent:           rootEvent,
-			CancelledBy:         cancelledByRef(execution.CancelledBy, cancelledByUsersByID),
+			CancelledBy:         userRef(execution.CancelledBy, cancelledByUsersByID),
+			Attempt:             int32(execution.GetAttempt()),
+			RetryOfExecutionId:  execution.GetRetryOfExecutionID(),
+			TraceId:             telemetry.TraceID(execution.Traceparent),
+		}
+
+		if execution.DeadlineAt != nil {
+			pbExecution.DeadlineAt = timestamppb.New(*execution.DeadlineAt)
+		}
+
+		if execution.WorkflowVersionID != nil {
+			pbExecution.CanvasVersionId = execution.WorkflowVersionID.String()
 		}
 
 		if len(childExecutions) == 0 {
@@ -323,6 +335,10 @@ func NodeExecutionResultReasonToProto(reason string) pb.CanvasNodeExecution_Resu
 		return pb.CanvasNodeExecution_RESULT_REASON_ERROR
 	case models.CanvasNodeExecutionResultReasonErrorResolved:
 		return pb.CanvasNodeExecution_RESULT_REASON_ERROR_RESOLVED
+	case models.CanvasNodeExecutionResultReasonTimeout:
+		return pb.CanvasNodeExecution_RESULT_REASON_TIMEOUT
+	case models.CanvasNodeExecutionResultReasonSkipped:
+		return pb.CanvasNodeExecution_RESULT_REASON_SKIPPED
 	default:
 		return pb.CanvasNodeExecution_RESULT_REASON_OK
 	}
@@ -397,7 +413,7 @@ func rootEventIDs(executions []models.CanvasNodeExecution) []string {
 	return ids
 }
 
-func cancelledByRef(id *uuid.UUID, users map[uuid.UUID]models.User) *pb.UserRef {
+func userRef(id *uuid.UUID, users map[uuid.UUID]models.User) *pb.UserRef {
 	if id == nil {
 		return nil
 	}
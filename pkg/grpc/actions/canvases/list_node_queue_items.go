@@ -75,6 +75,7 @@ func SerializeNodeQueueItems(queueItems []models.CanvasNodeQueueItem) ([]*pb.Can
 			NodeId:    queueItem.NodeID,
 			CreatedAt: timestamppb.New(*queueItem.CreatedAt),
 			Input:     input,
+			Priority:  int32(queueItem.Priority),
 		}
 
 		if queueItem.RootEvent != nil {
@@ -0,0 +1,139 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// PublishCanvasDraft replaces the canvas with its draft.
+// If an approval role is given, the draft is not published right away.
+// Instead, a change request is created, and the draft is published when someone with that role approves it.
+func PublishCanvasDraft(ctx context.Context, authService authorization.Authorization, encryptor crypto.Encryptor, registry *registry.Registry, organizationID string, canvasID uuid.UUID, message, approvalRole, webhookBaseURL string) (*pb.PublishCanvasDraftResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	draft, err := models.FindCanvasDraft(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas draft not found")
+	}
+
+	var publishedBy *uuid.UUID
+	if userID, ok := authentication.GetUserIdFromMetadata(ctx); ok {
+		id := uuid.MustParse(userID)
+		publishedBy = &id
+	}
+
+	if approvalRole != "" {
+		return requestDraftApproval(authService, registry, organizationID, draft, message, approvalRole, publishedBy)
+	}
+
+	canvas, version, err := publishDraft(ctx, encryptor, registry, organizationID, draft, message, webhookBaseURL, func(tx *gorm.DB, version *models.CanvasVersion) error {
+		return models.DeleteCanvasDraftNotUpdatedSinceInTransaction(tx, canvasID, *draft.UpdatedAt)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	protoCanvas, err := SerializeCanvas(canvas, true)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	serialized, err := SerializeCanvasVersions([]models.CanvasVersion{*version}, false)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.PublishCanvasDraftResponse{
+		Canvas:  protoCanvas,
+		Version: serialized[0],
+	}, nil
+}
+
+func requestDraftApproval(authService authorization.Authorization, registry *registry.Registry, organizationID string, draft *models.CanvasDraft, message, approvalRole string, requestedBy *uuid.UUID) (*pb.PublishCanvasDraftResponse, error) {
+	_, err := authService.GetRoleDefinition(approvalRole, models.DomainTypeOrganization, organizationID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "role %s not found", approvalRole)
+	}
+
+	err = checkDraft(registry, organizationID, draft)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := models.CreateCanvasChangeRequestInTransaction(database.Conn(), draft, approvalRole, message, requestedBy)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	serialized, err := serializeCanvasChangeRequests([]models.CanvasChangeRequest{*request})
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.PublishCanvasDraftResponse{
+		ChangeRequest: serialized[0],
+	}, nil
+}
+
+// checkDraft makes sure the draft is valid and based on the latest version of the canvas.
+func checkDraft(registry *registry.Registry, organizationID string, draft *models.CanvasDraft) error {
+	latest, err := latestCanvasVersion(draft.WorkflowID)
+	if err != nil {
+		return actions.ToStatus(err)
+	}
+
+	err = checkDraftBase(draft.WorkflowID, draft.BaseVersionID, latest)
+	if err != nil {
+		return actions.ToStatus(err)
+	}
+
+	//
+	// Components, integrations and blueprints may have changed since the draft was saved,
+	// so the draft is validated again before being published.
+	//
+	nodes, _, err := validateCanvasDraft(registry, organizationID, draft.WorkflowID.String(), draftToProto(draft.WorkflowID, draft))
+	if err != nil {
+		return actions.ToStatus(err)
+	}
+
+	return draftErrors(nodes)
+}
+
+// publishDraft replaces the canvas with the draft, through the same path as any other canvas update.
+// afterPublish runs in the same transaction as the update, so publishing is atomic.
+func publishDraft(ctx context.Context, encryptor crypto.Encryptor, registry *registry.Registry, organizationID string, draft *models.CanvasDraft, message, webhookBaseURL string, afterPublish afterCanvasUpdate) (*models.Canvas, *models.CanvasVersion, error) {
+	err := checkDraft(registry, organizationID, draft)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	canvasID := draft.WorkflowID
+	return updateCanvas(ctx, encryptor, registry, organizationID, canvasID.String(), draftToProto(canvasID, draft), message, webhookBaseURL, func(tx *gorm.DB, version *models.CanvasVersion) error {
+		//
+		// The canvas record is locked by the update at this point,
+		// so checking the base version again makes sure no concurrent update is lost.
+		//
+		err := checkDraftBase(canvasID, draft.BaseVersionID, version.Version-1)
+		if err != nil {
+			return err
+		}
+
+		return afterPublish(tx, version)
+	})
+}
@@ -0,0 +1,196 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/datatypes"
+)
+
+func Test__CanvasDrafts(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+	webhookBaseURL := "http://localhost:3000/api/v1"
+
+	draftWithIf := func(expression string) *pb.Canvas {
+		configuration, err := structpb.NewStruct(map[string]any{"expression": expression})
+		require.NoError(t, err)
+
+		return &pb.Canvas{
+			Metadata: &pb.Canvas_Metadata{Name: canvas.Name, Description: canvas.Description},
+			Spec: &pb.Canvas_Spec{
+				Nodes: []*componentpb.Node{
+					{Id: "node-1", Name: "Node 1", Type: componentpb.Node_TYPE_COMPONENT, Component: &componentpb.Node_ComponentRef{Name: "noop"}},
+					{Id: "node-2", Name: "Node 2", Type: componentpb.Node_TYPE_COMPONENT, Component: &componentpb.Node_ComponentRef{Name: "if"}, Configuration: configuration},
+				},
+				Edges: []*componentpb.Edge{
+					{SourceId: "node-1", TargetId: "node-2", Channel: "default"},
+				},
+			},
+		}
+	}
+
+	requireCode := func(t *testing.T, err error, code codes.Code) {
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, code, s.Code())
+	}
+
+	t.Run("draft with invalid expression is saved with validation errors", func(t *testing.T) {
+		response, err := UpdateCanvasDraft(ctx, r.Registry, orgID, canvas.ID, draftWithIf(`$["Node 1"].data ==`))
+		require.NoError(t, err)
+		require.Len(t, response.Draft.ValidationErrors, 1)
+		assert.Equal(t, "node-2", response.Draft.ValidationErrors[0].NodeId)
+		assert.Contains(t, response.Draft.ValidationErrors[0].Message, "invalid expression")
+
+		//
+		// The running canvas is not changed.
+		//
+		nodes, err := models.FindCanvasNodes(canvas.ID)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+	})
+
+	t.Run("invalid draft cannot be published", func(t *testing.T) {
+		_, err := PublishCanvasDraft(ctx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, "", "", webhookBaseURL)
+		requireCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("valid draft is published as a new version", func(t *testing.T) {
+		response, err := UpdateCanvasDraft(ctx, r.Registry, orgID, canvas.ID, draftWithIf(`$["Node 1"].data.ok == true`))
+		require.NoError(t, err)
+		assert.Empty(t, response.Draft.ValidationErrors)
+		require.Len(t, response.Draft.Diff.Nodes, 1)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_ADDED, response.Draft.Diff.Nodes[0].Type)
+
+		published, err := PublishCanvasDraft(ctx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, "Add if", "", webhookBaseURL)
+		require.NoError(t, err)
+		assert.Nil(t, published.ChangeRequest)
+		assert.Equal(t, uint32(2), published.Version.Version)
+		assert.Equal(t, "Add if", published.Version.Message)
+
+		nodes, err := models.FindCanvasNodes(canvas.ID)
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+
+		_, err = DescribeCanvasDraft(ctx, orgID, canvas.ID)
+		requireCode(t, err, codes.NotFound)
+	})
+
+	t.Run("draft is not published if canvas changed after it was created", func(t *testing.T) {
+		_, err := UpdateCanvasDraft(ctx, r.Registry, orgID, canvas.ID, draftWithIf(`true`))
+		require.NoError(t, err)
+
+		_, err = UpdateCanvas(ctx, r.Encryptor, r.Registry, orgID, canvas.ID.String(), draftWithIf(`false`), "", webhookBaseURL)
+		require.NoError(t, err)
+
+		_, err = PublishCanvasDraft(ctx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, "", "", webhookBaseURL)
+		requireCode(t, err, codes.FailedPrecondition)
+
+		_, err = DiscardCanvasDraft(ctx, orgID, canvas.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("publishing with an approval role creates a change request", func(t *testing.T) {
+		_, err := UpdateCanvasDraft(ctx, r.Registry, orgID, canvas.ID, draftWithIf(`$["Node 1"].data.ok == false`))
+		require.NoError(t, err)
+
+		response, err := PublishCanvasDraft(ctx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, "Invert if", models.RoleOrgAdmin, webhookBaseURL)
+		require.NoError(t, err)
+		require.NotNil(t, response.ChangeRequest)
+		assert.Nil(t, response.Version)
+		assert.Equal(t, pb.CanvasChangeRequestState_STATE_PENDING, response.ChangeRequest.State)
+		assert.Equal(t, models.RoleOrgAdmin, response.ChangeRequest.ApprovalRole)
+
+		versions, err := models.ListCanvasVersions(canvas.ID, 1, nil)
+		require.NoError(t, err)
+		latestVersion := versions[0].Version
+
+		changeRequestID := uuid.MustParse(response.ChangeRequest.Id)
+
+		//
+		// Requester cannot approve their own change request.
+		//
+		_, err = ApproveCanvasChangeRequest(ctx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, changeRequestID, webhookBaseURL)
+		requireCode(t, err, codes.PermissionDenied)
+
+		//
+		// Users without the role cannot approve it either.
+		//
+		viewer := support.CreateUser(t, r, r.Organization.ID)
+		viewerCtx := authentication.SetUserIdInMetadata(context.Background(), viewer.ID.String())
+		_, err = ApproveCanvasChangeRequest(viewerCtx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, changeRequestID, webhookBaseURL)
+		requireCode(t, err, codes.PermissionDenied)
+
+		admin := support.CreateUser(t, r, r.Organization.ID)
+		require.NoError(t, r.AuthService.AssignRole(admin.ID.String(), models.RoleOrgAdmin, orgID, models.DomainTypeOrganization))
+		adminCtx := authentication.SetUserIdInMetadata(context.Background(), admin.ID.String())
+
+		approved, err := ApproveCanvasChangeRequest(adminCtx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, changeRequestID, webhookBaseURL)
+		require.NoError(t, err)
+		assert.Equal(t, pb.CanvasChangeRequestState_STATE_PUBLISHED, approved.ChangeRequest.State)
+		assert.Equal(t, approved.Version.Id, approved.ChangeRequest.PublishedVersionId)
+		assert.Equal(t, uint32(latestVersion+1), approved.Version.Version)
+		assert.Equal(t, "Invert if", approved.Version.Message)
+
+		_, err = DescribeCanvasDraft(ctx, orgID, canvas.ID)
+		requireCode(t, err, codes.NotFound)
+
+		_, err = ApproveCanvasChangeRequest(adminCtx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, changeRequestID, webhookBaseURL)
+		requireCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("requester can reject their change request", func(t *testing.T) {
+		_, err := UpdateCanvasDraft(ctx, r.Registry, orgID, canvas.ID, draftWithIf(`true`))
+		require.NoError(t, err)
+
+		response, err := PublishCanvasDraft(ctx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, "", models.RoleOrgAdmin, webhookBaseURL)
+		require.NoError(t, err)
+
+		rejected, err := RejectCanvasChangeRequest(ctx, r.AuthService, orgID, canvas.ID, uuid.MustParse(response.ChangeRequest.Id))
+		require.NoError(t, err)
+		assert.Equal(t, pb.CanvasChangeRequestState_STATE_REJECTED, rejected.ChangeRequest.State)
+
+		list, err := ListCanvasChangeRequests(ctx, orgID, canvas.ID, []pb.CanvasChangeRequestState{pb.CanvasChangeRequestState_STATE_PENDING})
+		require.NoError(t, err)
+		assert.Empty(t, list.ChangeRequests)
+
+		list, err = ListCanvasChangeRequests(ctx, orgID, canvas.ID, nil)
+		require.NoError(t, err)
+		assert.Len(t, list.ChangeRequests, 2)
+	})
+
+	t.Run("unknown approval role is rejected", func(t *testing.T) {
+		_, err := PublishCanvasDraft(ctx, r.AuthService, r.Encryptor, r.Registry, orgID, canvas.ID, "", "does-not-exist", webhookBaseURL)
+		requireCode(t, err, codes.InvalidArgument)
+	})
+}
@@ -0,0 +1,71 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// RejectCanvasChangeRequest rejects a pending change request, leaving the canvas unchanged.
+// Besides users with the approval role, the user who requested the change can also reject it, withdrawing it.
+func RejectCanvasChangeRequest(ctx context.Context, authService authorization.Authorization, organizationID string, canvasID, changeRequestID uuid.UUID) (*pb.RejectCanvasChangeRequestResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	request, err := models.FindCanvasChangeRequest(canvasID, changeRequestID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "change request not found")
+	}
+
+	reviewerID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.RequestedBy == nil || *request.RequestedBy != reviewerID {
+		allowed, err := hasRole(authService, organizationID, reviewerID, request.ApprovalRole)
+		if err != nil {
+			return nil, actions.ToStatus(err)
+		}
+
+		if !allowed {
+			return nil, status.Errorf(codes.PermissionDenied, "change request must be rejected by %s", request.ApprovalRole)
+		}
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		request, err = models.LockCanvasChangeRequestInTransaction(tx, canvasID, changeRequestID)
+		if err != nil {
+			return err
+		}
+
+		if request.State != models.CanvasChangeRequestStatePending {
+			return status.Errorf(codes.FailedPrecondition, "change request is %s", request.State)
+		}
+
+		return request.RejectInTransaction(tx, &reviewerID)
+	})
+
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	serialized, err := serializeCanvasChangeRequests([]models.CanvasChangeRequest{*request})
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.RejectCanvasChangeRequestResponse{
+		ChangeRequest: serialized[0],
+	}, nil
+}
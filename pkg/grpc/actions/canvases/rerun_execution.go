@@ -0,0 +1,103 @@
+package canvases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// RerunExecution puts the input event of a finished execution back in the queue of its node.
+// The queue item re-uses the root event of the original execution, and since the input event
+// is the same, the execution created from it has the same previous execution,
+// so the configuration expressions for the node resolve against the same upstream executions.
+func RerunExecution(ctx context.Context, organizationID string, canvasID, executionID uuid.UUID) (*pb.RerunExecutionResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	execution, err := models.FindNodeExecution(canvasID, executionID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "execution not found")
+	}
+
+	if execution.ParentExecutionID != nil {
+		return nil, status.Error(codes.InvalidArgument, "cannot rerun child execution directly, rerun the parent execution instead")
+	}
+
+	if execution.State != models.CanvasNodeExecutionStateFinished {
+		return nil, status.Error(codes.FailedPrecondition, "only finished executions can be rerun")
+	}
+
+	var queueItem *models.CanvasNodeQueueItem
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		node, err := models.FindCanvasNode(tx, canvasID, execution.NodeID)
+		if err != nil {
+			return status.Error(codes.FailedPrecondition, "node not found")
+		}
+
+		if node.Type != models.NodeTypeComponent && node.Type != models.NodeTypeBlueprint {
+			return status.Errorf(codes.InvalidArgument, "cannot rerun executions for %s nodes", node.Type)
+		}
+
+		if node.State == models.CanvasNodeStateError {
+			return status.Error(codes.FailedPrecondition, "node is in error state")
+		}
+
+		_, err = models.FindCanvasEventInTransaction(tx, execution.EventID)
+		if err != nil {
+			return status.Error(codes.FailedPrecondition, "input event for execution not found")
+		}
+
+		queueItem, err = createRerunQueueItem(tx, node, execution)
+		return err
+	})
+
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		log.Errorf("failed to rerun execution %s: %v", execution.ID, err)
+		return nil, status.Error(codes.Internal, "failed to rerun execution")
+	}
+
+	messages.NewCanvasQueueItemMessage(canvasID.String(), queueItem.ID.String(), queueItem.NodeID).Publish(false)
+
+	serialized, err := SerializeNodeQueueItems([]models.CanvasNodeQueueItem{*queueItem})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RerunExecutionResponse{QueueItem: serialized[0]}, nil
+}
+
+func createRerunQueueItem(tx *gorm.DB, node *models.CanvasNode, execution *models.CanvasNodeExecution) (*models.CanvasNodeQueueItem, error) {
+	now := time.Now()
+	queueItem := models.CanvasNodeQueueItem{
+		WorkflowID:  execution.WorkflowID,
+		NodeID:      execution.NodeID,
+		RootEventID: execution.RootEventID,
+		EventID:     execution.EventID,
+		Traceparent: execution.Traceparent,
+		Priority:    execution.Priority,
+		CreatedAt:   &now,
+	}
+
+	err := contexts.CreateQueueItem(tx, node, &queueItem)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queueItem, nil
+}
@@ -0,0 +1,138 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__RerunExecution__QueuesOriginalInput(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+			{
+				NodeID: "node-2",
+				Name:   "Node 2",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "node-1", TargetID: "node-2", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	firstExecution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+	outputs, err := firstExecution.Pass(map[string][]any{"default": {map[string]any{"value": "hello"}}})
+	require.NoError(t, err)
+	require.Len(t, outputs, 1)
+
+	execution := support.CreateNextNodeExecution(t, canvas.ID, "node-2", rootEvent.ID, outputs[0].ID, &firstExecution.ID)
+	require.NoError(t, execution.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+	response, err := RerunExecution(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID)
+	require.NoError(t, err)
+	require.NotNil(t, response.QueueItem)
+
+	queueItem, err := models.FindNodeQueueItem(canvas.ID, uuid.MustParse(response.QueueItem.Id))
+	require.NoError(t, err)
+	assert.Equal(t, "node-2", queueItem.NodeID)
+	assert.Equal(t, execution.EventID, queueItem.EventID)
+	assert.Equal(t, execution.RootEventID, queueItem.RootEventID)
+
+	//
+	// Original execution is left untouched,
+	// and no execution is created until the queue item is processed.
+	//
+	original, err := models.FindNodeExecution(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, original.State)
+	assert.Equal(t, models.CanvasNodeExecutionResultFailed, original.Result)
+
+	var count int64
+	require.NoError(t, database.Conn().Model(&models.CanvasNodeExecution{}).Where("workflow_id = ? AND node_id = ?", canvas.ID, "node-2").Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func Test__RerunExecution__ReturnsErrorForUnfinishedExecution(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+	_, err := RerunExecution(context.Background(), r.Organization.ID.String(), canvas.ID, execution.ID)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func Test__RerunExecution__ReturnsErrorWhenRerunningChild(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeBlueprint,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Blueprint: &models.BlueprintRef{ID: "test-blueprint"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	parentExecution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+	childEvent := support.EmitCanvasEventForNode(t, canvas.ID, "child-node-1", "default", nil)
+	childExecution := support.CreateCanvasNodeExecution(t, canvas.ID, "child-node-1", rootEvent.ID, childEvent.ID, &parentExecution.ID)
+
+	_, err := RerunExecution(context.Background(), r.Organization.ID.String(), canvas.ID, childExecution.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot rerun child execution directly")
+}
@@ -0,0 +1,63 @@
+package canvases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RestoreCanvasVersion updates the canvas to a previous version.
+// The restore goes through the same path as any other canvas update,
+// so nodes are set up again, and a new version is created for it.
+func RestoreCanvasVersion(ctx context.Context, encryptor crypto.Encryptor, registry *registry.Registry, organizationID string, canvasID, versionID uuid.UUID, webhookBaseURL string) (*pb.RestoreCanvasVersionResponse, error) {
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	version, err := models.FindCanvasVersion(canvasID, versionID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas version not found")
+	}
+
+	pbCanvas := &pb.Canvas{
+		Metadata: &pb.Canvas_Metadata{
+			Id:          canvas.ID.String(),
+			Name:        version.Name,
+			Description: version.Description,
+		},
+		Spec: &pb.Canvas_Spec{
+			Nodes: actions.NodesToProto(versionNodes(version)),
+			Edges: actions.EdgesToProto(version.Edges),
+		},
+	}
+
+	message := fmt.Sprintf("Restored version %d", version.Version)
+	restored, newVersion, err := updateCanvas(ctx, encryptor, registry, organizationID, canvasID.String(), pbCanvas, message, webhookBaseURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	protoCanvas, err := SerializeCanvas(restored, true)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	serialized, err := SerializeCanvasVersions([]models.CanvasVersion{*newVersion}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreCanvasVersionResponse{
+		Canvas:  protoCanvas,
+		Version: serialized[0],
+	}, nil
+}
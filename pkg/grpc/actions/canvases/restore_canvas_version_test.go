@@ -0,0 +1,150 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__CanvasVersions(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+
+	updatedCanvas := &pb.Canvas{
+		Metadata: &pb.Canvas_Metadata{
+			Name:        canvas.Name,
+			Description: canvas.Description,
+		},
+		Spec: &pb.Canvas_Spec{
+			Nodes: []*componentpb.Node{
+				{Id: "node-1", Name: "Node 1", Type: componentpb.Node_TYPE_COMPONENT, Component: &componentpb.Node_ComponentRef{Name: "noop"}},
+				{Id: "node-2", Name: "Node 2", Type: componentpb.Node_TYPE_COMPONENT, Component: &componentpb.Node_ComponentRef{Name: "noop"}},
+			},
+			Edges: []*componentpb.Edge{
+				{SourceId: "node-1", TargetId: "node-2", Channel: "default"},
+			},
+		},
+	}
+
+	_, err := UpdateCanvas(ctx, r.Encryptor, r.Registry, orgID, canvas.ID.String(), updatedCanvas, "Add node 2", "http://localhost:3000/api/v1")
+	require.NoError(t, err)
+
+	t.Run("updates create new versions", func(t *testing.T) {
+		response, err := ListCanvasVersions(ctx, orgID, canvas.ID, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, response.Versions, 2)
+		assert.Equal(t, uint32(2), response.TotalCount)
+		assert.False(t, response.HasNextPage)
+
+		latest := response.Versions[0]
+		assert.Equal(t, uint32(2), latest.Version)
+		assert.Equal(t, "Add node 2", latest.Message)
+		require.NotNil(t, latest.CreatedBy)
+		assert.Equal(t, r.User.String(), latest.CreatedBy.Id)
+		assert.Nil(t, latest.Spec)
+
+		assert.Equal(t, uint32(1), response.Versions[1].Version)
+	})
+
+	t.Run("versions are paginated by version number", func(t *testing.T) {
+		response, err := ListCanvasVersions(ctx, orgID, canvas.ID, 1, 0)
+		require.NoError(t, err)
+		require.Len(t, response.Versions, 1)
+		assert.True(t, response.HasNextPage)
+
+		response, err = ListCanvasVersions(ctx, orgID, canvas.ID, 1, 2)
+		require.NoError(t, err)
+		require.Len(t, response.Versions, 1)
+		assert.Equal(t, uint32(1), response.Versions[0].Version)
+	})
+
+	t.Run("describe includes nodes and edges", func(t *testing.T) {
+		versions, err := models.ListCanvasVersions(canvas.ID, 1, nil)
+		require.NoError(t, err)
+
+		response, err := DescribeCanvasVersion(ctx, orgID, canvas.ID, versions[0].ID)
+		require.NoError(t, err)
+		require.NotNil(t, response.Version.Spec)
+		assert.Len(t, response.Version.Spec.Nodes, 2)
+		assert.Len(t, response.Version.Spec.Edges, 1)
+	})
+
+	t.Run("diff with previous version", func(t *testing.T) {
+		versions, err := models.ListCanvasVersions(canvas.ID, 1, nil)
+		require.NoError(t, err)
+
+		response, err := DiffCanvasVersions(ctx, orgID, canvas.ID, versions[0].ID, nil)
+		require.NoError(t, err)
+		require.NotNil(t, response.From)
+		assert.Equal(t, uint32(1), response.From.Version)
+		require.Len(t, response.Diff.Nodes, 1)
+		assert.Equal(t, "node-2", response.Diff.Nodes[0].NodeId)
+		assert.Equal(t, pb.CanvasVersionDiff_CHANGE_TYPE_ADDED, response.Diff.Nodes[0].Type)
+		require.Len(t, response.Diff.Edges, 1)
+	})
+
+	t.Run("version from another canvas is not found", func(t *testing.T) {
+		_, err := DescribeCanvasVersion(ctx, orgID, canvas.ID, uuid.New())
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("executions record the version they ran under", func(t *testing.T) {
+		versions, err := models.ListCanvasVersions(canvas.ID, 1, nil)
+		require.NoError(t, err)
+
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+		require.NotNil(t, execution.WorkflowVersionID)
+		assert.Equal(t, versions[0].ID, *execution.WorkflowVersionID)
+	})
+
+	t.Run("restore creates a new version with the old nodes and edges", func(t *testing.T) {
+		versions, err := models.ListCanvasVersions(canvas.ID, 10, nil)
+		require.NoError(t, err)
+		first := versions[len(versions)-1]
+
+		response, err := RestoreCanvasVersion(ctx, r.Encryptor, r.Registry, orgID, canvas.ID, first.ID, "http://localhost:3000/api/v1")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(3), response.Version.Version)
+		assert.Equal(t, "Restored version 1", response.Version.Message)
+		require.Len(t, response.Canvas.Spec.Nodes, 1)
+		assert.Equal(t, "node-1", response.Canvas.Spec.Nodes[0].Id)
+		assert.Empty(t, response.Canvas.Spec.Edges)
+
+		nodes, err := models.FindCanvasNodes(canvas.ID)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, "node-1", nodes[0].NodeID)
+	})
+}
@@ -1,6 +1,7 @@
 package canvases
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -15,8 +16,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
+const maxConcurrencyGroupNameLength = 128
+
 func SerializeCanvas(canvas *models.Canvas, includeStatus bool) (*pb.Canvas, error) {
 	serializedNodes, err := serializeCanvasNodes(canvas)
 	if err != nil {
@@ -144,7 +148,10 @@ func serializeCanvasNodes(canvas *models.Canvas) ([]*compb.Node, error) {
 	return serialized, nil
 }
 
-func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) ([]models.Node, []models.Edge, error) {
+// ParseCanvas validates the canvas and converts it into its nodes and edges.
+// The canvas ID is used to check the access to the secrets used by the nodes,
+// so it must be the ID the canvas has, or will have, once saved.
+func ParseCanvas(registry *registry.Registry, orgID string, canvasID string, canvas *pb.Canvas) ([]models.Node, []models.Edge, error) {
 	if canvas.Metadata == nil {
 		return nil, nil, status.Error(codes.InvalidArgument, "canvas metadata is required")
 	}
@@ -182,7 +189,35 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 		nodeIDs[node.Id] = true
 		nodeTypeByID[node.Id] = node.Type
 
-		if err := validateNodeRef(registry, orgID, node); err != nil {
+		if err := validateConcurrencyGroup(node); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "node %s: %v", node.Id, err)
+		}
+
+		if err := validateRetryPolicy(node); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "node %s: %v", node.Id, err)
+		}
+
+		if err := validateExecutionTimeout(node); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "node %s: %v", node.Id, err)
+		}
+
+		if err := validateQueuePolicy(node); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "node %s: %v", node.Id, err)
+		}
+
+		if err := validateRateLimit(node); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "node %s: %v", node.Id, err)
+		}
+
+		if err := validateNodeRef(registry, orgID, canvasID, node); err != nil {
+			//
+			// Secrets the node cannot use reject the whole canvas,
+			// while other configuration errors are only recorded in the node.
+			//
+			if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "node %s: %s", node.Id, s.Message())
+			}
+
 			nodeValidationErrors[node.Id] = err.Error()
 		}
 	}
@@ -235,7 +270,100 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 	return nodes, actions.ProtoToEdges(canvas.Spec.Edges), nil
 }
 
-func validateNodeRef(registry *registry.Registry, organizationID string, node *compb.Node) error {
+func validateConcurrencyGroup(node *compb.Node) error {
+	if node.ConcurrencyGroup == nil || node.ConcurrencyGroup.Name == "" {
+		return nil
+	}
+
+	if node.Type != compb.Node_TYPE_COMPONENT && node.Type != compb.Node_TYPE_BLUEPRINT {
+		return fmt.Errorf("concurrency groups are only supported for component and blueprint nodes")
+	}
+
+	if len(node.ConcurrencyGroup.Name) > maxConcurrencyGroupNameLength {
+		return fmt.Errorf("concurrency group name cannot be longer than %d characters", maxConcurrencyGroupNameLength)
+	}
+
+	return nil
+}
+
+func validateRetryPolicy(node *compb.Node) error {
+	policy := node.RetryPolicy
+	if policy == nil || policy.MaxAttempts == 0 {
+		return nil
+	}
+
+	if node.Type != compb.Node_TYPE_COMPONENT {
+		return fmt.Errorf("retry policies are only supported for component nodes")
+	}
+
+	if policy.MaxAttempts < 1 || policy.MaxAttempts > models.MaxRetryAttempts {
+		return fmt.Errorf("retry policy max attempts must be between 1 and %d", models.MaxRetryAttempts)
+	}
+
+	if policy.InitialDelaySeconds < 0 || policy.MaxDelaySeconds < 0 {
+		return fmt.Errorf("retry policy delays cannot be negative")
+	}
+
+	if policy.BackoffMultiplier != 0 && policy.BackoffMultiplier < 1 {
+		return fmt.Errorf("retry policy backoff multiplier must be at least 1")
+	}
+
+	if policy.Jitter < 0 || policy.Jitter > 1 {
+		return fmt.Errorf("retry policy jitter must be between 0 and 1")
+	}
+
+	for _, reason := range policy.RetryOn {
+		if reason != models.CanvasNodeExecutionResultReasonError && reason != models.CanvasNodeExecutionResultReasonTimeout {
+			return fmt.Errorf("retry policy cannot retry on result reason %s", reason)
+		}
+	}
+
+	return nil
+}
+
+func validateExecutionTimeout(node *compb.Node) error {
+	if node.ExecutionTimeoutSeconds == 0 {
+		return nil
+	}
+
+	if node.Type != compb.Node_TYPE_COMPONENT {
+		return fmt.Errorf("execution timeouts are only supported for component nodes")
+	}
+
+	if node.ExecutionTimeoutSeconds < 0 {
+		return fmt.Errorf("execution timeout cannot be negative")
+	}
+
+	return nil
+}
+
+func validateQueuePolicy(node *compb.Node) error {
+	policy := actions.ProtoToQueuePolicy(node.QueuePolicy)
+	if policy == nil {
+		return nil
+	}
+
+	if node.Type != compb.Node_TYPE_COMPONENT && node.Type != compb.Node_TYPE_BLUEPRINT {
+		return fmt.Errorf("queue policies are only supported for component and blueprint nodes")
+	}
+
+	return policy.Validate()
+}
+
+func validateRateLimit(node *compb.Node) error {
+	limit := actions.ProtoToNodeRateLimit(node.RateLimit)
+	if limit == nil {
+		return nil
+	}
+
+	if node.Type != compb.Node_TYPE_COMPONENT && node.Type != compb.Node_TYPE_BLUEPRINT {
+		return fmt.Errorf("rate limits are only supported for component and blueprint nodes")
+	}
+
+	return limit.Validate()
+}
+
+func validateNodeRef(registry *registry.Registry, organizationID string, canvasID string, node *compb.Node) error {
 	switch node.Type {
 	case compb.Node_TYPE_COMPONENT:
 		if node.Component == nil {
@@ -251,7 +379,12 @@ func validateNodeRef(registry *registry.Registry, organizationID string, node *c
 			return err
 		}
 
-		return configuration.ValidateConfiguration(component.Configuration(), node.Configuration.AsMap())
+		err = configuration.ValidateConfiguration(component.Configuration(), node.Configuration.AsMap())
+		if err != nil {
+			return err
+		}
+
+		return validateSecretAccess(organizationID, canvasID, node, node.Component.Name, component.Configuration())
 
 	case compb.Node_TYPE_BLUEPRINT:
 		if node.Blueprint == nil {
@@ -267,7 +400,12 @@ func validateNodeRef(registry *registry.Registry, organizationID string, node *c
 			return fmt.Errorf("blueprint %s not found", node.Blueprint.Id)
 		}
 
-		return configuration.ValidateConfiguration(blueprint.Configuration, node.Configuration.AsMap())
+		err = configuration.ValidateConfiguration(blueprint.Configuration, node.Configuration.AsMap())
+		if err != nil {
+			return err
+		}
+
+		return validateSecretAccess(organizationID, canvasID, node, "", blueprint.Configuration)
 
 	case compb.Node_TYPE_TRIGGER:
 		if node.Trigger == nil {
@@ -283,7 +421,12 @@ func validateNodeRef(registry *registry.Registry, organizationID string, node *c
 			return err
 		}
 
-		return configuration.ValidateConfiguration(trigger.Configuration(), node.Configuration.AsMap())
+		err = configuration.ValidateConfiguration(trigger.Configuration(), node.Configuration.AsMap())
+		if err != nil {
+			return err
+		}
+
+		return validateSecretAccess(organizationID, canvasID, node, node.Trigger.Name, trigger.Configuration())
 
 	case compb.Node_TYPE_WIDGET:
 		if node.Widget == nil {
@@ -306,6 +449,57 @@ func validateNodeRef(registry *registry.Registry, organizationID string, node *c
 	}
 }
 
+// validateSecretAccess checks the secrets referenced in the configuration of the node
+// exist, and the node can read them, returning an InvalidArgument error if not.
+// For blueprint nodes, the component is empty, so only the canvas is checked here,
+// and the components of the blueprint are checked when they read the secret.
+func validateSecretAccess(organizationID string, canvasID string, node *compb.Node, component string, fields []configuration.Field) error {
+	references := configuration.SecretKeyReferences(fields, node.Configuration.AsMap())
+	if len(references) == 0 {
+		return nil
+	}
+
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid organization ID")
+	}
+
+	//
+	// Template canvases are parsed without an ID,
+	// in which case only secrets available to all canvases can be used.
+	//
+	workflowID := uuid.Nil
+	if canvasID != "" {
+		workflowID, err = uuid.Parse(canvasID)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid canvas ID")
+		}
+	}
+
+	accessor := models.SecretAccessor{
+		WorkflowID: workflowID,
+		NodeID:     node.Id,
+		Component:  component,
+	}
+
+	for _, reference := range references {
+		secret, err := models.FindSecretByName(models.DomainTypeOrganization, orgID, reference.Secret)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Errorf(codes.InvalidArgument, "secret %s not found", reference.Secret)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error finding secret %s: %v", reference.Secret, err)
+		}
+
+		if !secret.CanBeReadBy(accessor) {
+			return status.Errorf(codes.InvalidArgument, "secret %s is not available to this node", reference.Secret)
+		}
+	}
+
+	return nil
+}
+
 func findAndValidateTrigger(registry *registry.Registry, organizationID string, node *compb.Node) (core.Trigger, error) {
 	parts := strings.SplitN(node.Trigger.Name, ".", 2)
 	if len(parts) > 2 {
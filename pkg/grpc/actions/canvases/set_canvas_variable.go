@@ -0,0 +1,67 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/datatypes"
+)
+
+func SetCanvasVariable(ctx context.Context, organizationID string, canvasID uuid.UUID, name string, value *structpb.Value, expectedVersion *uint32) (*pb.SetCanvasVariableResponse, error) {
+	_, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	err = models.ValidateCanvasVariableName(name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if value == nil {
+		return nil, status.Error(codes.InvalidArgument, "value is required")
+	}
+
+	var updatedBy *uuid.UUID
+	if userID, ok := authentication.GetUserIdFromMetadata(ctx); ok {
+		id := uuid.MustParse(userID)
+		updatedBy = &id
+	}
+
+	variable := &models.CanvasVariable{
+		WorkflowID: canvasID,
+		Name:       name,
+		Value:      datatypes.NewJSONType(value.AsInterface()),
+		UpdatedBy:  updatedBy,
+	}
+
+	var expected *int
+	if expectedVersion != nil {
+		v := int(*expectedVersion)
+		expected = &v
+	}
+
+	err = models.SetCanvasVariableInTransaction(database.Conn(), variable, expected)
+	if err != nil {
+		if errors.Is(err, models.ErrCanvasVariableVersionConflict) {
+			return nil, status.Error(codes.Aborted, "variable was changed since the expected version")
+		}
+
+		return nil, err
+	}
+
+	serialized, err := SerializeCanvasVariables([]models.CanvasVariable{*variable})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetCanvasVariableResponse{Variable: serialized[0]}, nil
+}
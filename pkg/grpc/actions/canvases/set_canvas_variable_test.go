@@ -0,0 +1,84 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test__CanvasVariables(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+
+	requireCode := func(t *testing.T, err error, code codes.Code) {
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, code, s.Code())
+	}
+
+	version := func(v uint32) *uint32 { return &v }
+
+	t.Run("invalid name is rejected", func(t *testing.T) {
+		_, err := SetCanvasVariable(ctx, orgID, canvas.ID, "rollout-percentage", structpb.NewNumberValue(10), nil)
+		requireCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("variable is created and updated", func(t *testing.T) {
+		response, err := SetCanvasVariable(ctx, orgID, canvas.ID, "rollout", structpb.NewNumberValue(10), nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), response.Variable.Version)
+		assert.Equal(t, float64(10), response.Variable.Value.GetNumberValue())
+		assert.Equal(t, r.User.String(), response.Variable.UpdatedBy.Id)
+
+		response, err = SetCanvasVariable(ctx, orgID, canvas.ID, "rollout", structpb.NewNumberValue(25), nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), response.Variable.Version)
+
+		list, err := ListCanvasVariables(ctx, orgID, canvas.ID)
+		require.NoError(t, err)
+		require.Len(t, list.Variables, 1)
+		assert.Equal(t, "rollout", list.Variables[0].Name)
+		assert.Equal(t, float64(25), list.Variables[0].Value.GetNumberValue())
+	})
+
+	t.Run("stale expected version is rejected", func(t *testing.T) {
+		_, err := SetCanvasVariable(ctx, orgID, canvas.ID, "rollout", structpb.NewNumberValue(50), version(1))
+		requireCode(t, err, codes.Aborted)
+
+		response, err := SetCanvasVariable(ctx, orgID, canvas.ID, "rollout", structpb.NewNumberValue(50), version(2))
+		require.NoError(t, err)
+		assert.Equal(t, uint32(3), response.Variable.Version)
+	})
+
+	t.Run("expected version 0 only creates the variable", func(t *testing.T) {
+		_, err := SetCanvasVariable(ctx, orgID, canvas.ID, "rollout", structpb.NewNumberValue(0), version(0))
+		requireCode(t, err, codes.Aborted)
+
+		response, err := SetCanvasVariable(ctx, orgID, canvas.ID, "on_call", structpb.NewStringValue("alice"), version(0))
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), response.Variable.Version)
+	})
+
+	t.Run("variable is deleted", func(t *testing.T) {
+		_, err := DeleteCanvasVariable(ctx, orgID, canvas.ID, "on_call", version(2))
+		requireCode(t, err, codes.Aborted)
+
+		_, err = DeleteCanvasVariable(ctx, orgID, canvas.ID, "on_call", nil)
+		require.NoError(t, err)
+
+		_, err = DeleteCanvasVariable(ctx, orgID, canvas.ID, "on_call", nil)
+		requireCode(t, err, codes.NotFound)
+	})
+}
@@ -0,0 +1,509 @@
+package canvases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// MaxSimulationSteps limits how many nodes a simulation visits,
+// so canvases with loops do not simulate forever.
+const MaxSimulationSteps = 100
+
+// SimulateCanvas walks the canvas from a node, like the event router does,
+// starting with the given payload, or with the example data of the node when none is given.
+// Components that are safe to run are executed with in-memory contexts;
+// all other components emit their example output. Blueprint nodes are walked
+// through the nodes inside them. Nothing is stored.
+func SimulateCanvas(ctx context.Context, registry *registry.Registry, organizationID string, canvasID uuid.UUID, nodeID, channel string, payload map[string]any) (*pb.SimulateCanvasResponse, error) {
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	nodes, err := models.FindCanvasNodes(canvasID)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	nodesByID := make(map[string]models.CanvasNode, len(nodes))
+	for _, node := range nodes {
+		nodesByID[node.NodeID] = node
+	}
+
+	startNode, ok := nodesByID[nodeID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "node not found")
+	}
+
+	rootPayload, err := simulationRootPayload(registry, &startNode, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel == "" {
+		channel = core.DefaultOutputChannel.Name
+	}
+
+	simulation := &canvasSimulation{
+		registry:       registry,
+		organizationID: organizationID,
+		canvas:         canvas,
+		nodes:          nodesByID,
+		blueprints:     map[string]*models.Blueprint{},
+		root:           contexts.SimulatedOutput{NodeID: nodeID, Data: rootPayload},
+	}
+
+	return simulation.Run(channel)
+}
+
+// simulationRootPayload returns the payload the simulation starts with.
+// When no payload is given, the example data of the trigger,
+// or the example output of the component, is used.
+func simulationRootPayload(registry *registry.Registry, node *models.CanvasNode, payload map[string]any) (any, error) {
+	if payload != nil {
+		return contexts.NormalizeSimulatedPayload(payload)
+	}
+
+	ref := node.Ref.Data()
+	switch {
+	case node.Type == models.NodeTypeTrigger && ref.Trigger != nil:
+		trigger, err := registry.GetTrigger(ref.Trigger.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "trigger %s not found", ref.Trigger.Name)
+		}
+
+		//
+		// Triggers emit their data wrapped in the same structure
+		// used by the event context.
+		//
+		return contexts.NormalizeSimulatedPayload(map[string]any{
+			"type":      trigger.Name(),
+			"timestamp": time.Now(),
+			"data":      trigger.ExampleData(),
+		})
+
+	case node.Type == models.NodeTypeComponent && ref.Component != nil:
+		component, err := registry.GetComponent(ref.Component.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "component %s not found", ref.Component.Name)
+		}
+
+		return contexts.NormalizeSimulatedPayload(component.ExampleOutput())
+	}
+
+	return nil, status.Error(codes.InvalidArgument, "payload is required for this node")
+}
+
+type canvasSimulation struct {
+	registry       *registry.Registry
+	organizationID string
+	canvas         *models.Canvas
+	nodes          map[string]models.CanvasNode
+	blueprints     map[string]*models.Blueprint
+	root           contexts.SimulatedOutput
+}
+
+// simulationItem is an event waiting to be delivered to a node,
+// with the chain of outputs that led to it, from newest to oldest.
+type simulationItem struct {
+	nodeID  string
+	source  contexts.SimulatedOutput
+	channel string
+	chain   []contexts.SimulatedOutput
+	depth   int
+}
+
+func (s *canvasSimulation) Run(channel string) (*pb.SimulateCanvasResponse, error) {
+	queue := s.itemsFor(s.root, channel, []contexts.SimulatedOutput{}, 1)
+	steps := []*pb.CanvasSimulationStep{}
+
+	for len(queue) > 0 {
+		if len(steps) >= MaxSimulationSteps {
+			return &pb.SimulateCanvasResponse{Steps: steps, Truncated: true}, nil
+		}
+
+		item := queue[0]
+		queue = queue[1:]
+
+		step := s.simulateNode(item)
+		serialized, err := serializeSimulationStep(step)
+		if err != nil {
+			return nil, actions.ToStatus(err)
+		}
+
+		steps = append(steps, serialized)
+		queue = append(queue, s.nextItems(item, step)...)
+	}
+
+	return &pb.SimulateCanvasResponse{Steps: steps}, nil
+}
+
+// nextItems returns the events delivered after a node is simulated.
+// Like the node executor, a blueprint node delivers its input to the first node inside it.
+// The outputs of any other node are added to the chain seen by the nodes after it.
+func (s *canvasSimulation) nextItems(item simulationItem, step *simulationStep) []simulationItem {
+	if step.blueprint != nil {
+		return []simulationItem{{
+			nodeID:  item.nodeID + ":" + step.blueprint.FindRootNode().ID,
+			source:  item.source,
+			channel: item.channel,
+			chain:   item.chain,
+			depth:   item.depth + 1,
+		}}
+	}
+
+	items := []simulationItem{}
+	for _, event := range step.events {
+		output := contexts.SimulatedOutput{NodeID: item.nodeID, Data: event.Data}
+		chain := append([]contexts.SimulatedOutput{output}, item.chain...)
+		items = append(items, s.itemsFor(output, event.Channel, chain, item.depth+1)...)
+	}
+
+	return items
+}
+
+func (s *canvasSimulation) itemsFor(source contexts.SimulatedOutput, channel string, chain []contexts.SimulatedOutput, depth int) []simulationItem {
+	node, ok := s.nodes[source.NodeID]
+	if ok && node.ParentNodeID != nil {
+		return s.blueprintItemsFor(&node, source, channel, chain, depth)
+	}
+
+	items := []simulationItem{}
+	for _, edge := range s.canvas.FindEdges(source.NodeID, channel) {
+		items = append(items, simulationItem{
+			nodeID:  edge.TargetID,
+			source:  source,
+			channel: channel,
+			chain:   chain,
+			depth:   depth,
+		})
+	}
+
+	return items
+}
+
+// blueprintItemsFor routes the output of a node inside a blueprint, like the event router does:
+// it follows the edges of the blueprint, and when the output is on one of the output channels
+// of the blueprint, it leaves the blueprint node through that channel.
+func (s *canvasSimulation) blueprintItemsFor(node *models.CanvasNode, source contexts.SimulatedOutput, channel string, chain []contexts.SimulatedOutput, depth int) []simulationItem {
+	items := []simulationItem{}
+	parent, ok := s.nodes[*node.ParentNodeID]
+	if !ok || parent.Ref.Data().Blueprint == nil {
+		return items
+	}
+
+	blueprint, err := s.findBlueprint(parent.Ref.Data().Blueprint.ID)
+	if err != nil {
+		return items
+	}
+
+	childNodeID := strings.TrimPrefix(node.NodeID, parent.NodeID+":")
+	for _, edge := range blueprint.FindEdges(childNodeID, channel) {
+		items = append(items, simulationItem{
+			nodeID:  parent.NodeID + ":" + edge.TargetID,
+			source:  source,
+			channel: channel,
+			chain:   chain,
+			depth:   depth,
+		})
+	}
+
+	for _, outputChannel := range blueprint.OutputChannels {
+		if outputChannel.NodeID != childNodeID || outputChannel.NodeOutputChannel != channel {
+			continue
+		}
+
+		output := contexts.SimulatedOutput{NodeID: parent.NodeID, Data: source.Data}
+		outputChain := append([]contexts.SimulatedOutput{output}, chain...)
+		items = append(items, s.itemsFor(output, outputChannel.Name, outputChain, depth)...)
+	}
+
+	return items
+}
+
+func (s *canvasSimulation) findBlueprint(id string) (*models.Blueprint, error) {
+	if blueprint, ok := s.blueprints[id]; ok {
+		return blueprint, nil
+	}
+
+	blueprint, err := models.FindBlueprint(s.organizationID, id)
+	if err != nil {
+		return nil, fmt.Errorf("blueprint %s not found", id)
+	}
+
+	s.blueprints[id] = blueprint
+	return blueprint, nil
+}
+
+// simulationStep is the result of delivering an event to a node.
+type simulationStep struct {
+	item          simulationItem
+	node          *models.CanvasNode
+	mode          pb.CanvasSimulationStep_Mode
+	configuration map[string]any
+	metadata      any
+	events        []contexts.SimulatedEvent
+	blueprint     *models.Blueprint
+	err           error
+	message       string
+}
+
+func (s *canvasSimulation) simulateNode(item simulationItem) *simulationStep {
+	step := &simulationStep{item: item, mode: pb.CanvasSimulationStep_MODE_SKIPPED}
+
+	node, ok := s.nodes[item.nodeID]
+	if !ok {
+		step.err = fmt.Errorf("node %s not found", item.nodeID)
+		return step
+	}
+
+	step.node = &node
+
+	//
+	// The event router does not create queue items for nodes in error state.
+	//
+	if node.State == models.CanvasNodeStateError {
+		step.message = "node is in error state, so events are not routed to it"
+		return step
+	}
+
+	fields, err := s.configurationFields(&node)
+	if err != nil {
+		step.err = err
+		return step
+	}
+
+	config, err := s.builderFor(&node, item).
+		WithConfigurationFields(fields).
+		Build(node.Configuration.Data())
+
+	if err != nil {
+		step.err = fmt.Errorf("error building configuration: %w", err)
+		return step
+	}
+
+	step.configuration = config
+
+	ref := node.Ref.Data()
+	if node.Type == models.NodeTypeBlueprint && ref.Blueprint != nil {
+		blueprint, err := s.findBlueprint(ref.Blueprint.ID)
+		if err != nil {
+			step.err = err
+			return step
+		}
+
+		if blueprint.FindRootNode() == nil {
+			step.err = fmt.Errorf("blueprint %s has no start node", blueprint.Name)
+			return step
+		}
+
+		step.blueprint = blueprint
+		step.message = "blueprint nodes are simulated through the nodes inside them"
+		return step
+	}
+
+	if node.Type != models.NodeTypeComponent || ref.Component == nil {
+		step.message = fmt.Sprintf("%s nodes are not simulated", node.Type)
+		return step
+	}
+
+	component, err := s.registry.GetComponent(ref.Component.Name)
+	if err != nil {
+		step.err = fmt.Errorf("component %s not found", ref.Component.Name)
+		return step
+	}
+
+	simulatable, ok := component.(core.SimulatableComponent)
+	if !ok || !simulatable.Simulatable() {
+		step.mode = pb.CanvasSimulationStep_MODE_EXAMPLE_OUTPUT
+		step.events, step.err = s.exampleEvents(component, config)
+		return step
+	}
+
+	step.mode = pb.CanvasSimulationStep_MODE_EXECUTED
+	s.execute(component, &node, item, config, step)
+	return step
+}
+
+func (s *canvasSimulation) builderFor(node *models.CanvasNode, item simulationItem) *contexts.NodeConfigurationBuilder {
+	builder := contexts.NewNodeConfigurationBuilder(database.Conn(), s.canvas.ID).
+		WithNodeID(node.NodeID).
+		WithSimulatedRoot(s.root.NodeID, s.root.Data).
+		WithSimulatedChain(item.chain).
+		WithInput(map[string]any{item.source.NodeID: item.source.Data})
+
+	//
+	// Nodes inside a blueprint can use the configuration of the blueprint node.
+	//
+	if node.ParentNodeID != nil {
+		if parent, ok := s.nodes[*node.ParentNodeID]; ok {
+			builder = builder.ForBlueprintNode(&parent)
+		}
+	}
+
+	return builder
+}
+
+func (s *canvasSimulation) configurationFields(node *models.CanvasNode) ([]configuration.Field, error) {
+	ref := node.Ref.Data()
+	switch {
+	case ref.Component != nil:
+		component, err := s.registry.GetComponent(ref.Component.Name)
+		if err != nil {
+			return nil, fmt.Errorf("component %s not found", ref.Component.Name)
+		}
+
+		return component.Configuration(), nil
+
+	case ref.Blueprint != nil:
+		blueprint, err := s.findBlueprint(ref.Blueprint.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return blueprint.Configuration, nil
+	}
+
+	return nil, nil
+}
+
+// exampleEvents emits the example output of the component
+// on its first output channel, since that is usually the successful one.
+func (s *canvasSimulation) exampleEvents(component core.Component, config map[string]any) ([]contexts.SimulatedEvent, error) {
+	channel := core.DefaultOutputChannel.Name
+	channels := component.OutputChannels(config)
+	if len(channels) > 0 {
+		channel = channels[0].Name
+	}
+
+	data, err := contexts.NormalizeSimulatedPayload(component.ExampleOutput())
+	if err != nil {
+		return nil, err
+	}
+
+	return []contexts.SimulatedEvent{{Channel: channel, Data: data}}, nil
+}
+
+func (s *canvasSimulation) execute(component core.Component, node *models.CanvasNode, item simulationItem, config map[string]any, step *simulationStep) {
+	executionState := contexts.NewSimulatedExecutionStateContext()
+	metadata := contexts.NewSimulatedMetadataContext(map[string]any{})
+
+	ctx := core.ExecutionContext{
+		ID:             uuid.New(),
+		WorkflowID:     s.canvas.ID.String(),
+		OrganizationID: s.organizationID,
+		NodeID:         node.NodeID,
+		SourceNodeID:   item.source.NodeID,
+		Configuration:  config,
+		Data:           item.source.Data,
+		Logger:         log.WithFields(log.Fields{"canvas_id": s.canvas.ID.String(), "node_id": node.NodeID, "simulation": true}),
+		Metadata:       metadata,
+		NodeMetadata:   contexts.NewSimulatedMetadataContext(node.Metadata.Data()),
+		ExecutionState: executionState,
+	}
+
+	ctx.ExpressionEnv = func(expression string) (map[string]any, error) {
+		return s.builderFor(node, item).BuildExpressionEnv(expression)
+	}
+
+	err := component.Execute(ctx)
+	step.metadata = metadata.Get()
+	step.events = executionState.Events
+
+	if err != nil {
+		step.err = err
+		return
+	}
+
+	if executionState.FailureReason != "" {
+		step.err = fmt.Errorf("execution failed: %s", executionState.FailureMessage)
+		return
+	}
+
+	if len(executionState.Events) == 0 {
+		step.message = "no events emitted"
+	}
+}
+
+func serializeSimulationStep(step *simulationStep) (*pb.CanvasSimulationStep, error) {
+	result := &pb.CanvasSimulationStep{
+		NodeId:        step.item.nodeID,
+		SourceNodeId:  step.item.source.NodeID,
+		SourceChannel: step.item.channel,
+		Depth:         uint32(step.item.depth),
+		Mode:          step.mode,
+		Message:       step.message,
+		Outputs:       []*pb.CanvasSimulationStep_Output{},
+	}
+
+	if step.node != nil {
+		result.NodeName = step.node.Name
+	}
+
+	if step.err != nil {
+		result.Error = step.err.Error()
+	}
+
+	if step.configuration != nil {
+		configuration, err := simulationStruct(step.configuration)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Configuration = configuration
+	}
+
+	if step.metadata != nil {
+		metadata, err := simulationStruct(step.metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Metadata = metadata
+	}
+
+	for _, event := range step.events {
+		data, err := simulationStruct(event.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Outputs = append(result.Outputs, &pb.CanvasSimulationStep_Output{
+			Channel: event.Channel,
+			Data:    data,
+		})
+	}
+
+	return result, nil
+}
+
+// simulationStruct converts a value to a struct,
+// wrapping values that are not objects in a "value" field.
+func simulationStruct(value any) (*structpb.Struct, error) {
+	normalized, err := contexts.NormalizeSimulatedPayload(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if m, ok := normalized.(map[string]any); ok {
+		return structpb.NewStruct(m)
+	}
+
+	return structpb.NewStruct(map[string]any{"value": normalized})
+}
@@ -0,0 +1,206 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__SimulateCanvas(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	orgID := r.Organization.ID.String()
+
+	createCanvas := func(expression string) *models.Canvas {
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "trigger-1",
+					Name:   "Start",
+					Type:   models.NodeTypeTrigger,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+				},
+				{
+					NodeID:        "if-1",
+					Name:          "Is bar",
+					Type:          models.NodeTypeComponent,
+					Ref:           datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "if"}}),
+					Configuration: datatypes.NewJSONType(map[string]any{"expression": expression}),
+				},
+				{
+					NodeID: "http-1",
+					Name:   "Request",
+					Type:   models.NodeTypeComponent,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "http"}}),
+					Configuration: datatypes.NewJSONType(map[string]any{
+						"method": "GET",
+						"url":    `https://example.com/{{ $["Start"].data.foo }}`,
+					}),
+				},
+				{
+					NodeID: "noop-1",
+					Name:   "Skip",
+					Type:   models.NodeTypeComponent,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				},
+			},
+			[]models.Edge{
+				{SourceID: "trigger-1", TargetID: "if-1", Channel: "default"},
+				{SourceID: "if-1", TargetID: "http-1", Channel: "true"},
+				{SourceID: "if-1", TargetID: "noop-1", Channel: "false"},
+			},
+		)
+
+		return canvas
+	}
+
+	t.Run("trigger example data is used when no payload is given", func(t *testing.T) {
+		canvas := createCanvas(`$["Start"].data.foo == "bar"`)
+
+		response, err := SimulateCanvas(context.Background(), r.Registry, orgID, canvas.ID, "trigger-1", "", nil)
+		require.NoError(t, err)
+		assert.False(t, response.Truncated)
+		require.Len(t, response.Steps, 2)
+
+		ifStep := response.Steps[0]
+		assert.Equal(t, "if-1", ifStep.NodeId)
+		assert.Equal(t, "trigger-1", ifStep.SourceNodeId)
+		assert.Equal(t, uint32(1), ifStep.Depth)
+		assert.Equal(t, pb.CanvasSimulationStep_MODE_EXECUTED, ifStep.Mode)
+		assert.Empty(t, ifStep.Error)
+		require.Len(t, ifStep.Outputs, 1)
+		assert.Equal(t, "true", ifStep.Outputs[0].Channel)
+		assert.Equal(t, `$["Start"].data.foo == "bar"`, ifStep.Metadata.AsMap()["expression"])
+
+		//
+		// HTTP requests are not sent, so the example output is used,
+		// but the configuration is still resolved.
+		//
+		httpStep := response.Steps[1]
+		assert.Equal(t, "http-1", httpStep.NodeId)
+		assert.Equal(t, uint32(2), httpStep.Depth)
+		assert.Equal(t, pb.CanvasSimulationStep_MODE_EXAMPLE_OUTPUT, httpStep.Mode)
+		assert.Equal(t, "https://example.com/bar", httpStep.Configuration.AsMap()["url"])
+		require.Len(t, httpStep.Outputs, 1)
+		assert.Equal(t, "default", httpStep.Outputs[0].Channel)
+	})
+
+	t.Run("given payload is used", func(t *testing.T) {
+		canvas := createCanvas(`$["Start"].data.foo == "bar"`)
+		payload := map[string]any{"data": map[string]any{"foo": "baz"}}
+
+		response, err := SimulateCanvas(context.Background(), r.Registry, orgID, canvas.ID, "trigger-1", "", payload)
+		require.NoError(t, err)
+		require.Len(t, response.Steps, 2)
+		assert.Equal(t, "false", response.Steps[0].Outputs[0].Channel)
+		assert.Equal(t, "noop-1", response.Steps[1].NodeId)
+		assert.Equal(t, pb.CanvasSimulationStep_MODE_EXECUTED, response.Steps[1].Mode)
+	})
+
+	t.Run("expression errors are returned in the trace", func(t *testing.T) {
+		canvas := createCanvas(`$["Missing"].data.foo == "bar"`)
+
+		response, err := SimulateCanvas(context.Background(), r.Registry, orgID, canvas.ID, "trigger-1", "", nil)
+		require.NoError(t, err)
+		require.Len(t, response.Steps, 1)
+		assert.Equal(t, "if-1", response.Steps[0].NodeId)
+		assert.Contains(t, response.Steps[0].Error, "Missing")
+		assert.Empty(t, response.Steps[0].Outputs)
+	})
+
+	t.Run("blueprint nodes are walked through their internal nodes", func(t *testing.T) {
+		blueprint := support.CreateBlueprint(
+			t,
+			r.Organization.ID,
+			[]models.Node{
+				{ID: "first", Name: "First", Type: models.NodeTypeComponent, Ref: models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}},
+				{ID: "second", Name: "Second", Type: models.NodeTypeComponent, Ref: models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}},
+			},
+			[]models.Edge{
+				{SourceID: "first", TargetID: "second", Channel: "default"},
+			},
+			[]models.BlueprintOutputChannel{
+				{Name: "done", NodeID: "second", NodeOutputChannel: "default"},
+			},
+		)
+
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "trigger-1",
+					Name:   "Start",
+					Type:   models.NodeTypeTrigger,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+				},
+				{
+					NodeID: "blueprint-1",
+					Name:   "Blueprint",
+					Type:   models.NodeTypeBlueprint,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Blueprint: &models.BlueprintRef{ID: blueprint.ID.String()}}),
+				},
+				{
+					NodeID: "noop-1",
+					Name:   "After",
+					Type:   models.NodeTypeComponent,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				},
+			},
+			[]models.Edge{
+				{SourceID: "trigger-1", TargetID: "blueprint-1", Channel: "default"},
+				{SourceID: "blueprint-1", TargetID: "noop-1", Channel: "done"},
+			},
+		)
+
+		response, err := SimulateCanvas(context.Background(), r.Registry, orgID, canvas.ID, "trigger-1", "", nil)
+		require.NoError(t, err)
+		require.Len(t, response.Steps, 4)
+
+		assert.Equal(t, "blueprint-1", response.Steps[0].NodeId)
+		assert.Equal(t, pb.CanvasSimulationStep_MODE_SKIPPED, response.Steps[0].Mode)
+		assert.Empty(t, response.Steps[0].Error)
+
+		assert.Equal(t, "blueprint-1:first", response.Steps[1].NodeId)
+		assert.Equal(t, "trigger-1", response.Steps[1].SourceNodeId)
+		assert.Equal(t, pb.CanvasSimulationStep_MODE_EXECUTED, response.Steps[1].Mode)
+
+		assert.Equal(t, "blueprint-1:second", response.Steps[2].NodeId)
+		assert.Equal(t, "blueprint-1:first", response.Steps[2].SourceNodeId)
+
+		//
+		// The output of the blueprint leaves through its output channel.
+		//
+		assert.Equal(t, "noop-1", response.Steps[3].NodeId)
+		assert.Equal(t, "blueprint-1", response.Steps[3].SourceNodeId)
+		assert.Equal(t, "done", response.Steps[3].SourceChannel)
+		assert.Equal(t, pb.CanvasSimulationStep_MODE_EXECUTED, response.Steps[3].Mode)
+	})
+
+	t.Run("nothing is stored", func(t *testing.T) {
+		canvas := createCanvas(`true`)
+
+		_, err := SimulateCanvas(context.Background(), r.Registry, orgID, canvas.ID, "trigger-1", "", nil)
+		require.NoError(t, err)
+
+		var events int64
+		require.NoError(t, database.Conn().Model(&models.CanvasEvent{}).Where("workflow_id = ?", canvas.ID).Count(&events).Error)
+		assert.Zero(t, events)
+
+		var executions int64
+		require.NoError(t, database.Conn().Model(&models.CanvasNodeExecution{}).Where("workflow_id = ?", canvas.ID).Count(&executions).Error)
+		assert.Zero(t, executions)
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
@@ -25,34 +26,56 @@ import (
 	"gorm.io/gorm"
 )
 
-func UpdateCanvas(ctx context.Context, encryptor crypto.Encryptor, registry *registry.Registry, organizationID string, id string, pbCanvas *pb.Canvas, webhookBaseURL string) (*pb.UpdateCanvasResponse, error) {
+func UpdateCanvas(ctx context.Context, encryptor crypto.Encryptor, registry *registry.Registry, organizationID string, id string, pbCanvas *pb.Canvas, versionMessage string, webhookBaseURL string) (*pb.UpdateCanvasResponse, error) {
+	canvas, _, err := updateCanvas(ctx, encryptor, registry, organizationID, id, pbCanvas, versionMessage, webhookBaseURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	protoCanvas, err := SerializeCanvas(canvas, true)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.UpdateCanvasResponse{
+		Canvas: protoCanvas,
+	}, nil
+}
+
+// afterCanvasUpdate runs in the same transaction as the canvas update,
+// after the new canvas version is created. Returning an error rolls back the update.
+type afterCanvasUpdate func(tx *gorm.DB, version *models.CanvasVersion) error
+
+// updateCanvas applies the update to the canvas and its nodes,
+// and records the result as a new canvas version.
+func updateCanvas(ctx context.Context, encryptor crypto.Encryptor, registry *registry.Registry, organizationID string, id string, pbCanvas *pb.Canvas, versionMessage string, webhookBaseURL string, afterUpdate afterCanvasUpdate) (*models.Canvas, *models.CanvasVersion, error) {
 	canvasID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
 	}
 
 	existingCanvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			if _, templateErr := models.FindCanvasTemplate(canvasID); templateErr == nil {
-				return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
+				return nil, nil, status.Error(codes.FailedPrecondition, "templates are read-only")
 			}
 		}
-		return nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
+		return nil, nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
 	}
 
 	if existingCanvas.IsTemplate {
-		return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
+		return nil, nil, status.Error(codes.FailedPrecondition, "templates are read-only")
 	}
 
-	nodes, edges, err := ParseCanvas(registry, organizationID, pbCanvas)
+	nodes, edges, err := ParseCanvas(registry, organizationID, canvasID.String(), pbCanvas)
 	if err != nil {
-		return nil, actions.ToStatus(err)
+		return nil, nil, actions.ToStatus(err)
 	}
 
 	existingNodesUnscoped, err := models.FindCanvasNodesUnscoped(canvasID)
 	if err != nil {
-		return nil, actions.ToStatus(err)
+		return nil, nil, actions.ToStatus(err)
 	}
 
 	nodes, edges, _ = remapNodeIDsForConflicts(nodes, edges, existingNodesUnscoped)
@@ -64,11 +87,18 @@ func UpdateCanvas(ctx context.Context, encryptor crypto.Encryptor, registry *reg
 
 	expandedNodes, err := expandNodes(organizationID, nodes)
 	if err != nil {
-		return nil, actions.ToStatus(err)
+		return nil, nil, actions.ToStatus(err)
+	}
+
+	var updatedBy *uuid.UUID
+	if userID, ok := authentication.GetUserIdFromMetadata(ctx); ok {
+		id := uuid.MustParse(userID)
+		updatedBy = &id
 	}
 
 	now := time.Now()
 
+	var version *models.CanvasVersion
 	err = database.Conn().Transaction(func(tx *gorm.DB) error {
 		//
 		// Update the canvas node records
@@ -140,21 +170,28 @@ func UpdateCanvas(ctx context.Context, encryptor crypto.Encryptor, registry *reg
 			return err
 		}
 
-		return deleteNodes(tx, existingNodes, expandedNodes)
-	})
+		version, err = models.CreateCanvasVersionInTransaction(tx, existingCanvas, updatedBy, versionMessage)
+		if err != nil {
+			return err
+		}
 
-	if err != nil {
-		return nil, actions.ToStatus(err)
-	}
+		err = deleteNodes(tx, existingNodes, expandedNodes)
+		if err != nil {
+			return err
+		}
+
+		if afterUpdate != nil {
+			return afterUpdate(tx, version)
+		}
+
+		return nil
+	})
 
-	protoCanvas, err := SerializeCanvas(existingCanvas, true)
 	if err != nil {
-		return nil, actions.ToStatus(err)
+		return nil, nil, actions.ToStatus(err)
 	}
 
-	return &pb.UpdateCanvasResponse{
-		Canvas: protoCanvas,
-	}, nil
+	return existingCanvas, version, nil
 }
 
 // Remap node IDs that conflict with soft-deleted workflow_nodes entries so we
@@ -237,6 +274,11 @@ func upsertNode(tx *gorm.DB, existingNodes []models.CanvasNode, node models.Node
 		existingNode.Position = datatypes.NewJSONType(node.Position)
 		existingNode.IsCollapsed = node.IsCollapsed
 		existingNode.AppInstallationID = appInstallationID
+		existingNode.SetConcurrencyGroup(node.ConcurrencyGroup)
+		existingNode.SetRetryPolicy(node.RetryPolicy)
+		existingNode.SetExecutionTimeout(node.ExecutionTimeoutSeconds)
+		existingNode.SetQueuePolicy(node.QueuePolicy)
+		existingNode.SetRateLimit(node.RateLimit)
 
 		if node.ErrorMessage != nil && *node.ErrorMessage != "" {
 			existingNode.State = models.CanvasNodeStateError
@@ -299,6 +341,12 @@ func upsertNode(tx *gorm.DB, existingNodes []models.CanvasNode, node models.Node
 		UpdatedAt:         &now,
 	}
 
+	canvasNode.SetConcurrencyGroup(node.ConcurrencyGroup)
+	canvasNode.SetRetryPolicy(node.RetryPolicy)
+	canvasNode.SetExecutionTimeout(node.ExecutionTimeoutSeconds)
+	canvasNode.SetQueuePolicy(node.QueuePolicy)
+	canvasNode.SetRateLimit(node.RateLimit)
+
 	err := tx.Create(&canvasNode).Error
 	if err != nil {
 		return nil, err
@@ -0,0 +1,207 @@
+package canvases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	compb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+// UpdateCanvasDraft creates or replaces the draft of a canvas.
+// The draft is validated the same way a canvas update is, with expressions also compiled,
+// but invalid nodes are saved with their errors, so the draft can be fixed later.
+func UpdateCanvasDraft(ctx context.Context, registry *registry.Registry, organizationID string, canvasID uuid.UUID, pbCanvas *pb.Canvas) (*pb.UpdateCanvasDraftResponse, error) {
+	canvas, err := models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "canvas not found")
+	}
+
+	if canvas.IsTemplate {
+		return nil, status.Error(codes.FailedPrecondition, "templates are read-only")
+	}
+
+	nodes, edges, err := validateCanvasDraft(registry, organizationID, canvasID.String(), pbCanvas)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	//
+	// The draft is based on the canvas version that was live when it was created.
+	// Updating an existing draft keeps its base version.
+	//
+	baseVersionID, err := models.FindLatestCanvasVersionIDInTransaction(database.Conn(), canvasID)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	var updatedBy *uuid.UUID
+	if userID, ok := authentication.GetUserIdFromMetadata(ctx); ok {
+		id := uuid.MustParse(userID)
+		updatedBy = &id
+	}
+
+	now := time.Now()
+	err = models.UpsertCanvasDraft(&models.CanvasDraft{
+		WorkflowID:    canvasID,
+		BaseVersionID: baseVersionID,
+		Name:          pbCanvas.Metadata.Name,
+		Description:   pbCanvas.Metadata.Description,
+		Nodes:         datatypes.NewJSONSlice(nodes),
+		Edges:         datatypes.NewJSONSlice(edges),
+		UpdatedBy:     updatedBy,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	})
+
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	draft, err := models.FindCanvasDraft(canvasID)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	serialized, err := serializeCanvasDraft(draft)
+	if err != nil {
+		return nil, actions.ToStatus(err)
+	}
+
+	return &pb.UpdateCanvasDraftResponse{
+		Draft: serialized,
+	}, nil
+}
+
+// validateCanvasDraft parses the canvas like ParseCanvas does,
+// and also compiles the expressions used in the configuration of its nodes.
+// Node errors are returned in the ErrorMessage of the nodes.
+func validateCanvasDraft(registry *registry.Registry, organizationID string, canvasID string, pbCanvas *pb.Canvas) ([]models.Node, []models.Edge, error) {
+	nodes, edges, err := ParseCanvas(registry, organizationID, canvasID, pbCanvas)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, node := range pbCanvas.Spec.Nodes {
+		if nodes[i].ErrorMessage != nil {
+			continue
+		}
+
+		fields, err := nodeConfigurationFields(registry, organizationID, node)
+		if err != nil {
+			errorMessage := err.Error()
+			nodes[i].ErrorMessage = &errorMessage
+			continue
+		}
+
+		err = configuration.CompileExpressions(fields, node.Configuration.AsMap())
+		if err != nil {
+			errorMessage := err.Error()
+			nodes[i].ErrorMessage = &errorMessage
+		}
+	}
+
+	return nodes, edges, nil
+}
+
+func nodeConfigurationFields(registry *registry.Registry, organizationID string, node *compb.Node) ([]configuration.Field, error) {
+	switch node.Type {
+	case compb.Node_TYPE_COMPONENT:
+		component, err := findAndValidateComponent(registry, organizationID, node)
+		if err != nil {
+			return nil, err
+		}
+
+		return component.Configuration(), nil
+
+	case compb.Node_TYPE_TRIGGER:
+		trigger, err := findAndValidateTrigger(registry, organizationID, node)
+		if err != nil {
+			return nil, err
+		}
+
+		return trigger.Configuration(), nil
+
+	case compb.Node_TYPE_BLUEPRINT:
+		blueprint, err := models.FindBlueprint(organizationID, node.Blueprint.Id)
+		if err != nil {
+			return nil, fmt.Errorf("blueprint %s not found", node.Blueprint.Id)
+		}
+
+		return blueprint.Configuration, nil
+
+	default:
+		// Widgets are not executed, so their configuration has no expressions.
+		return []configuration.Field{}, nil
+	}
+}
+
+// draftErrors returns an error describing the invalid nodes of a draft, if any.
+func draftErrors(nodes []models.Node) error {
+	for _, node := range nodes {
+		if node.ErrorMessage != nil {
+			return status.Errorf(codes.FailedPrecondition, "draft is invalid: node %s: %s", node.ID, *node.ErrorMessage)
+		}
+	}
+
+	return nil
+}
+
+// draftToProto builds the canvas that publishing the draft results in.
+func draftToProto(canvasID uuid.UUID, draft *models.CanvasDraft) *pb.Canvas {
+	return &pb.Canvas{
+		Metadata: &pb.Canvas_Metadata{
+			Id:          canvasID.String(),
+			Name:        draft.Name,
+			Description: draft.Description,
+		},
+		Spec: &pb.Canvas_Spec{
+			Nodes: actions.NodesToProto(draft.Nodes),
+			Edges: actions.EdgesToProto(draft.Edges),
+		},
+	}
+}
+
+// checkDraftBase makes sure the draft is based on the latest version of the canvas,
+// so publishing a draft never discards changes made to the canvas after the draft was created.
+func checkDraftBase(canvasID uuid.UUID, baseVersionID *uuid.UUID, latestVersion int) error {
+	base, err := findBaseVersion(canvasID, baseVersionID)
+	if err != nil {
+		return err
+	}
+
+	if base == nil {
+		return status.Error(codes.FailedPrecondition, "canvas changed after the draft was created")
+	}
+
+	if base.Version != latestVersion {
+		return status.Errorf(codes.FailedPrecondition, "canvas changed after the draft was created: draft is based on version %d, but canvas is on version %d", base.Version, latestVersion)
+	}
+
+	return nil
+}
+
+// latestCanvasVersion returns the number of the latest version of the canvas.
+func latestCanvasVersion(canvasID uuid.UUID) (int, error) {
+	versions, err := models.ListCanvasVersions(canvasID, 1, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(versions) == 0 {
+		return 0, nil
+	}
+
+	return versions[0].Version, nil
+}
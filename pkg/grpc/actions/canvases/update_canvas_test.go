@@ -80,6 +80,7 @@ func TestUpdateCanvas_NodeRemovalUseSoftDelete(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		canvasPb,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed when removing nodes with execution KVs")
@@ -173,6 +174,7 @@ func TestUpdateCanvas_RemapConflictingNodeIDs(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		removeNodePB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed when removing nodes")
@@ -218,6 +220,7 @@ func TestUpdateCanvas_RemapConflictingNodeIDs(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		remapCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed when remapping conflicting node IDs")
@@ -326,6 +329,7 @@ func TestUpdateCanvas_ErroredNodesCanExist(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		updatedCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed even with existing errored nodes")
@@ -408,6 +412,7 @@ func TestUpdateWorkflow_ErroredNodeResetOnUpdate(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		updatedCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed and reset errored node")
@@ -511,6 +516,7 @@ func TestUpdateCanvas_NonErroredNodesKeepState(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		updatedCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateWorkflow should succeed")
@@ -584,6 +590,7 @@ func TestUpdateCanvas_ValidationErrorsPersisted(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		updatedCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed even with validation errors")
@@ -657,6 +664,7 @@ func TestUpdateCanvas_SetupErrorsPersistedInResponse(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		updatedCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed even with setup errors")
@@ -712,6 +720,7 @@ func TestUpdateCanvas_ErroredNodeBecomesValidAgain(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		invalidCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed even with validation errors")
@@ -749,6 +758,7 @@ func TestUpdateCanvas_ErroredNodeBecomesValidAgain(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		validCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed with valid configuration")
@@ -812,6 +822,7 @@ func TestUpdateCanvas_WidgetNodesHandled(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		initialCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed with widget nodes")
@@ -879,6 +890,7 @@ func TestUpdateCanvas_WidgetNodesHandled(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		updatedCanvasPB,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateCanvas should succeed when updating widget nodes")
@@ -954,6 +966,7 @@ func TestUpdateCanvas_WidgetNodesCannotConnect(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		workflowWithWidgetAsSource,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.Error(t, err, "UpdateWorkflow should fail when widget node is used as source")
@@ -1001,6 +1014,7 @@ func TestUpdateCanvas_WidgetNodesCannotConnect(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		workflowWithWidgetAsTarget,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.Error(t, err, "UpdateCanvas should fail when widget node is used as target")
@@ -1053,6 +1067,7 @@ func TestUpdateCanvas_WidgetTextLengthValidation(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		workflowWithLongAnnotation,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err)
@@ -1101,6 +1116,7 @@ func TestUpdateCanvas_WidgetTextLengthValidation(t *testing.T) {
 		r.Organization.ID.String(),
 		canvas.ID.String(),
 		workflowWithMaxAnnotation,
+		"",
 		"http://localhost:3000/api/v1",
 	)
 	require.NoError(t, err, "UpdateWorkflow should succeed with max length annotation text")
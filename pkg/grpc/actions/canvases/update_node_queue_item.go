@@ -0,0 +1,59 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func UpdateNodeQueueItem(ctx context.Context, registry *registry.Registry, canvasID, nodeID, itemID string, priority int32) (*pb.UpdateNodeQueueItemResponse, error) {
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	itemUUID, err := uuid.Parse(itemID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid item_id")
+	}
+
+	if nodeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "node_id is required")
+	}
+
+	if err := models.ValidateQueuePriority(int(priority)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = models.UpdateNodeQueueItemPriority(canvasUUID, nodeID, itemUUID, int(priority))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "queue item not found")
+		}
+
+		return nil, err
+	}
+
+	queueItem, err := models.FindNodeQueueItem(canvasUUID, itemUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "queue item not found")
+		}
+
+		return nil, err
+	}
+
+	serialized, err := SerializeNodeQueueItems([]models.CanvasNodeQueueItem{*queueItem})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateNodeQueueItemResponse{Item: serialized[0]}, nil
+}
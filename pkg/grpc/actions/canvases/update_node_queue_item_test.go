@@ -0,0 +1,77 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test_UpdateNodeQueueItem(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	nodeID := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: nodeID,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		nil,
+	)
+
+	first := support.EmitCanvasEventForNode(t, canvas.ID, nodeID, "default", nil)
+	support.CreateQueueItem(t, canvas.ID, nodeID, first.ID, first.ID)
+	second := support.EmitCanvasEventForNode(t, canvas.ID, nodeID, "default", nil)
+	support.CreateQueueItem(t, canvas.ID, nodeID, second.ID, second.ID)
+
+	items, err := models.ListNodeQueueItems(canvas.ID, nodeID, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	// Items are listed newest first, so this is the last one to be dequeued.
+	newest := items[0]
+
+	t.Run("priority out of range -> error", func(t *testing.T) {
+		_, err := UpdateNodeQueueItem(context.Background(), r.Registry, canvas.ID.String(), nodeID, newest.ID.String(), 101)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("item that does not exist -> error", func(t *testing.T) {
+		_, err := UpdateNodeQueueItem(context.Background(), r.Registry, canvas.ID.String(), nodeID, uuid.NewString(), 10)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("higher priority item is dequeued first", func(t *testing.T) {
+		next, err := models.FindNextQueueItemPerNode(canvas.ID)
+		require.NoError(t, err)
+		require.Len(t, next, 1)
+		assert.NotEqual(t, newest.ID, next[0].ID)
+
+		response, err := UpdateNodeQueueItem(context.Background(), r.Registry, canvas.ID.String(), nodeID, newest.ID.String(), 10)
+		require.NoError(t, err)
+		assert.Equal(t, int32(10), response.Item.Priority)
+
+		next, err = models.FindNextQueueItemPerNode(canvas.ID)
+		require.NoError(t, err)
+		require.Len(t, next, 1)
+		assert.Equal(t, newest.ID, next[0].ID)
+	})
+}
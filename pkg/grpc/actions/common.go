@@ -703,16 +703,21 @@ func ProtoToNodes(nodes []*componentpb.Node) []models.Node {
 		}
 
 		result[i] = models.Node{
-			ID:             node.Id,
-			Name:           node.Name,
-			Type:           ProtoToNodeType(node.Type),
-			Ref:            ProtoToNodeRef(node),
-			Configuration:  node.Configuration.AsMap(),
-			Position:       ProtoToPosition(node.Position),
-			IsCollapsed:    node.IsCollapsed,
-			IntegrationID:  integrationID,
-			ErrorMessage:   errorMessage,
-			WarningMessage: warningMessage,
+			ID:                      node.Id,
+			Name:                    node.Name,
+			Type:                    ProtoToNodeType(node.Type),
+			Ref:                     ProtoToNodeRef(node),
+			Configuration:           node.Configuration.AsMap(),
+			Position:                ProtoToPosition(node.Position),
+			IsCollapsed:             node.IsCollapsed,
+			IntegrationID:           integrationID,
+			ErrorMessage:            errorMessage,
+			WarningMessage:          warningMessage,
+			ConcurrencyGroup:        ProtoToConcurrencyGroup(node.ConcurrencyGroup),
+			RetryPolicy:             ProtoToRetryPolicy(node.RetryPolicy),
+			ExecutionTimeoutSeconds: int(node.ExecutionTimeoutSeconds),
+			QueuePolicy:             ProtoToQueuePolicy(node.QueuePolicy),
+			RateLimit:               ProtoToNodeRateLimit(node.RateLimit),
 		}
 	}
 	return result
@@ -774,6 +779,26 @@ func NodesToProto(nodes []models.Node) []*componentpb.Node {
 		if node.WarningMessage != nil && *node.WarningMessage != "" {
 			result[i].WarningMessage = *node.WarningMessage
 		}
+
+		if node.ConcurrencyGroup != nil {
+			result[i].ConcurrencyGroup = ConcurrencyGroupToProto(node.ConcurrencyGroup)
+		}
+
+		if node.RetryPolicy != nil {
+			result[i].RetryPolicy = RetryPolicyToProto(node.RetryPolicy)
+		}
+
+		if node.ExecutionTimeoutSeconds > 0 {
+			result[i].ExecutionTimeoutSeconds = int32(node.ExecutionTimeoutSeconds)
+		}
+
+		if node.QueuePolicy != nil {
+			result[i].QueuePolicy = QueuePolicyToProto(node.QueuePolicy)
+		}
+
+		if node.RateLimit != nil {
+			result[i].RateLimit = NodeRateLimitToProto(node.RateLimit)
+		}
 	}
 
 	return result
@@ -912,6 +937,136 @@ func NodeTypeToProto(nodeType string) componentpb.Node_Type {
 	}
 }
 
+func ProtoToConcurrencyGroup(group *componentpb.Node_ConcurrencyGroup) *models.ConcurrencyGroup {
+	if group == nil || group.Name == "" {
+		return nil
+	}
+
+	return &models.ConcurrencyGroup{
+		Name:   group.Name,
+		Policy: ProtoToConcurrencyPolicy(group.Policy),
+	}
+}
+
+func ConcurrencyGroupToProto(group *models.ConcurrencyGroup) *componentpb.Node_ConcurrencyGroup {
+	return &componentpb.Node_ConcurrencyGroup{
+		Name:   group.Name,
+		Policy: ConcurrencyPolicyToProto(group.Policy),
+	}
+}
+
+func ProtoToConcurrencyPolicy(policy componentpb.Node_ConcurrencyGroup_Policy) string {
+	switch policy {
+	case componentpb.Node_ConcurrencyGroup_POLICY_CANCEL_IN_PROGRESS:
+		return models.ConcurrencyPolicyCancelInProgress
+	case componentpb.Node_ConcurrencyGroup_POLICY_SKIP_IF_RUNNING:
+		return models.ConcurrencyPolicySkipIfRunning
+	default:
+		return models.ConcurrencyPolicyQueue
+	}
+}
+
+func ConcurrencyPolicyToProto(policy string) componentpb.Node_ConcurrencyGroup_Policy {
+	switch policy {
+	case models.ConcurrencyPolicyCancelInProgress:
+		return componentpb.Node_ConcurrencyGroup_POLICY_CANCEL_IN_PROGRESS
+	case models.ConcurrencyPolicySkipIfRunning:
+		return componentpb.Node_ConcurrencyGroup_POLICY_SKIP_IF_RUNNING
+	default:
+		return componentpb.Node_ConcurrencyGroup_POLICY_QUEUE
+	}
+}
+
+func ProtoToRetryPolicy(policy *componentpb.Node_RetryPolicy) *models.RetryPolicy {
+	if policy == nil || policy.MaxAttempts <= 1 {
+		return nil
+	}
+
+	return &models.RetryPolicy{
+		MaxAttempts:         int(policy.MaxAttempts),
+		InitialDelaySeconds: int(policy.InitialDelaySeconds),
+		MaxDelaySeconds:     int(policy.MaxDelaySeconds),
+		BackoffMultiplier:   policy.BackoffMultiplier,
+		Jitter:              policy.Jitter,
+		RetryOn:             policy.RetryOn,
+	}
+}
+
+func RetryPolicyToProto(policy *models.RetryPolicy) *componentpb.Node_RetryPolicy {
+	return &componentpb.Node_RetryPolicy{
+		MaxAttempts:         int32(policy.MaxAttempts),
+		InitialDelaySeconds: int32(policy.InitialDelaySeconds),
+		MaxDelaySeconds:     int32(policy.MaxDelaySeconds),
+		BackoffMultiplier:   policy.BackoffMultiplier,
+		Jitter:              policy.Jitter,
+		RetryOn:             policy.RetryOn,
+	}
+}
+
+func ProtoToQueuePolicy(policy *componentpb.Node_QueuePolicy) *models.QueuePolicy {
+	if policy == nil || policy.Strategy == componentpb.Node_QueuePolicy_STRATEGY_NONE {
+		return nil
+	}
+
+	return &models.QueuePolicy{
+		Strategy:        ProtoToQueuePolicyStrategy(policy.Strategy),
+		DebounceSeconds: int(policy.DebounceSeconds),
+		Key:             policy.Key,
+	}
+}
+
+func QueuePolicyToProto(policy *models.QueuePolicy) *componentpb.Node_QueuePolicy {
+	return &componentpb.Node_QueuePolicy{
+		Strategy:        QueuePolicyStrategyToProto(policy.Strategy),
+		DebounceSeconds: int32(policy.DebounceSeconds),
+		Key:             policy.Key,
+	}
+}
+
+func ProtoToQueuePolicyStrategy(strategy componentpb.Node_QueuePolicy_Strategy) string {
+	switch strategy {
+	case componentpb.Node_QueuePolicy_STRATEGY_DEBOUNCE:
+		return models.QueuePolicyStrategyDebounce
+	case componentpb.Node_QueuePolicy_STRATEGY_COALESCE:
+		return models.QueuePolicyStrategyCoalesce
+	case componentpb.Node_QueuePolicy_STRATEGY_DROP_DUPLICATES:
+		return models.QueuePolicyStrategyDropDuplicates
+	default:
+		return ""
+	}
+}
+
+func QueuePolicyStrategyToProto(strategy string) componentpb.Node_QueuePolicy_Strategy {
+	switch strategy {
+	case models.QueuePolicyStrategyDebounce:
+		return componentpb.Node_QueuePolicy_STRATEGY_DEBOUNCE
+	case models.QueuePolicyStrategyCoalesce:
+		return componentpb.Node_QueuePolicy_STRATEGY_COALESCE
+	case models.QueuePolicyStrategyDropDuplicates:
+		return componentpb.Node_QueuePolicy_STRATEGY_DROP_DUPLICATES
+	default:
+		return componentpb.Node_QueuePolicy_STRATEGY_NONE
+	}
+}
+
+func ProtoToNodeRateLimit(limit *componentpb.Node_RateLimit) *models.RateLimit {
+	if limit == nil || (limit.Limit == 0 && limit.PeriodSeconds == 0) {
+		return nil
+	}
+
+	return &models.RateLimit{
+		Limit:         int(limit.Limit),
+		PeriodSeconds: int(limit.PeriodSeconds),
+	}
+}
+
+func NodeRateLimitToProto(limit *models.RateLimit) *componentpb.Node_RateLimit {
+	return &componentpb.Node_RateLimit{
+		Limit:         int32(limit.Limit),
+		PeriodSeconds: int32(limit.PeriodSeconds),
+	}
+}
+
 func ProtoToNodeRef(node *componentpb.Node) models.NodeRef {
 	ref := models.NodeRef{}
 
@@ -1167,21 +1322,35 @@ func SerializeTriggers(in []core.Trigger) []*triggerpb.Trigger {
 	return out
 }
 
-func AppendGlobalTriggerFields(fields []configuration.Field) []configuration.Field {
-	if slices.ContainsFunc(fields, func(field configuration.Field) bool {
-		return field.Name == "customName"
-	}) {
-		return fields
-	}
-
-	fields = append(fields, configuration.Field{
+var globalTriggerFields = []configuration.Field{
+	{
 		Name:        "customName",
 		Label:       "Run title (optional)",
 		Type:        configuration.FieldTypeString,
 		Togglable:   true,
 		Description: "Optional run title template. Supports expressions like {{ $.data }}.",
 		Placeholder: "Deploy {{ $.repository.name }} @ {{ $.head_commit.id }}",
-	})
+	},
+	{
+		Name:        "priority",
+		Label:       "Run priority (optional)",
+		Type:        configuration.FieldTypeString,
+		Togglable:   true,
+		Description: "Runs with a higher priority skip ahead of other runs in node queues. From -100 to 100, 0 by default. Supports expressions.",
+		Placeholder: "{{ $.data.headers[\"X-Priority\"][0] }}",
+	},
+}
+
+func AppendGlobalTriggerFields(fields []configuration.Field) []configuration.Field {
+	for _, global := range globalTriggerFields {
+		if slices.ContainsFunc(fields, func(field configuration.Field) bool {
+			return field.Name == global.Name
+		}) {
+			continue
+		}
+
+		fields = append(fields, global)
+	}
 
 	return fields
 }
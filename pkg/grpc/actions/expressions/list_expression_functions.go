@@ -0,0 +1,28 @@
+package expressions
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/expressions"
+	pb "github.com/superplanehq/superplane/pkg/protos/expressions"
+)
+
+func ListExpressionFunctions(ctx context.Context, category string) (*pb.ListExpressionFunctionsResponse, error) {
+	functions := []*pb.ExpressionFunction{}
+	for _, function := range expressions.Functions() {
+		if category != "" && function.Category != category {
+			continue
+		}
+
+		functions = append(functions, &pb.ExpressionFunction{
+			Name:        function.Name,
+			Category:    function.Category,
+			Signature:   function.Signature,
+			Description: function.Description,
+			Example:     function.Example,
+			Builtin:     function.Builtin,
+		})
+	}
+
+	return &pb.ListExpressionFunctionsResponse{Functions: functions}, nil
+}
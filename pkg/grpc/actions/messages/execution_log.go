@@ -0,0 +1,45 @@
+package messages
+
+import (
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const WorkflowExecutionLogRoutingKey = "workflow-execution-log"
+
+type CanvasExecutionLogMessage struct {
+	message *pb.CanvasNodeExecutionLogMessage
+}
+
+func NewCanvasExecutionLogMessage(execution *models.CanvasNodeExecution, logs []models.CanvasNodeExecutionLog) CanvasExecutionLogMessage {
+	lines := make([]*pb.ExecutionLogLine, 0, len(logs))
+	for _, l := range logs {
+		line := &pb.ExecutionLogLine{
+			Id:      l.ID,
+			Stream:  l.Stream,
+			Level:   l.Level,
+			Message: l.Message,
+		}
+
+		if l.CreatedAt != nil {
+			line.CreatedAt = timestamppb.New(*l.CreatedAt)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return CanvasExecutionLogMessage{
+		message: &pb.CanvasNodeExecutionLogMessage{
+			ExecutionId: execution.ID.String(),
+			CanvasId:    execution.WorkflowID.String(),
+			NodeId:      execution.NodeID,
+			Lines:       lines,
+			Timestamp:   timestamppb.Now(),
+		},
+	}
+}
+
+func (m CanvasExecutionLogMessage) Publish() error {
+	return Publish(WorkflowExchange, WorkflowExecutionLogRoutingKey, toBytes(m.message))
+}
@@ -20,7 +20,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
-func CreateIntegration(ctx context.Context, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, webhooksBaseURL string, orgID string, integrationName, name string, appConfig *structpb.Struct) (*pb.CreateIntegrationResponse, error) {
+func CreateIntegration(ctx context.Context, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, webhooksBaseURL string, orgID string, integrationName, name string, appConfig *structpb.Struct, rateLimit *pb.Integration_RateLimit) (*pb.CreateIntegrationResponse, error) {
 	integration, err := registry.GetIntegration(integrationName)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "integration %s not found", integrationName)
@@ -39,6 +39,11 @@ func CreateIntegration(ctx context.Context, registry *registry.Registry, oidcPro
 		return nil, status.Errorf(codes.AlreadyExists, "an integration with the name %s already exists in this organization", name)
 	}
 
+	limit, err := protoToIntegrationRateLimit(rateLimit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	//
 	// We must encrypt the sensitive configuration fields before storing
 	//
@@ -59,6 +64,7 @@ func CreateIntegration(ctx context.Context, registry *registry.Registry, oidcPro
 		return nil, status.Error(codes.Internal, "failed to create integration")
 	}
 
+	newIntegration.SetRateLimit(limit)
 	integrationCtx := contexts.NewIntegrationContext(
 		database.Conn(),
 		nil,
@@ -102,6 +108,19 @@ func CreateIntegration(ctx context.Context, registry *registry.Registry, oidcPro
 	}, nil
 }
 
+func protoToIntegrationRateLimit(rateLimit *pb.Integration_RateLimit) (*models.RateLimit, error) {
+	if rateLimit == nil || (rateLimit.Limit == 0 && rateLimit.PeriodSeconds == 0) {
+		return nil, nil
+	}
+
+	limit := &models.RateLimit{
+		Limit:         int(rateLimit.Limit),
+		PeriodSeconds: int(rateLimit.PeriodSeconds),
+	}
+
+	return limit, limit.Validate()
+}
+
 func serializeIntegration(registry *registry.Registry, instance *models.Integration, nodeRefs []models.CanvasNodeReference) (*pb.Integration, error) {
 	integration, err := registry.GetIntegration(instance.AppName)
 	if err != nil {
@@ -138,6 +157,13 @@ func serializeIntegration(registry *registry.Registry, instance *models.Integrat
 		},
 	}
 
+	if limit := instance.GetRateLimit(); limit != nil {
+		proto.Spec.RateLimit = &pb.Integration_RateLimit{
+			Limit:         int32(limit.Limit),
+			PeriodSeconds: int32(limit.PeriodSeconds),
+		}
+	}
+
 	if instance.BrowserAction != nil {
 		browserAction := instance.BrowserAction.Data()
 		proto.Status.BrowserAction = &pb.BrowserAction{
@@ -32,7 +32,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Create first integration
 		//
-		response, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig)
+		response, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig, nil)
 		require.NoError(t, err)
 		require.NotNil(t, response)
 		require.NotNil(t, response.Integration)
@@ -41,7 +41,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Try to create second integration with the same name
 		//
-		_, err = CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig)
+		_, err = CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig, nil)
 		require.Error(t, err)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -57,7 +57,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Create first integration
 		//
-		response, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig)
+		response, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig, nil)
 		require.NoError(t, err)
 		require.NotNil(t, response)
 		integrationID := response.Integration.Metadata.Id
@@ -94,7 +94,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Create a new installation with the same name
 		//
-		response2, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig)
+		response2, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig, nil)
 		require.NoError(t, err)
 		require.NotNil(t, response2)
 		assert.Equal(t, name, response2.Integration.Metadata.Name)
@@ -123,7 +123,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Create integration in first organization
 		//
-		response1, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig)
+		response1, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "github", name, appConfig, nil)
 		require.NoError(t, err)
 		require.NotNil(t, response1)
 		assert.Equal(t, name, response1.Integration.Metadata.Name)
@@ -131,7 +131,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Create integration with same name in second organization
 		//
-		response2, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, org2.ID.String(), "github", name, appConfig)
+		response2, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, org2.ID.String(), "github", name, appConfig, nil)
 		require.NoError(t, err)
 		require.NotNil(t, response2)
 		assert.Equal(t, name, response2.Integration.Metadata.Name)
@@ -157,7 +157,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Try to create an integration that doesn't exist
 		//
-		_, err = CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "nonexistent-app", name, appConfig)
+		_, err = CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "nonexistent-app", name, appConfig, nil)
 		require.Error(t, err)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -184,7 +184,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Create the integration
 		//
-		response, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig)
+		response, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig, nil)
 		require.NoError(t, err)
 		require.NotNil(t, response)
 		require.NotNil(t, response.Integration)
@@ -227,7 +227,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Create the integration
 		//
-		response, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig)
+		response, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig, nil)
 		require.NoError(t, err)
 		require.NotNil(t, response)
 		require.NotNil(t, response.Integration)
@@ -42,7 +42,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Create installation
 		//
-		installResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig)
+		installResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := installResponse.Integration.Metadata.Id
 
@@ -141,7 +141,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Create integration in first organization
 		//
-		installResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig)
+		installResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := installResponse.Integration.Metadata.Id
 
@@ -173,7 +173,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Create integration
 		//
-		installResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig)
+		installResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := installResponse.Integration.Metadata.Id
 
@@ -212,7 +212,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Create integration
 		//
-		installResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig)
+		installResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := installResponse.Integration.Metadata.Id
 
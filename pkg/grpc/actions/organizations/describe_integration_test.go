@@ -39,7 +39,7 @@ func Test__DescribeIntegration(t *testing.T) {
 		//
 		// Create an integration
 		//
-		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig)
+		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := createResponse.Integration.Metadata.Id
 
@@ -117,7 +117,7 @@ func Test__DescribeIntegration(t *testing.T) {
 		//
 		// Create integration in first organization
 		//
-		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig)
+		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := createResponse.Integration.Metadata.Id
 
@@ -150,7 +150,7 @@ func Test__DescribeIntegration(t *testing.T) {
 		//
 		// Create integration with configuration
 		//
-		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig)
+		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", name, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := createResponse.Integration.Metadata.Id
 
@@ -0,0 +1,73 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func GetRetentionPolicy(orgID string) (*pb.GetRetentionPolicyResponse, error) {
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	policy, err := models.FindOrganizationRetentionPolicy(orgUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &pb.GetRetentionPolicyResponse{Policy: &pb.RetentionPolicy{}}, nil
+		}
+
+		return nil, status.Error(codes.Internal, "failed to fetch retention policy")
+	}
+
+	return &pb.GetRetentionPolicyResponse{
+		Policy: serializeRetentionPolicy(policy),
+	}, nil
+}
+
+func UpdateRetentionPolicy(orgID string, policy *pb.RetentionPolicy) (*pb.UpdateRetentionPolicyResponse, error) {
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	if policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	maxAgeDays := int(policy.MaxAgeDays)
+	maxRuns := int(policy.MaxRuns)
+	if err := models.ValidateRetentionPolicy(maxAgeDays, maxRuns); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	saved, err := models.SaveOrganizationRetentionPolicy(orgUUID, maxAgeDays, maxRuns, policy.Archive)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to update retention policy")
+	}
+
+	return &pb.UpdateRetentionPolicyResponse{
+		Policy: serializeRetentionPolicy(saved),
+	}, nil
+}
+
+func serializeRetentionPolicy(policy *models.RetentionPolicy) *pb.RetentionPolicy {
+	result := &pb.RetentionPolicy{
+		MaxAgeDays: uint32(policy.MaxAgeDays),
+		MaxRuns:    uint32(policy.MaxRuns),
+		Archive:    policy.Archive,
+	}
+
+	if policy.UpdatedAt != nil {
+		result.UpdatedAt = timestamppb.New(*policy.UpdatedAt)
+	}
+
+	return result
+}
@@ -32,6 +32,7 @@ func UpdateIntegration(
 	integrationID string,
 	configuration map[string]any,
 	name string,
+	rateLimit *pb.Integration_RateLimit,
 ) (*pb.UpdateIntegrationResponse, error) {
 	org, err := uuid.Parse(orgID)
 	if err != nil {
@@ -61,6 +62,19 @@ func UpdateIntegration(
 		instance.InstallationName = name
 	}
 
+	//
+	// The rate limit is only updated if one is given.
+	// An empty one removes the existing rate limit.
+	//
+	if rateLimit != nil {
+		limit, err := protoToIntegrationRateLimit(rateLimit)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		instance.SetRateLimit(limit)
+	}
+
 	integration, err := registry.GetIntegration(instance.AppName)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "integration %s not found", instance.AppName)
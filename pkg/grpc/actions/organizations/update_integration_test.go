@@ -40,7 +40,7 @@ func Test__UpdateIntegration(t *testing.T) {
 		//
 		// Create integration
 		//
-		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", integrationName, appConfig)
+		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", integrationName, appConfig, nil)
 		require.NoError(t, err)
 		require.NotNil(t, createResponse)
 		integrationID := createResponse.Integration.Metadata.Id
@@ -48,7 +48,7 @@ func Test__UpdateIntegration(t *testing.T) {
 		//
 		// Update the integration configuration
 		//
-		updateResponse, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), integrationID, map[string]any{"key": "value2", "new_key": "new_value"}, "")
+		updateResponse, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), integrationID, map[string]any{"key": "value2", "new_key": "new_value"}, "", nil)
 		require.NoError(t, err)
 		require.NotNil(t, updateResponse)
 		require.NotNil(t, updateResponse.Integration)
@@ -98,14 +98,14 @@ func Test__UpdateIntegration(t *testing.T) {
 		//
 		// Create integration
 		//
-		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", integrationName, appConfig)
+		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", integrationName, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := createResponse.Integration.Metadata.Id
 
 		//
 		// Update the integration configuration (this should fail)
 		//
-		updateResponse, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), integrationID, map[string]any{"key": "value2"}, "")
+		updateResponse, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), integrationID, map[string]any{"key": "value2"}, "", nil)
 		require.NoError(t, err)
 		require.NotNil(t, updateResponse)
 
@@ -128,7 +128,7 @@ func Test__UpdateIntegration(t *testing.T) {
 		//
 		// Try to update with an invalid integration ID
 		//
-		_, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "invalid-uuid", map[string]any{"key": "value"}, "")
+		_, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "invalid-uuid", map[string]any{"key": "value"}, "", nil)
 		require.Error(t, err)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -140,7 +140,7 @@ func Test__UpdateIntegration(t *testing.T) {
 		//
 		// Try to update a non-existent integration
 		//
-		_, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), uuid.NewString(), map[string]any{"key": "value"}, "")
+		_, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), uuid.NewString(), map[string]any{"key": "value"}, "", nil)
 		require.Error(t, err)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -166,14 +166,14 @@ func Test__UpdateIntegration(t *testing.T) {
 		//
 		// Create integration with multiple config keys
 		//
-		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", integrationName, appConfig)
+		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", integrationName, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := createResponse.Integration.Metadata.Id
 
 		//
 		// Update only one key
 		//
-		_, err = UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), integrationID, map[string]any{"key2": "updated_value2"}, "")
+		_, err = UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), integrationID, map[string]any{"key2": "updated_value2"}, "", nil)
 		require.NoError(t, err)
 
 		//
@@ -199,12 +199,12 @@ func Test__UpdateIntegration(t *testing.T) {
 		appConfig, err := structpb.NewStruct(map[string]any{"key": "value1"})
 		require.NoError(t, err)
 
-		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", integrationName, appConfig)
+		createResponse, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", integrationName, appConfig, nil)
 		require.NoError(t, err)
 		integrationID := createResponse.Integration.Metadata.Id
 
 		updatedName := support.RandomName("integration")
-		updateResponse, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), integrationID, nil, updatedName)
+		updateResponse, err := UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), integrationID, nil, updatedName, nil)
 		require.NoError(t, err)
 		require.NotNil(t, updateResponse)
 		require.NotNil(t, updateResponse.Integration)
@@ -231,13 +231,13 @@ func Test__UpdateIntegration(t *testing.T) {
 		appConfig, err := structpb.NewStruct(map[string]any{"key": "value1"})
 		require.NoError(t, err)
 
-		_, err = CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", firstName, appConfig)
+		_, err = CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", firstName, appConfig, nil)
 		require.NoError(t, err)
 
-		secondIntegration, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", secondName, appConfig)
+		secondIntegration, err := CreateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), "dummy", secondName, appConfig, nil)
 		require.NoError(t, err)
 
-		_, err = UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), secondIntegration.Integration.Metadata.Id, nil, firstName)
+		_, err = UpdateIntegration(ctx, r.Registry, nil, baseURL, baseURL, r.Organization.ID.String(), secondIntegration.Integration.Metadata.Id, nil, firstName, nil)
 		require.Error(t, err)
 
 		s, ok := status.FromError(err)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
@@ -45,7 +46,12 @@ func CreateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType st
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	secret, err := models.CreateSecret(spec.Metadata.Name, provider, userID, domainType, uuid.MustParse(domainID), data)
+	access, err := protoToSecretAccess(domainID, spec.Spec)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	secret, err := models.CreateSecretWithAccess(spec.Metadata.Name, provider, userID, domainType, uuid.MustParse(domainID), data, access)
 	if err != nil {
 		if errors.Is(err, models.ErrNameAlreadyUsed) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -55,6 +61,8 @@ func CreateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType st
 		return nil, status.Error(codes.Internal, "failed to create secret")
 	}
 
+	recordSecretAccess(ctx, secret, models.SecretAccessActionCreate, "")
+
 	s, err := serializeSecret(ctx, encryptor, *secret)
 	if err != nil {
 		return nil, err
@@ -67,6 +75,8 @@ func protoToSecretProvider(provider pb.Secret_Provider) string {
 	switch provider {
 	case pb.Secret_PROVIDER_LOCAL:
 		return secrets.ProviderLocal
+	case pb.Secret_PROVIDER_VAULT:
+		return secrets.ProviderVault
 	default:
 		return ""
 	}
@@ -76,11 +86,48 @@ func secretProviderToProto(provider string) pb.Secret_Provider {
 	switch provider {
 	case secrets.ProviderLocal:
 		return pb.Secret_PROVIDER_LOCAL
+	case secrets.ProviderVault:
+		return pb.Secret_PROVIDER_VAULT
 	default:
 		return pb.Secret_PROVIDER_UNKNOWN
 	}
 }
 
+// protoToSecretAccess returns the scope and access policy of the secret.
+// Secrets can only be scoped to canvases of the organization they belong to.
+func protoToSecretAccess(organizationID string, spec *pb.Secret_Spec) (models.SecretAccess, error) {
+	access := models.SecretAccess{}
+	if spec.Scope != nil {
+		if spec.Scope.CanvasId != "" {
+			canvasID, err := uuid.Parse(spec.Scope.CanvasId)
+			if err != nil {
+				return access, fmt.Errorf("invalid canvas ID")
+			}
+
+			_, err = models.FindCanvas(uuid.MustParse(organizationID), canvasID)
+			if err != nil {
+				return access, fmt.Errorf("canvas %s not found", spec.Scope.CanvasId)
+			}
+
+			access.WorkflowID = &canvasID
+		}
+
+		if spec.Scope.NodeId != "" {
+			nodeID := spec.Scope.NodeId
+			access.NodeID = &nodeID
+		}
+	}
+
+	if spec.AccessPolicy != nil {
+		access.Policy = &models.SecretAccessPolicy{
+			Canvases:   spec.AccessPolicy.CanvasIds,
+			Components: spec.AccessPolicy.Components,
+		}
+	}
+
+	return access, access.Validate()
+}
+
 func prepareSecretData(ctx context.Context, encryptor crypto.Encryptor, secret *pb.Secret) ([]byte, error) {
 	if secret.Spec == nil {
 		return nil, fmt.Errorf("missing secret spec")
@@ -103,6 +150,26 @@ func prepareSecretData(ctx context.Context, encryptor crypto.Encryptor, secret *
 
 		return encrypted, nil
 
+	//
+	// Vault secrets only store the reference,
+	// which is not sensitive, so it is not encrypted.
+	//
+	case pb.Secret_PROVIDER_VAULT:
+		if secret.Spec.Vault == nil {
+			return nil, fmt.Errorf("missing vault reference")
+		}
+
+		ref := secrets.VaultReference{
+			Path:  strings.Trim(secret.Spec.Vault.Path, "/ "),
+			Mount: strings.Trim(secret.Spec.Vault.Mount, "/ "),
+		}
+
+		if err := ref.Validate(); err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(ref)
+
 	default:
 		return nil, fmt.Errorf("provider not supported")
 	}
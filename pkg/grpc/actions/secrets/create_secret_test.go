@@ -73,6 +73,120 @@ func Test__CreateSecret(t *testing.T) {
 		require.Equal(t, map[string]string{"test": "***"}, response.Secret.Spec.Local.Data)
 	})
 
+	t.Run("vault secret only stores the reference", func(t *testing.T) {
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_VAULT,
+				Vault: &protos.Secret_Vault{
+					Path:  "/ci/github/",
+					Mount: "kv",
+				},
+			},
+		}
+
+		response, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		require.NoError(t, err)
+		assert.Equal(t, protos.Secret_PROVIDER_VAULT, response.Secret.Spec.Provider)
+		assert.Nil(t, response.Secret.Spec.Local)
+		require.NotNil(t, response.Secret.Spec.Vault)
+		assert.Equal(t, "ci/github", response.Secret.Spec.Vault.Path)
+		assert.Equal(t, "kv", response.Secret.Spec.Vault.Mount)
+
+		record, err := models.FindSecretByName(models.DomainTypeOrganization, r.Organization.ID, secret.Metadata.Name)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"mount": "kv", "path": "ci/github"}`, string(record.Data))
+	})
+
+	t.Run("vault secret without path", func(t *testing.T) {
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_VAULT,
+				Vault:    &protos.Secret_Vault{},
+			},
+		}
+
+		_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("secret scoped to a canvas node", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_LOCAL,
+				Local:    &protos.Secret_Local{Data: map[string]string{"test": "test"}},
+				Scope: &protos.Secret_Scope{
+					CanvasId: canvas.ID.String(),
+					NodeId:   "node-1",
+				},
+				AccessPolicy: &protos.Secret_AccessPolicy{
+					Components: []string{"ssh"},
+				},
+			},
+		}
+
+		response, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		require.NoError(t, err)
+		require.NotNil(t, response.Secret.Spec.Scope)
+		assert.Equal(t, canvas.ID.String(), response.Secret.Spec.Scope.CanvasId)
+		assert.Equal(t, "node-1", response.Secret.Spec.Scope.NodeId)
+		require.NotNil(t, response.Secret.Spec.AccessPolicy)
+		assert.Equal(t, []string{"ssh"}, response.Secret.Spec.AccessPolicy.Components)
+
+		record, err := models.FindSecretByName(models.DomainTypeOrganization, r.Organization.ID, secret.Metadata.Name)
+		require.NoError(t, err)
+		require.NotNil(t, record.WorkflowID)
+		assert.Equal(t, canvas.ID, *record.WorkflowID)
+		assert.Equal(t, []string{"ssh"}, record.GetAccessPolicy().Components)
+	})
+
+	t.Run("secret scoped to canvas that does not exist", func(t *testing.T) {
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_LOCAL,
+				Local:    &protos.Secret_Local{Data: map[string]string{"test": "test"}},
+				Scope:    &protos.Secret_Scope{CanvasId: uuid.NewString()},
+			},
+		}
+
+		_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("node scope without canvas", func(t *testing.T) {
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_LOCAL,
+				Local:    &protos.Secret_Local{Data: map[string]string{"test": "test"}},
+				Scope:    &protos.Secret_Scope{NodeId: "node-1"},
+			},
+		}
+
+		_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
 	t.Run("name already used", func(t *testing.T) {
 		name := support.RandomName("secret")
 		ctx := authentication.SetUserIdInMetadata(context.Background(), uuid.NewString())
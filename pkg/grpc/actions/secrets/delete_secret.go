@@ -29,5 +29,7 @@ func DeleteSecret(ctx context.Context, domainType, domainID, idOrName string) (*
 		return nil, status.Error(codes.Internal, "error deleting secret")
 	}
 
+	recordSecretAccess(ctx, secret, models.SecretAccessActionDelete, "")
+
 	return &pb.DeleteSecretResponse{}, nil
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -28,6 +29,10 @@ func DeleteSecretKey(ctx context.Context, encryptor crypto.Encryptor, domainType
 		return nil, status.Error(codes.InvalidArgument, "secret not found")
 	}
 
+	if secret.Provider != secrets.ProviderLocal {
+		return nil, status.Error(codes.FailedPrecondition, "keys can only be managed for local secrets")
+	}
+
 	data, err := decryptSecretData(ctx, encryptor, *secret)
 	if err != nil {
 		return nil, err
@@ -51,6 +56,7 @@ func DeleteSecretKey(ctx context.Context, encryptor crypto.Encryptor, domainType
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	updated.Data = encrypted
+	recordSecretAccess(ctx, updated, models.SecretAccessActionUpdate, keyName)
 
 	s, err := serializeSecret(ctx, encryptor, *updated)
 	if err != nil {
@@ -5,16 +5,19 @@ import (
 	"encoding/json"
 
 	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func DescribeSecret(ctx context.Context, encryptor crypto.Encryptor, domainType, domainId, idOrName string) (*pb.DescribeSecretResponse, error) {
+func DescribeSecret(ctx context.Context, encryptor crypto.Encryptor, registry *registry.Registry, domainType, domainId, idOrName string) (*pb.DescribeSecretResponse, error) {
 	err := actions.ValidateUUIDs(idOrName)
 	var secret *models.Secret
 	if err != nil {
@@ -32,11 +35,100 @@ func DescribeSecret(ctx context.Context, encryptor crypto.Encryptor, domainType,
 		return nil, err
 	}
 
+	references, err := findSecretReferences(registry, *secret)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error finding secret references")
+	}
+
+	recordSecretAccess(ctx, secret, models.SecretAccessActionDescribe, "")
+
 	return &pb.DescribeSecretResponse{
-		Secret: s,
+		Secret:     s,
+		References: references,
 	}, nil
 }
 
+// findSecretReferences returns the canvas nodes referencing the secret
+// in one of the secret-key fields of their configuration.
+func findSecretReferences(registry *registry.Registry, secret models.Secret) ([]*pb.SecretReference, error) {
+	name, err := json.Marshal(secret.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := models.ListCanvasNodesWithConfigurationContaining(secret.DomainID, string(name))
+	if err != nil {
+		return nil, err
+	}
+
+	canvasNames := map[uuid.UUID]string{}
+	references := []*pb.SecretReference{}
+	for _, node := range nodes {
+		fields, err := nodeConfigurationFields(registry, secret.DomainID, node)
+		if err != nil {
+			continue
+		}
+
+		for _, reference := range configuration.SecretKeyReferences(fields, node.Configuration.Data()) {
+			if reference.Secret != secret.Name {
+				continue
+			}
+
+			canvasName, ok := canvasNames[node.WorkflowID]
+			if !ok {
+				canvas, err := models.FindCanvas(secret.DomainID, node.WorkflowID)
+				if err == nil {
+					canvasName = canvas.Name
+				}
+
+				canvasNames[node.WorkflowID] = canvasName
+			}
+
+			references = append(references, &pb.SecretReference{
+				CanvasId:   node.WorkflowID.String(),
+				CanvasName: canvasName,
+				NodeId:     node.NodeID,
+				NodeName:   node.Name,
+				Key:        reference.Key,
+			})
+		}
+	}
+
+	return references, nil
+}
+
+func nodeConfigurationFields(registry *registry.Registry, organizationID uuid.UUID, node models.CanvasNode) ([]configuration.Field, error) {
+	ref := node.Ref.Data()
+	switch {
+	case ref.Component != nil:
+		component, err := registry.GetComponent(ref.Component.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		return component.Configuration(), nil
+
+	case ref.Trigger != nil:
+		trigger, err := registry.GetTrigger(ref.Trigger.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		return trigger.Configuration(), nil
+
+	case ref.Blueprint != nil:
+		blueprint, err := models.FindBlueprint(organizationID.String(), ref.Blueprint.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return blueprint.Configuration, nil
+
+	default:
+		return nil, nil
+	}
+}
+
 func serializeSecret(ctx context.Context, encryptor crypto.Encryptor, secret models.Secret) (*pb.Secret, error) {
 	s := &pb.Secret{
 		Metadata: &pb.Secret_Metadata{
@@ -46,11 +138,11 @@ func serializeSecret(ctx context.Context, encryptor crypto.Encryptor, secret mod
 			DomainId:   secret.DomainID.String(),
 			CreatedAt:  timestamppb.New(*secret.CreatedAt),
 		},
-		Spec: &pb.Secret_Spec{
-			Provider: secretProviderToProto(secret.Provider),
-		},
+		Spec: serializeSecretAccess(&secret),
 	}
 
+	s.Spec.Provider = secretProviderToProto(secret.Provider)
+
 	switch s.Spec.Provider {
 	case pb.Secret_PROVIDER_LOCAL:
 		local, err := serializeLocalSecretData(ctx, encryptor, secret)
@@ -61,11 +153,40 @@ func serializeSecret(ctx context.Context, encryptor crypto.Encryptor, secret mod
 		s.Spec.Local = local
 		return s, nil
 
+	case pb.Secret_PROVIDER_VAULT:
+		ref, err := secrets.ParseVaultReference(secret.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Spec.Vault = &pb.Secret_Vault{Path: ref.Path, Mount: ref.Mount}
+		return s, nil
+
 	default:
 		return s, nil
 	}
 }
 
+// serializeSecretAccess returns a spec with only the scope and access policy of the secret.
+func serializeSecretAccess(secret *models.Secret) *pb.Secret_Spec {
+	spec := &pb.Secret_Spec{}
+	if secret.WorkflowID != nil {
+		spec.Scope = &pb.Secret_Scope{CanvasId: secret.WorkflowID.String()}
+		if secret.NodeID != nil {
+			spec.Scope.NodeId = *secret.NodeID
+		}
+	}
+
+	if policy := secret.GetAccessPolicy(); policy != nil {
+		spec.AccessPolicy = &pb.Secret_AccessPolicy{
+			CanvasIds:  policy.Canvases,
+			Components: policy.Components,
+		}
+	}
+
+	return spec
+}
+
 func serializeLocalSecretData(ctx context.Context, encryptor crypto.Encryptor, secret models.Secret) (*pb.Secret_Local, error) {
 	data, err := encryptor.Decrypt(ctx, secret.Data, []byte(secret.Name))
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/superplanehq/superplane/test/support"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
 )
 
 func Test__DescribeSecret(t *testing.T) {
@@ -22,7 +23,7 @@ func Test__DescribeSecret(t *testing.T) {
 	encryptor := &crypto.NoOpEncryptor{}
 
 	t.Run("secret does not exist -> error", func(t *testing.T) {
-		_, err := DescribeSecret(context.Background(), encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), uuid.NewString())
+		_, err := DescribeSecret(context.Background(), encryptor, r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), uuid.NewString())
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
 		assert.Equal(t, codes.InvalidArgument, s.Code())
@@ -36,7 +37,7 @@ func Test__DescribeSecret(t *testing.T) {
 		_, err := models.CreateSecret("test", secrets.ProviderLocal, uuid.NewString(), models.DomainTypeOrganization, r.Organization.ID, data)
 		require.NoError(t, err)
 
-		response, err := DescribeSecret(context.Background(), encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), "test")
+		response, err := DescribeSecret(context.Background(), encryptor, r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), "test")
 		require.NoError(t, err)
 		require.NotNil(t, response)
 		require.NotNil(t, response.Secret)
@@ -48,4 +49,47 @@ func Test__DescribeSecret(t *testing.T) {
 		require.NotNil(t, response.Secret.Spec.Local)
 		require.Equal(t, map[string]string{"test": "***"}, response.Secret.Spec.Local.Data)
 	})
+
+	t.Run("secret referenced by canvas nodes", func(t *testing.T) {
+		secret, err := support.CreateSecret(t, r, map[string]string{"password": "hello"})
+		require.NoError(t, err)
+
+		nodes := []models.CanvasNode{
+			{
+				NodeID: "ssh-1",
+				Name:   "Run command",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "ssh"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{
+					"host":     "example.com",
+					"username": "root",
+					"command":  "echo hello",
+					"authentication": map[string]any{
+						"authMethod": "password",
+						"password":   map[string]any{"secret": secret.Name, "key": "password"},
+					},
+				}),
+			},
+			{
+				NodeID: "noop-1",
+				Name:   "Mentions the secret",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{
+					"description": secret.Name,
+				}),
+			},
+		}
+
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, []models.Edge{})
+
+		response, err := DescribeSecret(context.Background(), encryptor, r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), secret.Name)
+		require.NoError(t, err)
+		require.Len(t, response.References, 1)
+		assert.Equal(t, canvas.ID.String(), response.References[0].CanvasId)
+		assert.Equal(t, canvas.Name, response.References[0].CanvasName)
+		assert.Equal(t, "ssh-1", response.References[0].NodeId)
+		assert.Equal(t, "Run command", response.References[0].NodeName)
+		assert.Equal(t, "password", response.References[0].Key)
+	})
 }
@@ -0,0 +1,164 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const MaxSecretAccessEventsLimit = 100
+
+func ListSecretAccessEvents(ctx context.Context, domainType, domainID, idOrName string, limit uint32, before, after *timestamppb.Timestamp) (*pb.ListSecretAccessEventsResponse, error) {
+	organizationID := uuid.MustParse(domainID)
+	filter := models.SecretAccessEventFilter{}
+
+	//
+	// Events of deleted secrets are kept,
+	// so if the secret is not found, the events are looked up by name.
+	//
+	err := actions.ValidateUUIDs(idOrName)
+	if err != nil {
+		secret, err := models.FindSecretByName(domainType, organizationID, idOrName)
+		if err == nil {
+			filter.SecretID = &secret.ID
+		} else {
+			filter.SecretName = idOrName
+		}
+	} else {
+		secretID := uuid.MustParse(idOrName)
+		filter.SecretID = &secretID
+	}
+
+	if before != nil {
+		t := before.AsTime()
+		filter.Before = &t
+	}
+
+	if after != nil {
+		t := after.AsTime()
+		filter.After = &t
+	}
+
+	if limit == 0 || limit > MaxSecretAccessEventsLimit {
+		limit = MaxSecretAccessEventsLimit
+	}
+
+	events, err := models.ListSecretAccessEvents(organizationID, filter, int(limit)+1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error listing secret access events")
+	}
+
+	hasNextPage := len(events) > int(limit)
+	if hasNextPage {
+		events = events[:limit]
+	}
+
+	response := &pb.ListSecretAccessEventsResponse{
+		Events:      serializeSecretAccessEvents(events),
+		HasNextPage: hasNextPage,
+	}
+
+	if len(events) > 0 {
+		response.LastTimestamp = timestamppb.New(*events[len(events)-1].CreatedAt)
+	}
+
+	return response, nil
+}
+
+// recordSecretAccess records an API call on a secret, and on one of its keys, if keyName is set.
+// API calls never return secret values, so failing
+// to record one is logged, but does not fail the call.
+func recordSecretAccess(ctx context.Context, secret *models.Secret, action string, keyName string) {
+	userID, _ := authentication.GetUserIdFromMetadata(ctx)
+	event := models.NewSecretAccessEventForUser(secret, action, userID)
+	if keyName != "" {
+		event.KeyName = &keyName
+	}
+
+	err := event.Create()
+	if err != nil {
+		log.Errorf("failed to record %s of secret %s: %v", action, secret.ID, err)
+	}
+}
+
+func serializeSecretAccessEvents(events []models.SecretAccessEvent) []*pb.SecretAccessEvent {
+	result := make([]*pb.SecretAccessEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, serializeSecretAccessEvent(event))
+	}
+
+	return result
+}
+
+func serializeSecretAccessEvent(event models.SecretAccessEvent) *pb.SecretAccessEvent {
+	e := &pb.SecretAccessEvent{
+		Id:         event.ID.String(),
+		SecretId:   event.SecretID.String(),
+		SecretName: event.SecretName,
+		Action:     secretAccessActionToProto(event.Action),
+		ActorType:  secretAccessActorToProto(event.ActorType),
+		CreatedAt:  timestamppb.New(*event.CreatedAt),
+	}
+
+	if event.KeyName != nil {
+		e.KeyName = *event.KeyName
+	}
+
+	if event.ActorID != nil {
+		e.ActorId = event.ActorID.String()
+	}
+
+	if event.WorkflowID != nil {
+		e.CanvasId = event.WorkflowID.String()
+	}
+
+	if event.NodeID != nil {
+		e.NodeId = *event.NodeID
+	}
+
+	if event.ExecutionID != nil {
+		e.ExecutionId = event.ExecutionID.String()
+	}
+
+	return e
+}
+
+func secretAccessActionToProto(action string) pb.SecretAccessEvent_Action {
+	switch action {
+	case models.SecretAccessActionRead:
+		return pb.SecretAccessEvent_ACTION_READ
+	case models.SecretAccessActionDenied:
+		return pb.SecretAccessEvent_ACTION_DENIED
+	case models.SecretAccessActionDescribe:
+		return pb.SecretAccessEvent_ACTION_DESCRIBE
+	case models.SecretAccessActionCreate:
+		return pb.SecretAccessEvent_ACTION_CREATE
+	case models.SecretAccessActionUpdate:
+		return pb.SecretAccessEvent_ACTION_UPDATE
+	case models.SecretAccessActionDelete:
+		return pb.SecretAccessEvent_ACTION_DELETE
+	default:
+		return pb.SecretAccessEvent_ACTION_UNKNOWN
+	}
+}
+
+func secretAccessActorToProto(actorType string) pb.SecretAccessEvent_ActorType {
+	switch actorType {
+	case models.SecretAccessActorUser:
+		return pb.SecretAccessEvent_ACTOR_TYPE_USER
+	case models.SecretAccessActorServiceAccount:
+		return pb.SecretAccessEvent_ACTOR_TYPE_SERVICE_ACCOUNT
+	case models.SecretAccessActorExecution:
+		return pb.SecretAccessEvent_ACTOR_TYPE_EXECUTION
+	default:
+		return pb.SecretAccessEvent_ACTOR_TYPE_UNKNOWN
+	}
+}
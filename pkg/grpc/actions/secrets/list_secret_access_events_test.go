@@ -0,0 +1,85 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	protos "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test__ListSecretAccessEvents(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{})
+	encryptor := &crypto.NoOpEncryptor{}
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+
+	name := support.RandomName("secret")
+	created, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, orgID, &protos.Secret{
+		Metadata: &protos.Secret_Metadata{Name: name},
+		Spec: &protos.Secret_Spec{
+			Provider: protos.Secret_PROVIDER_LOCAL,
+			Local:    &protos.Secret_Local{Data: map[string]string{"password": "hello"}},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = DescribeSecret(ctx, encryptor, r.Registry, models.DomainTypeOrganization, orgID, name)
+	require.NoError(t, err)
+	_, err = SetSecretKey(ctx, encryptor, models.DomainTypeOrganization, orgID, name, "username", "root")
+	require.NoError(t, err)
+
+	t.Run("events are listed most recent first", func(t *testing.T) {
+		response, err := ListSecretAccessEvents(ctx, models.DomainTypeOrganization, orgID, name, 0, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, response.Events, 3)
+		assert.False(t, response.HasNextPage)
+
+		assert.Equal(t, protos.SecretAccessEvent_ACTION_UPDATE, response.Events[0].Action)
+		assert.Equal(t, "username", response.Events[0].KeyName)
+		assert.Equal(t, protos.SecretAccessEvent_ACTION_DESCRIBE, response.Events[1].Action)
+		assert.Equal(t, protos.SecretAccessEvent_ACTION_CREATE, response.Events[2].Action)
+
+		for _, event := range response.Events {
+			assert.Equal(t, created.Secret.Metadata.Id, event.SecretId)
+			assert.Equal(t, protos.SecretAccessEvent_ACTOR_TYPE_USER, event.ActorType)
+			assert.Equal(t, r.User.String(), event.ActorId)
+		}
+	})
+
+	t.Run("events are paginated", func(t *testing.T) {
+		response, err := ListSecretAccessEvents(ctx, models.DomainTypeOrganization, orgID, created.Secret.Metadata.Id, 2, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, response.Events, 2)
+		assert.True(t, response.HasNextPage)
+
+		response, err = ListSecretAccessEvents(ctx, models.DomainTypeOrganization, orgID, created.Secret.Metadata.Id, 2, response.LastTimestamp, nil)
+		require.NoError(t, err)
+		require.Len(t, response.Events, 1)
+		assert.False(t, response.HasNextPage)
+		assert.Equal(t, protos.SecretAccessEvent_ACTION_CREATE, response.Events[0].Action)
+	})
+
+	t.Run("events after a timestamp", func(t *testing.T) {
+		response, err := ListSecretAccessEvents(ctx, models.DomainTypeOrganization, orgID, name, 0, nil, timestamppb.New(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+		assert.Empty(t, response.Events)
+	})
+
+	t.Run("events of deleted secrets are listed by name", func(t *testing.T) {
+		_, err := DeleteSecret(ctx, models.DomainTypeOrganization, orgID, name)
+		require.NoError(t, err)
+
+		response, err := ListSecretAccessEvents(ctx, models.DomainTypeOrganization, orgID, name, 0, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, response.Events, 4)
+		assert.Equal(t, protos.SecretAccessEvent_ACTION_DELETE, response.Events[0].Action)
+	})
+}
@@ -20,6 +20,14 @@ func ListSecrets(ctx context.Context, encryptor crypto.Encryptor, domainType, do
 		return nil, err
 	}
 
+	//
+	// Listing returns the same keys as describing each secret,
+	// so it is recorded as a describe of every secret listed.
+	//
+	for _, secret := range secrets {
+		recordSecretAccess(ctx, &secret, models.SecretAccessActionDescribe, "")
+	}
+
 	return &pb.ListSecretsResponse{
 		Secrets: s,
 	}, nil
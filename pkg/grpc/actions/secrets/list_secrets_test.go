@@ -44,5 +44,10 @@ func Test__ListSecrets(t *testing.T) {
 		assert.Equal(t, protos.Secret_PROVIDER_LOCAL, secret.Spec.Provider)
 		require.NotNil(t, secret.Spec.Local)
 		require.Equal(t, map[string]string{"test": "***"}, secret.Spec.Local.Data)
+
+		events, err := ListSecretAccessEvents(context.Background(), models.DomainTypeOrganization, r.Organization.ID.String(), secret.Metadata.Id, 0, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		assert.Equal(t, protos.SecretAccessEvent_ACTION_DESCRIBE, events.Events[0].Action)
 	})
 }
@@ -47,11 +47,34 @@ func UpdateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType, d
 		return nil, err
 	}
 
+	//
+	// The scope and access policy are only replaced if they are set,
+	// so clients that do not know about them don't clear them on every update.
+	//
+	var access *models.SecretAccess
+	if spec.Spec.Scope != nil || spec.Spec.AccessPolicy != nil {
+		updated, err := protoToSecretAccess(domainID, updatedAccessSpec(secret, spec.Spec))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		access = &updated
+	}
+
 	secret, err = secret.UpdateData(data)
 	if err != nil {
 		return nil, err
 	}
 
+	if access != nil {
+		secret, err = secret.UpdateAccess(*access)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	recordSecretAccess(ctx, secret, models.SecretAccessActionUpdate, "")
+
 	s, err := serializeSecret(ctx, encryptor, *secret)
 	if err != nil {
 		return nil, err
@@ -59,3 +82,23 @@ func UpdateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType, d
 
 	return &pb.UpdateSecretResponse{Secret: s}, nil
 }
+
+// updatedAccessSpec fills the scope or access policy missing from the update
+// with the ones the secret already has.
+func updatedAccessSpec(secret *models.Secret, spec *pb.Secret_Spec) *pb.Secret_Spec {
+	current := serializeSecretAccess(secret)
+	updated := &pb.Secret_Spec{
+		Scope:        spec.Scope,
+		AccessPolicy: spec.AccessPolicy,
+	}
+
+	if updated.Scope == nil {
+		updated.Scope = current.Scope
+	}
+
+	if updated.AccessPolicy == nil {
+		updated.AccessPolicy = current.AccessPolicy
+	}
+
+	return updated
+}
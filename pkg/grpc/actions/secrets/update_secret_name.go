@@ -45,6 +45,8 @@ func UpdateSecretName(ctx context.Context, encryptor crypto.Encryptor, domainTyp
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	recordSecretAccess(ctx, updated, models.SecretAccessActionUpdate, "")
+
 	s, err := serializeSecret(ctx, encryptor, *updated)
 	if err != nil {
 		return nil, err
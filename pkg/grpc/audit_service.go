@@ -0,0 +1,20 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/grpc/actions/audit"
+	pb "github.com/superplanehq/superplane/pkg/protos/audit"
+)
+
+type AuditService struct {
+	pb.UnimplementedAuditServer
+}
+
+func NewAuditService() *AuditService {
+	return &AuditService{}
+}
+
+func (s *AuditService) ListAuditLogEntries(ctx context.Context, req *pb.ListAuditLogEntriesRequest) (*pb.ListAuditLogEntriesResponse, error) {
+	return audit.ListAuditLogEntries(ctx, req)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -16,14 +18,16 @@ import (
 type CanvasService struct {
 	registry       *registry.Registry
 	encryptor      crypto.Encryptor
+	artifacts      artifacts.Storage
 	authService    authorization.Authorization
 	webhookBaseURL string
 }
 
-func NewCanvasService(authService authorization.Authorization, registry *registry.Registry, encryptor crypto.Encryptor, webhookBaseURL string) *CanvasService {
+func NewCanvasService(authService authorization.Authorization, registry *registry.Registry, encryptor crypto.Encryptor, artifactStorage artifacts.Storage, webhookBaseURL string) *CanvasService {
 	return &CanvasService{
 		registry:       registry,
 		encryptor:      encryptor,
+		artifacts:      artifactStorage,
 		authService:    authService,
 		webhookBaseURL: webhookBaseURL,
 	}
@@ -52,7 +56,7 @@ func (s *CanvasService) UpdateCanvas(ctx context.Context, req *pb.UpdateCanvasRe
 		return nil, status.Error(codes.InvalidArgument, "canvas is required")
 	}
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
-	return canvases.UpdateCanvas(ctx, s.encryptor, s.registry, organizationID, req.Id, req.Canvas, s.webhookBaseURL)
+	return canvases.UpdateCanvas(ctx, s.encryptor, s.registry, organizationID, req.Id, req.Canvas, req.VersionMessage, s.webhookBaseURL)
 }
 
 func (s *CanvasService) DeleteCanvas(ctx context.Context, req *pb.DeleteCanvasRequest) (*pb.DeleteCanvasResponse, error) {
@@ -68,6 +72,10 @@ func (s *CanvasService) DeleteNodeQueueItem(ctx context.Context, req *pb.DeleteN
 	return canvases.DeleteNodeQueueItem(ctx, s.registry, req.CanvasId, req.NodeId, req.ItemId)
 }
 
+func (s *CanvasService) UpdateNodeQueueItem(ctx context.Context, req *pb.UpdateNodeQueueItemRequest) (*pb.UpdateNodeQueueItemResponse, error) {
+	return canvases.UpdateNodeQueueItem(ctx, s.registry, req.CanvasId, req.NodeId, req.ItemId, req.Priority)
+}
+
 func (s *CanvasService) UpdateNodePause(ctx context.Context, req *pb.UpdateNodePauseRequest) (*pb.UpdateNodePauseResponse, error) {
 	return canvases.UpdateNodePause(ctx, s.registry, req.CanvasId, req.NodeId, req.Paused)
 }
@@ -214,6 +222,72 @@ func (s *CanvasService) CancelExecution(ctx context.Context, req *pb.CancelExecu
 	return canvases.CancelExecution(ctx, s.authService, s.encryptor, organizationID, s.registry, canvasID, executionID)
 }
 
+func (s *CanvasService) ListExecutionArtifacts(ctx context.Context, req *pb.ListExecutionArtifactsRequest) (*pb.ListExecutionArtifactsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListExecutionArtifacts(ctx, organizationID, canvasID, executionID)
+}
+
+func (s *CanvasService) DownloadExecutionArtifact(ctx context.Context, req *pb.DownloadExecutionArtifactRequest) (*httpbody.HttpBody, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	artifactID, err := uuid.Parse(req.ArtifactId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid artifact_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DownloadExecutionArtifact(ctx, s.artifacts, organizationID, canvasID, executionID, artifactID)
+}
+
+func (s *CanvasService) GetExecutionLogs(ctx context.Context, req *pb.GetExecutionLogsRequest) (*pb.GetExecutionLogsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.GetExecutionLogs(ctx, organizationID, canvasID, executionID, req.After, req.Limit)
+}
+
+func (s *CanvasService) RerunExecution(ctx context.Context, req *pb.RerunExecutionRequest) (*pb.RerunExecutionResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+
+	return canvases.RerunExecution(ctx, organizationID, canvasID, executionID)
+}
+
 func (s *CanvasService) ResolveExecutionErrors(ctx context.Context, req *pb.ResolveExecutionErrorsRequest) (*pb.ResolveExecutionErrorsResponse, error) {
 	canvasID, err := uuid.Parse(req.CanvasId)
 	if err != nil {
@@ -231,3 +305,221 @@ func (s *CanvasService) ResolveExecutionErrors(ctx context.Context, req *pb.Reso
 
 	return canvases.ResolveExecutionErrors(ctx, canvasID, executionIDs)
 }
+
+func (s *CanvasService) ListCanvasVersions(ctx context.Context, req *pb.ListCanvasVersionsRequest) (*pb.ListCanvasVersionsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListCanvasVersions(ctx, organizationID, canvasID, req.Limit, req.Before)
+}
+
+func (s *CanvasService) DescribeCanvasVersion(ctx context.Context, req *pb.DescribeCanvasVersionRequest) (*pb.DescribeCanvasVersionResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	versionID, err := uuid.Parse(req.VersionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid version_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DescribeCanvasVersion(ctx, organizationID, canvasID, versionID)
+}
+
+func (s *CanvasService) DiffCanvasVersions(ctx context.Context, req *pb.DiffCanvasVersionsRequest) (*pb.DiffCanvasVersionsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	toVersionID, err := uuid.Parse(req.ToVersionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid to_version_id")
+	}
+
+	var fromVersionID *uuid.UUID
+	if req.FromVersionId != "" {
+		id, err := uuid.Parse(req.FromVersionId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid from_version_id")
+		}
+
+		fromVersionID = &id
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DiffCanvasVersions(ctx, organizationID, canvasID, toVersionID, fromVersionID)
+}
+
+func (s *CanvasService) RestoreCanvasVersion(ctx context.Context, req *pb.RestoreCanvasVersionRequest) (*pb.RestoreCanvasVersionResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	versionID, err := uuid.Parse(req.VersionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid version_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.RestoreCanvasVersion(ctx, s.encryptor, s.registry, organizationID, canvasID, versionID, s.webhookBaseURL)
+}
+
+func (s *CanvasService) DescribeCanvasDraft(ctx context.Context, req *pb.DescribeCanvasDraftRequest) (*pb.DescribeCanvasDraftResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DescribeCanvasDraft(ctx, organizationID, canvasID)
+}
+
+func (s *CanvasService) UpdateCanvasDraft(ctx context.Context, req *pb.UpdateCanvasDraftRequest) (*pb.UpdateCanvasDraftResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	if req.Canvas == nil {
+		return nil, status.Error(codes.InvalidArgument, "canvas is required")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasDraft(ctx, s.registry, organizationID, canvasID, req.Canvas)
+}
+
+func (s *CanvasService) DiscardCanvasDraft(ctx context.Context, req *pb.DiscardCanvasDraftRequest) (*pb.DiscardCanvasDraftResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DiscardCanvasDraft(ctx, organizationID, canvasID)
+}
+
+func (s *CanvasService) PublishCanvasDraft(ctx context.Context, req *pb.PublishCanvasDraftRequest) (*pb.PublishCanvasDraftResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.PublishCanvasDraft(ctx, s.authService, s.encryptor, s.registry, organizationID, canvasID, req.Message, req.ApprovalRole, s.webhookBaseURL)
+}
+
+func (s *CanvasService) ListCanvasChangeRequests(ctx context.Context, req *pb.ListCanvasChangeRequestsRequest) (*pb.ListCanvasChangeRequestsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListCanvasChangeRequests(ctx, organizationID, canvasID, req.States)
+}
+
+func (s *CanvasService) ApproveCanvasChangeRequest(ctx context.Context, req *pb.ApproveCanvasChangeRequestRequest) (*pb.ApproveCanvasChangeRequestResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	changeRequestID, err := uuid.Parse(req.ChangeRequestId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid change_request_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ApproveCanvasChangeRequest(ctx, s.authService, s.encryptor, s.registry, organizationID, canvasID, changeRequestID, s.webhookBaseURL)
+}
+
+func (s *CanvasService) RejectCanvasChangeRequest(ctx context.Context, req *pb.RejectCanvasChangeRequestRequest) (*pb.RejectCanvasChangeRequestResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	changeRequestID, err := uuid.Parse(req.ChangeRequestId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid change_request_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.RejectCanvasChangeRequest(ctx, s.authService, organizationID, canvasID, changeRequestID)
+}
+
+func (s *CanvasService) SimulateCanvas(ctx context.Context, req *pb.SimulateCanvasRequest) (*pb.SimulateCanvasResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	if req.NodeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "node_id is required")
+	}
+
+	var payload map[string]any
+	if req.Payload != nil {
+		payload = req.Payload.AsMap()
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.SimulateCanvas(ctx, s.registry, organizationID, canvasID, req.NodeId, req.Channel, payload)
+}
+
+func (s *CanvasService) ListCanvasVariables(ctx context.Context, req *pb.ListCanvasVariablesRequest) (*pb.ListCanvasVariablesResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListCanvasVariables(ctx, organizationID, canvasID)
+}
+
+func (s *CanvasService) SetCanvasVariable(ctx context.Context, req *pb.SetCanvasVariableRequest) (*pb.SetCanvasVariableResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.SetCanvasVariable(ctx, organizationID, canvasID, req.Name, req.Value, req.ExpectedVersion)
+}
+
+func (s *CanvasService) DeleteCanvasVariable(ctx context.Context, req *pb.DeleteCanvasVariableRequest) (*pb.DeleteCanvasVariableResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DeleteCanvasVariable(ctx, organizationID, canvasID, req.Name, req.ExpectedVersion)
+}
+
+func (s *CanvasService) DescribeCanvasRetention(ctx context.Context, req *pb.DescribeCanvasRetentionRequest) (*pb.DescribeCanvasRetentionResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.DescribeCanvasRetention(ctx, organizationID, canvasID)
+}
+
+func (s *CanvasService) UpdateCanvasRetentionPolicy(ctx context.Context, req *pb.UpdateCanvasRetentionPolicyRequest) (*pb.UpdateCanvasRetentionPolicyResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasRetentionPolicy(ctx, organizationID, canvasID, req.Policy)
+}
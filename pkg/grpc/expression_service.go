@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/superplanehq/superplane/pkg/grpc/actions/expressions"
+	pb "github.com/superplanehq/superplane/pkg/protos/expressions"
+)
+
+type ExpressionService struct{}
+
+func NewExpressionService() *ExpressionService {
+	return &ExpressionService{}
+}
+
+func (s *ExpressionService) ListExpressionFunctions(ctx context.Context, req *pb.ListExpressionFunctionsRequest) (*pb.ListExpressionFunctionsResponse, error) {
+	return expressions.ListExpressionFunctions(ctx, req.Category)
+}
@@ -88,6 +88,16 @@ func (s *OrganizationService) ResetInviteLink(ctx context.Context, req *pb.Reset
 	return organizations.ResetInviteLink(orgID)
 }
 
+func (s *OrganizationService) GetRetentionPolicy(ctx context.Context, req *pb.GetRetentionPolicyRequest) (*pb.GetRetentionPolicyResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetRetentionPolicy(orgID)
+}
+
+func (s *OrganizationService) UpdateRetentionPolicy(ctx context.Context, req *pb.UpdateRetentionPolicyRequest) (*pb.UpdateRetentionPolicyResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.UpdateRetentionPolicy(orgID, req.Policy)
+}
+
 func (s *OrganizationService) AcceptInviteLink(ctx context.Context, req *pb.InviteLink) (*structpb.Struct, error) {
 	accountID, err := accountIDFromContext(ctx)
 	if err != nil {
@@ -124,6 +134,7 @@ func (s *OrganizationService) CreateIntegration(ctx context.Context, req *pb.Cre
 		req.IntegrationName,
 		req.Name,
 		req.Configuration,
+		req.RateLimit,
 	)
 }
 
@@ -144,6 +155,7 @@ func (s *OrganizationService) UpdateIntegration(ctx context.Context, req *pb.Upd
 		req.IntegrationId,
 		configuration,
 		req.Name,
+		req.RateLimit,
 	)
 }
 
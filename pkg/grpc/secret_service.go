@@ -7,16 +7,19 @@ import (
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/secrets"
 	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/registry"
 )
 
 type SecretService struct {
 	encryptor            crypto.Encryptor
+	registry             *registry.Registry
 	authorizationService authorization.Authorization
 }
 
-func NewSecretService(encryptor crypto.Encryptor, authService authorization.Authorization) *SecretService {
+func NewSecretService(encryptor crypto.Encryptor, registry *registry.Registry, authService authorization.Authorization) *SecretService {
 	return &SecretService{
 		encryptor:            encryptor,
+		registry:             registry,
 		authorizationService: authService,
 	}
 }
@@ -36,7 +39,7 @@ func (s *SecretService) UpdateSecret(ctx context.Context, req *pb.UpdateSecretRe
 func (s *SecretService) DescribeSecret(ctx context.Context, req *pb.DescribeSecretRequest) (*pb.DescribeSecretResponse, error) {
 	domainType := ctx.Value(authorization.DomainTypeContextKey).(string)
 	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
-	return secrets.DescribeSecret(ctx, s.encryptor, domainType, domainId, req.IdOrName)
+	return secrets.DescribeSecret(ctx, s.encryptor, s.registry, domainType, domainId, req.IdOrName)
 }
 
 func (s *SecretService) ListSecrets(ctx context.Context, req *pb.ListSecretsRequest) (*pb.ListSecretsResponse, error) {
@@ -68,3 +71,9 @@ func (s *SecretService) UpdateSecretName(ctx context.Context, req *pb.UpdateSecr
 	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
 	return secrets.UpdateSecretName(ctx, s.encryptor, domainType, domainId, req.IdOrName, req.Name)
 }
+
+func (s *SecretService) ListSecretAccessEvents(ctx context.Context, req *pb.ListSecretAccessEventsRequest) (*pb.ListSecretAccessEventsResponse, error) {
+	domainType := ctx.Value(authorization.DomainTypeContextKey).(string)
+	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
+	return secrets.ListSecretAccessEvents(ctx, domainType, domainId, req.IdOrName, req.Limit, req.Before, req.After)
+}
@@ -1,21 +1,27 @@
 package grpc
 
 import (
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"runtime/debug"
 	"time"
 
 	"github.com/getsentry/sentry-go"
 	log "github.com/sirupsen/logrus"
+	"github.com/soheilhy/cmux"
 
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/oidc"
+	pbAudit "github.com/superplanehq/superplane/pkg/protos/audit"
 	pbBlueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
 	pbComponents "github.com/superplanehq/superplane/pkg/protos/components"
+	pbExpressions "github.com/superplanehq/superplane/pkg/protos/expressions"
 	pbGroups "github.com/superplanehq/superplane/pkg/protos/groups"
 	integrationpb "github.com/superplanehq/superplane/pkg/protos/integrations"
 	mepb "github.com/superplanehq/superplane/pkg/protos/me"
@@ -54,7 +60,7 @@ func sentryRecoveryHandler(p any) error {
 	return status.Errorf(codes.Internal, "internal server error")
 }
 
-func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry, oidcProvider oidc.Provider, port int) {
+func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry, artifactStorage artifacts.Storage, oidcProvider oidc.Provider, port int, metricsHandler http.Handler) {
 	endpoint := fmt.Sprintf("0.0.0.0:%d", port)
 	lis, err := net.Listen("tcp", endpoint)
 
@@ -69,10 +75,17 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 		recovery.WithRecoveryHandler(customFunc),
 	}
 
+	//
+	// The audit interceptor wraps the authorization one,
+	// so calls rejected by it are recorded too.
+	//
+	authorizationInterceptor := authorization.NewAuthorizationInterceptor(authService)
+
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			recovery.UnaryServerInterceptor(opts...),
-			authorization.NewAuthorizationInterceptor(authService).UnaryInterceptor(),
+			authorization.NewAuditInterceptor(authorizationInterceptor, registry).UnaryInterceptor(),
+			authorizationInterceptor.UnaryInterceptor(),
 			sanitizeErrorUnaryInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
@@ -101,7 +114,7 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	roleService := NewRoleService(authService)
 	pbRoles.RegisterRolesServer(grpcServer, roleService)
 
-	secretsService := NewSecretService(encryptor, authService)
+	secretsService := NewSecretService(encryptor, registry, authService)
 	secretPb.RegisterSecretsServer(grpcServer, secretsService)
 
 	meService := NewMeService()
@@ -116,10 +129,13 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	widgetService := NewWidgetService(registry)
 	widgetPb.RegisterWidgetsServer(grpcServer, widgetService)
 
+	expressionService := NewExpressionService()
+	pbExpressions.RegisterExpressionsServer(grpcServer, expressionService)
+
 	blueprintService := NewBlueprintService(registry)
 	pbBlueprints.RegisterBlueprintsServer(grpcServer, blueprintService)
 
-	canvasService := NewCanvasService(authService, registry, encryptor, webhooksBaseURL+basePath)
+	canvasService := NewCanvasService(authService, registry, encryptor, artifactStorage, webhooksBaseURL+basePath)
 	pbCanvases.RegisterCanvasesServer(grpcServer, canvasService)
 
 	integrationService := NewIntegrationService(encryptor, registry)
@@ -128,13 +144,53 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	serviceAccountsService := NewServiceAccountsService(authService)
 	pbServiceAccounts.RegisterServiceAccountsServer(grpcServer, serviceAccountsService)
 
+	auditService := NewAuditService()
+	pbAudit.RegisterAuditServer(grpcServer, auditService)
+
 	reflection.Register(grpcServer)
 
 	//
 	// Start handling incoming requests
 	//
-	log.Infof("Starting GRPC on %s.", endpoint)
-	err = grpcServer.Serve(lis)
+	if metricsHandler == nil {
+		log.Infof("Starting GRPC on %s.", endpoint)
+		err = grpcServer.Serve(lis)
+		if err != nil {
+			panic(err)
+		}
+
+		return
+	}
+
+	//
+	// gRPC clients always use HTTP/2, and Prometheus scrapes with HTTP/1,
+	// so the /metrics endpoint can share the port with the gRPC server.
+	//
+	mux := cmux.New(lis)
+	grpcListener := mux.Match(cmux.HTTP2())
+	httpListener := mux.Match(cmux.HTTP1Fast())
+
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/metrics", metricsHandler)
+	httpServer := &http.Server{
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		if err := httpServer.Serve(httpListener); err != nil && !errors.Is(err, cmux.ErrListenerClosed) {
+			log.Errorf("metrics server stopped: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			panic(err)
+		}
+	}()
+
+	log.Infof("Starting GRPC and metrics on %s.", endpoint)
+	err = mux.Serve()
 	if err != nil {
 		panic(err)
 	}
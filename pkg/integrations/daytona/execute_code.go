@@ -173,6 +173,8 @@ func (e *ExecuteCode) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to execute code: %v", err)
 	}
 
+	writeOutputToLog(ctx.Logs, response.Result)
+
 	return ctx.ExecutionState.Emit(
 		core.DefaultOutputChannel.Name,
 		ExecuteCodePayloadType,
@@ -2,6 +2,7 @@ package daytona
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -151,6 +152,8 @@ func (e *ExecuteCommand) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to execute command: %v", err)
 	}
 
+	writeOutputToLog(ctx.Logs, response.Result)
+
 	return ctx.ExecutionState.Emit(
 		core.DefaultOutputChannel.Name,
 		ExecuteCommandPayloadType,
@@ -158,6 +161,16 @@ func (e *ExecuteCommand) Execute(ctx core.ExecutionContext) error {
 	)
 }
 
+// The toolbox API only returns the output once the command finishes,
+// so it is written to the execution log all at once.
+func writeOutputToLog(logs core.LogsContext, output string) {
+	if logs == nil || output == "" {
+		return
+	}
+
+	_, _ = io.WriteString(logs.Writer(core.LogStreamStdout), output)
+}
+
 func (e *ExecuteCommand) Cancel(ctx core.ExecutionContext) error {
 	return nil
 }
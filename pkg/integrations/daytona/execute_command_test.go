@@ -98,6 +98,7 @@ func Test__ExecuteCommand__Execute(t *testing.T) {
 		}
 
 		execCtx := &contexts.ExecutionStateContext{}
+		logsCtx := &contexts.LogsContext{}
 		err := component.Execute(core.ExecutionContext{
 			Configuration: map[string]any{
 				"sandboxId": "sandbox-123",
@@ -106,6 +107,7 @@ func Test__ExecuteCommand__Execute(t *testing.T) {
 			HTTP:           httpContext,
 			Integration:    appCtx,
 			ExecutionState: execCtx,
+			Logs:           logsCtx,
 		})
 
 		require.NoError(t, err)
@@ -113,6 +115,7 @@ func Test__ExecuteCommand__Execute(t *testing.T) {
 		assert.True(t, execCtx.Passed)
 		assert.Equal(t, ExecuteCommandPayloadType, execCtx.Type)
 		require.Len(t, execCtx.Payloads, 1)
+		assert.Equal(t, "hello world", logsCtx.Streams[core.LogStreamStdout].String())
 	})
 
 	t.Run("command execution with working directory", func(t *testing.T) {
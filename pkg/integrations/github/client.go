@@ -10,7 +10,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/core"
 )
 
-func NewClient(ctx core.IntegrationContext, ghAppID int64, installationID string) (*github.Client, error) {
+func NewClient(httpCtx core.HTTPContext, ctx core.IntegrationContext, ghAppID int64, installationID string) (*github.Client, error) {
 	ID, err := strconv.Atoi(installationID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse installation ID: %v", err)
@@ -21,8 +21,13 @@ func NewClient(ctx core.IntegrationContext, ghAppID int64, installationID string
 		return nil, fmt.Errorf("failed to find PEM: %v", err)
 	}
 
+	var transport http.RoundTripper = http.DefaultTransport
+	if httpCtx != nil {
+		transport = &httpContextTransport{http: httpCtx}
+	}
+
 	itr, err := ghinstallation.New(
-		http.DefaultTransport,
+		transport,
 		ghAppID,
 		int64(ID),
 		[]byte(pem),
@@ -35,6 +40,17 @@ func NewClient(ctx core.IntegrationContext, ghAppID int64, installationID string
 	return github.NewClient(&http.Client{Transport: itr}), nil
 }
 
+// httpContextTransport sends the requests of the GitHub client
+// through the HTTP context, so they are subject to the same
+// restrictions and rate limits as any other integration request.
+type httpContextTransport struct {
+	http core.HTTPContext
+}
+
+func (t *httpContextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return t.http.Do(request)
+}
+
 func findSecret(ctx core.IntegrationContext, secretName string) (string, error) {
 	secrets, err := ctx.GetSecrets()
 	if err != nil {
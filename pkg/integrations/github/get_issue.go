@@ -126,7 +126,7 @@ func (c *GetIssue) Execute(ctx core.ExecutionContext) error {
 	}
 
 	// Initialize GitHub client
-	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	client, err := NewClient(ctx.HTTP, ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
 	if err != nil {
 		return fmt.Errorf("failed to initialize GitHub client: %w", err)
 	}
@@ -285,7 +285,7 @@ func (g *GitHub) handleInstallationEvent(ctx core.HTTPRequestContext, metadata M
 }
 
 func (g *GitHub) handleInstallationRepositoriesEvent(ctx core.HTTPRequestContext, metadata Metadata) {
-	client, err := NewClient(ctx.Integration, metadata.GitHubApp.ID, metadata.InstallationID)
+	client, err := NewClient(ctx.HTTP, ctx.Integration, metadata.GitHubApp.ID, metadata.InstallationID)
 	if err != nil {
 		ctx.Logger.Errorf("failed to create client: %v", err)
 		http.Error(ctx.Response, "internal server error", http.StatusInternalServerError)
@@ -418,7 +418,7 @@ func (g *GitHub) afterAppInstallation(ctx core.HTTPRequestContext, metadata Meta
 	}
 
 	metadata.InstallationID = installationID
-	client, err := NewClient(ctx.Integration, metadata.GitHubApp.ID, installationID)
+	client, err := NewClient(ctx.HTTP, ctx.Integration, metadata.GitHubApp.ID, installationID)
 	if err != nil {
 		ctx.Logger.Errorf("failed to create client: %v", err)
 		http.Error(ctx.Response, "internal server error", http.StatusInternalServerError)
@@ -80,7 +80,7 @@ func (h *GitHubWebhookHandler) Setup(ctx core.WebhookHandlerContext) (any, error
 		return nil, err
 	}
 
-	client, err := NewClient(ctx.Integration, metadata.GitHubApp.ID, metadata.InstallationID)
+	client, err := NewClient(ctx.HTTP, ctx.Integration, metadata.GitHubApp.ID, metadata.InstallationID)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +127,7 @@ func (h *GitHubWebhookHandler) Cleanup(ctx core.WebhookHandlerContext) error {
 		return err
 	}
 
-	client, err := NewClient(ctx.Integration, metadata.GitHubApp.ID, metadata.InstallationID)
+	client, err := NewClient(ctx.HTTP, ctx.Integration, metadata.GitHubApp.ID, metadata.InstallationID)
 	if err != nil {
 		return err
 	}
@@ -21,17 +21,17 @@ type Client struct {
 func NewClient(httpCtx core.HTTPContext, ctx core.IntegrationContext) (*Client, error) {
 	baseURL, err := ctx.GetConfig("baseUrl")
 	if err != nil {
-		return nil, fmt.Errorf("error getting baseUrl: %v", err)
+		return nil, fmt.Errorf("error getting baseUrl: %w", err)
 	}
 
 	email, err := ctx.GetConfig("email")
 	if err != nil {
-		return nil, fmt.Errorf("error getting email: %v", err)
+		return nil, fmt.Errorf("error getting email: %w", err)
 	}
 
 	apiToken, err := ctx.GetConfig("apiToken")
 	if err != nil {
-		return nil, fmt.Errorf("error getting apiToken: %v", err)
+		return nil, fmt.Errorf("error getting apiToken: %w", err)
 	}
 
 	return &Client{
@@ -50,7 +50,7 @@ func (c *Client) authHeader() string {
 func (c *Client) execRequest(method, url string, body io.Reader) ([]byte, error) {
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
-		return nil, fmt.Errorf("error building request: %v", err)
+		return nil, fmt.Errorf("error building request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -59,13 +59,13 @@ func (c *Client) execRequest(method, url string, body io.Reader) ([]byte, error)
 
 	res, err := c.http.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error executing request: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 	defer res.Body.Close()
 
 	responseBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading body: %v", err)
+		return nil, fmt.Errorf("error reading body: %w", err)
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
@@ -92,7 +92,7 @@ func (c *Client) GetCurrentUser() (*User, error) {
 
 	var user User
 	if err := json.Unmarshal(responseBody, &user); err != nil {
-		return nil, fmt.Errorf("error parsing user response: %v", err)
+		return nil, fmt.Errorf("error parsing user response: %w", err)
 	}
 
 	return &user, nil
@@ -115,7 +115,7 @@ func (c *Client) ListProjects() ([]Project, error) {
 
 	var projects []Project
 	if err := json.Unmarshal(responseBody, &projects); err != nil {
-		return nil, fmt.Errorf("error parsing projects response: %v", err)
+		return nil, fmt.Errorf("error parsing projects response: %w", err)
 	}
 
 	return projects, nil
@@ -139,7 +139,7 @@ func (c *Client) GetIssue(issueKey string) (*Issue, error) {
 
 	var issue Issue
 	if err := json.Unmarshal(responseBody, &issue); err != nil {
-		return nil, fmt.Errorf("error parsing issue response: %v", err)
+		return nil, fmt.Errorf("error parsing issue response: %w", err)
 	}
 
 	return &issue, nil
@@ -219,7 +219,7 @@ func (c *Client) CreateIssue(req *CreateIssueRequest) (*CreateIssueResponse, err
 
 	body, err := json.Marshal(req)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling request: %v", err)
+		return nil, fmt.Errorf("error marshaling request: %w", err)
 	}
 
 	responseBody, err := c.execRequest(http.MethodPost, url, bytes.NewReader(body))
@@ -229,7 +229,7 @@ func (c *Client) CreateIssue(req *CreateIssueRequest) (*CreateIssueResponse, err
 
 	var response CreateIssueResponse
 	if err := json.Unmarshal(responseBody, &response); err != nil {
-		return nil, fmt.Errorf("error parsing create issue response: %v", err)
+		return nil, fmt.Errorf("error parsing create issue response: %w", err)
 	}
 
 	return &response, nil
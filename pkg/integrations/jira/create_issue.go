@@ -113,7 +113,7 @@ func (c *CreateIssue) Configuration() []configuration.Field {
 func (c *CreateIssue) Setup(ctx core.SetupContext) error {
 	spec := CreateIssueSpec{}
 	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
-		return fmt.Errorf("failed to decode configuration: %v", err)
+		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
 	if spec.Project == "" {
@@ -130,12 +130,12 @@ func (c *CreateIssue) Setup(ctx core.SetupContext) error {
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("failed to create client: %v", err)
+		return fmt.Errorf("failed to create client: %w", err)
 	}
 
 	projects, err := client.ListProjects()
 	if err != nil {
-		return fmt.Errorf("failed to list projects: %v", err)
+		return fmt.Errorf("failed to list projects: %w", err)
 	}
 
 	var project *Project
@@ -156,12 +156,12 @@ func (c *CreateIssue) Setup(ctx core.SetupContext) error {
 func (c *CreateIssue) Execute(ctx core.ExecutionContext) error {
 	spec := CreateIssueSpec{}
 	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
-		return fmt.Errorf("failed to decode configuration: %v", err)
+		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("failed to create client: %v", err)
+		return fmt.Errorf("failed to create client: %w", err)
 	}
 
 	req := &CreateIssueRequest{
@@ -175,7 +175,7 @@ func (c *CreateIssue) Execute(ctx core.ExecutionContext) error {
 
 	response, err := client.CreateIssue(req)
 	if err != nil {
-		return fmt.Errorf("failed to create issue: %v", err)
+		return fmt.Errorf("failed to create issue: %w", err)
 	}
 
 	return ctx.ExecutionState.Emit(
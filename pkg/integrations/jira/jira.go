@@ -90,7 +90,7 @@ func (j *Jira) Sync(ctx core.SyncContext) error {
 	config := Configuration{}
 	err := mapstructure.Decode(ctx.Configuration, &config)
 	if err != nil {
-		return fmt.Errorf("failed to decode config: %v", err)
+		return fmt.Errorf("failed to decode config: %w", err)
 	}
 
 	if config.BaseURL == "" {
@@ -107,17 +107,17 @@ func (j *Jira) Sync(ctx core.SyncContext) error {
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	_, err = client.GetCurrentUser()
 	if err != nil {
-		return fmt.Errorf("error verifying credentials: %v", err)
+		return fmt.Errorf("error verifying credentials: %w", err)
 	}
 
 	projects, err := client.ListProjects()
 	if err != nil {
-		return fmt.Errorf("error listing projects: %v", err)
+		return fmt.Errorf("error listing projects: %w", err)
 	}
 
 	ctx.Integration.SetMetadata(Metadata{Projects: projects})
@@ -96,7 +96,7 @@ func (c *AnnotateIncident) Setup(ctx core.SetupContext) error {
 	spec := AnnotateIncidentSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	if spec.IncidentID == "" {
@@ -114,22 +114,22 @@ func (c *AnnotateIncident) Execute(ctx core.ExecutionContext) error {
 	spec := AnnotateIncidentSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	err = client.AddIncidentNote(spec.IncidentID, spec.FromEmail, spec.Content)
 	if err != nil {
-		return fmt.Errorf("failed to add note to incident: %v", err)
+		return fmt.Errorf("failed to add note to incident: %w", err)
 	}
 
 	incident, err := client.GetIncident(spec.IncidentID)
 	if err != nil {
-		return fmt.Errorf("failed to fetch incident: %v", err)
+		return fmt.Errorf("failed to fetch incident: %w", err)
 	}
 
 	return ctx.ExecutionState.Emit(
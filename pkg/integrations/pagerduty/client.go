@@ -20,7 +20,7 @@ type Client struct {
 func NewClient(http core.HTTPContext, ctx core.IntegrationContext) (*Client, error) {
 	authType, err := ctx.GetConfig("authType")
 	if err != nil {
-		return nil, fmt.Errorf("error finding auth type: %v", err)
+		return nil, fmt.Errorf("error finding auth type: %w", err)
 	}
 
 	switch string(authType) {
@@ -40,7 +40,7 @@ func NewClient(http core.HTTPContext, ctx core.IntegrationContext) (*Client, err
 	case AuthTypeAppOAuth:
 		secrets, err := ctx.GetSecrets()
 		if err != nil {
-			return nil, fmt.Errorf("failed to get secrets: %v", err)
+			return nil, fmt.Errorf("failed to get secrets: %w", err)
 		}
 
 		var accessToken string
@@ -69,7 +69,7 @@ func NewClient(http core.HTTPContext, ctx core.IntegrationContext) (*Client, err
 func (c *Client) execRequest(method, url string, body io.Reader) ([]byte, error) {
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
-		return nil, fmt.Errorf("error building request: %v", err)
+		return nil, fmt.Errorf("error building request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -83,13 +83,13 @@ func (c *Client) execRequest(method, url string, body io.Reader) ([]byte, error)
 
 	res, err := c.http.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error executing request: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 	defer res.Body.Close()
 
 	responseBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading body: %v", err)
+		return nil, fmt.Errorf("error reading body: %w", err)
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
@@ -143,14 +143,14 @@ func (c *Client) CreateIncident(title, service, urgency, description, fromEmail
 
 	body, err := json.Marshal(request)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling request: %v", err)
+		return nil, fmt.Errorf("error marshaling request: %w", err)
 	}
 
 	url := fmt.Sprintf("%s/incidents", c.BaseURL)
 
 	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
-		return nil, fmt.Errorf("error building request: %v", err)
+		return nil, fmt.Errorf("error building request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -168,13 +168,13 @@ func (c *Client) CreateIncident(title, service, urgency, description, fromEmail
 
 	res, err := c.http.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error executing request: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 	defer res.Body.Close()
 
 	responseBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading body: %v", err)
+		return nil, fmt.Errorf("error reading body: %w", err)
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
@@ -184,7 +184,7 @@ func (c *Client) CreateIncident(title, service, urgency, description, fromEmail
 	var response map[string]any
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response, nil
@@ -284,14 +284,14 @@ func (c *Client) UpdateIncident(
 
 	body, err := json.Marshal(request)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling request: %v", err)
+		return nil, fmt.Errorf("error marshaling request: %w", err)
 	}
 
 	url := fmt.Sprintf("%s/incidents/%s", c.BaseURL, incidentID)
 
 	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(body))
 	if err != nil {
-		return nil, fmt.Errorf("error building request: %v", err)
+		return nil, fmt.Errorf("error building request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -309,13 +309,13 @@ func (c *Client) UpdateIncident(
 
 	res, err := c.http.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error executing request: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 	defer res.Body.Close()
 
 	responseBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading body: %v", err)
+		return nil, fmt.Errorf("error reading body: %w", err)
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
@@ -325,7 +325,7 @@ func (c *Client) UpdateIncident(
 	var response map[string]any
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response, nil
@@ -348,14 +348,14 @@ func (c *Client) AddIncidentNote(incidentID string, fromEmail string, content st
 
 	body, err := json.Marshal(request)
 	if err != nil {
-		return fmt.Errorf("error marshaling request: %v", err)
+		return fmt.Errorf("error marshaling request: %w", err)
 	}
 
 	url := fmt.Sprintf("%s/incidents/%s/notes", c.BaseURL, incidentID)
 
 	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
-		return fmt.Errorf("error building request: %v", err)
+		return fmt.Errorf("error building request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -373,13 +373,13 @@ func (c *Client) AddIncidentNote(incidentID string, fromEmail string, content st
 
 	res, err := c.http.Do(req)
 	if err != nil {
-		return fmt.Errorf("error executing request: %v", err)
+		return fmt.Errorf("error executing request: %w", err)
 	}
 	defer res.Body.Close()
 
 	responseBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return fmt.Errorf("error reading body: %v", err)
+		return fmt.Errorf("error reading body: %w", err)
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
@@ -399,7 +399,7 @@ func (c *Client) GetIncident(incidentID string) (any, error) {
 	var response map[string]any
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response, nil
@@ -433,7 +433,7 @@ func (c *Client) CreateWebhookSubscription(url string, events []string, filter W
 
 	body, err := json.Marshal(subscription)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling request: %v", err)
+		return nil, fmt.Errorf("error marshaling request: %w", err)
 	}
 
 	responseBody, err := c.execRequest(http.MethodPost, apiURL, bytes.NewReader(body))
@@ -447,7 +447,7 @@ func (c *Client) CreateWebhookSubscription(url string, events []string, filter W
 
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return &response.WebhookSubscription, nil
@@ -478,7 +478,7 @@ func (c *Client) ListServices() ([]Service, error) {
 
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response.Services, nil
@@ -497,7 +497,7 @@ func (c *Client) GetService(id string) (*Service, error) {
 
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return &response.Service, nil
@@ -522,7 +522,7 @@ func (c *Client) ListPriorities() ([]Priority, error) {
 
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response.Priorities, nil
@@ -548,7 +548,7 @@ func (c *Client) ListUsers() ([]User, error) {
 
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response.Users, nil
@@ -573,7 +573,7 @@ func (c *Client) ListEscalationPolicies() ([]EscalationPolicy, error) {
 
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response.EscalationPolicies, nil
@@ -645,7 +645,7 @@ func (c *Client) ListIncidents(serviceIDs []string) ([]Incident, error) {
 
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response.Incidents, nil
@@ -662,14 +662,14 @@ func (c *Client) SnoozeIncident(incidentID string, fromEmail string, duration in
 
 	body, err := json.Marshal(request)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling request: %v", err)
+		return nil, fmt.Errorf("error marshaling request: %w", err)
 	}
 
 	url := fmt.Sprintf("%s/incidents/%s/snooze", c.BaseURL, incidentID)
 
 	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
-		return nil, fmt.Errorf("error building request: %v", err)
+		return nil, fmt.Errorf("error building request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -687,13 +687,13 @@ func (c *Client) SnoozeIncident(incidentID string, fromEmail string, duration in
 
 	res, err := c.http.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error executing request: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 	defer res.Body.Close()
 
 	responseBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading body: %v", err)
+		return nil, fmt.Errorf("error reading body: %w", err)
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
@@ -703,7 +703,7 @@ func (c *Client) SnoozeIncident(incidentID string, fromEmail string, duration in
 	var response map[string]any
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response, nil
@@ -737,7 +737,7 @@ func (c *Client) ListIncidentNotes(incidentID string) ([]Note, error) {
 
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response.Notes, nil
@@ -772,7 +772,7 @@ func (c *Client) ListIncidentLogEntries(incidentID string, limit int) ([]LogEntr
 
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 
 	return response.LogEntries, nil
@@ -133,7 +133,7 @@ func (c *CreateIncident) Setup(ctx core.SetupContext) error {
 	spec := CreateIncidentSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	if spec.Title == "" {
@@ -150,12 +150,12 @@ func (c *CreateIncident) Setup(ctx core.SetupContext) error {
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	service, err := client.GetService(spec.Service)
 	if err != nil {
-		return fmt.Errorf("error finding service: %v", err)
+		return fmt.Errorf("error finding service: %w", err)
 	}
 
 	return ctx.Metadata.Set(NodeMetadata{Service: service})
@@ -165,17 +165,17 @@ func (c *CreateIncident) Execute(ctx core.ExecutionContext) error {
 	spec := CreateIncidentSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	incident, err := client.CreateIncident(spec.Title, spec.Service, spec.Urgency, spec.Description, spec.FromEmail)
 	if err != nil {
-		return fmt.Errorf("failed to create incident: %v", err)
+		return fmt.Errorf("failed to create incident: %w", err)
 	}
 
 	return ctx.ExecutionState.Emit(
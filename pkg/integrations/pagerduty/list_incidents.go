@@ -121,7 +121,7 @@ func (l *ListIncidents) Setup(ctx core.SetupContext) error {
 	spec := ListIncidentsSpec{}
 	err = mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	// If no services are specified in configuration, we don't need to fetch metadata
@@ -153,18 +153,18 @@ func (l *ListIncidents) Execute(ctx core.ExecutionContext) error {
 	spec := ListIncidentsSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	// List incidents, optionally filtered by services
 	incidents, err := client.ListIncidents(spec.Services)
 	if err != nil {
-		return fmt.Errorf("failed to list incidents: %v", err)
+		return fmt.Errorf("failed to list incidents: %w", err)
 	}
 
 	// Determine the output channel based on whether incidents exist
@@ -95,7 +95,7 @@ func (l *ListLogEntries) Setup(ctx core.SetupContext) error {
 	spec := ListLogEntriesSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	if spec.IncidentID == "" {
@@ -109,7 +109,7 @@ func (l *ListLogEntries) Execute(ctx core.ExecutionContext) error {
 	spec := ListLogEntriesSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	limit := spec.Limit
@@ -119,12 +119,12 @@ func (l *ListLogEntries) Execute(ctx core.ExecutionContext) error {
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	logEntries, err := client.ListIncidentLogEntries(spec.IncidentID, limit)
 	if err != nil {
-		return fmt.Errorf("failed to list log entries: %v", err)
+		return fmt.Errorf("failed to list log entries: %w", err)
 	}
 
 	responseData := map[string]any{
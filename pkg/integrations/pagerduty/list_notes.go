@@ -82,7 +82,7 @@ func (l *ListNotes) Setup(ctx core.SetupContext) error {
 	spec := ListNotesSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	if spec.IncidentID == "" {
@@ -96,17 +96,17 @@ func (l *ListNotes) Execute(ctx core.ExecutionContext) error {
 	spec := ListNotesSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	notes, err := client.ListIncidentNotes(spec.IncidentID)
 	if err != nil {
-		return fmt.Errorf("failed to list notes: %v", err)
+		return fmt.Errorf("failed to list notes: %w", err)
 	}
 
 	responseData := map[string]any{
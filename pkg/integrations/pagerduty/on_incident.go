@@ -124,7 +124,7 @@ func (t *OnIncident) Setup(ctx core.TriggerContext) error {
 	metadata := NodeMetadata{}
 	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
 	if err != nil {
-		return fmt.Errorf("failed to decode metadata: %v", err)
+		return fmt.Errorf("failed to decode metadata: %w", err)
 	}
 
 	//
@@ -150,17 +150,17 @@ func (t *OnIncident) Setup(ctx core.TriggerContext) error {
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	service, err := client.GetService(config.Service)
 	if err != nil {
-		return fmt.Errorf("error finding service: %v", err)
+		return fmt.Errorf("error finding service: %w", err)
 	}
 
 	err = ctx.Metadata.Set(NodeMetadata{Service: service})
 	if err != nil {
-		return fmt.Errorf("error setting node metadata: %v", err)
+		return fmt.Errorf("error setting node metadata: %w", err)
 	}
 
 	return ctx.Integration.RequestWebhook(WebhookConfiguration{
@@ -201,19 +201,19 @@ func (t *OnIncident) HandleWebhook(ctx core.WebhookRequestContext) (int, error)
 
 	secret, err := ctx.Webhook.GetSecret()
 	if err != nil {
-		return http.StatusInternalServerError, fmt.Errorf("error getting secret: %v", err)
+		return http.StatusInternalServerError, fmt.Errorf("error getting secret: %w", err)
 	}
 
 	// Verify signature using HMAC SHA256
 	if err := crypto.VerifySignature(secret, ctx.Body, parts[1]); err != nil {
-		return http.StatusForbidden, fmt.Errorf("invalid signature: %v", err)
+		return http.StatusForbidden, fmt.Errorf("invalid signature: %w", err)
 	}
 
 	// Parse webhook payload
 	var webhook Webhook
 	err = json.Unmarshal(ctx.Body, &webhook)
 	if err != nil {
-		return http.StatusBadRequest, fmt.Errorf("error parsing request body: %v", err)
+		return http.StatusBadRequest, fmt.Errorf("error parsing request body: %w", err)
 	}
 
 	eventType := webhook.Event.EventType
@@ -236,7 +236,7 @@ func (t *OnIncident) HandleWebhook(ctx core.WebhookRequestContext) (int, error)
 	)
 
 	if err != nil {
-		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
+		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %w", err)
 	}
 
 	return http.StatusOK, nil
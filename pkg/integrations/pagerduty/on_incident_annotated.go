@@ -96,7 +96,7 @@ func (t *OnIncidentAnnotated) Setup(ctx core.TriggerContext) error {
 	metadata := NodeMetadata{}
 	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
 	if err != nil {
-		return fmt.Errorf("failed to decode metadata: %v", err)
+		return fmt.Errorf("failed to decode metadata: %w", err)
 	}
 
 	//
@@ -118,17 +118,17 @@ func (t *OnIncidentAnnotated) Setup(ctx core.TriggerContext) error {
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	service, err := client.GetService(config.Service)
 	if err != nil {
-		return fmt.Errorf("error finding service: %v", err)
+		return fmt.Errorf("error finding service: %w", err)
 	}
 
 	err = ctx.Metadata.Set(NodeMetadata{Service: service})
 	if err != nil {
-		return fmt.Errorf("error setting node metadata: %v", err)
+		return fmt.Errorf("error setting node metadata: %w", err)
 	}
 
 	return ctx.Integration.RequestWebhook(WebhookConfiguration{
@@ -168,13 +168,13 @@ func (t *OnIncidentAnnotated) HandleWebhook(ctx core.WebhookRequestContext) (int
 	secret, err := ctx.Webhook.GetSecret()
 	if err != nil {
 		log.Printf("[OnIncidentAnnotated] Error getting secret: %v", err)
-		return http.StatusInternalServerError, fmt.Errorf("error getting secret: %v", err)
+		return http.StatusInternalServerError, fmt.Errorf("error getting secret: %w", err)
 	}
 
 	// Verify signature using HMAC SHA256
 	if err := crypto.VerifySignature(secret, ctx.Body, parts[1]); err != nil {
 		log.Printf("[OnIncidentAnnotated] Invalid signature: %v", err)
-		return http.StatusForbidden, fmt.Errorf("invalid signature: %v", err)
+		return http.StatusForbidden, fmt.Errorf("invalid signature: %w", err)
 	}
 
 	log.Printf("[OnIncidentAnnotated] Signature verified successfully")
@@ -184,7 +184,7 @@ func (t *OnIncidentAnnotated) HandleWebhook(ctx core.WebhookRequestContext) (int
 	err = json.Unmarshal(ctx.Body, &webhook)
 	if err != nil {
 		log.Printf("[OnIncidentAnnotated] Error parsing request body: %v", err)
-		return http.StatusBadRequest, fmt.Errorf("error parsing request body: %v", err)
+		return http.StatusBadRequest, fmt.Errorf("error parsing request body: %w", err)
 	}
 
 	eventType := webhook.Event.EventType
@@ -203,7 +203,7 @@ func (t *OnIncidentAnnotated) HandleWebhook(ctx core.WebhookRequestContext) (int
 	err = mapstructure.Decode(ctx.Configuration, &config)
 	if err != nil {
 		log.Printf("[OnIncidentAnnotated] Error decoding configuration: %v", err)
-		return http.StatusInternalServerError, fmt.Errorf("error decoding configuration: %v", err)
+		return http.StatusInternalServerError, fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	// Extract annotation content from the log_entries or directly from data
@@ -215,7 +215,7 @@ func (t *OnIncidentAnnotated) HandleWebhook(ctx core.WebhookRequestContext) (int
 		matched, err := regexp.MatchString(config.ContentFilter, annotationContent)
 		if err != nil {
 			log.Printf("[OnIncidentAnnotated] Error matching content filter: %v", err)
-			return http.StatusInternalServerError, fmt.Errorf("invalid content filter regex: %v", err)
+			return http.StatusInternalServerError, fmt.Errorf("invalid content filter regex: %w", err)
 		}
 		if !matched {
 			log.Printf("[OnIncidentAnnotated] Content does not match filter, skipping event")
@@ -236,7 +236,7 @@ func (t *OnIncidentAnnotated) HandleWebhook(ctx core.WebhookRequestContext) (int
 
 	if err != nil {
 		log.Printf("[OnIncidentAnnotated] Error emitting event: %v", err)
-		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
+		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %w", err)
 	}
 
 	log.Printf("[OnIncidentAnnotated] Event emitted successfully")
@@ -89,7 +89,7 @@ func (t *OnIncidentStatusUpdate) Setup(ctx core.TriggerContext) error {
 	metadata := NodeMetadata{}
 	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
 	if err != nil {
-		return fmt.Errorf("failed to decode metadata: %v", err)
+		return fmt.Errorf("failed to decode metadata: %w", err)
 	}
 
 	//
@@ -111,17 +111,17 @@ func (t *OnIncidentStatusUpdate) Setup(ctx core.TriggerContext) error {
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	service, err := client.GetService(config.Service)
 	if err != nil {
-		return fmt.Errorf("error finding service: %v", err)
+		return fmt.Errorf("error finding service: %w", err)
 	}
 
 	err = ctx.Metadata.Set(NodeMetadata{Service: service})
 	if err != nil {
-		return fmt.Errorf("error setting node metadata: %v", err)
+		return fmt.Errorf("error setting node metadata: %w", err)
 	}
 
 	return ctx.Integration.RequestWebhook(WebhookConfiguration{
@@ -161,13 +161,13 @@ func (t *OnIncidentStatusUpdate) HandleWebhook(ctx core.WebhookRequestContext) (
 	secret, err := ctx.Webhook.GetSecret()
 	if err != nil {
 		log.Printf("[OnIncidentStatusUpdate] Error getting secret: %v", err)
-		return http.StatusInternalServerError, fmt.Errorf("error getting secret: %v", err)
+		return http.StatusInternalServerError, fmt.Errorf("error getting secret: %w", err)
 	}
 
 	// Verify signature using HMAC SHA256
 	if err := crypto.VerifySignature(secret, ctx.Body, parts[1]); err != nil {
 		log.Printf("[OnIncidentStatusUpdate] Invalid signature: %v", err)
-		return http.StatusForbidden, fmt.Errorf("invalid signature: %v", err)
+		return http.StatusForbidden, fmt.Errorf("invalid signature: %w", err)
 	}
 
 	log.Printf("[OnIncidentStatusUpdate] Signature verified successfully")
@@ -177,7 +177,7 @@ func (t *OnIncidentStatusUpdate) HandleWebhook(ctx core.WebhookRequestContext) (
 	err = json.Unmarshal(ctx.Body, &webhook)
 	if err != nil {
 		log.Printf("[OnIncidentStatusUpdate] Error parsing request body: %v", err)
-		return http.StatusBadRequest, fmt.Errorf("error parsing request body: %v", err)
+		return http.StatusBadRequest, fmt.Errorf("error parsing request body: %w", err)
 	}
 
 	eventType := webhook.Event.EventType
@@ -205,7 +205,7 @@ func (t *OnIncidentStatusUpdate) HandleWebhook(ctx core.WebhookRequestContext) (
 
 	if err != nil {
 		log.Printf("[OnIncidentStatusUpdate] Error emitting event: %v", err)
-		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
+		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %w", err)
 	}
 
 	log.Printf("[OnIncidentStatusUpdate] Event emitted successfully")
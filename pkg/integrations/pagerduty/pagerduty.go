@@ -160,7 +160,7 @@ func (p *PagerDuty) Sync(ctx core.SyncContext) error {
 	configuration := Configuration{}
 	err := mapstructure.Decode(ctx.Configuration, &configuration)
 	if err != nil {
-		return fmt.Errorf("failed to decode config: %v", err)
+		return fmt.Errorf("failed to decode config: %w", err)
 	}
 
 	if configuration.Region == "" {
@@ -182,7 +182,7 @@ func (p *PagerDuty) Sync(ctx core.SyncContext) error {
 	metadata := Metadata{}
 	err = mapstructure.Decode(ctx.Integration.GetMetadata(), &metadata)
 	if err != nil {
-		return fmt.Errorf("failed to decode metadata: %v", err)
+		return fmt.Errorf("failed to decode metadata: %w", err)
 	}
 
 	//
@@ -198,12 +198,12 @@ func (p *PagerDuty) Sync(ctx core.SyncContext) error {
 func (p *PagerDuty) apiTokenSync(ctx core.SyncContext) error {
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	services, err := client.ListServices()
 	if err != nil {
-		return fmt.Errorf("error listing services: %v", err)
+		return fmt.Errorf("error listing services: %w", err)
 	}
 
 	ctx.Integration.SetMetadata(Metadata{Services: services})
@@ -246,13 +246,13 @@ func (p *PagerDuty) appOAuthSync(ctx core.SyncContext, configuration Configurati
 
 	r, err := http.NewRequest(http.MethodPost, "https://identity.pagerduty.com/oauth/token", strings.NewReader(data.Encode()))
 	if err != nil {
-		return fmt.Errorf("error creating request: %v", err)
+		return fmt.Errorf("error creating request: %w", err)
 	}
 
 	r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	resp, err := ctx.HTTP.Do(r)
 	if err != nil {
-		return fmt.Errorf("error executing request: %v", err)
+		return fmt.Errorf("error executing request: %w", err)
 	}
 
 	defer resp.Body.Close()
@@ -263,13 +263,13 @@ func (p *PagerDuty) appOAuthSync(ctx core.SyncContext, configuration Configurati
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("error reading response body: %v", err)
+		return fmt.Errorf("error reading response body: %w", err)
 	}
 
 	var tokenResponse TokenResponse
 	err = json.Unmarshal(body, &tokenResponse)
 	if err != nil {
-		return fmt.Errorf("error unmarshaling response: %v", err)
+		return fmt.Errorf("error unmarshaling response: %w", err)
 	}
 
 	err = ctx.Integration.SetSecret(AppAccessToken, []byte(tokenResponse.AccessToken))
@@ -287,7 +287,7 @@ func (p *PagerDuty) appOAuthSync(ctx core.SyncContext, configuration Configurati
 
 	services, err := client.ListServices()
 	if err != nil {
-		return fmt.Errorf("error determing abilities: %v", err)
+		return fmt.Errorf("error determing abilities: %w", err)
 	}
 
 	ctx.Integration.SetMetadata(Metadata{Services: services})
@@ -113,7 +113,7 @@ func (c *SnoozeIncident) Setup(ctx core.SetupContext) error {
 	spec := SnoozeIncidentSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	if spec.IncidentID == "" {
@@ -126,7 +126,7 @@ func (c *SnoozeIncident) Setup(ctx core.SetupContext) error {
 
 	_, err = strconv.Atoi(spec.Duration)
 	if err != nil {
-		return fmt.Errorf("invalid duration: %v", err)
+		return fmt.Errorf("invalid duration: %w", err)
 	}
 
 	return ctx.Metadata.Set(NodeMetadata{})
@@ -136,22 +136,22 @@ func (c *SnoozeIncident) Execute(ctx core.ExecutionContext) error {
 	spec := SnoozeIncidentSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	duration, err := strconv.Atoi(spec.Duration)
 	if err != nil {
-		return fmt.Errorf("invalid duration: %v", err)
+		return fmt.Errorf("invalid duration: %w", err)
 	}
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	incident, err := client.SnoozeIncident(spec.IncidentID, spec.FromEmail, duration)
 	if err != nil {
-		return fmt.Errorf("failed to snooze incident: %v", err)
+		return fmt.Errorf("failed to snooze incident: %w", err)
 	}
 
 	return ctx.ExecutionState.Emit(
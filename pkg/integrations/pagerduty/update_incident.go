@@ -167,7 +167,7 @@ func (c *UpdateIncident) Setup(ctx core.SetupContext) error {
 	spec := UpdateIncidentSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	if spec.IncidentID == "" {
@@ -191,12 +191,12 @@ func (c *UpdateIncident) Execute(ctx core.ExecutionContext) error {
 	spec := UpdateIncidentSpec{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
-		return fmt.Errorf("error decoding configuration: %v", err)
+		return fmt.Errorf("error decoding configuration: %w", err)
 	}
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return fmt.Errorf("error creating client: %w", err)
 	}
 
 	incident, err := client.UpdateIncident(
@@ -210,7 +210,7 @@ func (c *UpdateIncident) Execute(ctx core.ExecutionContext) error {
 		spec.Assignees,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to update incident: %v", err)
+		return fmt.Errorf("failed to update incident: %w", err)
 	}
 
 	return ctx.ExecutionState.Emit(
@@ -88,7 +88,7 @@ func (h *PagerDutyWebhookHandler) Setup(ctx core.WebhookHandlerContext) (any, er
 	configuration := WebhookConfiguration{}
 	err = mapstructure.Decode(ctx.Webhook.GetConfiguration(), &configuration)
 	if err != nil {
-		return nil, fmt.Errorf("error decoding webhook configuration: %v", err)
+		return nil, fmt.Errorf("error decoding webhook configuration: %w", err)
 	}
 
 	//
@@ -98,12 +98,12 @@ func (h *PagerDutyWebhookHandler) Setup(ctx core.WebhookHandlerContext) (any, er
 	//
 	subscription, err := client.CreateWebhookSubscription(ctx.Webhook.GetURL(), configuration.Events, configuration.Filter)
 	if err != nil {
-		return nil, fmt.Errorf("error creating webhook subscription: %v", err)
+		return nil, fmt.Errorf("error creating webhook subscription: %w", err)
 	}
 
 	err = ctx.Webhook.SetSecret([]byte(subscription.DeliveryMethod.Secret))
 	if err != nil {
-		return nil, fmt.Errorf("error updating webhook secret: %v", err)
+		return nil, fmt.Errorf("error updating webhook secret: %w", err)
 	}
 
 	return WebhookMetadata{
@@ -115,7 +115,7 @@ func (h *PagerDutyWebhookHandler) Cleanup(ctx core.WebhookHandlerContext) error
 	metadata := WebhookMetadata{}
 	err := mapstructure.Decode(ctx.Webhook.GetMetadata(), &metadata)
 	if err != nil {
-		return fmt.Errorf("error decoding webhook metadata: %v", err)
+		return fmt.Errorf("error decoding webhook metadata: %w", err)
 	}
 
 	client, err := NewClient(ctx.HTTP, ctx.Integration)
@@ -125,7 +125,7 @@ func (h *PagerDutyWebhookHandler) Cleanup(ctx core.WebhookHandlerContext) error
 
 	err = client.DeleteWebhookSubscription(metadata.SubscriptionID)
 	if err != nil {
-		return fmt.Errorf("error deleting webhook subscription: %v", err)
+		return fmt.Errorf("error deleting webhook subscription: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+//
+// ArtifactUpload records that an object was written to the artifact storage.
+// It is created outside of the transaction creating the artifact row,
+// so objects are not lost track of if that transaction rolls back.
+//
+
+type ArtifactUpload struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Storage    string
+	StorageKey string
+	CreatedAt  *time.Time
+}
+
+func (u *ArtifactUpload) TableName() string {
+	return "artifact_uploads"
+}
+
+func CreateArtifactUpload(storage, storageKey string) error {
+	now := time.Now()
+	return database.Conn().Create(&ArtifactUpload{
+		Storage:    storage,
+		StorageKey: storageKey,
+		CreatedAt:  &now,
+	}).Error
+}
+
+// StaleArtifactUpload is an upload old enough for the transaction
+// that created it to be done, and whether an artifact row references its object.
+type StaleArtifactUpload struct {
+	ID         uuid.UUID
+	StorageKey string
+	Referenced bool
+}
+
+// ListStaleArtifactUploads returns the uploads to a storage created before the given time, oldest first.
+func ListStaleArtifactUploads(storage string, before time.Time, limit int) ([]StaleArtifactUpload, error) {
+	var uploads []StaleArtifactUpload
+	err := database.Conn().
+		Raw(`
+			SELECT
+				u.id,
+				u.storage_key,
+				EXISTS (
+					SELECT 1 FROM workflow_node_execution_artifacts a
+					WHERE a.storage = u.storage AND a.storage_key = u.storage_key
+				) AS referenced
+			FROM artifact_uploads u
+			WHERE u.storage = ?
+			AND u.created_at < ?
+			ORDER BY u.created_at ASC
+			LIMIT ?
+		`, storage, before, limit).
+		Scan(&uploads).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return uploads, nil
+}
+
+func DeleteArtifactUpload(id uuid.UUID) error {
+	return database.Conn().Where("id = ?", id).Delete(&ArtifactUpload{}).Error
+}
@@ -119,17 +119,22 @@ func FindUnscopedBlueprintInTransaction(tx *gorm.DB, id string) (*Blueprint, err
 }
 
 type Node struct {
-	ID             string         `json:"id"`
-	Name           string         `json:"name"`
-	Type           string         `json:"type"`
-	Ref            NodeRef        `json:"ref"`
-	Configuration  map[string]any `json:"configuration"`
-	Metadata       map[string]any `json:"metadata"`
-	Position       Position       `json:"position"`
-	IsCollapsed    bool           `json:"isCollapsed"`
-	IntegrationID  *string        `json:"integrationId,omitempty"`
-	ErrorMessage   *string        `json:"errorMessage,omitempty"`
-	WarningMessage *string        `json:"warningMessage,omitempty"`
+	ID                      string            `json:"id"`
+	Name                    string            `json:"name"`
+	Type                    string            `json:"type"`
+	Ref                     NodeRef           `json:"ref"`
+	Configuration           map[string]any    `json:"configuration"`
+	Metadata                map[string]any    `json:"metadata"`
+	Position                Position          `json:"position"`
+	IsCollapsed             bool              `json:"isCollapsed"`
+	IntegrationID           *string           `json:"integrationId,omitempty"`
+	ErrorMessage            *string           `json:"errorMessage,omitempty"`
+	WarningMessage          *string           `json:"warningMessage,omitempty"`
+	ConcurrencyGroup        *ConcurrencyGroup `json:"concurrencyGroup,omitempty"`
+	RetryPolicy             *RetryPolicy      `json:"retryPolicy,omitempty"`
+	ExecutionTimeoutSeconds int               `json:"executionTimeoutSeconds,omitempty"`
+	QueuePolicy             *QueuePolicy      `json:"queuePolicy,omitempty"`
+	RateLimit               *RateLimit        `json:"rateLimit,omitempty"`
 }
 
 type Position struct {
@@ -144,6 +149,11 @@ type NodeRef struct {
 	Widget    *WidgetRef    `json:"widget,omitempty"`
 }
 
+type ConcurrencyGroup struct {
+	Name   string `json:"name"`
+	Policy string `json:"policy"`
+}
+
 type ComponentRef struct {
 	Name string `json:"name"`
 }
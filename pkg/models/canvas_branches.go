@@ -0,0 +1,144 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Executions waiting for other branches of their run to reach them,
+// like a Gather waiting for the items of a For Each, set this key in their KV.
+// If every other branch of the run stops while they are still waiting,
+// nothing else will reach them, so the engine invokes ActionBranchesStopped on them.
+// Executions waiting to emit more branches set it to WaitingToEmit,
+// and are notified before the ones collecting them, which set it to WaitingToCollect.
+const (
+	ExecutionKVWaitingForBranches = "waiting_for_branches"
+	WaitingToEmit                 = "emit"
+	WaitingToCollect              = "collect"
+
+	ActionBranchesStopped = "branchesStopped"
+)
+
+// ScheduleBranchesCheck is called when a branch of a run stops,
+// and if nothing else in the run is active, but executions are still waiting
+// for branches of the run, it schedules a request to notify the first one of them.
+// The request checks again before notifying it, since the run might have moved on since.
+func ScheduleBranchesCheck(tx *gorm.DB, rootEventID uuid.UUID) error {
+	waiting, err := FindNextWaitingExecutionInTransaction(tx, rootEventID)
+	if err != nil || waiting == nil {
+		return err
+	}
+
+	active, err := HasActiveBranchesForRootEvent(tx, rootEventID)
+	if err != nil || active {
+		return err
+	}
+
+	var pending int64
+	err = tx.
+		Model(&CanvasNodeRequest{}).
+		Where("execution_id = ?", waiting.ID).
+		Where("type = ?", NodeRequestTypeBranchesStopped).
+		Where("state = ?", NodeExecutionRequestStatePending).
+		Count(&pending).
+		Error
+
+	if err != nil || pending > 0 {
+		return err
+	}
+
+	now := time.Now()
+	return waiting.CreateRequest(tx, NodeRequestTypeBranchesStopped, NodeExecutionRequestSpec{
+		InvokeAction: &InvokeAction{
+			ActionName: ActionBranchesStopped,
+			Parameters: map[string]any{},
+		},
+	}, &now)
+}
+
+// FindNextWaitingExecutionInTransaction returns the execution waiting for branches of a run
+// that is notified first when the run stops, or nil if no execution is waiting.
+// Executions waiting to emit come before the ones waiting to collect,
+// and newer ones before older ones, so inner loops are notified before outer ones.
+func FindNextWaitingExecutionInTransaction(tx *gorm.DB, rootEventID uuid.UUID) (*CanvasNodeExecution, error) {
+	var executions []CanvasNodeExecution
+	err := tx.
+		Raw(`
+			SELECT x.* FROM workflow_node_executions x
+			INNER JOIN workflow_node_execution_kvs kv ON kv.execution_id = x.id
+			WHERE x.root_event_id = @root AND x.state IN @activeStates AND kv.key = @waiting
+			ORDER BY kv.value = @emit DESC, x.created_at DESC
+			LIMIT 1
+		`, map[string]any{
+			"root":         rootEventID,
+			"activeStates": []string{CanvasNodeExecutionStatePending, CanvasNodeExecutionStateStarted},
+			"waiting":      ExecutionKVWaitingForBranches,
+			"emit":         WaitingToEmit,
+		}).
+		Scan(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(executions) == 0 {
+		return nil, nil
+	}
+
+	return &executions[0], nil
+}
+
+// HasActiveBranchesForRootEvent is like HasActiveWorkForRootEvent,
+// but executions waiting for branches do not count as active,
+// unless something is about to happen to them, like an action being invoked.
+func HasActiveBranchesForRootEvent(tx *gorm.DB, rootEventID uuid.UUID) (bool, error) {
+	var active bool
+	err := tx.
+		Raw(`
+			SELECT
+				EXISTS (
+					SELECT 1 FROM workflow_events
+					WHERE id = @root AND state = @pendingEvent
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_events e
+					INNER JOIN workflow_node_executions x ON e.execution_id = x.id
+					WHERE x.root_event_id = @root AND e.state = @pendingEvent
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_node_queue_items
+					WHERE root_event_id = @root
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_node_executions x
+					WHERE x.root_event_id = @root AND x.state IN @activeStates
+					AND NOT EXISTS (
+						SELECT 1 FROM workflow_node_execution_kvs kv
+						WHERE kv.execution_id = x.id AND kv.key = @waiting
+					)
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_node_requests r
+					INNER JOIN workflow_node_executions x ON r.execution_id = x.id
+					WHERE x.root_event_id = @root AND r.state = @pendingRequest AND r.type NOT IN @ignoredRequests
+				)
+		`, map[string]any{
+			"root":            rootEventID,
+			"pendingEvent":    CanvasEventStatePending,
+			"activeStates":    []string{CanvasNodeExecutionStatePending, CanvasNodeExecutionStateStarted},
+			"waiting":         ExecutionKVWaitingForBranches,
+			"pendingRequest":  NodeExecutionRequestStatePending,
+			"ignoredRequests": []string{NodeRequestTypeTimeoutExecution, NodeRequestTypeBranchesStopped},
+		}).
+		Scan(&active).
+		Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return active, nil
+}
@@ -0,0 +1,141 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	CanvasChangeRequestStatePending   = "pending"
+	CanvasChangeRequestStatePublished = "published"
+	CanvasChangeRequestStateRejected  = "rejected"
+)
+
+// CanvasChangeRequest is a snapshot of a canvas draft
+// waiting for approval from a role before being published.
+type CanvasChangeRequest struct {
+	ID                 uuid.UUID `gorm:"primaryKey;default:uuid_generate_v4()"`
+	WorkflowID         uuid.UUID
+	BaseVersionID      *uuid.UUID
+	Name               string
+	Description        string
+	Nodes              datatypes.JSONSlice[Node]
+	Edges              datatypes.JSONSlice[Edge]
+	Message            string
+	ApprovalRole       string
+	State              string
+	RequestedBy        *uuid.UUID
+	ReviewedBy         *uuid.UUID
+	ReviewedAt         *time.Time
+	PublishedVersionID *uuid.UUID
+	CreatedAt          *time.Time
+	UpdatedAt          *time.Time
+}
+
+func (r *CanvasChangeRequest) TableName() string {
+	return "workflow_change_requests"
+}
+
+func CreateCanvasChangeRequestInTransaction(tx *gorm.DB, draft *CanvasDraft, approvalRole, message string, requestedBy *uuid.UUID) (*CanvasChangeRequest, error) {
+	now := time.Now()
+	request := CanvasChangeRequest{
+		WorkflowID:    draft.WorkflowID,
+		BaseVersionID: draft.BaseVersionID,
+		Name:          draft.Name,
+		Description:   draft.Description,
+		Nodes:         draft.Nodes,
+		Edges:         draft.Edges,
+		Message:       message,
+		ApprovalRole:  approvalRole,
+		State:         CanvasChangeRequestStatePending,
+		RequestedBy:   requestedBy,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+
+	err := tx.Create(&request).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+func ListCanvasChangeRequests(canvasID uuid.UUID, states []string) ([]CanvasChangeRequest, error) {
+	var requests []CanvasChangeRequest
+	query := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		Order("created_at DESC")
+
+	if len(states) > 0 {
+		query = query.Where("state IN ?", states)
+	}
+
+	err := query.Find(&requests).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func FindCanvasChangeRequest(canvasID, id uuid.UUID) (*CanvasChangeRequest, error) {
+	return FindCanvasChangeRequestInTransaction(database.Conn(), canvasID, id)
+}
+
+func FindCanvasChangeRequestInTransaction(tx *gorm.DB, canvasID, id uuid.UUID) (*CanvasChangeRequest, error) {
+	var request CanvasChangeRequest
+	err := tx.
+		Where("workflow_id = ?", canvasID).
+		Where("id = ?", id).
+		First(&request).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// LockCanvasChangeRequestInTransaction loads the change request for update,
+// so concurrent reviews of the same request are serialized.
+func LockCanvasChangeRequestInTransaction(tx *gorm.DB, canvasID, id uuid.UUID) (*CanvasChangeRequest, error) {
+	return FindCanvasChangeRequestInTransaction(tx.Clauses(clause.Locking{Strength: "UPDATE"}), canvasID, id)
+}
+
+func (r *CanvasChangeRequest) PublishInTransaction(tx *gorm.DB, reviewedBy *uuid.UUID, versionID uuid.UUID) error {
+	now := time.Now()
+	r.State = CanvasChangeRequestStatePublished
+	r.ReviewedBy = reviewedBy
+	r.ReviewedAt = &now
+	r.PublishedVersionID = &versionID
+	r.UpdatedAt = &now
+	return tx.Save(r).Error
+}
+
+func (r *CanvasChangeRequest) RejectInTransaction(tx *gorm.DB, reviewedBy *uuid.UUID) error {
+	now := time.Now()
+	r.State = CanvasChangeRequestStateRejected
+	r.ReviewedBy = reviewedBy
+	r.ReviewedAt = &now
+	r.UpdatedAt = &now
+	return tx.Save(r).Error
+}
+
+// Draft returns the canvas draft the change request was created from.
+func (r *CanvasChangeRequest) Draft() *CanvasDraft {
+	return &CanvasDraft{
+		WorkflowID:    r.WorkflowID,
+		BaseVersionID: r.BaseVersionID,
+		Name:          r.Name,
+		Description:   r.Description,
+		Nodes:         r.Nodes,
+		Edges:         r.Edges,
+	}
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CanvasDraft is a copy of a canvas that can be edited
+// without affecting the running graph, until it is published.
+// A canvas has at most one draft.
+type CanvasDraft struct {
+	WorkflowID    uuid.UUID `gorm:"primaryKey"`
+	BaseVersionID *uuid.UUID
+	Name          string
+	Description   string
+	Nodes         datatypes.JSONSlice[Node]
+	Edges         datatypes.JSONSlice[Edge]
+	UpdatedBy     *uuid.UUID
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
+
+func (d *CanvasDraft) TableName() string {
+	return "workflow_drafts"
+}
+
+func UpsertCanvasDraft(draft *CanvasDraft) error {
+	return UpsertCanvasDraftInTransaction(database.Conn(), draft)
+}
+
+// UpsertCanvasDraftInTransaction creates the draft for the canvas or replaces its contents.
+// The base version is only recorded when the draft is created,
+// since it is the version the draft changes are based on.
+func UpsertCanvasDraftInTransaction(tx *gorm.DB, draft *CanvasDraft) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workflow_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "description", "nodes", "edges", "updated_by", "updated_at"}),
+	}).Create(draft).Error
+}
+
+func FindCanvasDraft(canvasID uuid.UUID) (*CanvasDraft, error) {
+	return FindCanvasDraftInTransaction(database.Conn(), canvasID)
+}
+
+func FindCanvasDraftInTransaction(tx *gorm.DB, canvasID uuid.UUID) (*CanvasDraft, error) {
+	var draft CanvasDraft
+	err := tx.
+		Where("workflow_id = ?", canvasID).
+		First(&draft).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &draft, nil
+}
+
+func DeleteCanvasDraft(canvasID uuid.UUID) error {
+	return database.Conn().
+		Where("workflow_id = ?", canvasID).
+		Delete(&CanvasDraft{}).
+		Error
+}
+
+// DeleteCanvasDraftNotUpdatedSinceInTransaction deletes the draft of the canvas,
+// unless it was updated after the given time. It is used when the draft is published,
+// so edits made to the draft while it was being published are not lost.
+func DeleteCanvasDraftNotUpdatedSinceInTransaction(tx *gorm.DB, canvasID uuid.UUID, since time.Time) error {
+	return tx.
+		Where("workflow_id = ?", canvasID).
+		Where("updated_at <= ?", since).
+		Delete(&CanvasDraft{}).
+		Error
+}
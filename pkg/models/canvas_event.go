@@ -25,6 +25,26 @@ type CanvasEvent struct {
 	ExecutionID *uuid.UUID
 	State       string
 	CreatedAt   *time.Time
+
+	//
+	// Root events emitted by a Run Canvas execution in another canvas
+	// reference that execution here, so the engine can finish it
+	// when the execution tree for this event is done.
+	//
+	InvokedByExecutionID *uuid.UUID
+
+	//
+	// W3C traceparent for the trace of this event.
+	// Root events start a new trace, and events emitted
+	// by executions continue the trace of that execution.
+	//
+	Traceparent *string
+
+	//
+	// Priority of the run. Root events get it from the trigger,
+	// and events emitted by executions carry the priority of that execution.
+	//
+	Priority int
 }
 
 func (e *CanvasEvent) TableName() string {
@@ -0,0 +1,224 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	CanvasInvocationChannelPassed = "passed"
+	CanvasInvocationChannelFailed = "failed"
+	CanvasInvocationPayloadType   = "canvas.finished"
+
+	//
+	// Maximum number of canvases in a chain of Run Canvas invocations,
+	// including the canvas where the chain started.
+	//
+	MaxCanvasInvocationDepth = 10
+)
+
+var ErrRecursiveCanvasInvocation = errors.New("recursive canvas invocation")
+
+// FindUnscopedNodeExecutionInTransaction finds an execution by ID, in any canvas.
+func FindUnscopedNodeExecutionInTransaction(tx *gorm.DB, id uuid.UUID) (*CanvasNodeExecution, error) {
+	var execution CanvasNodeExecution
+	err := tx.
+		Where("id = ?", id).
+		First(&execution).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
+}
+
+// LockInvokingExecution locks the Run Canvas execution that invoked a canvas.
+// Unlike LockCanvasNodeExecution, it waits for the lock,
+// so completion checks for the same execution tree are serialized.
+func LockInvokingExecution(tx *gorm.DB, id uuid.UUID) (*CanvasNodeExecution, error) {
+	var execution CanvasNodeExecution
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&execution).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
+}
+
+// ScheduleCanvasInvocationCheck is called when an execution finishes,
+// and if the execution is part of a canvas invoked by a Run Canvas execution,
+// it schedules a request on the Run Canvas execution to check if the invocation is done.
+// Child executions are ignored, since their parent execution finishes with them.
+// Since the branch of the execution stopped, the run is checked for stopped branches too.
+func ScheduleCanvasInvocationCheck(tx *gorm.DB, execution *CanvasNodeExecution) error {
+	err := ScheduleBranchesCheck(tx, execution.RootEventID)
+	if err != nil {
+		return err
+	}
+
+	if execution.ParentExecutionID != nil {
+		return nil
+	}
+
+	rootEvent, err := FindCanvasEventInTransaction(tx, execution.RootEventID)
+	if err != nil {
+		return fmt.Errorf("failed to find root event %s: %w", execution.RootEventID, err)
+	}
+
+	if rootEvent.InvokedByExecutionID == nil {
+		return nil
+	}
+
+	invoker, err := FindUnscopedNodeExecutionInTransaction(tx, *rootEvent.InvokedByExecutionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if invoker.State == CanvasNodeExecutionStateFinished {
+		return nil
+	}
+
+	now := time.Now()
+	spec := NodeExecutionRequestSpec{
+		CompleteCanvasInvocation: &CompleteCanvasInvocation{RootEventID: rootEvent.ID},
+	}
+
+	return invoker.CreateRequest(tx, NodeRequestTypeCompleteCanvasInvocation, spec, &now)
+}
+
+// ListCanvasInvocationChain returns the IDs of the canvases in the chain
+// of Run Canvas invocations that led to the execution,
+// starting with the canvas of the execution itself.
+func ListCanvasInvocationChain(tx *gorm.DB, execution *CanvasNodeExecution) ([]uuid.UUID, error) {
+	chain := []uuid.UUID{execution.WorkflowID}
+	current := execution
+
+	for {
+		rootEvent, err := FindCanvasEventInTransaction(tx, current.RootEventID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find root event %s: %w", current.RootEventID, err)
+		}
+
+		if rootEvent.InvokedByExecutionID == nil {
+			return chain, nil
+		}
+
+		//
+		// The chain is only built from valid invocations,
+		// so it should never grow past the max depth,
+		// but we don't want to loop forever on bad data.
+		//
+		if len(chain) > MaxCanvasInvocationDepth {
+			return chain, nil
+		}
+
+		current, err = FindUnscopedNodeExecutionInTransaction(tx, *rootEvent.InvokedByExecutionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find invoking execution %s: %w", *rootEvent.InvokedByExecutionID, err)
+		}
+
+		chain = append(chain, current.WorkflowID)
+	}
+}
+
+// ValidateCanvasInvocation ensures that an execution can invoke the target canvas,
+// rejecting invocations of canvases already in the invocation chain,
+// and chains deeper than MaxCanvasInvocationDepth.
+func ValidateCanvasInvocation(tx *gorm.DB, execution *CanvasNodeExecution, targetCanvasID uuid.UUID) error {
+	chain, err := ListCanvasInvocationChain(tx, execution)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(chain, targetCanvasID) {
+		return fmt.Errorf("%w: canvas %s is already part of the invocation chain", ErrRecursiveCanvasInvocation, targetCanvasID)
+	}
+
+	if len(chain) >= MaxCanvasInvocationDepth {
+		return fmt.Errorf("%w: invocation chain is deeper than %d canvases", ErrRecursiveCanvasInvocation, MaxCanvasInvocationDepth)
+	}
+
+	return nil
+}
+
+// HasActiveWorkForRootEvent checks if anything in the execution tree for a root event
+// can still make progress: pending events, queue items,
+// pending or started executions, and scheduled retries.
+func HasActiveWorkForRootEvent(tx *gorm.DB, rootEventID uuid.UUID) (bool, error) {
+	var active bool
+	err := tx.
+		Raw(`
+			SELECT
+				EXISTS (
+					SELECT 1 FROM workflow_events
+					WHERE id = @root AND state = @pendingEvent
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_events e
+					INNER JOIN workflow_node_executions x ON e.execution_id = x.id
+					WHERE x.root_event_id = @root AND e.state = @pendingEvent
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_node_queue_items
+					WHERE root_event_id = @root
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_node_executions
+					WHERE root_event_id = @root AND state IN @activeStates
+				)
+				OR EXISTS (
+					SELECT 1 FROM workflow_node_requests r
+					INNER JOIN workflow_node_executions x ON r.execution_id = x.id
+					WHERE x.root_event_id = @root AND r.state = @pendingRequest AND r.type = @retry
+				)
+		`, map[string]any{
+			"root":           rootEventID,
+			"pendingEvent":   CanvasEventStatePending,
+			"activeStates":   []string{CanvasNodeExecutionStatePending, CanvasNodeExecutionStateStarted},
+			"pendingRequest": NodeExecutionRequestStatePending,
+			"retry":          NodeRequestTypeRetryExecution,
+		}).
+		Scan(&active).
+		Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return active, nil
+}
+
+// ListTopLevelExecutionsForRootEventInTransaction returns the executions
+// for a root event that are not part of a blueprint node execution.
+func ListTopLevelExecutionsForRootEventInTransaction(tx *gorm.DB, rootEventID uuid.UUID) ([]CanvasNodeExecution, error) {
+	var executions []CanvasNodeExecution
+	err := tx.
+		Where("root_event_id = ?", rootEventID).
+		Where("parent_execution_id IS NULL").
+		Order("created_at ASC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
@@ -24,6 +24,10 @@ const (
 	NodeTypeComponent = "component"
 	NodeTypeBlueprint = "blueprint"
 	NodeTypeWidget    = "widget"
+
+	ConcurrencyPolicyQueue            = "queue"
+	ConcurrencyPolicyCancelInProgress = "cancel-in-progress"
+	ConcurrencyPolicySkipIfRunning    = "skip-if-running"
 )
 
 type CanvasNode struct {
@@ -44,6 +48,38 @@ type CanvasNode struct {
 	CreatedAt         *time.Time
 	UpdatedAt         *time.Time
 	DeletedAt         gorm.DeletedAt `gorm:"index"`
+
+	//
+	// Concurrency group the node belongs to.
+	// Executions for all nodes in the same group,
+	// across all canvases in the organization, are serialized
+	// according to the group policy.
+	//
+	ConcurrencyGroup  *string
+	ConcurrencyPolicy *string
+
+	//
+	// Retry policy applied to failed executions of the node.
+	//
+	RetryPolicy *datatypes.JSONType[RetryPolicy]
+
+	//
+	// Maximum time an execution of the node can run for,
+	// before it is cancelled and failed by the engine.
+	//
+	ExecutionTimeoutSeconds *int
+
+	//
+	// Policy applied to the queue of the node,
+	// to debounce or coalesce bursts of queue items.
+	//
+	QueuePolicy *datatypes.JSONType[QueuePolicy]
+
+	//
+	// Maximum rate at which the queue items of the node
+	// are turned into executions.
+	//
+	RateLimit *datatypes.JSONType[RateLimit]
 }
 
 func (c *CanvasNode) TableName() string {
@@ -180,13 +216,17 @@ func FindCanvasNodesByIDs(tx *gorm.DB, canvasID uuid.UUID, nodeIDs []string) ([]
 	return nodes, nil
 }
 
+// ListCanvasNodesReady returns the nodes with items in their queue
+// that can be processed: the ready ones, and the processing ones
+// in a concurrency group with a policy for running executions,
+// since a new item for them might cancel or skip their running execution.
 func ListCanvasNodesReady() ([]CanvasNode, error) {
 	var nodes []CanvasNode
 	err := database.Conn().
 		Distinct().
 		Joins("JOIN workflow_node_queue_items ON workflow_nodes.workflow_id = workflow_node_queue_items.workflow_id AND workflow_nodes.node_id = workflow_node_queue_items.node_id").
 		Joins("JOIN workflows ON workflow_nodes.workflow_id = workflows.id").
-		Where("workflow_nodes.state = ?", CanvasNodeStateReady).
+		Where(readyForQueueCondition("workflow_nodes.")).
 		Where("workflow_nodes.type IN ?", []string{NodeTypeComponent, NodeTypeBlueprint}).
 		Where("workflows.deleted_at IS NULL").
 		Find(&nodes).
@@ -199,6 +239,27 @@ func ListCanvasNodesReady() ([]CanvasNode, error) {
 	return nodes, nil
 }
 
+// ListCanvasNodesWithConfigurationContaining returns the nodes of the canvases of an organization
+// with a configuration containing the given JSON value, e.g. a quoted string.
+// The configuration is matched as text, so callers should check the nodes returned.
+func ListCanvasNodesWithConfigurationContaining(organizationID uuid.UUID, value string) ([]CanvasNode, error) {
+	var nodes []CanvasNode
+	err := database.Conn().
+		Joins("JOIN workflows ON workflow_nodes.workflow_id = workflows.id").
+		Where("workflows.organization_id = ?", organizationID).
+		Where("workflows.deleted_at IS NULL").
+		Where("strpos(workflow_nodes.configuration::text, ?) > 0", value).
+		Order("workflow_nodes.workflow_id, workflow_nodes.node_id").
+		Find(&nodes).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
 func ListReadyTriggers() ([]CanvasNode, error) {
 	var nodes []CanvasNode
 	err := database.Conn().
@@ -214,6 +275,20 @@ func ListReadyTriggers() ([]CanvasNode, error) {
 	return nodes, nil
 }
 
+// readyForQueueCondition returns the condition used by ListCanvasNodesReady
+// for the nodes table, with the given prefix for its columns.
+func readyForQueueCondition(prefix string) clause.Expr {
+	return gorm.Expr(
+		fmt.Sprintf(
+			"(%[1]sstate = ? OR (%[1]sstate = ? AND %[1]sconcurrency_group IS NOT NULL AND %[1]sconcurrency_policy IN ?))",
+			prefix,
+		),
+		CanvasNodeStateReady,
+		CanvasNodeStateProcessing,
+		[]string{ConcurrencyPolicyCancelInProgress, ConcurrencyPolicySkipIfRunning},
+	)
+}
+
 func LockCanvasNode(tx *gorm.DB, workflowID uuid.UUID, nodeId string) (*CanvasNode, error) {
 	var node CanvasNode
 
@@ -221,7 +296,7 @@ func LockCanvasNode(tx *gorm.DB, workflowID uuid.UUID, nodeId string) (*CanvasNo
 		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
 		Where("workflow_id = ?", workflowID).
 		Where("node_id = ?", nodeId).
-		Where("state = ?", CanvasNodeStateReady).
+		Where(readyForQueueCondition("")).
 		First(&node).
 		Error
 
@@ -262,6 +337,130 @@ func ResumeStateForNodeInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID s
 	return CanvasNodeStateReady, nil
 }
 
+// LockConcurrencyGroup acquires a transaction-scoped advisory lock
+// for a concurrency group in an organization, serializing the queue
+// processing of all nodes in that group until the transaction ends.
+func LockConcurrencyGroup(tx *gorm.DB, organizationID uuid.UUID, group string) error {
+	key := fmt.Sprintf("concurrency-group:%s:%s", organizationID.String(), group)
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", key).Error
+}
+
+func (c *CanvasNode) GetConcurrencyGroup() *ConcurrencyGroup {
+	if c.ConcurrencyGroup == nil || *c.ConcurrencyGroup == "" {
+		return nil
+	}
+
+	policy := ConcurrencyPolicyQueue
+	if c.ConcurrencyPolicy != nil && *c.ConcurrencyPolicy != "" {
+		policy = *c.ConcurrencyPolicy
+	}
+
+	return &ConcurrencyGroup{Name: *c.ConcurrencyGroup, Policy: policy}
+}
+
+func (c *CanvasNode) SetConcurrencyGroup(group *ConcurrencyGroup) {
+	if group == nil || group.Name == "" {
+		c.ConcurrencyGroup = nil
+		c.ConcurrencyPolicy = nil
+		return
+	}
+
+	name := group.Name
+	policy := group.Policy
+	if policy == "" {
+		policy = ConcurrencyPolicyQueue
+	}
+
+	c.ConcurrencyGroup = &name
+	c.ConcurrencyPolicy = &policy
+}
+
+func (c *CanvasNode) GetRetryPolicy() *RetryPolicy {
+	if c.RetryPolicy == nil {
+		return nil
+	}
+
+	policy := c.RetryPolicy.Data()
+	if policy.MaxAttempts <= 1 {
+		return nil
+	}
+
+	return &policy
+}
+
+func (c *CanvasNode) SetRetryPolicy(policy *RetryPolicy) {
+	if policy == nil || policy.MaxAttempts <= 1 {
+		c.RetryPolicy = nil
+		return
+	}
+
+	retryPolicy := datatypes.NewJSONType(*policy)
+	c.RetryPolicy = &retryPolicy
+}
+
+func (c *CanvasNode) GetExecutionTimeout() time.Duration {
+	if c.ExecutionTimeoutSeconds == nil || *c.ExecutionTimeoutSeconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(*c.ExecutionTimeoutSeconds) * time.Second
+}
+
+func (c *CanvasNode) SetExecutionTimeout(seconds int) {
+	if seconds <= 0 {
+		c.ExecutionTimeoutSeconds = nil
+		return
+	}
+
+	c.ExecutionTimeoutSeconds = &seconds
+}
+
+func (c *CanvasNode) GetQueuePolicy() *QueuePolicy {
+	if c.QueuePolicy == nil {
+		return nil
+	}
+
+	policy := c.QueuePolicy.Data()
+	if policy.Strategy == "" {
+		return nil
+	}
+
+	return &policy
+}
+
+func (c *CanvasNode) SetQueuePolicy(policy *QueuePolicy) {
+	if policy == nil || policy.Strategy == "" {
+		c.QueuePolicy = nil
+		return
+	}
+
+	queuePolicy := datatypes.NewJSONType(*policy)
+	c.QueuePolicy = &queuePolicy
+}
+
+func (c *CanvasNode) GetRateLimit() *RateLimit {
+	if c.RateLimit == nil {
+		return nil
+	}
+
+	limit := c.RateLimit.Data()
+	if limit.Limit == 0 {
+		return nil
+	}
+
+	return &limit
+}
+
+func (c *CanvasNode) SetRateLimit(limit *RateLimit) {
+	if limit == nil || limit.Limit == 0 {
+		c.RateLimit = nil
+		return
+	}
+
+	rateLimit := datatypes.NewJSONType(*limit)
+	c.RateLimit = &rateLimit
+}
+
 func (c *CanvasNode) UpdateState(tx *gorm.DB, state string) error {
 	return tx.Model(c).
 		Update("state", state).
@@ -274,6 +473,7 @@ func (c *CanvasNode) FirstQueueItem(tx *gorm.DB) (*CanvasNodeQueueItem, error) {
 	err := tx.
 		Where("workflow_id = ?", c.WorkflowID).
 		Where("node_id = ?", c.NodeID).
+		Order("priority DESC").
 		Order("created_at ASC").
 		First(&queueItem).
 		Error
@@ -285,6 +485,26 @@ func (c *CanvasNode) FirstQueueItem(tx *gorm.DB) (*CanvasNodeQueueItem, error) {
 	return &queueItem, nil
 }
 
+// ListQueueItemsInTransaction returns the items in the queue of the node,
+// in the order they are dequeued, like FirstQueueItem.
+func (c *CanvasNode) ListQueueItemsInTransaction(tx *gorm.DB, limit int) ([]CanvasNodeQueueItem, error) {
+	var queueItems []CanvasNodeQueueItem
+	err := tx.
+		Where("workflow_id = ?", c.WorkflowID).
+		Where("node_id = ?", c.NodeID).
+		Order("priority DESC").
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&queueItems).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return queueItems, nil
+}
+
 func (c *CanvasNode) CreateRequest(tx *gorm.DB, reqType string, spec NodeExecutionRequestSpec, runAt *time.Time) error {
 	return tx.Create(&CanvasNodeRequest{
 		WorkflowID: c.WorkflowID,
@@ -299,6 +519,23 @@ func (c *CanvasNode) CreateRequest(tx *gorm.DB, reqType string, spec NodeExecuti
 	}).Error
 }
 
+const (
+	MinQueuePriority = -100
+	MaxQueuePriority = 100
+)
+
+func ValidateQueuePriority(priority int) error {
+	if priority < MinQueuePriority || priority > MaxQueuePriority {
+		return fmt.Errorf("priority must be between %d and %d", MinQueuePriority, MaxQueuePriority)
+	}
+
+	return nil
+}
+
+func ClampQueuePriority(priority int) int {
+	return min(max(priority, MinQueuePriority), MaxQueuePriority)
+}
+
 type CanvasNodeQueueItem struct {
 	ID         uuid.UUID `gorm:"primaryKey;default:uuid_generate_v4()"`
 	WorkflowID uuid.UUID
@@ -320,6 +557,25 @@ type CanvasNodeQueueItem struct {
 	// which holds the input for this queue item.
 	//
 	EventID uuid.UUID
+
+	//
+	// W3C traceparent copied from the event,
+	// so the execution created from this item continues its trace.
+	//
+	Traceparent *string
+
+	//
+	// Items with a higher priority are dequeued first,
+	// and items with the same priority, in the order they were created.
+	// Copied from the event, but can be changed while the item is in the queue.
+	//
+	Priority int
+
+	//
+	// Key of the queue policy of the node, resolved when the item is queued.
+	// Nil if the node has no queue policy with a key, or if it could not be resolved.
+	//
+	QueueKey *string
 }
 
 func (i *CanvasNodeQueueItem) TableName() string {
@@ -365,8 +621,8 @@ func CountNodeQueueItems(workflowID uuid.UUID, nodeID string) (int64, error) {
 	return totalCount, nil
 }
 
-// FindNextQueueItemPerNode finds the next (oldest) queue item for each node in a workflow
-// using DISTINCT ON to get one queue item per node_id, ordered by created_at ASC
+// FindNextQueueItemPerNode finds the next queue item for each node in a workflow
+// using DISTINCT ON to get one queue item per node_id, ordered by priority DESC and created_at ASC
 // Only returns queue items for nodes that have not been deleted
 func FindNextQueueItemPerNode(workflowID uuid.UUID) ([]CanvasNodeQueueItem, error) {
 	var queueItems []CanvasNodeQueueItem
@@ -379,7 +635,7 @@ func FindNextQueueItemPerNode(workflowID uuid.UUID) ([]CanvasNodeQueueItem, erro
 				AND qi.node_id = wn.node_id
 			WHERE qi.workflow_id = ?
 			AND wn.deleted_at IS NULL
-			ORDER BY qi.node_id, qi.created_at ASC
+			ORDER BY qi.node_id, qi.priority DESC, qi.created_at ASC
 		`, workflowID).
 		Scan(&queueItems).
 		Error
@@ -391,6 +647,24 @@ func FindNextQueueItemPerNode(workflowID uuid.UUID) ([]CanvasNodeQueueItem, erro
 	return queueItems, nil
 }
 
+// UpdateNodeQueueItemPriority changes the priority of an item still in the queue of the node.
+func UpdateNodeQueueItemPriority(workflowID uuid.UUID, nodeID string, queueItemID uuid.UUID, priority int) error {
+	result := database.Conn().
+		Model(&CanvasNodeQueueItem{}).
+		Where("workflow_id = ? AND node_id = ? AND id = ?", workflowID, nodeID, queueItemID).
+		Update("priority", priority)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
 func FindNodeQueueItem(workflowID uuid.UUID, queueItemID uuid.UUID) (*CanvasNodeQueueItem, error) {
 	var queueItem CanvasNodeQueueItem
 	err := database.Conn().
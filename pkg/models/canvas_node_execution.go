@@ -24,6 +24,8 @@ const (
 	CanvasNodeExecutionResultReasonOk            = "ok"
 	CanvasNodeExecutionResultReasonError         = "error"
 	CanvasNodeExecutionResultReasonErrorResolved = "error_resolved"
+	CanvasNodeExecutionResultReasonTimeout       = "timeout"
+	CanvasNodeExecutionResultReasonSkipped       = "skipped"
 )
 
 type CanvasNodeExecution struct {
@@ -56,12 +58,42 @@ type CanvasNodeExecution struct {
 	//
 	ParentExecutionID *uuid.UUID
 
+	//
+	// Executions for nodes with a retry policy are retried
+	// when they fail. Each retry is a new execution,
+	// with an incremented attempt number,
+	// referencing the failed execution it retries.
+	//
+	Attempt            int `gorm:"default:1"`
+	RetryOfExecutionID *uuid.UUID
+
 	//
 	// The reference to a WorkflowEvent record,
 	// which holds the input for this execution.
 	//
 	EventID uuid.UUID
 
+	//
+	// The canvas version the execution ran under.
+	// Set to the latest version of the canvas when the execution is created,
+	// unless it continues another execution, like retries and blueprint child executions do.
+	//
+	WorkflowVersionID *uuid.UUID
+
+	//
+	// W3C traceparent for the span of this execution.
+	// Events emitted by the execution, and executions
+	// continuing it, like retries, carry it forward.
+	//
+	Traceparent *string
+
+	//
+	// Priority of the queue item the execution was created from.
+	// Events emitted by the execution, and executions
+	// continuing it, carry it forward.
+	//
+	Priority int
+
 	//
 	// State management fields.
 	//
@@ -71,6 +103,26 @@ type CanvasNodeExecution struct {
 	ResultMessage string
 	CancelledBy   *uuid.UUID
 
+	//
+	// Executions for nodes with an execution timeout
+	// are cancelled and failed by the engine
+	// if they do not finish before this deadline.
+	//
+	DeadlineAt *time.Time
+
+	//
+	// When the execution finished, whatever the result.
+	// Unlike UpdatedAt, this does not change after that.
+	//
+	FinishedAt *time.Time
+
+	//
+	// Pending executions are not picked up before this time.
+	// Set when an execution hits the rate limit of its integration,
+	// to run it again once the limit allows it.
+	//
+	RunAfter *time.Time
+
 	//
 	// Components can store metadata about each execution here.
 	// This allows them to control the behavior of each execution.
@@ -90,6 +142,20 @@ func (e *CanvasNodeExecution) TableName() string {
 	return "workflow_node_executions"
 }
 
+func (e *CanvasNodeExecution) BeforeCreate(tx *gorm.DB) error {
+	if e.WorkflowVersionID != nil {
+		return nil
+	}
+
+	versionID, err := FindLatestCanvasVersionIDInTransaction(tx, e.WorkflowID)
+	if err != nil {
+		return err
+	}
+
+	e.WorkflowVersionID = versionID
+	return nil
+}
+
 func LockCanvasNodeExecution(tx *gorm.DB, id uuid.UUID) (*CanvasNodeExecution, error) {
 	var execution CanvasNodeExecution
 
@@ -114,6 +180,9 @@ func CreatePendingChildExecution(tx *gorm.DB, parent *CanvasNodeExecution, child
 		EventID:             parent.EventID,
 		PreviousExecutionID: &parent.ID,
 		ParentExecutionID:   &parent.ID,
+		WorkflowVersionID:   parent.WorkflowVersionID,
+		Traceparent:         parent.Traceparent,
+		Priority:            parent.Priority,
 		NodeID:              fmt.Sprintf("%s:%s", parent.NodeID, childNodeID),
 		State:               CanvasNodeExecutionStatePending,
 		Configuration:       datatypes.NewJSONType(config),
@@ -129,10 +198,41 @@ func CreatePendingChildExecution(tx *gorm.DB, parent *CanvasNodeExecution, child
 	return &execution, nil
 }
 
+// CreateRetryExecution creates a new pending execution
+// for the next attempt of a failed execution.
+func CreateRetryExecution(tx *gorm.DB, failed *CanvasNodeExecution) (*CanvasNodeExecution, error) {
+	now := time.Now()
+	execution := CanvasNodeExecution{
+		WorkflowID:          failed.WorkflowID,
+		NodeID:              failed.NodeID,
+		RootEventID:         failed.RootEventID,
+		EventID:             failed.EventID,
+		PreviousExecutionID: failed.PreviousExecutionID,
+		ParentExecutionID:   failed.ParentExecutionID,
+		Attempt:             failed.GetAttempt() + 1,
+		RetryOfExecutionID:  &failed.ID,
+		WorkflowVersionID:   failed.WorkflowVersionID,
+		Traceparent:         failed.Traceparent,
+		Priority:            failed.Priority,
+		State:               CanvasNodeExecutionStatePending,
+		Configuration:       datatypes.NewJSONType(failed.Configuration.Data()),
+		CreatedAt:           &now,
+		UpdatedAt:           &now,
+	}
+
+	err := tx.Create(&execution).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
+}
+
 func ListPendingNodeExecutions() ([]CanvasNodeExecution, error) {
 	var executions []CanvasNodeExecution
 	query := database.Conn().
 		Where("state = ?", CanvasNodeExecutionStatePending).
+		Where("run_after IS NULL OR run_after <= ?", time.Now()).
 		Order("created_at DESC")
 
 	err := query.Find(&executions).Error
@@ -231,10 +331,53 @@ func CountRunningExecutionsForNodeInTransaction(tx *gorm.DB, workflowID uuid.UUI
 	return runningCount, nil
 }
 
+// ListActiveExecutionsInConcurrencyGroup returns the pending and started
+// top-level executions for all the nodes in the concurrency group,
+// across all the canvases in the organization.
+func ListActiveExecutionsInConcurrencyGroup(tx *gorm.DB, organizationID uuid.UUID, group string) ([]CanvasNodeExecution, error) {
+	var executions []CanvasNodeExecution
+	err := tx.
+		Joins("JOIN workflow_nodes ON workflow_node_executions.workflow_id = workflow_nodes.workflow_id AND workflow_node_executions.node_id = workflow_nodes.node_id").
+		Joins("JOIN workflows ON workflow_node_executions.workflow_id = workflows.id").
+		Where("workflows.organization_id = ?", organizationID).
+		Where("workflows.deleted_at IS NULL").
+		Where("workflow_nodes.deleted_at IS NULL").
+		Where("workflow_nodes.concurrency_group = ?", group).
+		Where("workflow_node_executions.parent_execution_id IS NULL").
+		Where("workflow_node_executions.state IN ?", []string{CanvasNodeExecutionStatePending, CanvasNodeExecutionStateStarted}).
+		Order("workflow_node_executions.created_at ASC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
 func FindNodeExecution(workflowID, id uuid.UUID) (*CanvasNodeExecution, error) {
 	return FindNodeExecutionInTransaction(database.Conn(), workflowID, id)
 }
 
+// FindAndLockNodeExecutionInTransaction finds an execution in a canvas,
+// waiting for and holding a lock on it until the transaction ends.
+func FindAndLockNodeExecutionInTransaction(tx *gorm.DB, workflowID, id uuid.UUID) (*CanvasNodeExecution, error) {
+	var execution CanvasNodeExecution
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("workflow_id = ?", workflowID).
+		Where("id = ?", id).
+		First(&execution).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
+}
+
 func FindNodeExecutionInTransaction(tx *gorm.DB, workflowID, id uuid.UUID) (*CanvasNodeExecution, error) {
 	var execution CanvasNodeExecution
 	err := tx.
@@ -345,6 +488,22 @@ func (e *CanvasNodeExecution) GetPreviousExecutionID() string {
 	return e.PreviousExecutionID.String()
 }
 
+func (e *CanvasNodeExecution) GetRetryOfExecutionID() string {
+	if e.RetryOfExecutionID != nil {
+		return e.RetryOfExecutionID.String()
+	}
+
+	return ""
+}
+
+func (e *CanvasNodeExecution) GetAttempt() int {
+	if e.Attempt < 1 {
+		return 1
+	}
+
+	return e.Attempt
+}
+
 func (e *CanvasNodeExecution) GetParentExecutionID() string {
 	if e.ParentExecutionID == nil {
 		return ""
@@ -372,6 +531,52 @@ func (e *CanvasNodeExecution) StartInTransaction(tx *gorm.DB) error {
 		Error
 }
 
+// UpdateTraceparent sets the traceparent for the span of the execution,
+// so everything the execution emits continues the trace from there.
+func (e *CanvasNodeExecution) UpdateTraceparent(tx *gorm.DB, traceparent *string) error {
+	if traceparent == nil {
+		return nil
+	}
+
+	err := tx.Model(e).Update("traceparent", *traceparent).Error
+	if err != nil {
+		return err
+	}
+
+	e.Traceparent = traceparent
+	return nil
+}
+
+// ScheduleTimeout sets the deadline for a started execution,
+// and schedules the request that times it out
+// if the execution is still running by then.
+func (e *CanvasNodeExecution) ScheduleTimeout(tx *gorm.DB, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	err := tx.Model(e).Update("deadline_at", deadline).Error
+	if err != nil {
+		return err
+	}
+
+	e.DeadlineAt = &deadline
+	return e.CreateRequest(tx, NodeRequestTypeTimeoutExecution, NodeExecutionRequestSpec{}, &deadline)
+}
+
+// DelayInTransaction keeps a pending execution
+// from being picked up until the given time.
+func (e *CanvasNodeExecution) DelayInTransaction(tx *gorm.DB, until time.Time) error {
+	err := tx.Model(e).
+		Update("run_after", until).
+		Update("updated_at", time.Now()).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	e.RunAfter = &until
+	return nil
+}
+
 func (e *CanvasNodeExecution) Pass(outputs map[string][]any) ([]CanvasEvent, error) {
 	var events []CanvasEvent
 	err := database.Conn().Transaction(func(tx *gorm.DB) error {
@@ -392,26 +597,9 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 	//
 	// Create events for outputs
 	//
-	events := []CanvasEvent{}
-	for channel, outputs := range channelOutputs {
-		for _, event := range outputs {
-			events = append(events, CanvasEvent{
-				WorkflowID:  e.WorkflowID,
-				NodeID:      e.NodeID,
-				Channel:     channel,
-				Data:        datatypes.NewJSONType(event),
-				ExecutionID: &e.ID,
-				State:       CanvasEventStatePending,
-				CreatedAt:   &now,
-			})
-		}
-	}
-
-	if len(events) > 0 {
-		err := tx.Create(&events).Error
-		if err != nil {
-			return nil, fmt.Errorf("failed to create events: %w", err)
-		}
+	events, err := e.EmitInTransaction(tx, channelOutputs)
+	if err != nil {
+		return nil, err
 	}
 
 	//
@@ -436,15 +624,62 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 	//
 	err = tx.Model(e).
 		Updates(map[string]interface{}{
-			"state":      CanvasNodeExecutionStateFinished,
-			"result":     CanvasNodeExecutionResultPassed,
-			"updated_at": &now,
+			"state":       CanvasNodeExecutionStateFinished,
+			"result":      CanvasNodeExecutionResultPassed,
+			"updated_at":  &now,
+			"finished_at": &now,
 		}).Error
 
 	if err != nil {
 		return nil, err
 	}
 
+	//
+	// The events emitted are routed, and the invocation check
+	// for the canvas happens then, but if nothing was emitted,
+	// nothing else moves the execution tree forward.
+	//
+	if len(events) == 0 {
+		err = ScheduleCanvasInvocationCheck(tx, e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// EmitInTransaction creates output events for the execution,
+// without changing its state. This allows executions
+// to emit outputs while they are still running.
+func (e *CanvasNodeExecution) EmitInTransaction(tx *gorm.DB, channelOutputs map[string][]any) ([]CanvasEvent, error) {
+	now := time.Now()
+	events := []CanvasEvent{}
+	for channel, outputs := range channelOutputs {
+		for _, event := range outputs {
+			events = append(events, CanvasEvent{
+				WorkflowID:  e.WorkflowID,
+				NodeID:      e.NodeID,
+				Channel:     channel,
+				Data:        datatypes.NewJSONType(event),
+				ExecutionID: &e.ID,
+				State:       CanvasEventStatePending,
+				CreatedAt:   &now,
+				Traceparent: e.Traceparent,
+				Priority:    e.Priority,
+			})
+		}
+	}
+
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	err := tx.Create(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to create events: %w", err)
+	}
+
 	return events, nil
 }
 
@@ -464,20 +699,47 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 			"result_reason":  reason,
 			"result_message": message,
 			"updated_at":     &now,
+			"finished_at":    &now,
 		}).Error
 
 	if err != nil {
 		return err
 	}
 
-	//
-	// Update the workflow node state to ready.
-	//
 	node, err := FindCanvasNode(tx, e.WorkflowID, e.NodeID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
 	}
 
+	//
+	// If the node retry policy allows another attempt,
+	// we schedule it, and leave the node state alone,
+	// so no other queue items are processed while the retry is pending.
+	// Parent executions are not failed either, since the retry might succeed.
+	//
+	if node != nil {
+		scheduled, err := e.scheduleRetry(tx, node, reason)
+		if err != nil {
+			return err
+		}
+
+		if scheduled {
+			return nil
+		}
+	}
+
+	return e.FinishFailureInTransaction(tx, node, reason, message)
+}
+
+// FinishFailureInTransaction is what happens after an execution fails
+// and no retry is scheduled for it: the node goes back to ready,
+// and the parent execution, if any, fails too.
+// Executions that failed while waiting for a retry that will not happen
+// use it too, so their nodes are not left processing.
+func (e *CanvasNodeExecution) FinishFailureInTransaction(tx *gorm.DB, node *CanvasNode, reason, message string) error {
+	//
+	// Update the workflow node state to ready.
+	//
 	if node != nil {
 		if node.State != CanvasNodeStatePaused {
 			err := node.UpdateState(tx, CanvasNodeStateReady)
@@ -491,17 +753,40 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 	// Since an execution failure does not emit anything,
 	// we need to update the parent execution here too,
 	// if this execution is a child one.
+	// Parent executions that already finished are left alone.
 	//
 	if e.ParentExecutionID != nil {
-		parent, err := FindNodeExecution(e.WorkflowID, *e.ParentExecutionID)
+		parent, err := FindNodeExecutionInTransaction(tx, e.WorkflowID, *e.ParentExecutionID)
 		if err != nil {
 			return err
 		}
 
-		return parent.FailInTransaction(tx, reason, message)
+		if parent.State != CanvasNodeExecutionStateFinished {
+			return parent.FailInTransaction(tx, reason, message)
+		}
 	}
 
-	return nil
+	return ScheduleCanvasInvocationCheck(tx, e)
+}
+
+func (e *CanvasNodeExecution) scheduleRetry(tx *gorm.DB, node *CanvasNode, reason string) (bool, error) {
+	policy := node.GetRetryPolicy()
+	if policy == nil {
+		return false, nil
+	}
+
+	attempt := e.GetAttempt()
+	if !policy.ShouldRetry(attempt, reason) {
+		return false, nil
+	}
+
+	runAt := time.Now().Add(policy.Delay(attempt))
+	err := e.CreateRequest(tx, NodeRequestTypeRetryExecution, NodeExecutionRequestSpec{}, &runAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to schedule retry: %w", err)
+	}
+
+	return true, nil
 }
 
 func (e *CanvasNodeExecution) Cancel(cancelledBy *uuid.UUID) error {
@@ -517,6 +802,7 @@ func (e *CanvasNodeExecution) CancelInTransaction(tx *gorm.DB, cancelledBy *uuid
 			"result":       CanvasNodeExecutionResultCancelled,
 			"cancelled_by": cancelledBy,
 			"updated_at":   &now,
+			"finished_at":  &now,
 		}).Error
 
 	if err != nil {
@@ -537,7 +823,7 @@ func (e *CanvasNodeExecution) CancelInTransaction(tx *gorm.DB, cancelledBy *uuid
 		}
 	}
 
-	return nil
+	return ScheduleCanvasInvocationCheck(tx, e)
 }
 
 func (e *CanvasNodeExecution) GetInput(tx *gorm.DB) (any, error) {
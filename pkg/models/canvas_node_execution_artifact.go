@@ -0,0 +1,84 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+//
+// CanvasNodeExecutionArtifact is a file produced by a node execution,
+// like a log, a test report or an SBOM. Only the metadata is kept here.
+// The content is in the artifact storage, under StorageKey.
+//
+
+type CanvasNodeExecutionArtifact struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	WorkflowID  uuid.UUID
+	NodeID      string
+	ExecutionID uuid.UUID
+	Name        string
+	ContentType string
+	Size        int64
+	SHA256      string `gorm:"column:sha256"`
+	Storage     string
+	StorageKey  string
+	CreatedAt   *time.Time
+}
+
+func (a *CanvasNodeExecutionArtifact) TableName() string {
+	return "workflow_node_execution_artifacts"
+}
+
+func CreateNodeExecutionArtifactInTransaction(tx *gorm.DB, artifact *CanvasNodeExecutionArtifact) error {
+	now := time.Now()
+	artifact.CreatedAt = &now
+
+	err := tx.Create(artifact).Error
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return ErrNameAlreadyUsed
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func ListNodeExecutionArtifacts(executionID uuid.UUID) ([]CanvasNodeExecutionArtifact, error) {
+	return ListNodeExecutionArtifactsInTransaction(database.Conn(), executionID)
+}
+
+func ListNodeExecutionArtifactsInTransaction(tx *gorm.DB, executionID uuid.UUID) ([]CanvasNodeExecutionArtifact, error) {
+	var artifacts []CanvasNodeExecutionArtifact
+	err := tx.
+		Where("execution_id = ?", executionID).
+		Order("created_at ASC").
+		Find(&artifacts).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return artifacts, nil
+}
+
+func FindNodeExecutionArtifact(executionID, artifactID uuid.UUID) (*CanvasNodeExecutionArtifact, error) {
+	var artifact CanvasNodeExecutionArtifact
+	err := database.Conn().
+		Where("execution_id = ?", executionID).
+		Where("id = ?", artifactID).
+		First(&artifact).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &artifact, nil
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+const (
+	CanvasNodeExecutionLogStreamLog    = "log"
+	CanvasNodeExecutionLogStreamStdout = "stdout"
+	CanvasNodeExecutionLogStreamStderr = "stderr"
+
+	MaxExecutionLogLimit = 1000
+)
+
+//
+// CanvasNodeExecutionLog is a single line logged by a node execution,
+// either through the execution logger, or streamed by the component,
+// like the output of a command. IDs are sequential, so they are used
+// as the cursor when reading or tailing the log.
+//
+
+type CanvasNodeExecutionLog struct {
+	ID          int64 `gorm:"primaryKey;autoIncrement"`
+	WorkflowID  uuid.UUID
+	NodeID      string
+	ExecutionID uuid.UUID
+	Stream      string
+	Level       string
+	Message     string
+	CreatedAt   *time.Time
+}
+
+func (l *CanvasNodeExecutionLog) TableName() string {
+	return "workflow_node_execution_logs"
+}
+
+//
+// Log lines are always written through their own connection,
+// and not the transaction processing the execution, so they are
+// visible while the execution runs, and kept if it fails.
+//
+
+func CreateNodeExecutionLogs(logs []CanvasNodeExecutionLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	return database.Conn().Create(&logs).Error
+}
+
+func ListNodeExecutionLogs(executionID uuid.UUID, after int64, limit int) ([]CanvasNodeExecutionLog, error) {
+	var logs []CanvasNodeExecutionLog
+	err := database.Conn().
+		Where("execution_id = ?", executionID).
+		Where("id > ?", after).
+		Order("id ASC").
+		Limit(limit).
+		Find(&logs).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
@@ -11,7 +11,13 @@ import (
 )
 
 const (
-	NodeRequestTypeInvokeAction = "invoke-action"
+	NodeRequestTypeInvokeAction     = "invoke-action"
+	NodeRequestTypeCancelExecution  = "cancel-execution"
+	NodeRequestTypeRetryExecution   = "retry-execution"
+	NodeRequestTypeTimeoutExecution = "timeout-execution"
+
+	NodeRequestTypeCompleteCanvasInvocation = "complete-canvas-invocation"
+	NodeRequestTypeBranchesStopped          = "branches-stopped"
 
 	NodeExecutionRequestStatePending   = "pending"
 	NodeExecutionRequestStateCompleted = "completed"
@@ -35,7 +41,8 @@ func (r *CanvasNodeRequest) TableName() string {
 }
 
 type NodeExecutionRequestSpec struct {
-	InvokeAction *InvokeAction `json:"invoke_action,omitempty"`
+	InvokeAction             *InvokeAction             `json:"invoke_action,omitempty"`
+	CompleteCanvasInvocation *CompleteCanvasInvocation `json:"complete_canvas_invocation,omitempty"`
 }
 
 type InvokeAction struct {
@@ -43,6 +50,10 @@ type InvokeAction struct {
 	Parameters map[string]any `json:"parameters"`
 }
 
+type CompleteCanvasInvocation struct {
+	RootEventID uuid.UUID `json:"root_event_id"`
+}
+
 func LockNodeRequest(tx *gorm.DB, id uuid.UUID) (*CanvasNodeRequest, error) {
 	var request CanvasNodeRequest
 
@@ -98,6 +109,22 @@ func FindPendingRequestForNode(tx *gorm.DB, workflowID uuid.UUID, nodeID string)
 	return &request, nil
 }
 
+// Reschedule keeps a pending request from being processed
+// until the given time.
+func (r *CanvasNodeRequest) Reschedule(tx *gorm.DB, runAt time.Time) error {
+	err := tx.Model(r).
+		Update("run_at", runAt).
+		Update("updated_at", time.Now()).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	r.RunAt = runAt
+	return nil
+}
+
 func (r *CanvasNodeRequest) Complete(tx *gorm.DB) error {
 	return tx.Model(r).
 		Update("state", NodeExecutionRequestStateCompleted).
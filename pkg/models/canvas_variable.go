@@ -0,0 +1,202 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const MaxCanvasVariableNameLength = 128
+
+var ErrCanvasVariableVersionConflict = errors.New("variable version conflict")
+
+// Variable names must be valid identifiers, so they can be used as vars.<name> in expressions.
+var canvasVariableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// CanvasVariable is a named value kept between runs of a canvas.
+// Variables are available in expressions as vars.<name>,
+// and can be changed through the API or by components.
+// Every write increments the version, which is used for optimistic concurrency.
+type CanvasVariable struct {
+	WorkflowID           uuid.UUID `gorm:"primaryKey"`
+	Name                 string    `gorm:"primaryKey"`
+	Value                datatypes.JSONType[any]
+	Version              int
+	UpdatedBy            *uuid.UUID
+	UpdatedByExecutionID *uuid.UUID
+	CreatedAt            *time.Time
+	UpdatedAt            *time.Time
+}
+
+func (v *CanvasVariable) TableName() string {
+	return "workflow_variables"
+}
+
+func ValidateCanvasVariableName(name string) error {
+	if len(name) > MaxCanvasVariableNameLength {
+		return fmt.Errorf("variable name cannot be longer than %d characters", MaxCanvasVariableNameLength)
+	}
+
+	if !canvasVariableNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid variable name %q: use letters, digits and underscores, not starting with a digit", name)
+	}
+
+	return nil
+}
+
+func ListCanvasVariables(canvasID uuid.UUID) ([]CanvasVariable, error) {
+	return ListCanvasVariablesInTransaction(database.Conn(), canvasID)
+}
+
+func ListCanvasVariablesInTransaction(tx *gorm.DB, canvasID uuid.UUID) ([]CanvasVariable, error) {
+	var variables []CanvasVariable
+	err := tx.
+		Where("workflow_id = ?", canvasID).
+		Order("name ASC").
+		Find(&variables).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
+
+func FindCanvasVariable(canvasID uuid.UUID, name string) (*CanvasVariable, error) {
+	return FindCanvasVariableInTransaction(database.Conn(), canvasID, name)
+}
+
+func FindCanvasVariableInTransaction(tx *gorm.DB, canvasID uuid.UUID, name string) (*CanvasVariable, error) {
+	var variable CanvasVariable
+	err := tx.
+		Where("workflow_id = ?", canvasID).
+		Where("name = ?", name).
+		First(&variable).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &variable, nil
+}
+
+// SetCanvasVariableInTransaction writes the variable and increments its version.
+// If expectedVersion is not nil, the variable is only written if its current version
+// is expectedVersion, where 0 means the variable must not exist yet,
+// and ErrCanvasVariableVersionConflict is returned otherwise.
+func SetCanvasVariableInTransaction(tx *gorm.DB, variable *CanvasVariable, expectedVersion *int) error {
+	now := time.Now()
+	variable.UpdatedAt = &now
+
+	if expectedVersion == nil {
+		variable.CreatedAt = &now
+		variable.Version = 1
+
+		return tx.Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "workflow_id"}, {Name: "name"}},
+				DoUpdates: clause.Assignments(map[string]any{
+					"value":                   variable.Value,
+					"version":                 gorm.Expr("workflow_variables.version + 1"),
+					"updated_by":              variable.UpdatedBy,
+					"updated_by_execution_id": variable.UpdatedByExecutionID,
+					"updated_at":              now,
+				}),
+			},
+			clause.Returning{},
+		).Create(variable).Error
+	}
+
+	if *expectedVersion == 0 {
+		variable.CreatedAt = &now
+		variable.Version = 1
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(variable)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrCanvasVariableVersionConflict
+		}
+
+		return nil
+	}
+
+	result := tx.
+		Model(variable).
+		Clauses(clause.Returning{}).
+		Where("workflow_id = ?", variable.WorkflowID).
+		Where("name = ?", variable.Name).
+		Where("version = ?", *expectedVersion).
+		Updates(map[string]any{
+			"value":                   variable.Value,
+			"version":                 gorm.Expr("version + 1"),
+			"updated_by":              variable.UpdatedBy,
+			"updated_by_execution_id": variable.UpdatedByExecutionID,
+			"updated_at":              now,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrCanvasVariableVersionConflict
+	}
+
+	return nil
+}
+
+// DeleteCanvasVariable deletes the variable.
+// If expectedVersion is not nil, the variable is only deleted
+// if its current version is expectedVersion.
+func DeleteCanvasVariable(canvasID uuid.UUID, name string, expectedVersion *int) error {
+	query := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		Where("name = ?", name)
+
+	if expectedVersion != nil {
+		query = query.Where("version = ?", *expectedVersion)
+	}
+
+	result := query.Delete(&CanvasVariable{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		if expectedVersion != nil {
+			return ErrCanvasVariableVersionConflict
+		}
+
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// CanvasVariableValues returns the values of the variables of the canvas by name,
+// as they are exposed to expressions.
+func CanvasVariableValues(tx *gorm.DB, canvasID uuid.UUID) (map[string]any, error) {
+	variables, err := ListCanvasVariablesInTransaction(tx, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]any, len(variables))
+	for _, variable := range variables {
+		values[variable.Name] = variable.Value.Data()
+	}
+
+	return values, nil
+}
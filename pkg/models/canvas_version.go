@@ -0,0 +1,153 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// CanvasVersion is an immutable snapshot of a canvas,
+// created every time the canvas is created, updated or restored.
+type CanvasVersion struct {
+	ID          uuid.UUID `gorm:"primaryKey;default:uuid_generate_v4()"`
+	WorkflowID  uuid.UUID
+	Version     int
+	Name        string
+	Description string
+	Nodes       datatypes.JSONSlice[Node]
+	Edges       datatypes.JSONSlice[Edge]
+	Message     string
+	CreatedBy   *uuid.UUID
+	CreatedAt   *time.Time
+}
+
+func (v *CanvasVersion) TableName() string {
+	return "workflow_versions"
+}
+
+// CreateCanvasVersionInTransaction records the current state of the canvas as its next version.
+// It must run in the same transaction that created or updated the canvas record,
+// since the lock on that record is what serializes concurrent version numbering.
+func CreateCanvasVersionInTransaction(tx *gorm.DB, canvas *Canvas, createdBy *uuid.UUID, message string) (*CanvasVersion, error) {
+	var latest int
+	err := tx.
+		Model(&CanvasVersion{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("workflow_id = ?", canvas.ID).
+		Scan(&latest).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	version := CanvasVersion{
+		WorkflowID:  canvas.ID,
+		Version:     latest + 1,
+		Name:        canvas.Name,
+		Description: canvas.Description,
+		Nodes:       canvas.Nodes,
+		Edges:       canvas.Edges,
+		Message:     message,
+		CreatedBy:   createdBy,
+		CreatedAt:   &now,
+	}
+
+	err = tx.Create(&version).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+func ListCanvasVersions(canvasID uuid.UUID, limit int, before *int) ([]CanvasVersion, error) {
+	var versions []CanvasVersion
+	query := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		Order("version DESC").
+		Limit(limit)
+
+	if before != nil {
+		query = query.Where("version < ?", *before)
+	}
+
+	err := query.Find(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+func CountCanvasVersions(canvasID uuid.UUID) (int64, error) {
+	var count int64
+	err := database.Conn().
+		Model(&CanvasVersion{}).
+		Where("workflow_id = ?", canvasID).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func FindCanvasVersion(canvasID, id uuid.UUID) (*CanvasVersion, error) {
+	var version CanvasVersion
+	err := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		Where("id = ?", id).
+		First(&version).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// FindPreviousCanvasVersion finds the version created right before the given one.
+func FindPreviousCanvasVersion(version *CanvasVersion) (*CanvasVersion, error) {
+	var previous CanvasVersion
+	err := database.Conn().
+		Where("workflow_id = ?", version.WorkflowID).
+		Where("version < ?", version.Version).
+		Order("version DESC").
+		First(&previous).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &previous, nil
+}
+
+func FindLatestCanvasVersionIDInTransaction(tx *gorm.DB, canvasID uuid.UUID) (*uuid.UUID, error) {
+	var versions []CanvasVersion
+	err := tx.
+		Select("id").
+		Where("workflow_id = ?", canvasID).
+		Order("version DESC").
+		Limit(1).
+		Find(&versions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return nil, nil
+	}
+
+	return &versions[0].ID, nil
+}
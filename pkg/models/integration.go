@@ -27,6 +27,7 @@ type Integration struct {
 	Configuration    datatypes.JSONType[map[string]any]
 	Metadata         datatypes.JSONType[map[string]any]
 	BrowserAction    *datatypes.JSONType[BrowserAction]
+	RateLimit        *datatypes.JSONType[RateLimit]
 	CreatedAt        *time.Time
 	UpdatedAt        *time.Time
 	DeletedAt        gorm.DeletedAt `gorm:"index"`
@@ -36,6 +37,29 @@ func (a *Integration) TableName() string {
 	return "app_installations"
 }
 
+func (a *Integration) GetRateLimit() *RateLimit {
+	if a.RateLimit == nil {
+		return nil
+	}
+
+	limit := a.RateLimit.Data()
+	if limit.Limit == 0 {
+		return nil
+	}
+
+	return &limit
+}
+
+func (a *Integration) SetRateLimit(limit *RateLimit) {
+	if limit == nil || limit.Limit == 0 {
+		a.RateLimit = nil
+		return
+	}
+
+	rateLimit := datatypes.NewJSONType(*limit)
+	a.RateLimit = &rateLimit
+}
+
 type IntegrationSecret struct {
 	ID             uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
 	OrganizationID uuid.UUID
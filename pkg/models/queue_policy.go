@@ -0,0 +1,63 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	QueuePolicyStrategyDebounce       = "debounce"
+	QueuePolicyStrategyCoalesce       = "coalesce"
+	QueuePolicyStrategyDropDuplicates = "drop-duplicates"
+
+	MaxQueueDebounceSeconds = 3600
+)
+
+// QueuePolicy controls how queue items for a node
+// are handled when the node receives bursts of events.
+//
+// Items are grouped by Key, an expression evaluated against
+// the event that created the item. Without a key, all the items
+// in the queue of the node belong to the same group.
+//
+//   - debounce: the node waits until no new items are added to the group
+//     for DebounceSeconds, and then only processes the latest one.
+//   - coalesce: only the latest item in the group is processed.
+//   - drop-duplicates: items added while another item in the group
+//     is already queued are dropped.
+//
+// Items that are not processed because of the policy are recorded
+// as cancelled executions, with the skipped result reason.
+type QueuePolicy struct {
+	Strategy        string `json:"strategy"`
+	DebounceSeconds int    `json:"debounceSeconds,omitempty"`
+	Key             string `json:"key,omitempty"`
+}
+
+func (p *QueuePolicy) DebounceDuration() time.Duration {
+	return time.Duration(p.DebounceSeconds) * time.Second
+}
+
+func (p *QueuePolicy) Validate() error {
+	switch p.Strategy {
+	case QueuePolicyStrategyDebounce:
+		if p.DebounceSeconds < 1 || p.DebounceSeconds > MaxQueueDebounceSeconds {
+			return fmt.Errorf("queue policy debounce must be between 1 and %d seconds", MaxQueueDebounceSeconds)
+		}
+
+	case QueuePolicyStrategyCoalesce, QueuePolicyStrategyDropDuplicates:
+		if strings.TrimSpace(p.Key) == "" {
+			return fmt.Errorf("queue policy key is required for %s", p.Strategy)
+		}
+
+		if p.DebounceSeconds != 0 {
+			return fmt.Errorf("queue policy debounce is only supported for debounce")
+		}
+
+	default:
+		return fmt.Errorf("invalid queue policy strategy %s", p.Strategy)
+	}
+
+	return nil
+}
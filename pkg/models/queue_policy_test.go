@@ -0,0 +1,28 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test__QueuePolicy(t *testing.T) {
+	t.Run("debounce requires a valid debounce", func(t *testing.T) {
+		assert.NoError(t, (&QueuePolicy{Strategy: QueuePolicyStrategyDebounce, DebounceSeconds: 30}).Validate())
+		assert.NoError(t, (&QueuePolicy{Strategy: QueuePolicyStrategyDebounce, DebounceSeconds: 30, Key: "{{ root().ref }}"}).Validate())
+		assert.Error(t, (&QueuePolicy{Strategy: QueuePolicyStrategyDebounce}).Validate())
+		assert.Error(t, (&QueuePolicy{Strategy: QueuePolicyStrategyDebounce, DebounceSeconds: MaxQueueDebounceSeconds + 1}).Validate())
+	})
+
+	t.Run("coalesce and drop-duplicates require a key", func(t *testing.T) {
+		assert.NoError(t, (&QueuePolicy{Strategy: QueuePolicyStrategyCoalesce, Key: "{{ root().ref }}"}).Validate())
+		assert.NoError(t, (&QueuePolicy{Strategy: QueuePolicyStrategyDropDuplicates, Key: "{{ root().ref }}"}).Validate())
+		assert.Error(t, (&QueuePolicy{Strategy: QueuePolicyStrategyCoalesce}).Validate())
+		assert.Error(t, (&QueuePolicy{Strategy: QueuePolicyStrategyDropDuplicates, Key: " "}).Validate())
+		assert.Error(t, (&QueuePolicy{Strategy: QueuePolicyStrategyCoalesce, Key: "{{ root().ref }}", DebounceSeconds: 10}).Validate())
+	})
+
+	t.Run("unknown strategy is invalid", func(t *testing.T) {
+		assert.Error(t, (&QueuePolicy{Strategy: "throttle"}).Validate())
+	})
+}
@@ -0,0 +1,203 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	MaxRateLimit              = 100000
+	MaxRateLimitPeriodSeconds = 86400
+)
+
+// RateLimit allows at most Limit operations every PeriodSeconds.
+// It is enforced as a token bucket with Limit tokens,
+// refilled at a constant rate over the period, so bursts
+// of up to Limit operations are allowed.
+type RateLimit struct {
+	Limit         int `json:"limit"`
+	PeriodSeconds int `json:"periodSeconds"`
+}
+
+func (l *RateLimit) Period() time.Duration {
+	return time.Duration(l.PeriodSeconds) * time.Second
+}
+
+// refillRate is how many tokens are added to the bucket every second.
+func (l *RateLimit) refillRate() float64 {
+	return float64(l.Limit) / float64(l.PeriodSeconds)
+}
+
+func (l *RateLimit) Validate() error {
+	if l.Limit < 1 || l.Limit > MaxRateLimit {
+		return fmt.Errorf("rate limit must be between 1 and %d", MaxRateLimit)
+	}
+
+	if l.PeriodSeconds < 1 || l.PeriodSeconds > MaxRateLimitPeriodSeconds {
+		return fmt.Errorf("rate limit period must be between 1 and %d seconds", MaxRateLimitPeriodSeconds)
+	}
+
+	return nil
+}
+
+func NodeRateLimitKey(canvasID uuid.UUID, nodeID string) string {
+	return fmt.Sprintf("node:%s:%s", canvasID, nodeID)
+}
+
+func IntegrationRateLimitKey(integrationID uuid.UUID) string {
+	return fmt.Sprintf("integration:%s", integrationID)
+}
+
+//
+// RateLimitBucket holds the state of a rate limit,
+// shared by all the workers enforcing it.
+//
+// BlockedUntil is set when the remote side tells us to back off,
+// e.g. with a 429 response, and no tokens are handed out until then,
+// even if the bucket has no rate limit configured.
+//
+
+type RateLimitBucket struct {
+	Key          string `gorm:"primaryKey"`
+	Tokens       float64
+	RefilledAt   *time.Time
+	BlockedUntil *time.Time
+	UpdatedAt    *time.Time
+}
+
+func (b *RateLimitBucket) TableName() string {
+	return "rate_limit_buckets"
+}
+
+// TakeRateLimitToken takes a token from the bucket in its own transaction,
+// so the bucket is not locked while the caller uses the token.
+// It returns how long to wait before trying again if no token is available.
+func TakeRateLimitToken(key string, limit *RateLimit, now time.Time) (time.Duration, error) {
+	var wait time.Duration
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		wait, err = TakeRateLimitTokenInTransaction(tx, key, limit, now)
+		return err
+	})
+
+	return wait, err
+}
+
+// TakeRateLimitTokenInTransaction takes a token from the bucket.
+// If limit is nil, only a block on the bucket is checked.
+func TakeRateLimitTokenInTransaction(tx *gorm.DB, key string, limit *RateLimit, now time.Time) (time.Duration, error) {
+	if limit == nil {
+		return rateLimitBlockedFor(tx, key, now)
+	}
+
+	bucket, err := lockRateLimitBucket(tx, key, float64(limit.Limit), now)
+	if err != nil {
+		return 0, err
+	}
+
+	if bucket.BlockedUntil != nil && now.Before(*bucket.BlockedUntil) {
+		return bucket.BlockedUntil.Sub(now), nil
+	}
+
+	rate := limit.refillRate()
+	elapsed := now.Sub(*bucket.RefilledAt).Seconds()
+	if elapsed > 0 {
+		bucket.Tokens = math.Min(float64(limit.Limit), bucket.Tokens+elapsed*rate)
+		bucket.RefilledAt = &now
+	}
+
+	//
+	// If the limit was lowered since the bucket was filled,
+	// the bucket might hold more tokens than the new limit allows.
+	//
+	bucket.Tokens = math.Min(float64(limit.Limit), bucket.Tokens)
+
+	var wait time.Duration
+	if bucket.Tokens >= 1 {
+		bucket.Tokens--
+	} else {
+		wait = time.Duration((1 - bucket.Tokens) / rate * float64(time.Second))
+	}
+
+	bucket.UpdatedAt = &now
+	err = tx.Save(bucket).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return wait, nil
+}
+
+func rateLimitBlockedFor(tx *gorm.DB, key string, now time.Time) (time.Duration, error) {
+	var bucket RateLimitBucket
+	err := tx.Where("key = ?", key).First(&bucket).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	if bucket.BlockedUntil != nil && now.Before(*bucket.BlockedUntil) {
+		return bucket.BlockedUntil.Sub(now), nil
+	}
+
+	return 0, nil
+}
+
+func lockRateLimitBucket(tx *gorm.DB, key string, tokens float64, now time.Time) (*RateLimitBucket, error) {
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&RateLimitBucket{
+			Key:        key,
+			Tokens:     tokens,
+			RefilledAt: &now,
+			UpdatedAt:  &now,
+		}).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var bucket RateLimitBucket
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("key = ?", key).
+		First(&bucket).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &bucket, nil
+}
+
+// BlockRateLimitBucket stops handing out tokens for the bucket until the given time.
+// An existing block is only extended, never shortened.
+func BlockRateLimitBucket(key string, until time.Time) error {
+	now := time.Now()
+	return database.Conn().
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "key"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"blocked_until": gorm.Expr("GREATEST(rate_limit_buckets.blocked_until, EXCLUDED.blocked_until)"),
+				"updated_at":    now,
+			}),
+		}).
+		Create(&RateLimitBucket{
+			Key:          key,
+			Tokens:       0,
+			RefilledAt:   &now,
+			BlockedUntil: &until,
+			UpdatedAt:    &now,
+		}).
+		Error
+}
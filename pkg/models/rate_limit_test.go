@@ -0,0 +1,85 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+func Test__RateLimit_Validate(t *testing.T) {
+	assert.NoError(t, (&RateLimit{Limit: 30, PeriodSeconds: 60}).Validate())
+	assert.Error(t, (&RateLimit{Limit: 0, PeriodSeconds: 60}).Validate())
+	assert.Error(t, (&RateLimit{Limit: 30, PeriodSeconds: 0}).Validate())
+	assert.Error(t, (&RateLimit{Limit: MaxRateLimit + 1, PeriodSeconds: 60}).Validate())
+	assert.Error(t, (&RateLimit{Limit: 30, PeriodSeconds: MaxRateLimitPeriodSeconds + 1}).Validate())
+}
+
+func Test__TakeRateLimitToken(t *testing.T) {
+	require.NoError(t, database.TruncateTables())
+
+	limit := &RateLimit{Limit: 2, PeriodSeconds: 60}
+	now := time.Now()
+
+	t.Run("tokens are taken until the bucket is empty", func(t *testing.T) {
+		key := IntegrationRateLimitKey(uuid.New())
+
+		wait, err := TakeRateLimitToken(key, limit, now)
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+
+		wait, err = TakeRateLimitToken(key, limit, now)
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+
+		//
+		// One token is refilled every 30 seconds.
+		//
+		wait, err = TakeRateLimitToken(key, limit, now)
+		require.NoError(t, err)
+		assert.InDelta(t, 30*time.Second, wait, float64(time.Second))
+
+		wait, err = TakeRateLimitToken(key, limit, now.Add(30*time.Second))
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	})
+
+	t.Run("blocked bucket hands out no tokens", func(t *testing.T) {
+		key := IntegrationRateLimitKey(uuid.New())
+		require.NoError(t, BlockRateLimitBucket(key, now.Add(time.Minute)))
+
+		wait, err := TakeRateLimitToken(key, limit, now)
+		require.NoError(t, err)
+		assert.InDelta(t, time.Minute, wait, float64(time.Second))
+
+		//
+		// Blocks apply even without a rate limit.
+		//
+		wait, err = TakeRateLimitToken(key, nil, now)
+		require.NoError(t, err)
+		assert.InDelta(t, time.Minute, wait, float64(time.Second))
+
+		wait, err = TakeRateLimitToken(key, nil, now.Add(2*time.Minute))
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	})
+
+	t.Run("blocks are only extended", func(t *testing.T) {
+		key := IntegrationRateLimitKey(uuid.New())
+		require.NoError(t, BlockRateLimitBucket(key, now.Add(time.Minute)))
+		require.NoError(t, BlockRateLimitBucket(key, now.Add(time.Second)))
+
+		wait, err := TakeRateLimitToken(key, nil, now)
+		require.NoError(t, err)
+		assert.InDelta(t, time.Minute, wait, float64(time.Second))
+	})
+
+	t.Run("no bucket and no rate limit", func(t *testing.T) {
+		wait, err := TakeRateLimitToken(IntegrationRateLimitKey(uuid.New()), nil, now)
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	})
+}
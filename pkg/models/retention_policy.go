@@ -0,0 +1,368 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	MaxRetentionPolicyAgeDays = 3650
+	MaxRetentionPolicyRuns    = 1000000
+)
+
+//
+// RetentionPolicy controls how long the runs of a canvas are kept.
+// A run is a root event, and everything created while processing it:
+// executions, their outputs, logs, artifacts, and so on.
+//
+// Runs older than MaxAgeDays, or beyond the newest MaxRuns, are deleted.
+// Zero means no limit. If Archive is set, executions are archived before being deleted.
+//
+// An organization has at most one policy, with no WorkflowID,
+// and each canvas can have its own, which takes precedence over it.
+//
+
+type RetentionPolicy struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OrganizationID uuid.UUID
+	WorkflowID     *uuid.UUID
+	MaxAgeDays     int
+	MaxRuns        int
+	Archive        bool
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
+
+func (p *RetentionPolicy) TableName() string {
+	return "retention_policies"
+}
+
+func (p *RetentionPolicy) IsEnabled() bool {
+	return p.MaxAgeDays > 0 || p.MaxRuns > 0
+}
+
+func ValidateRetentionPolicy(maxAgeDays, maxRuns int) error {
+	if maxAgeDays < 0 || maxAgeDays > MaxRetentionPolicyAgeDays {
+		return errors.New("max age must be between 0 and 3650 days")
+	}
+
+	if maxRuns < 0 || maxRuns > MaxRetentionPolicyRuns {
+		return errors.New("max runs must be between 0 and 1000000")
+	}
+
+	return nil
+}
+
+func FindOrganizationRetentionPolicy(organizationID uuid.UUID) (*RetentionPolicy, error) {
+	var policy RetentionPolicy
+	err := database.Conn().
+		Where("organization_id = ?", organizationID).
+		Where("workflow_id IS NULL").
+		First(&policy).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func FindCanvasRetentionPolicy(canvasID uuid.UUID) (*RetentionPolicy, error) {
+	var policy RetentionPolicy
+	err := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		First(&policy).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// FindEffectiveRetentionPolicy returns the policy applied to the canvas:
+// its own policy, if it has one, or the policy of its organization.
+func FindEffectiveRetentionPolicy(organizationID, canvasID uuid.UUID) (*RetentionPolicy, error) {
+	policy, err := FindCanvasRetentionPolicy(canvasID)
+	if err == nil {
+		return policy, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return FindOrganizationRetentionPolicy(organizationID)
+}
+
+func SaveOrganizationRetentionPolicy(organizationID uuid.UUID, maxAgeDays, maxRuns int, archive bool) (*RetentionPolicy, error) {
+	return saveRetentionPolicy(&RetentionPolicy{
+		OrganizationID: organizationID,
+		MaxAgeDays:     maxAgeDays,
+		MaxRuns:        maxRuns,
+		Archive:        archive,
+	}, "organization_id", "workflow_id IS NULL")
+}
+
+func SaveCanvasRetentionPolicy(organizationID, canvasID uuid.UUID, maxAgeDays, maxRuns int, archive bool) (*RetentionPolicy, error) {
+	return saveRetentionPolicy(&RetentionPolicy{
+		OrganizationID: organizationID,
+		WorkflowID:     &canvasID,
+		MaxAgeDays:     maxAgeDays,
+		MaxRuns:        maxRuns,
+		Archive:        archive,
+	}, "workflow_id", "workflow_id IS NOT NULL")
+}
+
+func saveRetentionPolicy(policy *RetentionPolicy, column, targetWhere string) (*RetentionPolicy, error) {
+	now := time.Now()
+	policy.CreatedAt = &now
+	policy.UpdatedAt = &now
+
+	err := database.Conn().Clauses(
+		clause.OnConflict{
+			Columns:     []clause.Column{{Name: column}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: targetWhere}}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"max_age_days": policy.MaxAgeDays,
+				"max_runs":     policy.MaxRuns,
+				"archive":      policy.Archive,
+				"updated_at":   now,
+			}),
+		},
+		clause.Returning{},
+	).Create(policy).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func DeleteCanvasRetentionPolicy(canvasID uuid.UUID) error {
+	return database.Conn().
+		Where("workflow_id = ?", canvasID).
+		Delete(&RetentionPolicy{}).
+		Error
+}
+
+// EffectiveRetentionPolicy is the policy applied to a canvas.
+type EffectiveRetentionPolicy struct {
+	WorkflowID     uuid.UUID
+	OrganizationID uuid.UUID
+	MaxAgeDays     int
+	MaxRuns        int
+	Archive        bool
+}
+
+// ListEffectiveRetentionPolicies returns the policy applied
+// to every canvas that has one with some limit set.
+func ListEffectiveRetentionPolicies() ([]EffectiveRetentionPolicy, error) {
+	var policies []EffectiveRetentionPolicy
+	err := database.Conn().
+		Raw(`
+			SELECT
+				w.id AS workflow_id,
+				w.organization_id,
+				COALESCE(c.max_age_days, o.max_age_days) AS max_age_days,
+				COALESCE(c.max_runs, o.max_runs) AS max_runs,
+				COALESCE(c.archive, o.archive) AS archive
+			FROM workflows w
+			LEFT JOIN retention_policies c ON c.workflow_id = w.id
+			LEFT JOIN retention_policies o ON o.organization_id = w.organization_id AND o.workflow_id IS NULL
+			WHERE w.deleted_at IS NULL
+			AND w.is_template = false
+			AND (COALESCE(c.max_age_days, o.max_age_days) > 0 OR COALESCE(c.max_runs, o.max_runs) > 0)
+		`).
+		Scan(&policies).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// ListExpiredCanvasRunsInTransaction returns the oldest root events of runs
+// that are past the retention policy. Runs still in progress are never returned:
+// runs with pending events, queue items or unfinished executions,
+// or invoked by a Run Canvas execution that has not finished yet.
+func ListExpiredCanvasRunsInTransaction(tx *gorm.DB, policy EffectiveRetentionPolicy, now time.Time, limit int) ([]CanvasEvent, error) {
+	var expired []string
+	var args []any
+
+	if policy.MaxAgeDays > 0 {
+		expired = append(expired, "ev.created_at < ?")
+		args = append(args, now.AddDate(0, 0, -policy.MaxAgeDays))
+	}
+
+	if policy.MaxRuns > 0 {
+		expired = append(expired, `
+			ev.created_at < (
+				SELECT created_at FROM workflow_events
+				WHERE workflow_id = ev.workflow_id AND execution_id IS NULL
+				ORDER BY created_at DESC
+				OFFSET ? LIMIT 1
+			)
+		`)
+
+		args = append(args, policy.MaxRuns-1)
+	}
+
+	if len(expired) == 0 {
+		return nil, nil
+	}
+
+	condition := expired[0]
+	if len(expired) > 1 {
+		condition = "(" + expired[0] + " OR " + expired[1] + ")"
+	}
+
+	var events []CanvasEvent
+	err := tx.
+		Table("workflow_events AS ev").
+		Select("ev.*").
+		Where("ev.workflow_id = ?", policy.WorkflowID).
+		Where("ev.execution_id IS NULL").
+		Where(condition, args...).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM workflow_events p
+			WHERE p.workflow_id = ev.workflow_id
+			AND p.state = ?
+			AND (p.id = ev.id OR p.execution_id IN (SELECT id FROM workflow_node_executions WHERE root_event_id = ev.id))
+		)`, CanvasEventStatePending).
+		Where("NOT EXISTS (SELECT 1 FROM workflow_node_queue_items q WHERE q.root_event_id = ev.id)").
+		Where("NOT EXISTS (SELECT 1 FROM workflow_node_executions e WHERE e.root_event_id = ev.id AND e.state <> ?)", CanvasNodeExecutionStateFinished).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM workflow_node_executions i
+			WHERE i.id = ev.invoked_by_execution_id
+			AND i.state <> ?
+		)`, CanvasNodeExecutionStateFinished).
+		Order("ev.created_at ASC").
+		Limit(limit).
+		Scan(&events).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+//
+// CanvasRetentionStats keeps track of what the retention worker
+// did for a canvas. The row is also used to make sure
+// only one worker enforces the policy of a canvas at a time.
+//
+
+type CanvasRetentionStats struct {
+	WorkflowID         uuid.UUID `gorm:"primaryKey"`
+	LastEnforcedAt     *time.Time
+	DeletedRuns        int64
+	DeletedExecutions  int64
+	ArchivedExecutions int64
+	UpdatedAt          *time.Time
+}
+
+func (s *CanvasRetentionStats) TableName() string {
+	return "workflow_retention_stats"
+}
+
+func FindCanvasRetentionStats(canvasID uuid.UUID) (*CanvasRetentionStats, error) {
+	var stats CanvasRetentionStats
+	err := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		First(&stats).
+		Error
+
+	if err == nil {
+		return &stats, nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &CanvasRetentionStats{WorkflowID: canvasID}, nil
+	}
+
+	return nil, err
+}
+
+// LockCanvasRetentionStatsInTransaction locks the stats of the canvas, creating them if needed.
+// If another transaction holds the lock, gorm.ErrRecordNotFound is returned.
+func LockCanvasRetentionStatsInTransaction(tx *gorm.DB, canvasID uuid.UUID) (*CanvasRetentionStats, error) {
+	now := time.Now()
+	err := tx.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&CanvasRetentionStats{WorkflowID: canvasID, UpdatedAt: &now}).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var stats CanvasRetentionStats
+	err = tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("workflow_id = ?", canvasID).
+		First(&stats).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (s *CanvasRetentionStats) RecordInTransaction(tx *gorm.DB, runs, executions, archived int) error {
+	now := time.Now()
+	return tx.
+		Model(s).
+		Clauses(clause.Returning{}).
+		Where("workflow_id = ?", s.WorkflowID).
+		Updates(map[string]any{
+			"last_enforced_at":    now,
+			"deleted_runs":        gorm.Expr("deleted_runs + ?", runs),
+			"deleted_executions":  gorm.Expr("deleted_executions + ?", executions),
+			"archived_executions": gorm.Expr("archived_executions + ?", archived),
+			"updated_at":          now,
+		}).
+		Error
+}
+
+// CanvasRunsSummary is how many runs and executions a canvas has right now.
+type CanvasRunsSummary struct {
+	Runs        int64
+	Executions  int64
+	OldestRunAt *time.Time
+}
+
+func SummarizeCanvasRuns(canvasID uuid.UUID) (*CanvasRunsSummary, error) {
+	var summary CanvasRunsSummary
+	err := database.Conn().
+		Raw(`
+			SELECT
+				COUNT(*) AS runs,
+				MIN(created_at) AS oldest_run_at,
+				(SELECT COUNT(*) FROM workflow_node_executions WHERE workflow_id = ?) AS executions
+			FROM workflow_events
+			WHERE workflow_id = ? AND execution_id IS NULL
+		`, canvasID, canvasID).
+		Scan(&summary).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+const (
+	DefaultRetryInitialDelay      = 10 * time.Second
+	DefaultRetryBackoffMultiplier = 2.0
+	MaxRetryAttempts              = 10
+)
+
+// RetryPolicy describes how failed executions for a node are retried.
+// The delay before each new attempt grows exponentially,
+// starting at InitialDelaySeconds and multiplied by BackoffMultiplier
+// on every attempt, up to MaxDelaySeconds, if set.
+//
+// Jitter is a fraction between 0 and 1, used to randomize the delay,
+// so executions failing at the same time are not all retried at the same time.
+//
+// If RetryOn is empty, failures with any result reason are retried.
+type RetryPolicy struct {
+	MaxAttempts         int      `json:"maxAttempts"`
+	InitialDelaySeconds int      `json:"initialDelaySeconds,omitempty"`
+	MaxDelaySeconds     int      `json:"maxDelaySeconds,omitempty"`
+	BackoffMultiplier   float64  `json:"backoffMultiplier,omitempty"`
+	Jitter              float64  `json:"jitter,omitempty"`
+	RetryOn             []string `json:"retryOn,omitempty"`
+}
+
+// ShouldRetry returns true if an execution that failed
+// on the given attempt, with the given reason, should be retried.
+func (p *RetryPolicy) ShouldRetry(attempt int, reason string) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+
+	if len(p.RetryOn) == 0 {
+		return true
+	}
+
+	return slices.Contains(p.RetryOn, reason)
+}
+
+// Delay returns how long to wait before the attempt
+// that follows the given failed attempt.
+func (p *RetryPolicy) Delay(attempt int) time.Duration {
+	initialDelay := DefaultRetryInitialDelay
+	if p.InitialDelaySeconds > 0 {
+		initialDelay = time.Duration(p.InitialDelaySeconds) * time.Second
+	}
+
+	multiplier := DefaultRetryBackoffMultiplier
+	if p.BackoffMultiplier >= 1 {
+		multiplier = p.BackoffMultiplier
+	}
+
+	delay := float64(initialDelay) * math.Pow(multiplier, float64(max(attempt-1, 0)))
+	if p.MaxDelaySeconds > 0 {
+		delay = math.Min(delay, float64(time.Duration(p.MaxDelaySeconds)*time.Second))
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay = delay * (1 - jitter + 2*jitter*rand.Float64())
+	}
+
+	return time.Duration(delay)
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test__RetryPolicy(t *testing.T) {
+	t.Run("retries until max attempts is reached", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 3}
+		assert.True(t, policy.ShouldRetry(1, CanvasNodeExecutionResultReasonError))
+		assert.True(t, policy.ShouldRetry(2, CanvasNodeExecutionResultReasonError))
+		assert.False(t, policy.ShouldRetry(3, CanvasNodeExecutionResultReasonError))
+	})
+
+	t.Run("retries only on specified reasons", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 3, RetryOn: []string{CanvasNodeExecutionResultReasonError}}
+		assert.True(t, policy.ShouldRetry(1, CanvasNodeExecutionResultReasonError))
+		assert.False(t, policy.ShouldRetry(1, "timeout"))
+	})
+
+	t.Run("delay grows exponentially", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 5, InitialDelaySeconds: 5, BackoffMultiplier: 3}
+		assert.Equal(t, 5*time.Second, policy.Delay(1))
+		assert.Equal(t, 15*time.Second, policy.Delay(2))
+		assert.Equal(t, 45*time.Second, policy.Delay(3))
+	})
+
+	t.Run("default delay", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 5}
+		assert.Equal(t, DefaultRetryInitialDelay, policy.Delay(1))
+		assert.Equal(t, 2*DefaultRetryInitialDelay, policy.Delay(2))
+	})
+
+	t.Run("delay is capped by max delay", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 5, InitialDelaySeconds: 10, MaxDelaySeconds: 30}
+		assert.Equal(t, 20*time.Second, policy.Delay(2))
+		assert.Equal(t, 30*time.Second, policy.Delay(3))
+		assert.Equal(t, 30*time.Second, policy.Delay(4))
+	})
+
+	t.Run("jitter keeps delay within bounds", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 5, InitialDelaySeconds: 10, Jitter: 0.5}
+		for i := 0; i < 100; i++ {
+			delay := policy.Delay(1)
+			assert.GreaterOrEqual(t, delay, 5*time.Second)
+			assert.LessOrEqual(t, delay, 15*time.Second)
+		}
+	})
+}
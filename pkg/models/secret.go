@@ -6,6 +6,7 @@ import (
 
 	uuid "github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -20,6 +21,18 @@ type Secret struct {
 	UpdatedAt  *time.Time
 	Provider   string
 	Data       []byte
+
+	//
+	// Secrets scoped to a canvas can only be read by the nodes of that canvas,
+	// or only by one of its nodes, if NodeID is also set.
+	//
+	WorkflowID *uuid.UUID
+	NodeID     *string
+
+	//
+	// Further restricts which canvases and components can read the secret.
+	//
+	AccessPolicy *datatypes.JSONType[SecretAccessPolicy]
 }
 
 type SecretData struct {
@@ -114,6 +127,10 @@ func FindSecretByIDInTransaction(tx *gorm.DB, domainType string, domainID uuid.U
 }
 
 func CreateSecret(name, provider, requesterID, domainType string, domainID uuid.UUID, data []byte) (*Secret, error) {
+	return CreateSecretWithAccess(name, provider, requesterID, domainType, domainID, data, SecretAccess{})
+}
+
+func CreateSecretWithAccess(name, provider, requesterID, domainType string, domainID uuid.UUID, data []byte, access SecretAccess) (*Secret, error) {
 	now := time.Now()
 
 	secret := Secret{
@@ -127,6 +144,8 @@ func CreateSecret(name, provider, requesterID, domainType string, domainID uuid.
 		Data:       data,
 	}
 
+	secret.SetAccess(access)
+
 	err := database.Conn().
 		Clauses(clause.Returning{}).
 		Create(&secret).
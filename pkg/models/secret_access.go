@@ -0,0 +1,204 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+)
+
+const MaxSecretAccessPolicyEntries = 100
+
+var ErrSecretAccessDenied = fmt.Errorf("secret is not available to this node")
+
+// SecretAccessPolicy is an allow-list of the canvases and components
+// that can read a secret. Empty lists allow everything.
+//
+// Components are referenced by name, like "ssh" or "github.runWorkflow",
+// and apply to triggers as well.
+type SecretAccessPolicy struct {
+	Canvases   []string `json:"canvases,omitempty"`
+	Components []string `json:"components,omitempty"`
+}
+
+func (p *SecretAccessPolicy) IsEmpty() bool {
+	return p == nil || (len(p.Canvases) == 0 && len(p.Components) == 0)
+}
+
+func (p *SecretAccessPolicy) Validate() error {
+	if len(p.Canvases) > MaxSecretAccessPolicyEntries || len(p.Components) > MaxSecretAccessPolicyEntries {
+		return fmt.Errorf("access policy cannot have more than %d canvases or components", MaxSecretAccessPolicyEntries)
+	}
+
+	for _, canvasID := range p.Canvases {
+		if _, err := uuid.Parse(canvasID); err != nil {
+			return fmt.Errorf("invalid canvas ID %s in access policy", canvasID)
+		}
+	}
+
+	for _, component := range p.Components {
+		if component == "" {
+			return fmt.Errorf("component names in access policy cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// SecretAccess is where a secret can be read from:
+// its scope, and the access policy applied on top of it.
+type SecretAccess struct {
+	WorkflowID *uuid.UUID
+	NodeID     *string
+	Policy     *SecretAccessPolicy
+}
+
+func (a *SecretAccess) Validate() error {
+	if a.NodeID != nil && a.WorkflowID == nil {
+		return fmt.Errorf("node scoped secrets must also be scoped to a canvas")
+	}
+
+	if a.Policy.IsEmpty() {
+		return nil
+	}
+
+	if a.WorkflowID != nil && len(a.Policy.Canvases) > 0 {
+		return fmt.Errorf("canvas scoped secrets cannot be restricted to other canvases")
+	}
+
+	return a.Policy.Validate()
+}
+
+// SecretAccessor is the node reading a secret.
+type SecretAccessor struct {
+	WorkflowID uuid.UUID
+	NodeID     string
+
+	//
+	// Internal nodes of blueprints can read
+	// the secrets scoped to their parent node.
+	//
+	ParentNodeID string
+
+	//
+	// Component or trigger name of the node.
+	// If empty, component restrictions are not checked,
+	// which is only the case when validating blueprint nodes,
+	// since the components reading the secret are not known until execution.
+	//
+	Component string
+
+	//
+	// Execution reading the secret, recorded in the secret access events.
+	//
+	ExecutionID *uuid.UUID
+}
+
+func NewSecretAccessor(node *CanvasNode) SecretAccessor {
+	accessor := SecretAccessor{
+		WorkflowID: node.WorkflowID,
+		NodeID:     node.NodeID,
+	}
+
+	if node.ParentNodeID != nil {
+		accessor.ParentNodeID = *node.ParentNodeID
+	}
+
+	ref := node.Ref.Data()
+	switch {
+	case ref.Component != nil:
+		accessor.Component = ref.Component.Name
+	case ref.Trigger != nil:
+		accessor.Component = ref.Trigger.Name
+	}
+
+	return accessor
+}
+
+func (a SecretAccessor) ForExecution(executionID uuid.UUID) SecretAccessor {
+	a.ExecutionID = &executionID
+	return a
+}
+
+func (s *Secret) GetAccessPolicy() *SecretAccessPolicy {
+	if s.AccessPolicy == nil {
+		return nil
+	}
+
+	policy := s.AccessPolicy.Data()
+	if policy.IsEmpty() {
+		return nil
+	}
+
+	return &policy
+}
+
+func (s *Secret) SetAccess(access SecretAccess) {
+	s.WorkflowID = access.WorkflowID
+	s.NodeID = access.NodeID
+
+	if access.Policy.IsEmpty() {
+		s.AccessPolicy = nil
+		return
+	}
+
+	policy := datatypes.NewJSONType(*access.Policy)
+	s.AccessPolicy = &policy
+}
+
+func (s *Secret) UpdateAccess(access SecretAccess) (*Secret, error) {
+	now := time.Now()
+	s.SetAccess(access)
+
+	var policy any
+	if s.AccessPolicy != nil {
+		policy = s.AccessPolicy
+	}
+
+	err := database.Conn().
+		Model(s).
+		Where("id = ?", s.ID).
+		Updates(map[string]any{
+			"workflow_id":   s.WorkflowID,
+			"node_id":       s.NodeID,
+			"access_policy": policy,
+			"updated_at":    &now,
+		}).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.UpdatedAt = &now
+	return s, nil
+}
+
+// CanBeReadBy checks the scope and access policy of the secret.
+func (s *Secret) CanBeReadBy(accessor SecretAccessor) bool {
+	if s.WorkflowID != nil && *s.WorkflowID != accessor.WorkflowID {
+		return false
+	}
+
+	if s.NodeID != nil && *s.NodeID != accessor.NodeID && *s.NodeID != accessor.ParentNodeID {
+		return false
+	}
+
+	policy := s.GetAccessPolicy()
+	if policy == nil {
+		return true
+	}
+
+	if len(policy.Canvases) > 0 && !slices.Contains(policy.Canvases, accessor.WorkflowID.String()) {
+		return false
+	}
+
+	if len(policy.Components) > 0 && accessor.Component != "" && !slices.Contains(policy.Components, accessor.Component) {
+		return false
+	}
+
+	return true
+}
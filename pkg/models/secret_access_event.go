@@ -0,0 +1,136 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+const (
+	SecretAccessActionRead     = "read"
+	SecretAccessActionDenied   = "denied"
+	SecretAccessActionDescribe = "describe"
+	SecretAccessActionCreate   = "create"
+	SecretAccessActionUpdate   = "update"
+	SecretAccessActionDelete   = "delete"
+
+	SecretAccessActorUser           = "user"
+	SecretAccessActorServiceAccount = "service_account"
+	SecretAccessActorExecution      = "execution"
+)
+
+// SecretAccessEvent records a read or write of a secret,
+// either by a node execution, or through the API.
+//
+// Events are not removed when the secret is deleted,
+// so the secret name is recorded too.
+type SecretAccessEvent struct {
+	ID             uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	OrganizationID uuid.UUID
+	SecretID       uuid.UUID
+	SecretName     string
+	Action         string
+	KeyName        *string
+	ActorType      string
+	ActorID        *uuid.UUID
+	WorkflowID     *uuid.UUID
+	NodeID         *string
+	ExecutionID    *uuid.UUID
+	CreatedAt      *time.Time
+}
+
+// SecretAccessEventFilter selects the events of one secret.
+// Deleted secrets can only be found by name, through SecretName.
+type SecretAccessEventFilter struct {
+	SecretID   *uuid.UUID
+	SecretName string
+	Before     *time.Time
+	After      *time.Time
+}
+
+// NewSecretAccessEventForUser returns an event for an API call made by a user.
+// Service accounts are users too, so the user is looked up to tell them apart.
+func NewSecretAccessEventForUser(secret *Secret, action string, userID string) *SecretAccessEvent {
+	event := &SecretAccessEvent{
+		OrganizationID: secret.DomainID,
+		SecretID:       secret.ID,
+		SecretName:     secret.Name,
+		Action:         action,
+		ActorType:      SecretAccessActorUser,
+	}
+
+	actorID, err := uuid.Parse(userID)
+	if err != nil {
+		return event
+	}
+
+	event.ActorID = &actorID
+	user, err := FindActiveUserByID(secret.DomainID.String(), userID)
+	if err == nil && user.IsServiceAccount() {
+		event.ActorType = SecretAccessActorServiceAccount
+	}
+
+	return event
+}
+
+// NewSecretAccessEventForNode returns an event for a secret key read by a node.
+func NewSecretAccessEventForNode(secret *Secret, action string, keyName string, accessor SecretAccessor) *SecretAccessEvent {
+	event := &SecretAccessEvent{
+		OrganizationID: secret.DomainID,
+		SecretID:       secret.ID,
+		SecretName:     secret.Name,
+		Action:         action,
+		KeyName:        &keyName,
+		ActorType:      SecretAccessActorExecution,
+		ActorID:        accessor.ExecutionID,
+		ExecutionID:    accessor.ExecutionID,
+		NodeID:         &accessor.NodeID,
+	}
+
+	if accessor.WorkflowID != uuid.Nil {
+		event.WorkflowID = &accessor.WorkflowID
+	}
+
+	return event
+}
+
+func (e *SecretAccessEvent) Create() error {
+	return e.CreateInTransaction(database.Conn())
+}
+
+func (e *SecretAccessEvent) CreateInTransaction(tx *gorm.DB) error {
+	now := time.Now()
+	e.CreatedAt = &now
+	return tx.Create(e).Error
+}
+
+func ListSecretAccessEvents(organizationID uuid.UUID, filter SecretAccessEventFilter, limit int) ([]SecretAccessEvent, error) {
+	var events []SecretAccessEvent
+	query := database.Conn().
+		Where("organization_id = ?", organizationID).
+		Order("created_at DESC").
+		Limit(limit)
+
+	if filter.SecretID != nil {
+		query = query.Where("secret_id = ?", *filter.SecretID)
+	} else {
+		query = query.Where("secret_name = ?", filter.SecretName)
+	}
+
+	if filter.Before != nil {
+		query = query.Where("created_at < ?", filter.Before)
+	}
+
+	if filter.After != nil {
+		query = query.Where("created_at >= ?", filter.After)
+	}
+
+	err := query.Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
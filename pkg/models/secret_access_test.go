@@ -0,0 +1,103 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func Test__SecretAccess(t *testing.T) {
+	canvasID := uuid.New()
+	otherCanvasID := uuid.New()
+	nodeID := "node-1"
+
+	t.Run("node scoped secrets require a canvas", func(t *testing.T) {
+		access := SecretAccess{NodeID: &nodeID}
+		assert.ErrorContains(t, access.Validate(), "must also be scoped to a canvas")
+	})
+
+	t.Run("canvas scoped secrets cannot be restricted to other canvases", func(t *testing.T) {
+		access := SecretAccess{
+			WorkflowID: &canvasID,
+			Policy:     &SecretAccessPolicy{Canvases: []string{otherCanvasID.String()}},
+		}
+
+		assert.ErrorContains(t, access.Validate(), "cannot be restricted to other canvases")
+	})
+
+	t.Run("invalid policies", func(t *testing.T) {
+		access := SecretAccess{Policy: &SecretAccessPolicy{Canvases: []string{"not-a-uuid"}}}
+		assert.ErrorContains(t, access.Validate(), "invalid canvas ID not-a-uuid")
+
+		access = SecretAccess{Policy: &SecretAccessPolicy{Components: []string{""}}}
+		assert.ErrorContains(t, access.Validate(), "cannot be empty")
+	})
+
+	t.Run("valid access", func(t *testing.T) {
+		access := SecretAccess{
+			WorkflowID: &canvasID,
+			NodeID:     &nodeID,
+			Policy:     &SecretAccessPolicy{Components: []string{"ssh"}},
+		}
+
+		assert.NoError(t, access.Validate())
+	})
+}
+
+func Test__Secret__CanBeReadBy(t *testing.T) {
+	canvasID := uuid.New()
+	otherCanvasID := uuid.New()
+
+	newSecret := func(access SecretAccess) *Secret {
+		secret := &Secret{}
+		secret.SetAccess(access)
+		return secret
+	}
+
+	t.Run("organization secrets without policy can be read by any node", func(t *testing.T) {
+		secret := newSecret(SecretAccess{})
+		assert.True(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "a", Component: "ssh"}))
+		assert.True(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: otherCanvasID, NodeID: "b"}))
+	})
+
+	t.Run("canvas scoped secrets", func(t *testing.T) {
+		secret := newSecret(SecretAccess{WorkflowID: &canvasID})
+		assert.True(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "a"}))
+		assert.False(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: otherCanvasID, NodeID: "a"}))
+	})
+
+	t.Run("node scoped secrets", func(t *testing.T) {
+		nodeID := "a"
+		secret := newSecret(SecretAccess{WorkflowID: &canvasID, NodeID: &nodeID})
+		assert.True(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "a"}))
+		assert.False(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "b"}))
+		assert.False(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: otherCanvasID, NodeID: "a"}))
+
+		// internal nodes of a blueprint node can read its secrets
+		assert.True(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "a:child", ParentNodeID: "a"}))
+	})
+
+	t.Run("canvas allow-list", func(t *testing.T) {
+		secret := newSecret(SecretAccess{Policy: &SecretAccessPolicy{Canvases: []string{canvasID.String()}}})
+		assert.True(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "a"}))
+		assert.False(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: otherCanvasID, NodeID: "a"}))
+	})
+
+	t.Run("component allow-list", func(t *testing.T) {
+		secret := newSecret(SecretAccess{Policy: &SecretAccessPolicy{Components: []string{"ssh"}}})
+		assert.True(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "a", Component: "ssh"}))
+		assert.False(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "a", Component: "http"}))
+
+		// component is not checked when it is not known yet
+		assert.True(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "a"}))
+	})
+
+	t.Run("empty policies are ignored", func(t *testing.T) {
+		policy := datatypes.NewJSONType(SecretAccessPolicy{})
+		secret := &Secret{AccessPolicy: &policy}
+		assert.Nil(t, secret.GetAccessPolicy())
+		assert.True(t, secret.CanBeReadBy(SecretAccessor{WorkflowID: canvasID, NodeID: "a", Component: "http"}))
+	})
+}
@@ -965,3 +965,134 @@ func (a *CanvasNodeAPIService) CanvasesUpdateNodePauseExecute(r ApiCanvasesUpdat
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiCanvasesUpdateNodeQueueItemRequest struct {
+	ctx        context.Context
+	ApiService *CanvasNodeAPIService
+	canvasId   string
+	nodeId     string
+	itemId     string
+	body       *CanvasesUpdateNodeQueueItemBody
+}
+
+func (r ApiCanvasesUpdateNodeQueueItemRequest) Body(body CanvasesUpdateNodeQueueItemBody) ApiCanvasesUpdateNodeQueueItemRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesUpdateNodeQueueItemRequest) Execute() (*CanvasesUpdateNodeQueueItemResponse, *http.Response, error) {
+	return r.ApiService.CanvasesUpdateNodeQueueItemExecute(r)
+}
+
+/*
+CanvasesUpdateNodeQueueItem Update item in a node's queue
+
+Changes the priority of a specific item in a node's queue. Items with a higher priority are dequeued first
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param nodeId
+	@param itemId
+	@return ApiCanvasesUpdateNodeQueueItemRequest
+*/
+func (a *CanvasNodeAPIService) CanvasesUpdateNodeQueueItem(ctx context.Context, canvasId string, nodeId string, itemId string) ApiCanvasesUpdateNodeQueueItemRequest {
+	return ApiCanvasesUpdateNodeQueueItemRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+		nodeId:     nodeId,
+		itemId:     itemId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesUpdateNodeQueueItemResponse
+func (a *CanvasNodeAPIService) CanvasesUpdateNodeQueueItemExecute(r ApiCanvasesUpdateNodeQueueItemRequest) (*CanvasesUpdateNodeQueueItemResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPatch
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesUpdateNodeQueueItemResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeAPIService.CanvasesUpdateNodeQueueItem")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"nodeId"+"}", url.PathEscape(parameterValueToString(r.nodeId, "nodeId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"itemId"+"}", url.PathEscape(parameterValueToString(r.itemId, "itemId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
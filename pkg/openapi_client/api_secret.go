@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // SecretAPIService SecretAPI service
@@ -545,6 +546,166 @@ func (a *SecretAPIService) SecretsDescribeSecretExecute(r ApiSecretsDescribeSecr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiSecretsListSecretAccessEventsRequest struct {
+	ctx        context.Context
+	ApiService *SecretAPIService
+	idOrName   string
+	domainType *string
+	domainId   *string
+	limit      *int64
+	before     *time.Time
+	after      *time.Time
+}
+
+func (r ApiSecretsListSecretAccessEventsRequest) DomainType(domainType string) ApiSecretsListSecretAccessEventsRequest {
+	r.domainType = &domainType
+	return r
+}
+
+func (r ApiSecretsListSecretAccessEventsRequest) DomainId(domainId string) ApiSecretsListSecretAccessEventsRequest {
+	r.domainId = &domainId
+	return r
+}
+
+func (r ApiSecretsListSecretAccessEventsRequest) Limit(limit int64) ApiSecretsListSecretAccessEventsRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiSecretsListSecretAccessEventsRequest) Before(before time.Time) ApiSecretsListSecretAccessEventsRequest {
+	r.before = &before
+	return r
+}
+
+func (r ApiSecretsListSecretAccessEventsRequest) After(after time.Time) ApiSecretsListSecretAccessEventsRequest {
+	r.after = &after
+	return r
+}
+
+func (r ApiSecretsListSecretAccessEventsRequest) Execute() (*SecretsListSecretAccessEventsResponse, *http.Response, error) {
+	return r.ApiService.SecretsListSecretAccessEventsExecute(r)
+}
+
+/*
+SecretsListSecretAccessEvents List secret access events
+
+Returns the reads and writes of a secret, most recent first. Deleted secrets can be referenced by name.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param idOrName
+	@return ApiSecretsListSecretAccessEventsRequest
+*/
+func (a *SecretAPIService) SecretsListSecretAccessEvents(ctx context.Context, idOrName string) ApiSecretsListSecretAccessEventsRequest {
+	return ApiSecretsListSecretAccessEventsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		idOrName:   idOrName,
+	}
+}
+
+// Execute executes the request
+//
+//	@return SecretsListSecretAccessEventsResponse
+func (a *SecretAPIService) SecretsListSecretAccessEventsExecute(r ApiSecretsListSecretAccessEventsRequest) (*SecretsListSecretAccessEventsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *SecretsListSecretAccessEventsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SecretAPIService.SecretsListSecretAccessEvents")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/secrets/{idOrName}/access-events"
+	localVarPath = strings.Replace(localVarPath, "{"+"idOrName"+"}", url.PathEscape(parameterValueToString(r.idOrName, "idOrName")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.domainType != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "domainType", r.domainType, "", "")
+	} else {
+		var defaultValue string = "DOMAIN_TYPE_UNSPECIFIED"
+		r.domainType = &defaultValue
+	}
+	if r.domainId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "domainId", r.domainId, "", "")
+	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "", "")
+	}
+	if r.before != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
+	}
+	if r.after != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "after", r.after, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiSecretsListSecretsRequest struct {
 	ctx        context.Context
 	ApiService *SecretAPIService
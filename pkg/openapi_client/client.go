@@ -49,18 +49,30 @@ type APIClient struct {
 
 	// API Services
 
+	AuditAPI *AuditAPIService
+
 	BlueprintAPI *BlueprintAPIService
 
 	CanvasAPI *CanvasAPIService
 
+	CanvasDraftAPI *CanvasDraftAPIService
+
 	CanvasEventAPI *CanvasEventAPIService
 
 	CanvasNodeAPI *CanvasNodeAPIService
 
 	CanvasNodeExecutionAPI *CanvasNodeExecutionAPIService
 
+	CanvasRetentionAPI *CanvasRetentionAPIService
+
+	CanvasVariableAPI *CanvasVariableAPIService
+
+	CanvasVersionAPI *CanvasVersionAPIService
+
 	ComponentAPI *ComponentAPIService
 
+	ExpressionAPI *ExpressionAPIService
+
 	GroupsAPI *GroupsAPIService
 
 	IntegrationAPI *IntegrationAPIService
@@ -98,12 +110,18 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.AuditAPI = (*AuditAPIService)(&c.common)
 	c.BlueprintAPI = (*BlueprintAPIService)(&c.common)
 	c.CanvasAPI = (*CanvasAPIService)(&c.common)
+	c.CanvasDraftAPI = (*CanvasDraftAPIService)(&c.common)
 	c.CanvasEventAPI = (*CanvasEventAPIService)(&c.common)
 	c.CanvasNodeAPI = (*CanvasNodeAPIService)(&c.common)
 	c.CanvasNodeExecutionAPI = (*CanvasNodeExecutionAPIService)(&c.common)
+	c.CanvasRetentionAPI = (*CanvasRetentionAPIService)(&c.common)
+	c.CanvasVariableAPI = (*CanvasVariableAPIService)(&c.common)
+	c.CanvasVersionAPI = (*CanvasVersionAPIService)(&c.common)
 	c.ComponentAPI = (*ComponentAPIService)(&c.common)
+	c.ExpressionAPI = (*ExpressionAPIService)(&c.common)
 	c.GroupsAPI = (*GroupsAPIService)(&c.common)
 	c.IntegrationAPI = (*IntegrationAPIService)(&c.common)
 	c.MeAPI = (*MeAPIService)(&c.common)
@@ -24,6 +24,8 @@ const (
 	CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_OK             CanvasNodeExecutionResultReason = "RESULT_REASON_OK"
 	CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_ERROR          CanvasNodeExecutionResultReason = "RESULT_REASON_ERROR"
 	CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_ERROR_RESOLVED CanvasNodeExecutionResultReason = "RESULT_REASON_ERROR_RESOLVED"
+	CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_TIMEOUT        CanvasNodeExecutionResultReason = "RESULT_REASON_TIMEOUT"
+	CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_SKIPPED        CanvasNodeExecutionResultReason = "RESULT_REASON_SKIPPED"
 )
 
 // All allowed values of CanvasNodeExecutionResultReason enum
@@ -31,6 +33,8 @@ var AllowedCanvasNodeExecutionResultReasonEnumValues = []CanvasNodeExecutionResu
 	"RESULT_REASON_OK",
 	"RESULT_REASON_ERROR",
 	"RESULT_REASON_ERROR_RESOLVED",
+	"RESULT_REASON_TIMEOUT",
+	"RESULT_REASON_SKIPPED",
 }
 
 func (v *CanvasNodeExecutionResultReason) UnmarshalJSON(src []byte) error {
@@ -39,6 +39,11 @@ type CanvasesCanvasNodeExecution struct {
 	ChildExecutions     []CanvasesCanvasNodeExecution    `json:"childExecutions,omitempty"`
 	RootEvent           *CanvasesCanvasEvent             `json:"rootEvent,omitempty"`
 	CancelledBy         *SuperplaneCanvasesUserRef       `json:"cancelledBy,omitempty"`
+	Attempt             *int32                           `json:"attempt,omitempty"`
+	RetryOfExecutionId  *string                          `json:"retryOfExecutionId,omitempty"`
+	DeadlineAt          *time.Time                       `json:"deadlineAt,omitempty"`
+	CanvasVersionId     *string                          `json:"canvasVersionId,omitempty"`
+	TraceId             *string                          `json:"traceId,omitempty"`
 }
 
 // NewCanvasesCanvasNodeExecution instantiates a new CanvasesCanvasNodeExecution object
@@ -646,6 +651,166 @@ func (o *CanvasesCanvasNodeExecution) SetCancelledBy(v SuperplaneCanvasesUserRef
 	o.CancelledBy = &v
 }
 
+// GetAttempt returns the Attempt field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetAttempt() int32 {
+	if o == nil || IsNil(o.Attempt) {
+		var ret int32
+		return ret
+	}
+	return *o.Attempt
+}
+
+// GetAttemptOk returns a tuple with the Attempt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetAttemptOk() (*int32, bool) {
+	if o == nil || IsNil(o.Attempt) {
+		return nil, false
+	}
+	return o.Attempt, true
+}
+
+// HasAttempt returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasAttempt() bool {
+	if o != nil && !IsNil(o.Attempt) {
+		return true
+	}
+
+	return false
+}
+
+// SetAttempt gets a reference to the given int32 and assigns it to the Attempt field.
+func (o *CanvasesCanvasNodeExecution) SetAttempt(v int32) {
+	o.Attempt = &v
+}
+
+// GetRetryOfExecutionId returns the RetryOfExecutionId field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetRetryOfExecutionId() string {
+	if o == nil || IsNil(o.RetryOfExecutionId) {
+		var ret string
+		return ret
+	}
+	return *o.RetryOfExecutionId
+}
+
+// GetRetryOfExecutionIdOk returns a tuple with the RetryOfExecutionId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetRetryOfExecutionIdOk() (*string, bool) {
+	if o == nil || IsNil(o.RetryOfExecutionId) {
+		return nil, false
+	}
+	return o.RetryOfExecutionId, true
+}
+
+// HasRetryOfExecutionId returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasRetryOfExecutionId() bool {
+	if o != nil && !IsNil(o.RetryOfExecutionId) {
+		return true
+	}
+
+	return false
+}
+
+// SetRetryOfExecutionId gets a reference to the given string and assigns it to the RetryOfExecutionId field.
+func (o *CanvasesCanvasNodeExecution) SetRetryOfExecutionId(v string) {
+	o.RetryOfExecutionId = &v
+}
+
+// GetDeadlineAt returns the DeadlineAt field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetDeadlineAt() time.Time {
+	if o == nil || IsNil(o.DeadlineAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.DeadlineAt
+}
+
+// GetDeadlineAtOk returns a tuple with the DeadlineAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetDeadlineAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.DeadlineAt) {
+		return nil, false
+	}
+	return o.DeadlineAt, true
+}
+
+// HasDeadlineAt returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasDeadlineAt() bool {
+	if o != nil && !IsNil(o.DeadlineAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeadlineAt gets a reference to the given time.Time and assigns it to the DeadlineAt field.
+func (o *CanvasesCanvasNodeExecution) SetDeadlineAt(v time.Time) {
+	o.DeadlineAt = &v
+}
+
+// GetCanvasVersionId returns the CanvasVersionId field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetCanvasVersionId() string {
+	if o == nil || IsNil(o.CanvasVersionId) {
+		var ret string
+		return ret
+	}
+	return *o.CanvasVersionId
+}
+
+// GetCanvasVersionIdOk returns a tuple with the CanvasVersionId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetCanvasVersionIdOk() (*string, bool) {
+	if o == nil || IsNil(o.CanvasVersionId) {
+		return nil, false
+	}
+	return o.CanvasVersionId, true
+}
+
+// HasCanvasVersionId returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasCanvasVersionId() bool {
+	if o != nil && !IsNil(o.CanvasVersionId) {
+		return true
+	}
+
+	return false
+}
+
+// SetCanvasVersionId gets a reference to the given string and assigns it to the CanvasVersionId field.
+func (o *CanvasesCanvasNodeExecution) SetCanvasVersionId(v string) {
+	o.CanvasVersionId = &v
+}
+
+// GetTraceId returns the TraceId field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetTraceId() string {
+	if o == nil || IsNil(o.TraceId) {
+		var ret string
+		return ret
+	}
+	return *o.TraceId
+}
+
+// GetTraceIdOk returns a tuple with the TraceId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetTraceIdOk() (*string, bool) {
+	if o == nil || IsNil(o.TraceId) {
+		return nil, false
+	}
+	return o.TraceId, true
+}
+
+// HasTraceId returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasTraceId() bool {
+	if o != nil && !IsNil(o.TraceId) {
+		return true
+	}
+
+	return false
+}
+
+// SetTraceId gets a reference to the given string and assigns it to the TraceId field.
+func (o *CanvasesCanvasNodeExecution) SetTraceId(v string) {
+	o.TraceId = &v
+}
+
 func (o CanvasesCanvasNodeExecution) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -710,6 +875,21 @@ func (o CanvasesCanvasNodeExecution) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.CancelledBy) {
 		toSerialize["cancelledBy"] = o.CancelledBy
 	}
+	if !IsNil(o.Attempt) {
+		toSerialize["attempt"] = o.Attempt
+	}
+	if !IsNil(o.RetryOfExecutionId) {
+		toSerialize["retryOfExecutionId"] = o.RetryOfExecutionId
+	}
+	if !IsNil(o.DeadlineAt) {
+		toSerialize["deadlineAt"] = o.DeadlineAt
+	}
+	if !IsNil(o.CanvasVersionId) {
+		toSerialize["canvasVersionId"] = o.CanvasVersionId
+	}
+	if !IsNil(o.TraceId) {
+		toSerialize["traceId"] = o.TraceId
+	}
 	return toSerialize, nil
 }
 
@@ -27,6 +27,7 @@ type CanvasesCanvasNodeQueueItem struct {
 	Input     map[string]interface{} `json:"input,omitempty"`
 	RootEvent *CanvasesCanvasEvent   `json:"rootEvent,omitempty"`
 	CreatedAt *time.Time             `json:"createdAt,omitempty"`
+	Priority  *int32                 `json:"priority,omitempty"`
 }
 
 // NewCanvasesCanvasNodeQueueItem instantiates a new CanvasesCanvasNodeQueueItem object
@@ -238,6 +239,38 @@ func (o *CanvasesCanvasNodeQueueItem) SetCreatedAt(v time.Time) {
 	o.CreatedAt = &v
 }
 
+// GetPriority returns the Priority field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeQueueItem) GetPriority() int32 {
+	if o == nil || IsNil(o.Priority) {
+		var ret int32
+		return ret
+	}
+	return *o.Priority
+}
+
+// GetPriorityOk returns a tuple with the Priority field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeQueueItem) GetPriorityOk() (*int32, bool) {
+	if o == nil || IsNil(o.Priority) {
+		return nil, false
+	}
+	return o.Priority, true
+}
+
+// HasPriority returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeQueueItem) HasPriority() bool {
+	if o != nil && !IsNil(o.Priority) {
+		return true
+	}
+
+	return false
+}
+
+// SetPriority gets a reference to the given int32 and assigns it to the Priority field.
+func (o *CanvasesCanvasNodeQueueItem) SetPriority(v int32) {
+	o.Priority = &v
+}
+
 func (o CanvasesCanvasNodeQueueItem) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -266,6 +299,9 @@ func (o CanvasesCanvasNodeQueueItem) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.CreatedAt) {
 		toSerialize["createdAt"] = o.CreatedAt
 	}
+	if !IsNil(o.Priority) {
+		toSerialize["priority"] = o.Priority
+	}
 	return toSerialize, nil
 }
 
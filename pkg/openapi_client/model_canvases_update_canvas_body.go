@@ -20,7 +20,8 @@ var _ MappedNullable = &CanvasesUpdateCanvasBody{}
 
 // CanvasesUpdateCanvasBody struct for CanvasesUpdateCanvasBody
 type CanvasesUpdateCanvasBody struct {
-	Canvas *CanvasesCanvas `json:"canvas,omitempty"`
+	Canvas         *CanvasesCanvas `json:"canvas,omitempty"`
+	VersionMessage *string         `json:"versionMessage,omitempty"`
 }
 
 // NewCanvasesUpdateCanvasBody instantiates a new CanvasesUpdateCanvasBody object
@@ -72,6 +73,38 @@ func (o *CanvasesUpdateCanvasBody) SetCanvas(v CanvasesCanvas) {
 	o.Canvas = &v
 }
 
+// GetVersionMessage returns the VersionMessage field value if set, zero value otherwise.
+func (o *CanvasesUpdateCanvasBody) GetVersionMessage() string {
+	if o == nil || IsNil(o.VersionMessage) {
+		var ret string
+		return ret
+	}
+	return *o.VersionMessage
+}
+
+// GetVersionMessageOk returns a tuple with the VersionMessage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesUpdateCanvasBody) GetVersionMessageOk() (*string, bool) {
+	if o == nil || IsNil(o.VersionMessage) {
+		return nil, false
+	}
+	return o.VersionMessage, true
+}
+
+// HasVersionMessage returns a boolean if a field has been set.
+func (o *CanvasesUpdateCanvasBody) HasVersionMessage() bool {
+	if o != nil && !IsNil(o.VersionMessage) {
+		return true
+	}
+
+	return false
+}
+
+// SetVersionMessage gets a reference to the given string and assigns it to the VersionMessage field.
+func (o *CanvasesUpdateCanvasBody) SetVersionMessage(v string) {
+	o.VersionMessage = &v
+}
+
 func (o CanvasesUpdateCanvasBody) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -85,6 +118,9 @@ func (o CanvasesUpdateCanvasBody) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Canvas) {
 		toSerialize["canvas"] = o.Canvas
 	}
+	if !IsNil(o.VersionMessage) {
+		toSerialize["versionMessage"] = o.VersionMessage
+	}
 	return toSerialize, nil
 }
 
@@ -20,21 +20,26 @@ var _ MappedNullable = &ComponentsNode{}
 
 // ComponentsNode struct for ComponentsNode
 type ComponentsNode struct {
-	Id             *string                   `json:"id,omitempty"`
-	Name           *string                   `json:"name,omitempty"`
-	Type           *ComponentsNodeType       `json:"type,omitempty"`
-	Configuration  map[string]interface{}    `json:"configuration,omitempty"`
-	Metadata       map[string]interface{}    `json:"metadata,omitempty"`
-	Position       *ComponentsPosition       `json:"position,omitempty"`
-	Component      *NodeComponentRef         `json:"component,omitempty"`
-	Blueprint      *NodeBlueprintRef         `json:"blueprint,omitempty"`
-	Trigger        *NodeTriggerRef           `json:"trigger,omitempty"`
-	Widget         *NodeWidgetRef            `json:"widget,omitempty"`
-	IsCollapsed    *bool                     `json:"isCollapsed,omitempty"`
-	Integration    *ComponentsIntegrationRef `json:"integration,omitempty"`
-	ErrorMessage   *string                   `json:"errorMessage,omitempty"`
-	WarningMessage *string                   `json:"warningMessage,omitempty"`
-	Paused         *bool                     `json:"paused,omitempty"`
+	Id                      *string                   `json:"id,omitempty"`
+	Name                    *string                   `json:"name,omitempty"`
+	Type                    *ComponentsNodeType       `json:"type,omitempty"`
+	Configuration           map[string]interface{}    `json:"configuration,omitempty"`
+	Metadata                map[string]interface{}    `json:"metadata,omitempty"`
+	Position                *ComponentsPosition       `json:"position,omitempty"`
+	Component               *NodeComponentRef         `json:"component,omitempty"`
+	Blueprint               *NodeBlueprintRef         `json:"blueprint,omitempty"`
+	Trigger                 *NodeTriggerRef           `json:"trigger,omitempty"`
+	Widget                  *NodeWidgetRef            `json:"widget,omitempty"`
+	IsCollapsed             *bool                     `json:"isCollapsed,omitempty"`
+	Integration             *ComponentsIntegrationRef `json:"integration,omitempty"`
+	ErrorMessage            *string                   `json:"errorMessage,omitempty"`
+	WarningMessage          *string                   `json:"warningMessage,omitempty"`
+	Paused                  *bool                     `json:"paused,omitempty"`
+	ConcurrencyGroup        *NodeConcurrencyGroup     `json:"concurrencyGroup,omitempty"`
+	RetryPolicy             *NodeRetryPolicy          `json:"retryPolicy,omitempty"`
+	ExecutionTimeoutSeconds *int32                    `json:"executionTimeoutSeconds,omitempty"`
+	QueuePolicy             *NodeQueuePolicy          `json:"queuePolicy,omitempty"`
+	RateLimit               *ComponentsNodeRateLimit  `json:"rateLimit,omitempty"`
 }
 
 // NewComponentsNode instantiates a new ComponentsNode object
@@ -538,6 +543,166 @@ func (o *ComponentsNode) SetPaused(v bool) {
 	o.Paused = &v
 }
 
+// GetConcurrencyGroup returns the ConcurrencyGroup field value if set, zero value otherwise.
+func (o *ComponentsNode) GetConcurrencyGroup() NodeConcurrencyGroup {
+	if o == nil || IsNil(o.ConcurrencyGroup) {
+		var ret NodeConcurrencyGroup
+		return ret
+	}
+	return *o.ConcurrencyGroup
+}
+
+// GetConcurrencyGroupOk returns a tuple with the ConcurrencyGroup field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetConcurrencyGroupOk() (*NodeConcurrencyGroup, bool) {
+	if o == nil || IsNil(o.ConcurrencyGroup) {
+		return nil, false
+	}
+	return o.ConcurrencyGroup, true
+}
+
+// HasConcurrencyGroup returns a boolean if a field has been set.
+func (o *ComponentsNode) HasConcurrencyGroup() bool {
+	if o != nil && !IsNil(o.ConcurrencyGroup) {
+		return true
+	}
+
+	return false
+}
+
+// SetConcurrencyGroup gets a reference to the given NodeConcurrencyGroup and assigns it to the ConcurrencyGroup field.
+func (o *ComponentsNode) SetConcurrencyGroup(v NodeConcurrencyGroup) {
+	o.ConcurrencyGroup = &v
+}
+
+// GetRetryPolicy returns the RetryPolicy field value if set, zero value otherwise.
+func (o *ComponentsNode) GetRetryPolicy() NodeRetryPolicy {
+	if o == nil || IsNil(o.RetryPolicy) {
+		var ret NodeRetryPolicy
+		return ret
+	}
+	return *o.RetryPolicy
+}
+
+// GetRetryPolicyOk returns a tuple with the RetryPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetRetryPolicyOk() (*NodeRetryPolicy, bool) {
+	if o == nil || IsNil(o.RetryPolicy) {
+		return nil, false
+	}
+	return o.RetryPolicy, true
+}
+
+// HasRetryPolicy returns a boolean if a field has been set.
+func (o *ComponentsNode) HasRetryPolicy() bool {
+	if o != nil && !IsNil(o.RetryPolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetRetryPolicy gets a reference to the given NodeRetryPolicy and assigns it to the RetryPolicy field.
+func (o *ComponentsNode) SetRetryPolicy(v NodeRetryPolicy) {
+	o.RetryPolicy = &v
+}
+
+// GetExecutionTimeoutSeconds returns the ExecutionTimeoutSeconds field value if set, zero value otherwise.
+func (o *ComponentsNode) GetExecutionTimeoutSeconds() int32 {
+	if o == nil || IsNil(o.ExecutionTimeoutSeconds) {
+		var ret int32
+		return ret
+	}
+	return *o.ExecutionTimeoutSeconds
+}
+
+// GetExecutionTimeoutSecondsOk returns a tuple with the ExecutionTimeoutSeconds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetExecutionTimeoutSecondsOk() (*int32, bool) {
+	if o == nil || IsNil(o.ExecutionTimeoutSeconds) {
+		return nil, false
+	}
+	return o.ExecutionTimeoutSeconds, true
+}
+
+// HasExecutionTimeoutSeconds returns a boolean if a field has been set.
+func (o *ComponentsNode) HasExecutionTimeoutSeconds() bool {
+	if o != nil && !IsNil(o.ExecutionTimeoutSeconds) {
+		return true
+	}
+
+	return false
+}
+
+// SetExecutionTimeoutSeconds gets a reference to the given int32 and assigns it to the ExecutionTimeoutSeconds field.
+func (o *ComponentsNode) SetExecutionTimeoutSeconds(v int32) {
+	o.ExecutionTimeoutSeconds = &v
+}
+
+// GetQueuePolicy returns the QueuePolicy field value if set, zero value otherwise.
+func (o *ComponentsNode) GetQueuePolicy() NodeQueuePolicy {
+	if o == nil || IsNil(o.QueuePolicy) {
+		var ret NodeQueuePolicy
+		return ret
+	}
+	return *o.QueuePolicy
+}
+
+// GetQueuePolicyOk returns a tuple with the QueuePolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetQueuePolicyOk() (*NodeQueuePolicy, bool) {
+	if o == nil || IsNil(o.QueuePolicy) {
+		return nil, false
+	}
+	return o.QueuePolicy, true
+}
+
+// HasQueuePolicy returns a boolean if a field has been set.
+func (o *ComponentsNode) HasQueuePolicy() bool {
+	if o != nil && !IsNil(o.QueuePolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetQueuePolicy gets a reference to the given NodeQueuePolicy and assigns it to the QueuePolicy field.
+func (o *ComponentsNode) SetQueuePolicy(v NodeQueuePolicy) {
+	o.QueuePolicy = &v
+}
+
+// GetRateLimit returns the RateLimit field value if set, zero value otherwise.
+func (o *ComponentsNode) GetRateLimit() ComponentsNodeRateLimit {
+	if o == nil || IsNil(o.RateLimit) {
+		var ret ComponentsNodeRateLimit
+		return ret
+	}
+	return *o.RateLimit
+}
+
+// GetRateLimitOk returns a tuple with the RateLimit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetRateLimitOk() (*ComponentsNodeRateLimit, bool) {
+	if o == nil || IsNil(o.RateLimit) {
+		return nil, false
+	}
+	return o.RateLimit, true
+}
+
+// HasRateLimit returns a boolean if a field has been set.
+func (o *ComponentsNode) HasRateLimit() bool {
+	if o != nil && !IsNil(o.RateLimit) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateLimit gets a reference to the given ComponentsNodeRateLimit and assigns it to the RateLimit field.
+func (o *ComponentsNode) SetRateLimit(v ComponentsNodeRateLimit) {
+	o.RateLimit = &v
+}
+
 func (o ComponentsNode) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -593,6 +758,21 @@ func (o ComponentsNode) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Paused) {
 		toSerialize["paused"] = o.Paused
 	}
+	if !IsNil(o.ConcurrencyGroup) {
+		toSerialize["concurrencyGroup"] = o.ConcurrencyGroup
+	}
+	if !IsNil(o.RetryPolicy) {
+		toSerialize["retryPolicy"] = o.RetryPolicy
+	}
+	if !IsNil(o.ExecutionTimeoutSeconds) {
+		toSerialize["executionTimeoutSeconds"] = o.ExecutionTimeoutSeconds
+	}
+	if !IsNil(o.QueuePolicy) {
+		toSerialize["queuePolicy"] = o.QueuePolicy
+	}
+	if !IsNil(o.RateLimit) {
+		toSerialize["rateLimit"] = o.RateLimit
+	}
 	return toSerialize, nil
 }
 
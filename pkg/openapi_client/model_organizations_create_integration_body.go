@@ -20,9 +20,10 @@ var _ MappedNullable = &OrganizationsCreateIntegrationBody{}
 
 // OrganizationsCreateIntegrationBody struct for OrganizationsCreateIntegrationBody
 type OrganizationsCreateIntegrationBody struct {
-	Name            *string                `json:"name,omitempty"`
-	IntegrationName *string                `json:"integrationName,omitempty"`
-	Configuration   map[string]interface{} `json:"configuration,omitempty"`
+	Name            *string                            `json:"name,omitempty"`
+	IntegrationName *string                            `json:"integrationName,omitempty"`
+	Configuration   map[string]interface{}             `json:"configuration,omitempty"`
+	RateLimit       *OrganizationsIntegrationRateLimit `json:"rateLimit,omitempty"`
 }
 
 // NewOrganizationsCreateIntegrationBody instantiates a new OrganizationsCreateIntegrationBody object
@@ -138,6 +139,38 @@ func (o *OrganizationsCreateIntegrationBody) SetConfiguration(v map[string]inter
 	o.Configuration = v
 }
 
+// GetRateLimit returns the RateLimit field value if set, zero value otherwise.
+func (o *OrganizationsCreateIntegrationBody) GetRateLimit() OrganizationsIntegrationRateLimit {
+	if o == nil || IsNil(o.RateLimit) {
+		var ret OrganizationsIntegrationRateLimit
+		return ret
+	}
+	return *o.RateLimit
+}
+
+// GetRateLimitOk returns a tuple with the RateLimit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OrganizationsCreateIntegrationBody) GetRateLimitOk() (*OrganizationsIntegrationRateLimit, bool) {
+	if o == nil || IsNil(o.RateLimit) {
+		return nil, false
+	}
+	return o.RateLimit, true
+}
+
+// HasRateLimit returns a boolean if a field has been set.
+func (o *OrganizationsCreateIntegrationBody) HasRateLimit() bool {
+	if o != nil && !IsNil(o.RateLimit) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateLimit gets a reference to the given OrganizationsIntegrationRateLimit and assigns it to the RateLimit field.
+func (o *OrganizationsCreateIntegrationBody) SetRateLimit(v OrganizationsIntegrationRateLimit) {
+	o.RateLimit = &v
+}
+
 func (o OrganizationsCreateIntegrationBody) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -157,6 +190,9 @@ func (o OrganizationsCreateIntegrationBody) ToMap() (map[string]interface{}, err
 	if !IsNil(o.Configuration) {
 		toSerialize["configuration"] = o.Configuration
 	}
+	if !IsNil(o.RateLimit) {
+		toSerialize["rateLimit"] = o.RateLimit
+	}
 	return toSerialize, nil
 }
 
@@ -20,8 +20,9 @@ var _ MappedNullable = &OrganizationsIntegrationSpec{}
 
 // OrganizationsIntegrationSpec struct for OrganizationsIntegrationSpec
 type OrganizationsIntegrationSpec struct {
-	IntegrationName *string                `json:"integrationName,omitempty"`
-	Configuration   map[string]interface{} `json:"configuration,omitempty"`
+	IntegrationName *string                            `json:"integrationName,omitempty"`
+	Configuration   map[string]interface{}             `json:"configuration,omitempty"`
+	RateLimit       *OrganizationsIntegrationRateLimit `json:"rateLimit,omitempty"`
 }
 
 // NewOrganizationsIntegrationSpec instantiates a new OrganizationsIntegrationSpec object
@@ -105,6 +106,38 @@ func (o *OrganizationsIntegrationSpec) SetConfiguration(v map[string]interface{}
 	o.Configuration = v
 }
 
+// GetRateLimit returns the RateLimit field value if set, zero value otherwise.
+func (o *OrganizationsIntegrationSpec) GetRateLimit() OrganizationsIntegrationRateLimit {
+	if o == nil || IsNil(o.RateLimit) {
+		var ret OrganizationsIntegrationRateLimit
+		return ret
+	}
+	return *o.RateLimit
+}
+
+// GetRateLimitOk returns a tuple with the RateLimit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OrganizationsIntegrationSpec) GetRateLimitOk() (*OrganizationsIntegrationRateLimit, bool) {
+	if o == nil || IsNil(o.RateLimit) {
+		return nil, false
+	}
+	return o.RateLimit, true
+}
+
+// HasRateLimit returns a boolean if a field has been set.
+func (o *OrganizationsIntegrationSpec) HasRateLimit() bool {
+	if o != nil && !IsNil(o.RateLimit) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateLimit gets a reference to the given OrganizationsIntegrationRateLimit and assigns it to the RateLimit field.
+func (o *OrganizationsIntegrationSpec) SetRateLimit(v OrganizationsIntegrationRateLimit) {
+	o.RateLimit = &v
+}
+
 func (o OrganizationsIntegrationSpec) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -121,6 +154,9 @@ func (o OrganizationsIntegrationSpec) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Configuration) {
 		toSerialize["configuration"] = o.Configuration
 	}
+	if !IsNil(o.RateLimit) {
+		toSerialize["rateLimit"] = o.RateLimit
+	}
 	return toSerialize, nil
 }
 
@@ -20,8 +20,9 @@ var _ MappedNullable = &OrganizationsUpdateIntegrationBody{}
 
 // OrganizationsUpdateIntegrationBody struct for OrganizationsUpdateIntegrationBody
 type OrganizationsUpdateIntegrationBody struct {
-	Configuration map[string]interface{} `json:"configuration,omitempty"`
-	Name          *string                `json:"name,omitempty"`
+	Configuration map[string]interface{}             `json:"configuration,omitempty"`
+	Name          *string                            `json:"name,omitempty"`
+	RateLimit     *OrganizationsIntegrationRateLimit `json:"rateLimit,omitempty"`
 }
 
 // NewOrganizationsUpdateIntegrationBody instantiates a new OrganizationsUpdateIntegrationBody object
@@ -105,6 +106,38 @@ func (o *OrganizationsUpdateIntegrationBody) SetName(v string) {
 	o.Name = &v
 }
 
+// GetRateLimit returns the RateLimit field value if set, zero value otherwise.
+func (o *OrganizationsUpdateIntegrationBody) GetRateLimit() OrganizationsIntegrationRateLimit {
+	if o == nil || IsNil(o.RateLimit) {
+		var ret OrganizationsIntegrationRateLimit
+		return ret
+	}
+	return *o.RateLimit
+}
+
+// GetRateLimitOk returns a tuple with the RateLimit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OrganizationsUpdateIntegrationBody) GetRateLimitOk() (*OrganizationsIntegrationRateLimit, bool) {
+	if o == nil || IsNil(o.RateLimit) {
+		return nil, false
+	}
+	return o.RateLimit, true
+}
+
+// HasRateLimit returns a boolean if a field has been set.
+func (o *OrganizationsUpdateIntegrationBody) HasRateLimit() bool {
+	if o != nil && !IsNil(o.RateLimit) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateLimit gets a reference to the given OrganizationsIntegrationRateLimit and assigns it to the RateLimit field.
+func (o *OrganizationsUpdateIntegrationBody) SetRateLimit(v OrganizationsIntegrationRateLimit) {
+	o.RateLimit = &v
+}
+
 func (o OrganizationsUpdateIntegrationBody) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -121,6 +154,9 @@ func (o OrganizationsUpdateIntegrationBody) ToMap() (map[string]interface{}, err
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
+	if !IsNil(o.RateLimit) {
+		toSerialize["rateLimit"] = o.RateLimit
+	}
 	return toSerialize, nil
 }
 
@@ -21,6 +21,8 @@ var _ MappedNullable = &SecretsDescribeSecretResponse{}
 // SecretsDescribeSecretResponse struct for SecretsDescribeSecretResponse
 type SecretsDescribeSecretResponse struct {
 	Secret *SecretsSecret `json:"secret,omitempty"`
+	// Canvas nodes with a configuration referencing the secret.
+	References []SecretsSecretReference `json:"references,omitempty"`
 }
 
 // NewSecretsDescribeSecretResponse instantiates a new SecretsDescribeSecretResponse object
@@ -72,6 +74,38 @@ func (o *SecretsDescribeSecretResponse) SetSecret(v SecretsSecret) {
 	o.Secret = &v
 }
 
+// GetReferences returns the References field value if set, zero value otherwise.
+func (o *SecretsDescribeSecretResponse) GetReferences() []SecretsSecretReference {
+	if o == nil || IsNil(o.References) {
+		var ret []SecretsSecretReference
+		return ret
+	}
+	return o.References
+}
+
+// GetReferencesOk returns a tuple with the References field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsDescribeSecretResponse) GetReferencesOk() ([]SecretsSecretReference, bool) {
+	if o == nil || IsNil(o.References) {
+		return nil, false
+	}
+	return o.References, true
+}
+
+// HasReferences returns a boolean if a field has been set.
+func (o *SecretsDescribeSecretResponse) HasReferences() bool {
+	if o != nil && !IsNil(o.References) {
+		return true
+	}
+
+	return false
+}
+
+// SetReferences gets a reference to the given []SecretsSecretReference and assigns it to the References field.
+func (o *SecretsDescribeSecretResponse) SetReferences(v []SecretsSecretReference) {
+	o.References = v
+}
+
 func (o SecretsDescribeSecretResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -85,6 +119,9 @@ func (o SecretsDescribeSecretResponse) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Secret) {
 		toSerialize["secret"] = o.Secret
 	}
+	if !IsNil(o.References) {
+		toSerialize["references"] = o.References
+	}
 	return toSerialize, nil
 }
 
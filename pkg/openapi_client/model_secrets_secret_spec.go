@@ -22,6 +22,11 @@ var _ MappedNullable = &SecretsSecretSpec{}
 type SecretsSecretSpec struct {
 	Provider *SecretProvider `json:"provider,omitempty"`
 	Local    *SecretLocal    `json:"local,omitempty"`
+	Vault    *SecretVault    `json:"vault,omitempty"`
+	// On updates, the scope and access policy
+	// are only changed if they are set.
+	Scope        *SecretScope        `json:"scope,omitempty"`
+	AccessPolicy *SecretAccessPolicy `json:"accessPolicy,omitempty"`
 }
 
 // NewSecretsSecretSpec instantiates a new SecretsSecretSpec object
@@ -109,6 +114,102 @@ func (o *SecretsSecretSpec) SetLocal(v SecretLocal) {
 	o.Local = &v
 }
 
+// GetVault returns the Vault field value if set, zero value otherwise.
+func (o *SecretsSecretSpec) GetVault() SecretVault {
+	if o == nil || IsNil(o.Vault) {
+		var ret SecretVault
+		return ret
+	}
+	return *o.Vault
+}
+
+// GetVaultOk returns a tuple with the Vault field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsSecretSpec) GetVaultOk() (*SecretVault, bool) {
+	if o == nil || IsNil(o.Vault) {
+		return nil, false
+	}
+	return o.Vault, true
+}
+
+// HasVault returns a boolean if a field has been set.
+func (o *SecretsSecretSpec) HasVault() bool {
+	if o != nil && !IsNil(o.Vault) {
+		return true
+	}
+
+	return false
+}
+
+// SetVault gets a reference to the given SecretVault and assigns it to the Vault field.
+func (o *SecretsSecretSpec) SetVault(v SecretVault) {
+	o.Vault = &v
+}
+
+// GetScope returns the Scope field value if set, zero value otherwise.
+func (o *SecretsSecretSpec) GetScope() SecretScope {
+	if o == nil || IsNil(o.Scope) {
+		var ret SecretScope
+		return ret
+	}
+	return *o.Scope
+}
+
+// GetScopeOk returns a tuple with the Scope field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsSecretSpec) GetScopeOk() (*SecretScope, bool) {
+	if o == nil || IsNil(o.Scope) {
+		return nil, false
+	}
+	return o.Scope, true
+}
+
+// HasScope returns a boolean if a field has been set.
+func (o *SecretsSecretSpec) HasScope() bool {
+	if o != nil && !IsNil(o.Scope) {
+		return true
+	}
+
+	return false
+}
+
+// SetScope gets a reference to the given SecretScope and assigns it to the Scope field.
+func (o *SecretsSecretSpec) SetScope(v SecretScope) {
+	o.Scope = &v
+}
+
+// GetAccessPolicy returns the AccessPolicy field value if set, zero value otherwise.
+func (o *SecretsSecretSpec) GetAccessPolicy() SecretAccessPolicy {
+	if o == nil || IsNil(o.AccessPolicy) {
+		var ret SecretAccessPolicy
+		return ret
+	}
+	return *o.AccessPolicy
+}
+
+// GetAccessPolicyOk returns a tuple with the AccessPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsSecretSpec) GetAccessPolicyOk() (*SecretAccessPolicy, bool) {
+	if o == nil || IsNil(o.AccessPolicy) {
+		return nil, false
+	}
+	return o.AccessPolicy, true
+}
+
+// HasAccessPolicy returns a boolean if a field has been set.
+func (o *SecretsSecretSpec) HasAccessPolicy() bool {
+	if o != nil && !IsNil(o.AccessPolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetAccessPolicy gets a reference to the given SecretAccessPolicy and assigns it to the AccessPolicy field.
+func (o *SecretsSecretSpec) SetAccessPolicy(v SecretAccessPolicy) {
+	o.AccessPolicy = &v
+}
+
 func (o SecretsSecretSpec) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -125,6 +226,15 @@ func (o SecretsSecretSpec) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Local) {
 		toSerialize["local"] = o.Local
 	}
+	if !IsNil(o.Vault) {
+		toSerialize["vault"] = o.Vault
+	}
+	if !IsNil(o.Scope) {
+		toSerialize["scope"] = o.Scope
+	}
+	if !IsNil(o.AccessPolicy) {
+		toSerialize["accessPolicy"] = o.AccessPolicy
+	}
 	return toSerialize, nil
 }
 
@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.15.8
+// source: audit.proto
+
+package audit
+
+import (
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuditActorType int32
+
+const (
+	AuditActorType_ACTOR_TYPE_UNKNOWN         AuditActorType = 0
+	AuditActorType_ACTOR_TYPE_USER            AuditActorType = 1
+	AuditActorType_ACTOR_TYPE_SERVICE_ACCOUNT AuditActorType = 2
+)
+
+// Enum value maps for AuditActorType.
+var (
+	AuditActorType_name = map[int32]string{
+		0: "ACTOR_TYPE_UNKNOWN",
+		1: "ACTOR_TYPE_USER",
+		2: "ACTOR_TYPE_SERVICE_ACCOUNT",
+	}
+	AuditActorType_value = map[string]int32{
+		"ACTOR_TYPE_UNKNOWN":         0,
+		"ACTOR_TYPE_USER":            1,
+		"ACTOR_TYPE_SERVICE_ACCOUNT": 2,
+	}
+)
+
+func (x AuditActorType) Enum() *AuditActorType {
+	p := new(AuditActorType)
+	*p = x
+	return p
+}
+
+func (x AuditActorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditActorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_audit_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditActorType) Type() protoreflect.EnumType {
+	return &file_audit_proto_enumTypes[0]
+}
+
+func (x AuditActorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditActorType.Descriptor instead.
+func (AuditActorType) EnumDescriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{0}
+}
+
+type AuditLogEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorType  AuditActorType         `protobuf:"varint,2,opt,name=actor_type,json=actorType,proto3,enum=Superplane.Audit.AuditActorType" json:"actor_type,omitempty"`
+	ActorId    string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Method     string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Resource   string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action     string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	ResourceId string                 `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// The request of the call, with sensitive values redacted.
+	// Requests carry the changes being made, so this is the diff of the call.
+	Request       *_struct.Struct      `protobuf:"bytes,8,opt,name=request,proto3" json:"request,omitempty"`
+	Result        string               `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string               `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamp.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorType() AuditActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return AuditActorType_ACTOR_TYPE_UNKNOWN
+}
+
+func (x *AuditLogEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetRequest() *_struct.Struct {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditLogEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         uint32                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Method        string                 `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
+	Result        string                 `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogEntriesRequest) Reset() {
+	*x = ListAuditLogEntriesRequest{}
+	mi := &file_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogEntriesRequest) ProtoMessage() {}
+
+func (x *ListAuditLogEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAuditLogEntriesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditLogEntriesRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListAuditLogEntriesRequest) GetAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListAuditLogEntriesRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditLogEntriesRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ListAuditLogEntriesRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditLogEntriesRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ListAuditLogEntriesRequest) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type ListAuditLogEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditLogEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,2,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogEntriesResponse) Reset() {
+	*x = ListAuditLogEntriesResponse{}
+	mi := &file_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogEntriesResponse) ProtoMessage() {}
+
+func (x *ListAuditLogEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAuditLogEntriesResponse) GetEntries() []*AuditLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditLogEntriesResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListAuditLogEntriesResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+var File_audit_proto protoreflect.FileDescriptor
+
+const file_audit_proto_rawDesc = "" +
+	"\n" +
+	"\vaudit.proto\x12\x10Superplane.Audit\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x84\x03\n" +
+	"\rAuditLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12?\n" +
+	"\n" +
+	"actor_type\x18\x02 \x01(\x0e2 .Superplane.Audit.AuditActorTypeR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x1f\n" +
+	"\vresource_id\x18\a \x01(\tR\n" +
+	"resourceId\x121\n" +
+	"\arequest\x18\b \x01(\v2\x17.google.protobuf.StructR\arequest\x12\x16\n" +
+	"\x06result\x18\t \x01(\tR\x06result\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x97\x02\n" +
+	"\x1aListAuditLogEntriesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\x122\n" +
+	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x120\n" +
+	"\x05after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x16\n" +
+	"\x06method\x18\a \x01(\tR\x06method\x12\x16\n" +
+	"\x06result\x18\b \x01(\tR\x06result\"\xbf\x01\n" +
+	"\x1bListAuditLogEntriesResponse\x129\n" +
+	"\aentries\x18\x01 \x03(\v2\x1f.Superplane.Audit.AuditLogEntryR\aentries\x12\"\n" +
+	"\rhas_next_page\x18\x02 \x01(\bR\vhasNextPage\x12A\n" +
+	"\x0elast_timestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp*]\n" +
+	"\x0eAuditActorType\x12\x16\n" +
+	"\x12ACTOR_TYPE_UNKNOWN\x10\x00\x12\x13\n" +
+	"\x0fACTOR_TYPE_USER\x10\x01\x12\x1e\n" +
+	"\x1aACTOR_TYPE_SERVICE_ACCOUNT\x10\x022\x86\x02\n" +
+	"\x05Audit\x12\xfc\x01\n" +
+	"\x13ListAuditLogEntries\x12,.Superplane.Audit.ListAuditLogEntriesRequest\x1a-.Superplane.Audit.ListAuditLogEntriesResponse\"\x87\x01\x92Ak\n" +
+	"\x05Audit\x12\x16List audit log entries\x1aJReturns the mutating API calls made in the organization, most recent first\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/audit-logB\xd4\x01\x92A\x9b\x01\x12q\n" +
+	"\x14Superplane Audit API\x12-API for the Superplane organization audit log\"%\n" +
+	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ3github.com/superplanehq/superplane/pkg/protos/auditb\x06proto3"
+
+var (
+	file_audit_proto_rawDescOnce sync.Once
+	file_audit_proto_rawDescData []byte
+)
+
+func file_audit_proto_rawDescGZIP() []byte {
+	file_audit_proto_rawDescOnce.Do(func() {
+		file_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_audit_proto_rawDesc), len(file_audit_proto_rawDesc)))
+	})
+	return file_audit_proto_rawDescData
+}
+
+var file_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_audit_proto_goTypes = []any{
+	(AuditActorType)(0),                 // 0: Superplane.Audit.AuditActorType
+	(*AuditLogEntry)(nil),               // 1: Superplane.Audit.AuditLogEntry
+	(*ListAuditLogEntriesRequest)(nil),  // 2: Superplane.Audit.ListAuditLogEntriesRequest
+	(*ListAuditLogEntriesResponse)(nil), // 3: Superplane.Audit.ListAuditLogEntriesResponse
+	(*_struct.Struct)(nil),              // 4: google.protobuf.Struct
+	(*timestamp.Timestamp)(nil),         // 5: google.protobuf.Timestamp
+}
+var file_audit_proto_depIdxs = []int32{
+	0, // 0: Superplane.Audit.AuditLogEntry.actor_type:type_name -> Superplane.Audit.AuditActorType
+	4, // 1: Superplane.Audit.AuditLogEntry.request:type_name -> google.protobuf.Struct
+	5, // 2: Superplane.Audit.AuditLogEntry.created_at:type_name -> google.protobuf.Timestamp
+	5, // 3: Superplane.Audit.ListAuditLogEntriesRequest.before:type_name -> google.protobuf.Timestamp
+	5, // 4: Superplane.Audit.ListAuditLogEntriesRequest.after:type_name -> google.protobuf.Timestamp
+	1, // 5: Superplane.Audit.ListAuditLogEntriesResponse.entries:type_name -> Superplane.Audit.AuditLogEntry
+	5, // 6: Superplane.Audit.ListAuditLogEntriesResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	2, // 7: Superplane.Audit.Audit.ListAuditLogEntries:input_type -> Superplane.Audit.ListAuditLogEntriesRequest
+	3, // 8: Superplane.Audit.Audit.ListAuditLogEntries:output_type -> Superplane.Audit.ListAuditLogEntriesResponse
+	8, // [8:9] is the sub-list for method output_type
+	7, // [7:8] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_audit_proto_init() }
+func file_audit_proto_init() {
+	if File_audit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_audit_proto_rawDesc), len(file_audit_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_audit_proto_goTypes,
+		DependencyIndexes: file_audit_proto_depIdxs,
+		EnumInfos:         file_audit_proto_enumTypes,
+		MessageInfos:      file_audit_proto_msgTypes,
+	}.Build()
+	File_audit_proto = out.File
+	file_audit_proto_goTypes = nil
+	file_audit_proto_depIdxs = nil
+}
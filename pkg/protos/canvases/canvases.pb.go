@@ -12,6 +12,7 @@ import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	components "github.com/superplanehq/superplane/pkg/protos/components"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -26,6 +27,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CanvasChangeRequestState int32
+
+const (
+	CanvasChangeRequestState_STATE_UNKNOWN   CanvasChangeRequestState = 0
+	CanvasChangeRequestState_STATE_PENDING   CanvasChangeRequestState = 1
+	CanvasChangeRequestState_STATE_PUBLISHED CanvasChangeRequestState = 2
+	CanvasChangeRequestState_STATE_REJECTED  CanvasChangeRequestState = 3
+)
+
+// Enum value maps for CanvasChangeRequestState.
+var (
+	CanvasChangeRequestState_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "STATE_PENDING",
+		2: "STATE_PUBLISHED",
+		3: "STATE_REJECTED",
+	}
+	CanvasChangeRequestState_value = map[string]int32{
+		"STATE_UNKNOWN":   0,
+		"STATE_PENDING":   1,
+		"STATE_PUBLISHED": 2,
+		"STATE_REJECTED":  3,
+	}
+)
+
+func (x CanvasChangeRequestState) Enum() *CanvasChangeRequestState {
+	p := new(CanvasChangeRequestState)
+	*p = x
+	return p
+}
+
+func (x CanvasChangeRequestState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasChangeRequestState) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[0].Descriptor()
+}
+
+func (CanvasChangeRequestState) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[0]
+}
+
+func (x CanvasChangeRequestState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasChangeRequestState.Descriptor instead.
+func (CanvasChangeRequestState) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{0}
+}
+
 type CanvasNodeExecution_State int32
 
 const (
@@ -62,11 +115,11 @@ func (x CanvasNodeExecution_State) String() string {
 }
 
 func (CanvasNodeExecution_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[0].Descriptor()
+	return file_canvases_proto_enumTypes[1].Descriptor()
 }
 
 func (CanvasNodeExecution_State) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[0]
+	return &file_canvases_proto_enumTypes[1]
 }
 
 func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
@@ -75,7 +128,7 @@ func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_State.Descriptor instead.
 func (CanvasNodeExecution_State) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type CanvasNodeExecution_Result int32
@@ -114,11 +167,11 @@ func (x CanvasNodeExecution_Result) String() string {
 }
 
 func (CanvasNodeExecution_Result) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[1].Descriptor()
+	return file_canvases_proto_enumTypes[2].Descriptor()
 }
 
 func (CanvasNodeExecution_Result) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[1]
+	return &file_canvases_proto_enumTypes[2]
 }
 
 func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
@@ -127,7 +180,7 @@ func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_Result.Descriptor instead.
 func (CanvasNodeExecution_Result) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26, 1}
+	return file_canvases_proto_rawDescGZIP(), []int{28, 1}
 }
 
 type CanvasNodeExecution_ResultReason int32
@@ -136,6 +189,8 @@ const (
 	CanvasNodeExecution_RESULT_REASON_OK             CanvasNodeExecution_ResultReason = 0
 	CanvasNodeExecution_RESULT_REASON_ERROR          CanvasNodeExecution_ResultReason = 1
 	CanvasNodeExecution_RESULT_REASON_ERROR_RESOLVED CanvasNodeExecution_ResultReason = 2
+	CanvasNodeExecution_RESULT_REASON_TIMEOUT        CanvasNodeExecution_ResultReason = 3
+	CanvasNodeExecution_RESULT_REASON_SKIPPED        CanvasNodeExecution_ResultReason = 4
 )
 
 // Enum value maps for CanvasNodeExecution_ResultReason.
@@ -144,11 +199,15 @@ var (
 		0: "RESULT_REASON_OK",
 		1: "RESULT_REASON_ERROR",
 		2: "RESULT_REASON_ERROR_RESOLVED",
+		3: "RESULT_REASON_TIMEOUT",
+		4: "RESULT_REASON_SKIPPED",
 	}
 	CanvasNodeExecution_ResultReason_value = map[string]int32{
 		"RESULT_REASON_OK":             0,
 		"RESULT_REASON_ERROR":          1,
 		"RESULT_REASON_ERROR_RESOLVED": 2,
+		"RESULT_REASON_TIMEOUT":        3,
+		"RESULT_REASON_SKIPPED":        4,
 	}
 )
 
@@ -163,11 +222,11 @@ func (x CanvasNodeExecution_ResultReason) String() string {
 }
 
 func (CanvasNodeExecution_ResultReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[2].Descriptor()
+	return file_canvases_proto_enumTypes[3].Descriptor()
 }
 
 func (CanvasNodeExecution_ResultReason) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[2]
+	return &file_canvases_proto_enumTypes[3]
 }
 
 func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
@@ -176,7 +235,111 @@ func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_ResultReason.Descriptor instead.
 func (CanvasNodeExecution_ResultReason) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26, 2}
+	return file_canvases_proto_rawDescGZIP(), []int{28, 2}
+}
+
+type CanvasVersionDiff_ChangeType int32
+
+const (
+	CanvasVersionDiff_CHANGE_TYPE_UNKNOWN  CanvasVersionDiff_ChangeType = 0
+	CanvasVersionDiff_CHANGE_TYPE_ADDED    CanvasVersionDiff_ChangeType = 1
+	CanvasVersionDiff_CHANGE_TYPE_REMOVED  CanvasVersionDiff_ChangeType = 2
+	CanvasVersionDiff_CHANGE_TYPE_MODIFIED CanvasVersionDiff_ChangeType = 3
+)
+
+// Enum value maps for CanvasVersionDiff_ChangeType.
+var (
+	CanvasVersionDiff_ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNKNOWN",
+		1: "CHANGE_TYPE_ADDED",
+		2: "CHANGE_TYPE_REMOVED",
+		3: "CHANGE_TYPE_MODIFIED",
+	}
+	CanvasVersionDiff_ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNKNOWN":  0,
+		"CHANGE_TYPE_ADDED":    1,
+		"CHANGE_TYPE_REMOVED":  2,
+		"CHANGE_TYPE_MODIFIED": 3,
+	}
+)
+
+func (x CanvasVersionDiff_ChangeType) Enum() *CanvasVersionDiff_ChangeType {
+	p := new(CanvasVersionDiff_ChangeType)
+	*p = x
+	return p
+}
+
+func (x CanvasVersionDiff_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasVersionDiff_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[4].Descriptor()
+}
+
+func (CanvasVersionDiff_ChangeType) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[4]
+}
+
+func (x CanvasVersionDiff_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasVersionDiff_ChangeType.Descriptor instead.
+func (CanvasVersionDiff_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{55, 0}
+}
+
+type CanvasSimulationStep_Mode int32
+
+const (
+	CanvasSimulationStep_MODE_UNKNOWN        CanvasSimulationStep_Mode = 0
+	CanvasSimulationStep_MODE_EXECUTED       CanvasSimulationStep_Mode = 1
+	CanvasSimulationStep_MODE_EXAMPLE_OUTPUT CanvasSimulationStep_Mode = 2
+	CanvasSimulationStep_MODE_SKIPPED        CanvasSimulationStep_Mode = 3
+)
+
+// Enum value maps for CanvasSimulationStep_Mode.
+var (
+	CanvasSimulationStep_Mode_name = map[int32]string{
+		0: "MODE_UNKNOWN",
+		1: "MODE_EXECUTED",
+		2: "MODE_EXAMPLE_OUTPUT",
+		3: "MODE_SKIPPED",
+	}
+	CanvasSimulationStep_Mode_value = map[string]int32{
+		"MODE_UNKNOWN":        0,
+		"MODE_EXECUTED":       1,
+		"MODE_EXAMPLE_OUTPUT": 2,
+		"MODE_SKIPPED":        3,
+	}
+)
+
+func (x CanvasSimulationStep_Mode) Enum() *CanvasSimulationStep_Mode {
+	p := new(CanvasSimulationStep_Mode)
+	*p = x
+	return p
+}
+
+func (x CanvasSimulationStep_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasSimulationStep_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[5].Descriptor()
+}
+
+func (CanvasSimulationStep_Mode) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[5]
+}
+
+func (x CanvasSimulationStep_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasSimulationStep_Mode.Descriptor instead.
+func (CanvasSimulationStep_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{74, 0}
 }
 
 type ListCanvasesRequest struct {
@@ -444,11 +607,12 @@ func (x *CreateCanvasResponse) GetCanvas() *Canvas {
 }
 
 type UpdateCanvasRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Canvas        *Canvas                `protobuf:"bytes,2,opt,name=canvas,proto3" json:"canvas,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Canvas         *Canvas                `protobuf:"bytes,2,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	VersionMessage string                 `protobuf:"bytes,3,opt,name=version_message,json=versionMessage,proto3" json:"version_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateCanvasRequest) Reset() {
@@ -495,6 +659,13 @@ func (x *UpdateCanvasRequest) GetCanvas() *Canvas {
 	return nil
 }
 
+func (x *UpdateCanvasRequest) GetVersionMessage() string {
+	if x != nil {
+		return x.VersionMessage
+	}
+	return ""
+}
+
 type UpdateCanvasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Canvas        *Canvas                `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
@@ -1211,6 +1382,118 @@ func (*DeleteNodeQueueItemResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{19}
 }
 
+type UpdateNodeQueueItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNodeQueueItemRequest) Reset() {
+	*x = UpdateNodeQueueItemRequest{}
+	mi := &file_canvases_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNodeQueueItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodeQueueItemRequest) ProtoMessage() {}
+
+func (x *UpdateNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNodeQueueItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNodeQueueItemRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateNodeQueueItemRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *UpdateNodeQueueItemRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *UpdateNodeQueueItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *UpdateNodeQueueItemRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type UpdateNodeQueueItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *CanvasNodeQueueItem   `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNodeQueueItemResponse) Reset() {
+	*x = UpdateNodeQueueItemResponse{}
+	mi := &file_canvases_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNodeQueueItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodeQueueItemResponse) ProtoMessage() {}
+
+func (x *UpdateNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNodeQueueItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNodeQueueItemResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateNodeQueueItemResponse) GetItem() *CanvasNodeQueueItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
 type UpdateNodePauseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
@@ -1222,7 +1505,7 @@ type UpdateNodePauseRequest struct {
 
 func (x *UpdateNodePauseRequest) Reset() {
 	*x = UpdateNodePauseRequest{}
-	mi := &file_canvases_proto_msgTypes[20]
+	mi := &file_canvases_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1234,7 +1517,7 @@ func (x *UpdateNodePauseRequest) String() string {
 func (*UpdateNodePauseRequest) ProtoMessage() {}
 
 func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[20]
+	mi := &file_canvases_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1247,7 +1530,7 @@ func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodePauseRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNodePauseRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{20}
+	return file_canvases_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateNodePauseRequest) GetCanvasId() string {
@@ -1280,7 +1563,7 @@ type UpdateNodePauseResponse struct {
 
 func (x *UpdateNodePauseResponse) Reset() {
 	*x = UpdateNodePauseResponse{}
-	mi := &file_canvases_proto_msgTypes[21]
+	mi := &file_canvases_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1292,7 +1575,7 @@ func (x *UpdateNodePauseResponse) String() string {
 func (*UpdateNodePauseResponse) ProtoMessage() {}
 
 func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[21]
+	mi := &file_canvases_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,7 +1588,7 @@ func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodePauseResponse.ProtoReflect.Descriptor instead.
 func (*UpdateNodePauseResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{21}
+	return file_canvases_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateNodePauseResponse) GetNode() *components.Node {
@@ -1329,7 +1612,7 @@ type ListNodeExecutionsRequest struct {
 
 func (x *ListNodeExecutionsRequest) Reset() {
 	*x = ListNodeExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[22]
+	mi := &file_canvases_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1341,7 +1624,7 @@ func (x *ListNodeExecutionsRequest) String() string {
 func (*ListNodeExecutionsRequest) ProtoMessage() {}
 
 func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[22]
+	mi := &file_canvases_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,7 +1637,7 @@ func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListNodeExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{22}
+	return file_canvases_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListNodeExecutionsRequest) GetCanvasId() string {
@@ -1411,7 +1694,7 @@ type ListNodeExecutionsResponse struct {
 
 func (x *ListNodeExecutionsResponse) Reset() {
 	*x = ListNodeExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[23]
+	mi := &file_canvases_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1423,7 +1706,7 @@ func (x *ListNodeExecutionsResponse) String() string {
 func (*ListNodeExecutionsResponse) ProtoMessage() {}
 
 func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[23]
+	mi := &file_canvases_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +1719,7 @@ func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListNodeExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{23}
+	return file_canvases_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListNodeExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -1477,7 +1760,7 @@ type ListChildExecutionsRequest struct {
 
 func (x *ListChildExecutionsRequest) Reset() {
 	*x = ListChildExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[24]
+	mi := &file_canvases_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1489,7 +1772,7 @@ func (x *ListChildExecutionsRequest) String() string {
 func (*ListChildExecutionsRequest) ProtoMessage() {}
 
 func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[24]
+	mi := &file_canvases_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,7 +1785,7 @@ func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{24}
+	return file_canvases_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListChildExecutionsRequest) GetCanvasId() string {
@@ -1528,7 +1811,7 @@ type ListChildExecutionsResponse struct {
 
 func (x *ListChildExecutionsResponse) Reset() {
 	*x = ListChildExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[25]
+	mi := &file_canvases_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1540,7 +1823,7 @@ func (x *ListChildExecutionsResponse) String() string {
 func (*ListChildExecutionsResponse) ProtoMessage() {}
 
 func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[25]
+	mi := &file_canvases_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1553,7 +1836,7 @@ func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{25}
+	return file_canvases_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -1583,13 +1866,18 @@ type CanvasNodeExecution struct {
 	ChildExecutions     []*CanvasNodeExecution           `protobuf:"bytes,16,rep,name=child_executions,json=childExecutions,proto3" json:"child_executions,omitempty"`
 	RootEvent           *CanvasEvent                     `protobuf:"bytes,17,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
 	CancelledBy         *UserRef                         `protobuf:"bytes,18,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	Attempt             int32                            `protobuf:"varint,19,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	RetryOfExecutionId  string                           `protobuf:"bytes,20,opt,name=retry_of_execution_id,json=retryOfExecutionId,proto3" json:"retry_of_execution_id,omitempty"`
+	DeadlineAt          *timestamp.Timestamp             `protobuf:"bytes,21,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
+	CanvasVersionId     string                           `protobuf:"bytes,22,opt,name=canvas_version_id,json=canvasVersionId,proto3" json:"canvas_version_id,omitempty"`
+	TraceId             string                           `protobuf:"bytes,23,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *CanvasNodeExecution) Reset() {
 	*x = CanvasNodeExecution{}
-	mi := &file_canvases_proto_msgTypes[26]
+	mi := &file_canvases_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1601,7 +1889,7 @@ func (x *CanvasNodeExecution) String() string {
 func (*CanvasNodeExecution) ProtoMessage() {}
 
 func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[26]
+	mi := &file_canvases_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,7 +1902,7 @@ func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
 func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26}
+	return file_canvases_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CanvasNodeExecution) GetId() string {
@@ -1743,6 +2031,41 @@ func (x *CanvasNodeExecution) GetCancelledBy() *UserRef {
 	return nil
 }
 
+func (x *CanvasNodeExecution) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *CanvasNodeExecution) GetRetryOfExecutionId() string {
+	if x != nil {
+		return x.RetryOfExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetDeadlineAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeadlineAt
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetCanvasVersionId() string {
+	if x != nil {
+		return x.CanvasVersionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
 type CanvasNodeQueueItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1751,13 +2074,14 @@ type CanvasNodeQueueItem struct {
 	Input         *_struct.Struct        `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
 	RootEvent     *CanvasEvent           `protobuf:"bytes,5,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
 	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Priority      int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CanvasNodeQueueItem) Reset() {
 	*x = CanvasNodeQueueItem{}
-	mi := &file_canvases_proto_msgTypes[27]
+	mi := &file_canvases_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1769,7 +2093,7 @@ func (x *CanvasNodeQueueItem) String() string {
 func (*CanvasNodeQueueItem) ProtoMessage() {}
 
 func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[27]
+	mi := &file_canvases_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1782,7 +2106,7 @@ func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
 func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{27}
+	return file_canvases_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CanvasNodeQueueItem) GetId() string {
@@ -1827,6 +2151,13 @@ func (x *CanvasNodeQueueItem) GetCreatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *CanvasNodeQueueItem) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 type InvokeNodeExecutionActionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
@@ -1839,7 +2170,7 @@ type InvokeNodeExecutionActionRequest struct {
 
 func (x *InvokeNodeExecutionActionRequest) Reset() {
 	*x = InvokeNodeExecutionActionRequest{}
-	mi := &file_canvases_proto_msgTypes[28]
+	mi := &file_canvases_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1851,7 +2182,7 @@ func (x *InvokeNodeExecutionActionRequest) String() string {
 func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
 
 func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[28]
+	mi := &file_canvases_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1864,7 +2195,7 @@ func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
 func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{28}
+	return file_canvases_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
@@ -1903,7 +2234,7 @@ type InvokeNodeExecutionActionResponse struct {
 
 func (x *InvokeNodeExecutionActionResponse) Reset() {
 	*x = InvokeNodeExecutionActionResponse{}
-	mi := &file_canvases_proto_msgTypes[29]
+	mi := &file_canvases_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1915,7 +2246,7 @@ func (x *InvokeNodeExecutionActionResponse) String() string {
 func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
 
 func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[29]
+	mi := &file_canvases_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1928,7 +2259,7 @@ func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
 func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{29}
+	return file_canvases_proto_rawDescGZIP(), []int{31}
 }
 
 type InvokeNodeTriggerActionRequest struct {
@@ -1943,7 +2274,7 @@ type InvokeNodeTriggerActionRequest struct {
 
 func (x *InvokeNodeTriggerActionRequest) Reset() {
 	*x = InvokeNodeTriggerActionRequest{}
-	mi := &file_canvases_proto_msgTypes[30]
+	mi := &file_canvases_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1955,7 +2286,7 @@ func (x *InvokeNodeTriggerActionRequest) String() string {
 func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
 
 func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[30]
+	mi := &file_canvases_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,7 +2299,7 @@ func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
 func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30}
+	return file_canvases_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
@@ -2008,7 +2339,7 @@ type InvokeNodeTriggerActionResponse struct {
 
 func (x *InvokeNodeTriggerActionResponse) Reset() {
 	*x = InvokeNodeTriggerActionResponse{}
-	mi := &file_canvases_proto_msgTypes[31]
+	mi := &file_canvases_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2020,7 +2351,7 @@ func (x *InvokeNodeTriggerActionResponse) String() string {
 func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
 
 func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[31]
+	mi := &file_canvases_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,7 +2364,7 @@ func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
 func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{31}
+	return file_canvases_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
@@ -2054,7 +2385,7 @@ type ListCanvasEventsRequest struct {
 
 func (x *ListCanvasEventsRequest) Reset() {
 	*x = ListCanvasEventsRequest{}
-	mi := &file_canvases_proto_msgTypes[32]
+	mi := &file_canvases_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2066,7 +2397,7 @@ func (x *ListCanvasEventsRequest) String() string {
 func (*ListCanvasEventsRequest) ProtoMessage() {}
 
 func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[32]
+	mi := &file_canvases_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2079,7 +2410,7 @@ func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{32}
+	return file_canvases_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListCanvasEventsRequest) GetCanvasId() string {
@@ -2115,7 +2446,7 @@ type ListCanvasEventsResponse struct {
 
 func (x *ListCanvasEventsResponse) Reset() {
 	*x = ListCanvasEventsResponse{}
-	mi := &file_canvases_proto_msgTypes[33]
+	mi := &file_canvases_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2127,7 +2458,7 @@ func (x *ListCanvasEventsResponse) String() string {
 func (*ListCanvasEventsResponse) ProtoMessage() {}
 
 func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[33]
+	mi := &file_canvases_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2140,7 +2471,7 @@ func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{33}
+	return file_canvases_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
@@ -2186,7 +2517,7 @@ type CanvasEvent struct {
 
 func (x *CanvasEvent) Reset() {
 	*x = CanvasEvent{}
-	mi := &file_canvases_proto_msgTypes[34]
+	mi := &file_canvases_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2198,7 +2529,7 @@ func (x *CanvasEvent) String() string {
 func (*CanvasEvent) ProtoMessage() {}
 
 func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[34]
+	mi := &file_canvases_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2211,7 +2542,7 @@ func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
 func (*CanvasEvent) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{34}
+	return file_canvases_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CanvasEvent) GetId() string {
@@ -2279,7 +2610,7 @@ type CanvasEventWithExecutions struct {
 
 func (x *CanvasEventWithExecutions) Reset() {
 	*x = CanvasEventWithExecutions{}
-	mi := &file_canvases_proto_msgTypes[35]
+	mi := &file_canvases_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2291,7 +2622,7 @@ func (x *CanvasEventWithExecutions) String() string {
 func (*CanvasEventWithExecutions) ProtoMessage() {}
 
 func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[35]
+	mi := &file_canvases_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2304,7 +2635,7 @@ func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
 func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{35}
+	return file_canvases_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CanvasEventWithExecutions) GetId() string {
@@ -2373,7 +2704,7 @@ type ListEventExecutionsRequest struct {
 
 func (x *ListEventExecutionsRequest) Reset() {
 	*x = ListEventExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[36]
+	mi := &file_canvases_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2385,7 +2716,7 @@ func (x *ListEventExecutionsRequest) String() string {
 func (*ListEventExecutionsRequest) ProtoMessage() {}
 
 func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[36]
+	mi := &file_canvases_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,7 +2729,7 @@ func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{36}
+	return file_canvases_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListEventExecutionsRequest) GetCanvasId() string {
@@ -2424,7 +2755,7 @@ type ListEventExecutionsResponse struct {
 
 func (x *ListEventExecutionsResponse) Reset() {
 	*x = ListEventExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[37]
+	mi := &file_canvases_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2436,7 +2767,7 @@ func (x *ListEventExecutionsResponse) String() string {
 func (*ListEventExecutionsResponse) ProtoMessage() {}
 
 func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[37]
+	mi := &file_canvases_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2449,7 +2780,7 @@ func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{37}
+	return file_canvases_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -2469,7 +2800,7 @@ type CancelExecutionRequest struct {
 
 func (x *CancelExecutionRequest) Reset() {
 	*x = CancelExecutionRequest{}
-	mi := &file_canvases_proto_msgTypes[38]
+	mi := &file_canvases_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2481,7 +2812,7 @@ func (x *CancelExecutionRequest) String() string {
 func (*CancelExecutionRequest) ProtoMessage() {}
 
 func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[38]
+	mi := &file_canvases_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2494,7 +2825,7 @@ func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
 func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{38}
+	return file_canvases_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CancelExecutionRequest) GetCanvasId() string {
@@ -2519,7 +2850,7 @@ type CancelExecutionResponse struct {
 
 func (x *CancelExecutionResponse) Reset() {
 	*x = CancelExecutionResponse{}
-	mi := &file_canvases_proto_msgTypes[39]
+	mi := &file_canvases_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2531,7 +2862,7 @@ func (x *CancelExecutionResponse) String() string {
 func (*CancelExecutionResponse) ProtoMessage() {}
 
 func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[39]
+	mi := &file_canvases_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +2875,103 @@ func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
 func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{39}
+	return file_canvases_proto_rawDescGZIP(), []int{41}
+}
+
+type RerunExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RerunExecutionRequest) Reset() {
+	*x = RerunExecutionRequest{}
+	mi := &file_canvases_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerunExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunExecutionRequest) ProtoMessage() {}
+
+func (x *RerunExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunExecutionRequest.ProtoReflect.Descriptor instead.
+func (*RerunExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RerunExecutionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *RerunExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type RerunExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueItem     *CanvasNodeQueueItem   `protobuf:"bytes,1,opt,name=queue_item,json=queueItem,proto3" json:"queue_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RerunExecutionResponse) Reset() {
+	*x = RerunExecutionResponse{}
+	mi := &file_canvases_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerunExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunExecutionResponse) ProtoMessage() {}
+
+func (x *RerunExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunExecutionResponse.ProtoReflect.Descriptor instead.
+func (*RerunExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RerunExecutionResponse) GetQueueItem() *CanvasNodeQueueItem {
+	if x != nil {
+		return x.QueueItem
+	}
+	return nil
 }
 
 type ResolveExecutionErrorsRequest struct {
@@ -2557,7 +2984,7 @@ type ResolveExecutionErrorsRequest struct {
 
 func (x *ResolveExecutionErrorsRequest) Reset() {
 	*x = ResolveExecutionErrorsRequest{}
-	mi := &file_canvases_proto_msgTypes[40]
+	mi := &file_canvases_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2569,7 +2996,7 @@ func (x *ResolveExecutionErrorsRequest) String() string {
 func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
 
 func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[40]
+	mi := &file_canvases_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2582,7 +3009,7 @@ func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
 func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{40}
+	return file_canvases_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
@@ -2607,7 +3034,7 @@ type ResolveExecutionErrorsResponse struct {
 
 func (x *ResolveExecutionErrorsResponse) Reset() {
 	*x = ResolveExecutionErrorsResponse{}
-	mi := &file_canvases_proto_msgTypes[41]
+	mi := &file_canvases_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2619,7 +3046,7 @@ func (x *ResolveExecutionErrorsResponse) String() string {
 func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
 
 func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[41]
+	mi := &file_canvases_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2632,34 +3059,33 @@ func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
 func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{41}
+	return file_canvases_proto_rawDescGZIP(), []int{45}
 }
 
-type CanvasNodeEventMessage struct {
+type ListCanvasVersionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        uint32                 `protobuf:"varint,3,opt,name=before,proto3" json:"before,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeEventMessage) Reset() {
-	*x = CanvasNodeEventMessage{}
-	mi := &file_canvases_proto_msgTypes[42]
+func (x *ListCanvasVersionsRequest) Reset() {
+	*x = ListCanvasVersionsRequest{}
+	mi := &file_canvases_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeEventMessage) String() string {
+func (x *ListCanvasVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeEventMessage) ProtoMessage() {}
+func (*ListCanvasVersionsRequest) ProtoMessage() {}
 
-func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[42]
+func (x *ListCanvasVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2670,64 +3096,56 @@ func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *CanvasNodeEventMessage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ListCanvasVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CanvasNodeEventMessage) GetCanvasId() string {
+func (x *ListCanvasVersionsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasNodeEventMessage) GetNodeId() string {
+func (x *ListCanvasVersionsRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.NodeId
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *CanvasNodeEventMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *ListCanvasVersionsRequest) GetBefore() uint32 {
 	if x != nil {
-		return x.Timestamp
+		return x.Before
 	}
-	return nil
+	return 0
 }
 
-type CanvasNodeExecutionMessage struct {
+type ListCanvasVersionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Versions      []*CanvasVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecutionMessage) Reset() {
-	*x = CanvasNodeExecutionMessage{}
-	mi := &file_canvases_proto_msgTypes[43]
+func (x *ListCanvasVersionsResponse) Reset() {
+	*x = ListCanvasVersionsResponse{}
+	mi := &file_canvases_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecutionMessage) String() string {
+func (x *ListCanvasVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeExecutionMessage) ProtoMessage() {}
+func (*ListCanvasVersionsResponse) ProtoMessage() {}
 
-func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[43]
+func (x *ListCanvasVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2738,64 +3156,3164 @@ func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *CanvasNodeExecutionMessage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ListCanvasVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CanvasNodeExecutionMessage) GetCanvasId() string {
+func (x *ListCanvasVersionsResponse) GetVersions() []*CanvasVersion {
 	if x != nil {
-		return x.CanvasId
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecutionMessage) GetNodeId() string {
+func (x *ListCanvasVersionsResponse) GetTotalCount() uint32 {
 	if x != nil {
-		return x.NodeId
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CanvasNodeExecutionMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *ListCanvasVersionsResponse) GetHasNextPage() bool {
 	if x != nil {
-		return x.Timestamp
+		return x.HasNextPage
 	}
-	return nil
+	return false
 }
 
-type CanvasNodeQueueItemMessage struct {
+type DescribeCanvasVersionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeQueueItemMessage) Reset() {
-	*x = CanvasNodeQueueItemMessage{}
-	mi := &file_canvases_proto_msgTypes[44]
+func (x *DescribeCanvasVersionRequest) Reset() {
+	*x = DescribeCanvasVersionRequest{}
+	mi := &file_canvases_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeQueueItemMessage) String() string {
+func (x *DescribeCanvasVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCanvasVersionRequest) ProtoMessage() {}
+
+func (x *DescribeCanvasVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCanvasVersionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasVersionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DescribeCanvasVersionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DescribeCanvasVersionRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type DescribeCanvasVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *CanvasVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeCanvasVersionResponse) Reset() {
+	*x = DescribeCanvasVersionResponse{}
+	mi := &file_canvases_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeCanvasVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCanvasVersionResponse) ProtoMessage() {}
+
+func (x *DescribeCanvasVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCanvasVersionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasVersionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DescribeCanvasVersionResponse) GetVersion() *CanvasVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type DiffCanvasVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ToVersionId   string                 `protobuf:"bytes,2,opt,name=to_version_id,json=toVersionId,proto3" json:"to_version_id,omitempty"`
+	FromVersionId string                 `protobuf:"bytes,3,opt,name=from_version_id,json=fromVersionId,proto3" json:"from_version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffCanvasVersionsRequest) Reset() {
+	*x = DiffCanvasVersionsRequest{}
+	mi := &file_canvases_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffCanvasVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffCanvasVersionsRequest) ProtoMessage() {}
+
+func (x *DiffCanvasVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffCanvasVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffCanvasVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DiffCanvasVersionsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DiffCanvasVersionsRequest) GetToVersionId() string {
+	if x != nil {
+		return x.ToVersionId
+	}
+	return ""
+}
+
+func (x *DiffCanvasVersionsRequest) GetFromVersionId() string {
+	if x != nil {
+		return x.FromVersionId
+	}
+	return ""
+}
+
+type DiffCanvasVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *CanvasVersion         `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *CanvasVersion         `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Diff          *CanvasVersionDiff     `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffCanvasVersionsResponse) Reset() {
+	*x = DiffCanvasVersionsResponse{}
+	mi := &file_canvases_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffCanvasVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffCanvasVersionsResponse) ProtoMessage() {}
+
+func (x *DiffCanvasVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffCanvasVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffCanvasVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DiffCanvasVersionsResponse) GetFrom() *CanvasVersion {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffCanvasVersionsResponse) GetTo() *CanvasVersion {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DiffCanvasVersionsResponse) GetDiff() *CanvasVersionDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type RestoreCanvasVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCanvasVersionRequest) Reset() {
+	*x = RestoreCanvasVersionRequest{}
+	mi := &file_canvases_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCanvasVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCanvasVersionRequest) ProtoMessage() {}
+
+func (x *RestoreCanvasVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCanvasVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCanvasVersionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RestoreCanvasVersionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *RestoreCanvasVersionRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+type RestoreCanvasVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Canvas        *Canvas                `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	Version       *CanvasVersion         `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCanvasVersionResponse) Reset() {
+	*x = RestoreCanvasVersionResponse{}
+	mi := &file_canvases_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCanvasVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCanvasVersionResponse) ProtoMessage() {}
+
+func (x *RestoreCanvasVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCanvasVersionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCanvasVersionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RestoreCanvasVersionResponse) GetCanvas() *Canvas {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
+func (x *RestoreCanvasVersionResponse) GetVersion() *CanvasVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type CanvasVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Version       uint32                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedBy     *UserRef               `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Spec          *Canvas_Spec           `protobuf:"bytes,9,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasVersion) Reset() {
+	*x = CanvasVersion{}
+	mi := &file_canvases_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasVersion) ProtoMessage() {}
+
+func (x *CanvasVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasVersion.ProtoReflect.Descriptor instead.
+func (*CanvasVersion) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CanvasVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasVersion) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasVersion) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CanvasVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasVersion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CanvasVersion) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CanvasVersion) GetCreatedBy() *UserRef {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *CanvasVersion) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasVersion) GetSpec() *Canvas_Spec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type CanvasVersionDiff struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	ChangedFields []string                        `protobuf:"bytes,1,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Nodes         []*CanvasVersionDiff_NodeChange `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*CanvasVersionDiff_EdgeChange `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasVersionDiff) Reset() {
+	*x = CanvasVersionDiff{}
+	mi := &file_canvases_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasVersionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasVersionDiff) ProtoMessage() {}
+
+func (x *CanvasVersionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasVersionDiff.ProtoReflect.Descriptor instead.
+func (*CanvasVersionDiff) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CanvasVersionDiff) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *CanvasVersionDiff) GetNodes() []*CanvasVersionDiff_NodeChange {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *CanvasVersionDiff) GetEdges() []*CanvasVersionDiff_EdgeChange {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type DescribeCanvasDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeCanvasDraftRequest) Reset() {
+	*x = DescribeCanvasDraftRequest{}
+	mi := &file_canvases_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeCanvasDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCanvasDraftRequest) ProtoMessage() {}
+
+func (x *DescribeCanvasDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCanvasDraftRequest.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasDraftRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DescribeCanvasDraftRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type DescribeCanvasDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *CanvasDraft           `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeCanvasDraftResponse) Reset() {
+	*x = DescribeCanvasDraftResponse{}
+	mi := &file_canvases_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeCanvasDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCanvasDraftResponse) ProtoMessage() {}
+
+func (x *DescribeCanvasDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCanvasDraftResponse.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasDraftResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DescribeCanvasDraftResponse) GetDraft() *CanvasDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type UpdateCanvasDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Canvas        *Canvas                `protobuf:"bytes,2,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasDraftRequest) Reset() {
+	*x = UpdateCanvasDraftRequest{}
+	mi := &file_canvases_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasDraftRequest) ProtoMessage() {}
+
+func (x *UpdateCanvasDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasDraftRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasDraftRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateCanvasDraftRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *UpdateCanvasDraftRequest) GetCanvas() *Canvas {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
+type UpdateCanvasDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *CanvasDraft           `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasDraftResponse) Reset() {
+	*x = UpdateCanvasDraftResponse{}
+	mi := &file_canvases_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasDraftResponse) ProtoMessage() {}
+
+func (x *UpdateCanvasDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasDraftResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasDraftResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateCanvasDraftResponse) GetDraft() *CanvasDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type DiscardCanvasDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardCanvasDraftRequest) Reset() {
+	*x = DiscardCanvasDraftRequest{}
+	mi := &file_canvases_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardCanvasDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardCanvasDraftRequest) ProtoMessage() {}
+
+func (x *DiscardCanvasDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardCanvasDraftRequest.ProtoReflect.Descriptor instead.
+func (*DiscardCanvasDraftRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DiscardCanvasDraftRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type DiscardCanvasDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardCanvasDraftResponse) Reset() {
+	*x = DiscardCanvasDraftResponse{}
+	mi := &file_canvases_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardCanvasDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardCanvasDraftResponse) ProtoMessage() {}
+
+func (x *DiscardCanvasDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardCanvasDraftResponse.ProtoReflect.Descriptor instead.
+func (*DiscardCanvasDraftResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{61}
+}
+
+type PublishCanvasDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ApprovalRole  string                 `protobuf:"bytes,3,opt,name=approval_role,json=approvalRole,proto3" json:"approval_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishCanvasDraftRequest) Reset() {
+	*x = PublishCanvasDraftRequest{}
+	mi := &file_canvases_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishCanvasDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishCanvasDraftRequest) ProtoMessage() {}
+
+func (x *PublishCanvasDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishCanvasDraftRequest.ProtoReflect.Descriptor instead.
+func (*PublishCanvasDraftRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PublishCanvasDraftRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *PublishCanvasDraftRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PublishCanvasDraftRequest) GetApprovalRole() string {
+	if x != nil {
+		return x.ApprovalRole
+	}
+	return ""
+}
+
+type PublishCanvasDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Canvas        *Canvas                `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	Version       *CanvasVersion         `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,3,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishCanvasDraftResponse) Reset() {
+	*x = PublishCanvasDraftResponse{}
+	mi := &file_canvases_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishCanvasDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishCanvasDraftResponse) ProtoMessage() {}
+
+func (x *PublishCanvasDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishCanvasDraftResponse.ProtoReflect.Descriptor instead.
+func (*PublishCanvasDraftResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PublishCanvasDraftResponse) GetCanvas() *Canvas {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
+func (x *PublishCanvasDraftResponse) GetVersion() *CanvasVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *PublishCanvasDraftResponse) GetChangeRequest() *CanvasChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+type ListCanvasChangeRequestsRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	CanvasId      string                     `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	States        []CanvasChangeRequestState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=Superplane.Canvases.CanvasChangeRequestState" json:"states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasChangeRequestsRequest) Reset() {
+	*x = ListCanvasChangeRequestsRequest{}
+	mi := &file_canvases_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasChangeRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasChangeRequestsRequest) ProtoMessage() {}
+
+func (x *ListCanvasChangeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasChangeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasChangeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListCanvasChangeRequestsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListCanvasChangeRequestsRequest) GetStates() []CanvasChangeRequestState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type ListCanvasChangeRequestsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequests []*CanvasChangeRequest `protobuf:"bytes,1,rep,name=change_requests,json=changeRequests,proto3" json:"change_requests,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCanvasChangeRequestsResponse) Reset() {
+	*x = ListCanvasChangeRequestsResponse{}
+	mi := &file_canvases_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasChangeRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasChangeRequestsResponse) ProtoMessage() {}
+
+func (x *ListCanvasChangeRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasChangeRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasChangeRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListCanvasChangeRequestsResponse) GetChangeRequests() []*CanvasChangeRequest {
+	if x != nil {
+		return x.ChangeRequests
+	}
+	return nil
+}
+
+type ApproveCanvasChangeRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ChangeRequestId string                 `protobuf:"bytes,2,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApproveCanvasChangeRequestRequest) Reset() {
+	*x = ApproveCanvasChangeRequestRequest{}
+	mi := &file_canvases_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCanvasChangeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCanvasChangeRequestRequest) ProtoMessage() {}
+
+func (x *ApproveCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ApproveCanvasChangeRequestRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ApproveCanvasChangeRequestRequest) GetChangeRequestId() string {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return ""
+}
+
+type ApproveCanvasChangeRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Canvas        *Canvas                `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	Version       *CanvasVersion         `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,3,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveCanvasChangeRequestResponse) Reset() {
+	*x = ApproveCanvasChangeRequestResponse{}
+	mi := &file_canvases_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCanvasChangeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCanvasChangeRequestResponse) ProtoMessage() {}
+
+func (x *ApproveCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ApproveCanvasChangeRequestResponse) GetCanvas() *Canvas {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
+func (x *ApproveCanvasChangeRequestResponse) GetVersion() *CanvasVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *ApproveCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+type RejectCanvasChangeRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ChangeRequestId string                 `protobuf:"bytes,2,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RejectCanvasChangeRequestRequest) Reset() {
+	*x = RejectCanvasChangeRequestRequest{}
+	mi := &file_canvases_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCanvasChangeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCanvasChangeRequestRequest) ProtoMessage() {}
+
+func (x *RejectCanvasChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCanvasChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectCanvasChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RejectCanvasChangeRequestRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *RejectCanvasChangeRequestRequest) GetChangeRequestId() string {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return ""
+}
+
+type RejectCanvasChangeRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequest *CanvasChangeRequest   `protobuf:"bytes,1,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectCanvasChangeRequestResponse) Reset() {
+	*x = RejectCanvasChangeRequestResponse{}
+	mi := &file_canvases_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCanvasChangeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCanvasChangeRequestResponse) ProtoMessage() {}
+
+func (x *RejectCanvasChangeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCanvasChangeRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectCanvasChangeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RejectCanvasChangeRequestResponse) GetChangeRequest() *CanvasChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+type CanvasDraft struct {
+	state            protoimpl.MessageState         `protogen:"open.v1"`
+	CanvasId         string                         `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Name             string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Spec             *Canvas_Spec                   `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	BaseVersionId    string                         `protobuf:"bytes,5,opt,name=base_version_id,json=baseVersionId,proto3" json:"base_version_id,omitempty"`
+	UpdatedBy        *UserRef                       `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt        *timestamp.Timestamp           `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamp.Timestamp           `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ValidationErrors []*CanvasDraft_ValidationError `protobuf:"bytes,9,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+	Diff             *CanvasVersionDiff             `protobuf:"bytes,10,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CanvasDraft) Reset() {
+	*x = CanvasDraft{}
+	mi := &file_canvases_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasDraft) ProtoMessage() {}
+
+func (x *CanvasDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasDraft.ProtoReflect.Descriptor instead.
+func (*CanvasDraft) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CanvasDraft) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasDraft) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasDraft) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CanvasDraft) GetSpec() *Canvas_Spec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CanvasDraft) GetBaseVersionId() string {
+	if x != nil {
+		return x.BaseVersionId
+	}
+	return ""
+}
+
+func (x *CanvasDraft) GetUpdatedBy() *UserRef {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *CanvasDraft) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasDraft) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CanvasDraft) GetValidationErrors() []*CanvasDraft_ValidationError {
+	if x != nil {
+		return x.ValidationErrors
+	}
+	return nil
+}
+
+func (x *CanvasDraft) GetDiff() *CanvasVersionDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type CanvasChangeRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Id                 string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId           string                   `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Name               string                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Message            string                   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	ApprovalRole       string                   `protobuf:"bytes,6,opt,name=approval_role,json=approvalRole,proto3" json:"approval_role,omitempty"`
+	State              CanvasChangeRequestState `protobuf:"varint,7,opt,name=state,proto3,enum=Superplane.Canvases.CanvasChangeRequestState" json:"state,omitempty"`
+	BaseVersionId      string                   `protobuf:"bytes,8,opt,name=base_version_id,json=baseVersionId,proto3" json:"base_version_id,omitempty"`
+	PublishedVersionId string                   `protobuf:"bytes,9,opt,name=published_version_id,json=publishedVersionId,proto3" json:"published_version_id,omitempty"`
+	RequestedBy        *UserRef                 `protobuf:"bytes,10,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	ReviewedBy         *UserRef                 `protobuf:"bytes,11,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt         *timestamp.Timestamp     `protobuf:"bytes,12,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	CreatedAt          *timestamp.Timestamp     `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Diff               *CanvasVersionDiff       `protobuf:"bytes,14,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CanvasChangeRequest) Reset() {
+	*x = CanvasChangeRequest{}
+	mi := &file_canvases_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasChangeRequest) ProtoMessage() {}
+
+func (x *CanvasChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasChangeRequest.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CanvasChangeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest) GetApprovalRole() string {
+	if x != nil {
+		return x.ApprovalRole
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest) GetState() CanvasChangeRequestState {
+	if x != nil {
+		return x.State
+	}
+	return CanvasChangeRequestState_STATE_UNKNOWN
+}
+
+func (x *CanvasChangeRequest) GetBaseVersionId() string {
+	if x != nil {
+		return x.BaseVersionId
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest) GetPublishedVersionId() string {
+	if x != nil {
+		return x.PublishedVersionId
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest) GetRequestedBy() *UserRef {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequest) GetReviewedBy() *UserRef {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequest) GetReviewedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequest) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequest) GetDiff() *CanvasVersionDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type SimulateCanvasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Payload       *_struct.Struct        `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateCanvasRequest) Reset() {
+	*x = SimulateCanvasRequest{}
+	mi := &file_canvases_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateCanvasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateCanvasRequest) ProtoMessage() {}
+
+func (x *SimulateCanvasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateCanvasRequest.ProtoReflect.Descriptor instead.
+func (*SimulateCanvasRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SimulateCanvasRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *SimulateCanvasRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SimulateCanvasRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SimulateCanvasRequest) GetPayload() *_struct.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type SimulateCanvasResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Steps         []*CanvasSimulationStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	Truncated     bool                    `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateCanvasResponse) Reset() {
+	*x = SimulateCanvasResponse{}
+	mi := &file_canvases_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateCanvasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateCanvasResponse) ProtoMessage() {}
+
+func (x *SimulateCanvasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateCanvasResponse.ProtoReflect.Descriptor instead.
+func (*SimulateCanvasResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SimulateCanvasResponse) GetSteps() []*CanvasSimulationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *SimulateCanvasResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type CanvasSimulationStep struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	NodeId        string                         `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                         `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	SourceNodeId  string                         `protobuf:"bytes,3,opt,name=source_node_id,json=sourceNodeId,proto3" json:"source_node_id,omitempty"`
+	SourceChannel string                         `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	Depth         uint32                         `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Mode          CanvasSimulationStep_Mode      `protobuf:"varint,6,opt,name=mode,proto3,enum=Superplane.Canvases.CanvasSimulationStep_Mode" json:"mode,omitempty"`
+	Configuration *_struct.Struct                `protobuf:"bytes,7,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Metadata      *_struct.Struct                `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Outputs       []*CanvasSimulationStep_Output `protobuf:"bytes,9,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Error         string                         `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	Message       string                         `protobuf:"bytes,11,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasSimulationStep) Reset() {
+	*x = CanvasSimulationStep{}
+	mi := &file_canvases_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasSimulationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasSimulationStep) ProtoMessage() {}
+
+func (x *CanvasSimulationStep) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasSimulationStep.ProtoReflect.Descriptor instead.
+func (*CanvasSimulationStep) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CanvasSimulationStep) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasSimulationStep) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CanvasSimulationStep) GetSourceNodeId() string {
+	if x != nil {
+		return x.SourceNodeId
+	}
+	return ""
+}
+
+func (x *CanvasSimulationStep) GetSourceChannel() string {
+	if x != nil {
+		return x.SourceChannel
+	}
+	return ""
+}
+
+func (x *CanvasSimulationStep) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *CanvasSimulationStep) GetMode() CanvasSimulationStep_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return CanvasSimulationStep_MODE_UNKNOWN
+}
+
+func (x *CanvasSimulationStep) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *CanvasSimulationStep) GetMetadata() *_struct.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CanvasSimulationStep) GetOutputs() []*CanvasSimulationStep_Output {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *CanvasSimulationStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CanvasSimulationStep) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListCanvasVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasVariablesRequest) Reset() {
+	*x = ListCanvasVariablesRequest{}
+	mi := &file_canvases_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasVariablesRequest) ProtoMessage() {}
+
+func (x *ListCanvasVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasVariablesRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListCanvasVariablesRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type ListCanvasVariablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variables     []*CanvasVariable      `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasVariablesResponse) Reset() {
+	*x = ListCanvasVariablesResponse{}
+	mi := &file_canvases_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasVariablesResponse) ProtoMessage() {}
+
+func (x *ListCanvasVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasVariablesResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListCanvasVariablesResponse) GetVariables() []*CanvasVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type SetCanvasVariableRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value           *_struct.Value         `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExpectedVersion *uint32                `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetCanvasVariableRequest) Reset() {
+	*x = SetCanvasVariableRequest{}
+	mi := &file_canvases_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCanvasVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCanvasVariableRequest) ProtoMessage() {}
+
+func (x *SetCanvasVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCanvasVariableRequest.ProtoReflect.Descriptor instead.
+func (*SetCanvasVariableRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SetCanvasVariableRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *SetCanvasVariableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetCanvasVariableRequest) GetValue() *_struct.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SetCanvasVariableRequest) GetExpectedVersion() uint32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type SetCanvasVariableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variable      *CanvasVariable        `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCanvasVariableResponse) Reset() {
+	*x = SetCanvasVariableResponse{}
+	mi := &file_canvases_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCanvasVariableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCanvasVariableResponse) ProtoMessage() {}
+
+func (x *SetCanvasVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCanvasVariableResponse.ProtoReflect.Descriptor instead.
+func (*SetCanvasVariableResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetCanvasVariableResponse) GetVariable() *CanvasVariable {
+	if x != nil {
+		return x.Variable
+	}
+	return nil
+}
+
+type DeleteCanvasVariableRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedVersion *uint32                `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasVariableRequest) Reset() {
+	*x = DeleteCanvasVariableRequest{}
+	mi := &file_canvases_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasVariableRequest) ProtoMessage() {}
+
+func (x *DeleteCanvasVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasVariableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasVariableRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteCanvasVariableRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DeleteCanvasVariableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteCanvasVariableRequest) GetExpectedVersion() uint32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteCanvasVariableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCanvasVariableResponse) Reset() {
+	*x = DeleteCanvasVariableResponse{}
+	mi := &file_canvases_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCanvasVariableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCanvasVariableResponse) ProtoMessage() {}
+
+func (x *DeleteCanvasVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCanvasVariableResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCanvasVariableResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{80}
+}
+
+type CanvasVariable struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                *_struct.Value         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Version              uint32                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedBy            *UserRef               `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedByExecutionId string                 `protobuf:"bytes,5,opt,name=updated_by_execution_id,json=updatedByExecutionId,proto3" json:"updated_by_execution_id,omitempty"`
+	CreatedAt            *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CanvasVariable) Reset() {
+	*x = CanvasVariable{}
+	mi := &file_canvases_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasVariable) ProtoMessage() {}
+
+func (x *CanvasVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasVariable.ProtoReflect.Descriptor instead.
+func (*CanvasVariable) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CanvasVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasVariable) GetValue() *_struct.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CanvasVariable) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CanvasVariable) GetUpdatedBy() *UserRef {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *CanvasVariable) GetUpdatedByExecutionId() string {
+	if x != nil {
+		return x.UpdatedByExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasVariable) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasVariable) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListExecutionArtifactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExecutionArtifactsRequest) Reset() {
+	*x = ListExecutionArtifactsRequest{}
+	mi := &file_canvases_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExecutionArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionArtifactsRequest) ProtoMessage() {}
+
+func (x *ListExecutionArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListExecutionArtifactsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListExecutionArtifactsRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type ListExecutionArtifactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifacts     []*ExecutionArtifact   `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExecutionArtifactsResponse) Reset() {
+	*x = ListExecutionArtifactsResponse{}
+	mi := &file_canvases_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExecutionArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionArtifactsResponse) ProtoMessage() {}
+
+func (x *ListExecutionArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListExecutionArtifactsResponse) GetArtifacts() []*ExecutionArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+type DownloadExecutionArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	ArtifactId    string                 `protobuf:"bytes,3,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadExecutionArtifactRequest) Reset() {
+	*x = DownloadExecutionArtifactRequest{}
+	mi := &file_canvases_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadExecutionArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadExecutionArtifactRequest) ProtoMessage() {}
+
+func (x *DownloadExecutionArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadExecutionArtifactRequest.ProtoReflect.Descriptor instead.
+func (*DownloadExecutionArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DownloadExecutionArtifactRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DownloadExecutionArtifactRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *DownloadExecutionArtifactRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+type ExecutionArtifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256        string                 `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionArtifact) Reset() {
+	*x = ExecutionArtifact{}
+	mi := &file_canvases_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionArtifact) ProtoMessage() {}
+
+func (x *ExecutionArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionArtifact.ProtoReflect.Descriptor instead.
+func (*ExecutionArtifact) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ExecutionArtifact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExecutionArtifact) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *ExecutionArtifact) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ExecutionArtifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecutionArtifact) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExecutionArtifact) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ExecutionArtifact) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ExecutionArtifact) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetExecutionLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	After         int64                  `protobuf:"varint,3,opt,name=after,proto3" json:"after,omitempty"`
+	Limit         uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionLogsRequest) Reset() {
+	*x = GetExecutionLogsRequest{}
+	mi := &file_canvases_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionLogsRequest) ProtoMessage() {}
+
+func (x *GetExecutionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetExecutionLogsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *GetExecutionLogsRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *GetExecutionLogsRequest) GetAfter() int64 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+func (x *GetExecutionLogsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetExecutionLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*ExecutionLogLine    `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionLogsResponse) Reset() {
+	*x = GetExecutionLogsResponse{}
+	mi := &file_canvases_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionLogsResponse) ProtoMessage() {}
+
+func (x *GetExecutionLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetExecutionLogsResponse) GetLines() []*ExecutionLogLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GetExecutionLogsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type ExecutionLogLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stream        string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionLogLine) Reset() {
+	*x = ExecutionLogLine{}
+	mi := &file_canvases_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionLogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionLogLine) ProtoMessage() {}
+
+func (x *ExecutionLogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionLogLine.ProtoReflect.Descriptor instead.
+func (*ExecutionLogLine) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ExecutionLogLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExecutionLogLine) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *ExecutionLogLine) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *ExecutionLogLine) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExecutionLogLine) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DescribeCanvasRetentionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeCanvasRetentionRequest) Reset() {
+	*x = DescribeCanvasRetentionRequest{}
+	mi := &file_canvases_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeCanvasRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCanvasRetentionRequest) ProtoMessage() {}
+
+func (x *DescribeCanvasRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCanvasRetentionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DescribeCanvasRetentionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+type DescribeCanvasRetentionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Policy          *CanvasRetentionPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	EffectivePolicy *CanvasRetentionPolicy `protobuf:"bytes,2,opt,name=effective_policy,json=effectivePolicy,proto3" json:"effective_policy,omitempty"`
+	Stats           *CanvasRetentionStats  `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DescribeCanvasRetentionResponse) Reset() {
+	*x = DescribeCanvasRetentionResponse{}
+	mi := &file_canvases_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeCanvasRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCanvasRetentionResponse) ProtoMessage() {}
+
+func (x *DescribeCanvasRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCanvasRetentionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeCanvasRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DescribeCanvasRetentionResponse) GetPolicy() *CanvasRetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *DescribeCanvasRetentionResponse) GetEffectivePolicy() *CanvasRetentionPolicy {
+	if x != nil {
+		return x.EffectivePolicy
+	}
+	return nil
+}
+
+func (x *DescribeCanvasRetentionResponse) GetStats() *CanvasRetentionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type UpdateCanvasRetentionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Policy        *CanvasRetentionPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasRetentionPolicyRequest) Reset() {
+	*x = UpdateCanvasRetentionPolicyRequest{}
+	mi := &file_canvases_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateCanvasRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UpdateCanvasRetentionPolicyRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *UpdateCanvasRetentionPolicyRequest) GetPolicy() *CanvasRetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type UpdateCanvasRetentionPolicyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Policy          *CanvasRetentionPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	EffectivePolicy *CanvasRetentionPolicy `protobuf:"bytes,2,opt,name=effective_policy,json=effectivePolicy,proto3" json:"effective_policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasRetentionPolicyResponse) Reset() {
+	*x = UpdateCanvasRetentionPolicyResponse{}
+	mi := &file_canvases_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCanvasRetentionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCanvasRetentionPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateCanvasRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCanvasRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UpdateCanvasRetentionPolicyResponse) GetPolicy() *CanvasRetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *UpdateCanvasRetentionPolicyResponse) GetEffectivePolicy() *CanvasRetentionPolicy {
+	if x != nil {
+		return x.EffectivePolicy
+	}
+	return nil
+}
+
+// Runs older than max_age_days, or beyond the newest max_runs, are deleted.
+// Zero means no limit. If archive is set, runs are archived before being deleted.
+// The effective policy is inherited when it comes from the organization.
+type CanvasRetentionPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxAgeDays    uint32                 `protobuf:"varint,1,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	MaxRuns       uint32                 `protobuf:"varint,2,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	Archive       bool                   `protobuf:"varint,3,opt,name=archive,proto3" json:"archive,omitempty"`
+	Inherited     bool                   `protobuf:"varint,4,opt,name=inherited,proto3" json:"inherited,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasRetentionPolicy) Reset() {
+	*x = CanvasRetentionPolicy{}
+	mi := &file_canvases_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasRetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasRetentionPolicy) ProtoMessage() {}
+
+func (x *CanvasRetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasRetentionPolicy.ProtoReflect.Descriptor instead.
+func (*CanvasRetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CanvasRetentionPolicy) GetMaxAgeDays() uint32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *CanvasRetentionPolicy) GetMaxRuns() uint32 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+func (x *CanvasRetentionPolicy) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+func (x *CanvasRetentionPolicy) GetInherited() bool {
+	if x != nil {
+		return x.Inherited
+	}
+	return false
+}
+
+type CanvasRetentionStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Runs               int64                  `protobuf:"varint,1,opt,name=runs,proto3" json:"runs,omitempty"`
+	Executions         int64                  `protobuf:"varint,2,opt,name=executions,proto3" json:"executions,omitempty"`
+	OldestRunAt        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=oldest_run_at,json=oldestRunAt,proto3" json:"oldest_run_at,omitempty"`
+	LastEnforcedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_enforced_at,json=lastEnforcedAt,proto3" json:"last_enforced_at,omitempty"`
+	DeletedRuns        int64                  `protobuf:"varint,5,opt,name=deleted_runs,json=deletedRuns,proto3" json:"deleted_runs,omitempty"`
+	DeletedExecutions  int64                  `protobuf:"varint,6,opt,name=deleted_executions,json=deletedExecutions,proto3" json:"deleted_executions,omitempty"`
+	ArchivedExecutions int64                  `protobuf:"varint,7,opt,name=archived_executions,json=archivedExecutions,proto3" json:"archived_executions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CanvasRetentionStats) Reset() {
+	*x = CanvasRetentionStats{}
+	mi := &file_canvases_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasRetentionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasRetentionStats) ProtoMessage() {}
+
+func (x *CanvasRetentionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasRetentionStats.ProtoReflect.Descriptor instead.
+func (*CanvasRetentionStats) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CanvasRetentionStats) GetRuns() int64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *CanvasRetentionStats) GetExecutions() int64 {
+	if x != nil {
+		return x.Executions
+	}
+	return 0
+}
+
+func (x *CanvasRetentionStats) GetOldestRunAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.OldestRunAt
+	}
+	return nil
+}
+
+func (x *CanvasRetentionStats) GetLastEnforcedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastEnforcedAt
+	}
+	return nil
+}
+
+func (x *CanvasRetentionStats) GetDeletedRuns() int64 {
+	if x != nil {
+		return x.DeletedRuns
+	}
+	return 0
+}
+
+func (x *CanvasRetentionStats) GetDeletedExecutions() int64 {
+	if x != nil {
+		return x.DeletedExecutions
+	}
+	return 0
+}
+
+func (x *CanvasRetentionStats) GetArchivedExecutions() int64 {
+	if x != nil {
+		return x.ArchivedExecutions
+	}
+	return 0
+}
+
+type CanvasNodeEventMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeEventMessage) Reset() {
+	*x = CanvasNodeEventMessage{}
+	mi := &file_canvases_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeEventMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeEventMessage) ProtoMessage() {}
+
+func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CanvasNodeEventMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeEventMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeEventMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeEventMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeExecutionMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionMessage) Reset() {
+	*x = CanvasNodeExecutionMessage{}
+	mi := &file_canvases_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionMessage) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CanvasNodeExecutionMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeExecutionLogMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionId   string                 `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Lines         []*ExecutionLogLine    `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionLogMessage) Reset() {
+	*x = CanvasNodeExecutionLogMessage{}
+	mi := &file_canvases_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionLogMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionLogMessage) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionLogMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionLogMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionLogMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetLines() []*ExecutionLogLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeQueueItemMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeQueueItemMessage) Reset() {
+	*x = CanvasNodeQueueItemMessage{}
+	mi := &file_canvases_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeQueueItemMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
 
 func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[44]
+	mi := &file_canvases_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,7 +6326,7 @@ func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{44}
+	return file_canvases_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *CanvasNodeQueueItemMessage) GetId() string {
@@ -2855,7 +6373,7 @@ type Canvas_Metadata struct {
 
 func (x *Canvas_Metadata) Reset() {
 	*x = Canvas_Metadata{}
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2867,7 +6385,7 @@ func (x *Canvas_Metadata) String() string {
 func (*Canvas_Metadata) ProtoMessage() {}
 
 func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2949,7 +6467,7 @@ type Canvas_Spec struct {
 
 func (x *Canvas_Spec) Reset() {
 	*x = Canvas_Spec{}
-	mi := &file_canvases_proto_msgTypes[46]
+	mi := &file_canvases_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2961,7 +6479,7 @@ func (x *Canvas_Spec) String() string {
 func (*Canvas_Spec) ProtoMessage() {}
 
 func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[46]
+	mi := &file_canvases_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,7 +6520,7 @@ type Canvas_Status struct {
 
 func (x *Canvas_Status) Reset() {
 	*x = Canvas_Status{}
-	mi := &file_canvases_proto_msgTypes[47]
+	mi := &file_canvases_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3014,7 +6532,7 @@ func (x *Canvas_Status) String() string {
 func (*Canvas_Status) ProtoMessage() {}
 
 func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[47]
+	mi := &file_canvases_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,11 +6569,251 @@ func (x *Canvas_Status) GetLastEvents() []*CanvasEvent {
 	return nil
 }
 
+type CanvasVersionDiff_NodeChange struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	NodeId        string                       `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                       `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Type          CanvasVersionDiff_ChangeType `protobuf:"varint,3,opt,name=type,proto3,enum=Superplane.Canvases.CanvasVersionDiff_ChangeType" json:"type,omitempty"`
+	ChangedFields []string                     `protobuf:"bytes,4,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Before        *components.Node             `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After         *components.Node             `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasVersionDiff_NodeChange) Reset() {
+	*x = CanvasVersionDiff_NodeChange{}
+	mi := &file_canvases_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasVersionDiff_NodeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasVersionDiff_NodeChange) ProtoMessage() {}
+
+func (x *CanvasVersionDiff_NodeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasVersionDiff_NodeChange.ProtoReflect.Descriptor instead.
+func (*CanvasVersionDiff_NodeChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{55, 0}
+}
+
+func (x *CanvasVersionDiff_NodeChange) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasVersionDiff_NodeChange) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *CanvasVersionDiff_NodeChange) GetType() CanvasVersionDiff_ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return CanvasVersionDiff_CHANGE_TYPE_UNKNOWN
+}
+
+func (x *CanvasVersionDiff_NodeChange) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *CanvasVersionDiff_NodeChange) GetBefore() *components.Node {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CanvasVersionDiff_NodeChange) GetAfter() *components.Node {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type CanvasVersionDiff_EdgeChange struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Type          CanvasVersionDiff_ChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Canvases.CanvasVersionDiff_ChangeType" json:"type,omitempty"`
+	Edge          *components.Edge             `protobuf:"bytes,2,opt,name=edge,proto3" json:"edge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasVersionDiff_EdgeChange) Reset() {
+	*x = CanvasVersionDiff_EdgeChange{}
+	mi := &file_canvases_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasVersionDiff_EdgeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasVersionDiff_EdgeChange) ProtoMessage() {}
+
+func (x *CanvasVersionDiff_EdgeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasVersionDiff_EdgeChange.ProtoReflect.Descriptor instead.
+func (*CanvasVersionDiff_EdgeChange) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{55, 1}
+}
+
+func (x *CanvasVersionDiff_EdgeChange) GetType() CanvasVersionDiff_ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return CanvasVersionDiff_CHANGE_TYPE_UNKNOWN
+}
+
+func (x *CanvasVersionDiff_EdgeChange) GetEdge() *components.Edge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+type CanvasDraft_ValidationError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasDraft_ValidationError) Reset() {
+	*x = CanvasDraft_ValidationError{}
+	mi := &file_canvases_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasDraft_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasDraft_ValidationError) ProtoMessage() {}
+
+func (x *CanvasDraft_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasDraft_ValidationError.ProtoReflect.Descriptor instead.
+func (*CanvasDraft_ValidationError) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *CanvasDraft_ValidationError) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasDraft_ValidationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CanvasSimulationStep_Output struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasSimulationStep_Output) Reset() {
+	*x = CanvasSimulationStep_Output{}
+	mi := &file_canvases_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasSimulationStep_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasSimulationStep_Output) ProtoMessage() {}
+
+func (x *CanvasSimulationStep_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasSimulationStep_Output.ProtoReflect.Descriptor instead.
+func (*CanvasSimulationStep_Output) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{74, 0}
+}
+
+func (x *CanvasSimulationStep_Output) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CanvasSimulationStep_Output) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_canvases_proto protoreflect.FileDescriptor
 
 const file_canvases_proto_rawDesc = "" +
 	"\n" +
-	"\x0ecanvases.proto\x12\x13Superplane.Canvases\x1a\x10components.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"B\n" +
+	"\x0ecanvases.proto\x12\x13Superplane.Canvases\x1a\x10components.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"B\n" +
 	"\x13ListCanvasesRequest\x12+\n" +
 	"\x11include_templates\x18\x01 \x01(\bR\x10includeTemplates\"O\n" +
 	"\x14ListCanvasesResponse\x127\n" +
@@ -3067,10 +6825,11 @@ const file_canvases_proto_rawDesc = "" +
 	"\x13CreateCanvasRequest\x123\n" +
 	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"K\n" +
 	"\x14CreateCanvasResponse\x123\n" +
-	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"Z\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"\x83\x01\n" +
 	"\x13UpdateCanvasRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
-	"\x06canvas\x18\x02 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"K\n" +
+	"\x06canvas\x18\x02 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x12'\n" +
+	"\x0fversion_message\x18\x03 \x01(\tR\x0eversionMessage\"K\n" +
 	"\x14UpdateCanvasResponse\x123\n" +
 	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"%\n" +
 	"\x13DeleteCanvasRequest\x12\x0e\n" +
@@ -3137,7 +6896,14 @@ const file_canvases_proto_rawDesc = "" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x17\n" +
 	"\aitem_id\x18\x03 \x01(\tR\x06itemId\"\x1d\n" +
-	"\x1bDeleteNodeQueueItemResponse\"f\n" +
+	"\x1bDeleteNodeQueueItemResponse\"\x87\x01\n" +
+	"\x1aUpdateNodeQueueItemRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\"[\n" +
+	"\x1bUpdateNodeQueueItemResponse\x12<\n" +
+	"\x04item\x18\x01 \x01(\v2(.Superplane.Canvases.CanvasNodeQueueItemR\x04item\"f\n" +
 	"\x16UpdateNodePauseRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x16\n" +
@@ -3165,8 +6931,7 @@ const file_canvases_proto_rawDesc = "" +
 	"\x1bListChildExecutionsResponse\x12H\n" +
 	"\n" +
 	"executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\n" +
-	"executions\"\x85\n" +
-	"\n" +
+	"executions\"\x8d\f\n" +
 	"\x13CanvasNodeExecution\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -3189,7 +6954,13 @@ const file_canvases_proto_rawDesc = "" +
 	"\x10child_executions\x18\x10 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\x0fchildExecutions\x12?\n" +
 	"\n" +
 	"root_event\x18\x11 \x01(\v2 .Superplane.Canvases.CanvasEventR\trootEvent\x12?\n" +
-	"\fcancelled_by\x18\x12 \x01(\v2\x1c.Superplane.Canvases.UserRefR\vcancelledBy\"T\n" +
+	"\fcancelled_by\x18\x12 \x01(\v2\x1c.Superplane.Canvases.UserRefR\vcancelledBy\x12\x18\n" +
+	"\aattempt\x18\x13 \x01(\x05R\aattempt\x121\n" +
+	"\x15retry_of_execution_id\x18\x14 \x01(\tR\x12retryOfExecutionId\x12;\n" +
+	"\vdeadline_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deadlineAt\x12*\n" +
+	"\x11canvas_version_id\x18\x16 \x01(\tR\x0fcanvasVersionId\x12\x19\n" +
+	"\btrace_id\x18\x17 \x01(\tR\atraceId\"T\n" +
 	"\x05State\x12\x11\n" +
 	"\rSTATE_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rSTATE_PENDING\x10\x01\x12\x11\n" +
@@ -3199,11 +6970,13 @@ const file_canvases_proto_rawDesc = "" +
 	"\x0eRESULT_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rRESULT_PASSED\x10\x01\x12\x11\n" +
 	"\rRESULT_FAILED\x10\x02\x12\x14\n" +
-	"\x10RESULT_CANCELLED\x10\x03\"_\n" +
+	"\x10RESULT_CANCELLED\x10\x03\"\x95\x01\n" +
 	"\fResultReason\x12\x14\n" +
 	"\x10RESULT_REASON_OK\x10\x00\x12\x17\n" +
 	"\x13RESULT_REASON_ERROR\x10\x01\x12 \n" +
-	"\x1cRESULT_REASON_ERROR_RESOLVED\x10\x02\"\x86\x02\n" +
+	"\x1cRESULT_REASON_ERROR_RESOLVED\x10\x02\x12\x19\n" +
+	"\x15RESULT_REASON_TIMEOUT\x10\x03\x12\x19\n" +
+	"\x15RESULT_REASON_SKIPPED\x10\x04\"\xa2\x02\n" +
 	"\x13CanvasNodeQueueItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -3212,7 +6985,8 @@ const file_canvases_proto_rawDesc = "" +
 	"\n" +
 	"root_event\x18\x05 \x01(\v2 .Superplane.Canvases.CanvasEventR\trootEvent\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbc\x01\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\"\xbc\x01\n" +
 	" InvokeNodeExecutionActionRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x1f\n" +
@@ -3275,11 +7049,276 @@ const file_canvases_proto_rawDesc = "" +
 	"\x16CancelExecutionRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"\x19\n" +
-	"\x17CancelExecutionResponse\"a\n" +
+	"\x17CancelExecutionResponse\"W\n" +
+	"\x15RerunExecutionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"a\n" +
+	"\x16RerunExecutionResponse\x12G\n" +
+	"\n" +
+	"queue_item\x18\x01 \x01(\v2(.Superplane.Canvases.CanvasNodeQueueItemR\tqueueItem\"a\n" +
 	"\x1dResolveExecutionErrorsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12#\n" +
 	"\rexecution_ids\x18\x02 \x03(\tR\fexecutionIds\" \n" +
-	"\x1eResolveExecutionErrorsResponse\"\x98\x01\n" +
+	"\x1eResolveExecutionErrorsResponse\"f\n" +
+	"\x19ListCanvasVersionsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06before\x18\x03 \x01(\rR\x06before\"\xa1\x01\n" +
+	"\x1aListCanvasVersionsResponse\x12>\n" +
+	"\bversions\x18\x01 \x03(\v2\".Superplane.Canvases.CanvasVersionR\bversions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12\"\n" +
+	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\"Z\n" +
+	"\x1cDescribeCanvasVersionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"]\n" +
+	"\x1dDescribeCanvasVersionResponse\x12<\n" +
+	"\aversion\x18\x01 \x01(\v2\".Superplane.Canvases.CanvasVersionR\aversion\"\x84\x01\n" +
+	"\x19DiffCanvasVersionsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\"\n" +
+	"\rto_version_id\x18\x02 \x01(\tR\vtoVersionId\x12&\n" +
+	"\x0ffrom_version_id\x18\x03 \x01(\tR\rfromVersionId\"\xc4\x01\n" +
+	"\x1aDiffCanvasVersionsResponse\x126\n" +
+	"\x04from\x18\x01 \x01(\v2\".Superplane.Canvases.CanvasVersionR\x04from\x122\n" +
+	"\x02to\x18\x02 \x01(\v2\".Superplane.Canvases.CanvasVersionR\x02to\x12:\n" +
+	"\x04diff\x18\x03 \x01(\v2&.Superplane.Canvases.CanvasVersionDiffR\x04diff\"Y\n" +
+	"\x1bRestoreCanvasVersionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"\x91\x01\n" +
+	"\x1cRestoreCanvasVersionResponse\x123\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x12<\n" +
+	"\aversion\x18\x02 \x01(\v2\".Superplane.Canvases.CanvasVersionR\aversion\"\xd4\x02\n" +
+	"\rCanvasVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\rR\aversion\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12;\n" +
+	"\n" +
+	"created_by\x18\a \x01(\v2\x1c.Superplane.Canvases.UserRefR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x124\n" +
+	"\x04spec\x18\t \x01(\v2 .Superplane.Canvases.Canvas.SpecR\x04spec\"\xdf\x05\n" +
+	"\x11CanvasVersionDiff\x12%\n" +
+	"\x0echanged_fields\x18\x01 \x03(\tR\rchangedFields\x12G\n" +
+	"\x05nodes\x18\x02 \x03(\v21.Superplane.Canvases.CanvasVersionDiff.NodeChangeR\x05nodes\x12G\n" +
+	"\x05edges\x18\x03 \x03(\v21.Superplane.Canvases.CanvasVersionDiff.EdgeChangeR\x05edges\x1a\x98\x02\n" +
+	"\n" +
+	"NodeChange\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12E\n" +
+	"\x04type\x18\x03 \x01(\x0e21.Superplane.Canvases.CanvasVersionDiff.ChangeTypeR\x04type\x12%\n" +
+	"\x0echanged_fields\x18\x04 \x03(\tR\rchangedFields\x123\n" +
+	"\x06before\x18\x05 \x01(\v2\x1b.Superplane.Components.NodeR\x06before\x121\n" +
+	"\x05after\x18\x06 \x01(\v2\x1b.Superplane.Components.NodeR\x05after\x1a\x84\x01\n" +
+	"\n" +
+	"EdgeChange\x12E\n" +
+	"\x04type\x18\x01 \x01(\x0e21.Superplane.Canvases.CanvasVersionDiff.ChangeTypeR\x04type\x12/\n" +
+	"\x04edge\x18\x02 \x01(\v2\x1b.Superplane.Components.EdgeR\x04edge\"o\n" +
+	"\n" +
+	"ChangeType\x12\x17\n" +
+	"\x13CHANGE_TYPE_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11CHANGE_TYPE_ADDED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_TYPE_REMOVED\x10\x02\x12\x18\n" +
+	"\x14CHANGE_TYPE_MODIFIED\x10\x03\"9\n" +
+	"\x1aDescribeCanvasDraftRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"U\n" +
+	"\x1bDescribeCanvasDraftResponse\x126\n" +
+	"\x05draft\x18\x01 \x01(\v2 .Superplane.Canvases.CanvasDraftR\x05draft\"l\n" +
+	"\x18UpdateCanvasDraftRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x123\n" +
+	"\x06canvas\x18\x02 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"S\n" +
+	"\x19UpdateCanvasDraftResponse\x126\n" +
+	"\x05draft\x18\x01 \x01(\v2 .Superplane.Canvases.CanvasDraftR\x05draft\"8\n" +
+	"\x19DiscardCanvasDraftRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"\x1c\n" +
+	"\x1aDiscardCanvasDraftResponse\"w\n" +
+	"\x19PublishCanvasDraftRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\rapproval_role\x18\x03 \x01(\tR\fapprovalRole\"\xe0\x01\n" +
+	"\x1aPublishCanvasDraftResponse\x123\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x12<\n" +
+	"\aversion\x18\x02 \x01(\v2\".Superplane.Canvases.CanvasVersionR\aversion\x12O\n" +
+	"\x0echange_request\x18\x03 \x01(\v2(.Superplane.Canvases.CanvasChangeRequestR\rchangeRequest\"\x85\x01\n" +
+	"\x1fListCanvasChangeRequestsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12E\n" +
+	"\x06states\x18\x02 \x03(\x0e2-.Superplane.Canvases.CanvasChangeRequestStateR\x06states\"u\n" +
+	" ListCanvasChangeRequestsResponse\x12Q\n" +
+	"\x0fchange_requests\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasChangeRequestR\x0echangeRequests\"l\n" +
+	"!ApproveCanvasChangeRequestRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12*\n" +
+	"\x11change_request_id\x18\x02 \x01(\tR\x0fchangeRequestId\"\xe8\x01\n" +
+	"\"ApproveCanvasChangeRequestResponse\x123\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x12<\n" +
+	"\aversion\x18\x02 \x01(\v2\".Superplane.Canvases.CanvasVersionR\aversion\x12O\n" +
+	"\x0echange_request\x18\x03 \x01(\v2(.Superplane.Canvases.CanvasChangeRequestR\rchangeRequest\"k\n" +
+	" RejectCanvasChangeRequestRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12*\n" +
+	"\x11change_request_id\x18\x02 \x01(\tR\x0fchangeRequestId\"t\n" +
+	"!RejectCanvasChangeRequestResponse\x12O\n" +
+	"\x0echange_request\x18\x01 \x01(\v2(.Superplane.Canvases.CanvasChangeRequestR\rchangeRequest\"\xd2\x04\n" +
+	"\vCanvasDraft\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x124\n" +
+	"\x04spec\x18\x04 \x01(\v2 .Superplane.Canvases.Canvas.SpecR\x04spec\x12&\n" +
+	"\x0fbase_version_id\x18\x05 \x01(\tR\rbaseVersionId\x12;\n" +
+	"\n" +
+	"updated_by\x18\x06 \x01(\v2\x1c.Superplane.Canvases.UserRefR\tupdatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12]\n" +
+	"\x11validation_errors\x18\t \x03(\v20.Superplane.Canvases.CanvasDraft.ValidationErrorR\x10validationErrors\x12:\n" +
+	"\x04diff\x18\n" +
+	" \x01(\v2&.Superplane.Canvases.CanvasVersionDiffR\x04diff\x1aD\n" +
+	"\x0fValidationError\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8a\x05\n" +
+	"\x13CanvasChangeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12#\n" +
+	"\rapproval_role\x18\x06 \x01(\tR\fapprovalRole\x12C\n" +
+	"\x05state\x18\a \x01(\x0e2-.Superplane.Canvases.CanvasChangeRequestStateR\x05state\x12&\n" +
+	"\x0fbase_version_id\x18\b \x01(\tR\rbaseVersionId\x120\n" +
+	"\x14published_version_id\x18\t \x01(\tR\x12publishedVersionId\x12?\n" +
+	"\frequested_by\x18\n" +
+	" \x01(\v2\x1c.Superplane.Canvases.UserRefR\vrequestedBy\x12=\n" +
+	"\vreviewed_by\x18\v \x01(\v2\x1c.Superplane.Canvases.UserRefR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12:\n" +
+	"\x04diff\x18\x0e \x01(\v2&.Superplane.Canvases.CanvasVersionDiffR\x04diff\"\x9a\x01\n" +
+	"\x15SimulateCanvasRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x121\n" +
+	"\apayload\x18\x04 \x01(\v2\x17.google.protobuf.StructR\apayload\"w\n" +
+	"\x16SimulateCanvasResponse\x12?\n" +
+	"\x05steps\x18\x01 \x03(\v2).Superplane.Canvases.CanvasSimulationStepR\x05steps\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\"\x8c\x05\n" +
+	"\x14CanvasSimulationStep\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12$\n" +
+	"\x0esource_node_id\x18\x03 \x01(\tR\fsourceNodeId\x12%\n" +
+	"\x0esource_channel\x18\x04 \x01(\tR\rsourceChannel\x12\x14\n" +
+	"\x05depth\x18\x05 \x01(\rR\x05depth\x12B\n" +
+	"\x04mode\x18\x06 \x01(\x0e2..Superplane.Canvases.CanvasSimulationStep.ModeR\x04mode\x12=\n" +
+	"\rconfiguration\x18\a \x01(\v2\x17.google.protobuf.StructR\rconfiguration\x123\n" +
+	"\bmetadata\x18\b \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12J\n" +
+	"\aoutputs\x18\t \x03(\v20.Superplane.Canvases.CanvasSimulationStep.OutputR\aoutputs\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x18\n" +
+	"\amessage\x18\v \x01(\tR\amessage\x1aO\n" +
+	"\x06Output\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data\"V\n" +
+	"\x04Mode\x12\x10\n" +
+	"\fMODE_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rMODE_EXECUTED\x10\x01\x12\x17\n" +
+	"\x13MODE_EXAMPLE_OUTPUT\x10\x02\x12\x10\n" +
+	"\fMODE_SKIPPED\x10\x03\"9\n" +
+	"\x1aListCanvasVariablesRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"`\n" +
+	"\x1bListCanvasVariablesResponse\x12A\n" +
+	"\tvariables\x18\x01 \x03(\v2#.Superplane.Canvases.CanvasVariableR\tvariables\"\xbe\x01\n" +
+	"\x18SetCanvasVariableRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\x05value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05value\x12.\n" +
+	"\x10expected_version\x18\x04 \x01(\rH\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"\\\n" +
+	"\x19SetCanvasVariableResponse\x12?\n" +
+	"\bvariable\x18\x01 \x01(\v2#.Superplane.Canvases.CanvasVariableR\bvariable\"\x93\x01\n" +
+	"\x1bDeleteCanvasVariableRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\rH\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"\x1e\n" +
+	"\x1cDeleteCanvasVariableResponse\"\xd6\x02\n" +
+	"\x0eCanvasVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\rR\aversion\x12;\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\v2\x1c.Superplane.Canvases.UserRefR\tupdatedBy\x125\n" +
+	"\x17updated_by_execution_id\x18\x05 \x01(\tR\x14updatedByExecutionId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"_\n" +
+	"\x1dListExecutionArtifactsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"f\n" +
+	"\x1eListExecutionArtifactsResponse\x12D\n" +
+	"\tartifacts\x18\x01 \x03(\v2&.Superplane.Canvases.ExecutionArtifactR\tartifacts\"\x83\x01\n" +
+	" DownloadExecutionArtifactRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x1f\n" +
+	"\vartifact_id\x18\x03 \x01(\tR\n" +
+	"artifactId\"\xfd\x01\n" +
+	"\x11ExecutionArtifact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\a \x01(\tR\x06sha256\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x85\x01\n" +
+	"\x17GetExecutionLogsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\x03R\x05after\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\"r\n" +
+	"\x18GetExecutionLogsResponse\x12;\n" +
+	"\x05lines\x18\x01 \x03(\v2%.Superplane.Canvases.ExecutionLogLineR\x05lines\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xa5\x01\n" +
+	"\x10ExecutionLogLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06stream\x18\x02 \x01(\tR\x06stream\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"=\n" +
+	"\x1eDescribeCanvasRetentionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"\xfd\x01\n" +
+	"\x1fDescribeCanvasRetentionResponse\x12B\n" +
+	"\x06policy\x18\x01 \x01(\v2*.Superplane.Canvases.CanvasRetentionPolicyR\x06policy\x12U\n" +
+	"\x10effective_policy\x18\x02 \x01(\v2*.Superplane.Canvases.CanvasRetentionPolicyR\x0feffectivePolicy\x12?\n" +
+	"\x05stats\x18\x03 \x01(\v2).Superplane.Canvases.CanvasRetentionStatsR\x05stats\"\x85\x01\n" +
+	"\"UpdateCanvasRetentionPolicyRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12B\n" +
+	"\x06policy\x18\x02 \x01(\v2*.Superplane.Canvases.CanvasRetentionPolicyR\x06policy\"\xc0\x01\n" +
+	"#UpdateCanvasRetentionPolicyResponse\x12B\n" +
+	"\x06policy\x18\x01 \x01(\v2*.Superplane.Canvases.CanvasRetentionPolicyR\x06policy\x12U\n" +
+	"\x10effective_policy\x18\x02 \x01(\v2*.Superplane.Canvases.CanvasRetentionPolicyR\x0feffectivePolicy\"\x8c\x01\n" +
+	"\x15CanvasRetentionPolicy\x12 \n" +
+	"\fmax_age_days\x18\x01 \x01(\rR\n" +
+	"maxAgeDays\x12\x19\n" +
+	"\bmax_runs\x18\x02 \x01(\rR\amaxRuns\x12\x18\n" +
+	"\aarchive\x18\x03 \x01(\bR\aarchive\x12\x1c\n" +
+	"\tinherited\x18\x04 \x01(\bR\tinherited\"\xd3\x02\n" +
+	"\x14CanvasRetentionStats\x12\x12\n" +
+	"\x04runs\x18\x01 \x01(\x03R\x04runs\x12\x1e\n" +
+	"\n" +
+	"executions\x18\x02 \x01(\x03R\n" +
+	"executions\x12>\n" +
+	"\roldest_run_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\voldestRunAt\x12D\n" +
+	"\x10last_enforced_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastEnforcedAt\x12!\n" +
+	"\fdeleted_runs\x18\x05 \x01(\x03R\vdeletedRuns\x12-\n" +
+	"\x12deleted_executions\x18\x06 \x01(\x03R\x11deletedExecutions\x12/\n" +
+	"\x13archived_executions\x18\a \x01(\x03R\x12archivedExecutions\"\x98\x01\n" +
 	"\x16CanvasNodeEventMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -3289,12 +7328,23 @@ const file_canvases_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9c\x01\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xef\x01\n" +
+	"\x1dCanvasNodeExecutionLogMessage\x12!\n" +
+	"\fexecution_id\x18\x01 \x01(\tR\vexecutionId\x12\x1b\n" +
+	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12;\n" +
+	"\x05lines\x18\x04 \x03(\v2%.Superplane.Canvases.ExecutionLogLineR\x05lines\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9c\x01\n" +
 	"\x1aCanvasNodeQueueItemMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xb7$\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp*i\n" +
+	"\x18CanvasChangeRequestState\x12\x11\n" +
+	"\rSTATE_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rSTATE_PENDING\x10\x01\x12\x13\n" +
+	"\x0fSTATE_PUBLISHED\x10\x02\x12\x12\n" +
+	"\x0eSTATE_REJECTED\x10\x032\x8d[\n" +
 	"\bCanvases\x12\xb7\x01\n" +
 	"\fListCanvases\x12(.Superplane.Canvases.ListCanvasesRequest\x1a).Superplane.Canvases.ListCanvasesResponse\"R\x92A7\n" +
 	"\x06Canvas\x12\rList canvases\x1a\x1eReturns a list of all canvases\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/canvases\x12\xb0\x01\n" +
@@ -3311,7 +7361,10 @@ const file_canvases_proto_rawDesc = "" +
 	"CanvasNode\x12\x1cList items in a node's queue\x1a)Returns a list of items in a node's queue\x82\xd3\xe4\x93\x024\x122/api/v1/canvases/{canvas_id}/nodes/{node_id}/queue\x12\x9a\x02\n" +
 	"\x13DeleteNodeQueueItem\x12/.Superplane.Canvases.DeleteNodeQueueItemRequest\x1a0.Superplane.Canvases.DeleteNodeQueueItemResponse\"\x9f\x01\x92AX\n" +
 	"\n" +
-	"CanvasNode\x12\x1fDelete item from a node's queue\x1a)Deletes a specific item in a node's queue\x82\xd3\xe4\x93\x02>*</api/v1/canvases/{canvas_id}/nodes/{node_id}/queue/{item_id}\x12\xb6\x02\n" +
+	"CanvasNode\x12\x1fDelete item from a node's queue\x1a)Deletes a specific item in a node's queue\x82\xd3\xe4\x93\x02>*</api/v1/canvases/{canvas_id}/nodes/{node_id}/queue/{item_id}\x12\xdd\x02\n" +
+	"\x13UpdateNodeQueueItem\x12/.Superplane.Canvases.UpdateNodeQueueItemRequest\x1a0.Superplane.Canvases.UpdateNodeQueueItemResponse\"\xe2\x01\x92A\x97\x01\n" +
+	"\n" +
+	"CanvasNode\x12\x1dUpdate item in a node's queue\x1ajChanges the priority of a specific item in a node's queue. Items with a higher priority are dequeued first\x82\xd3\xe4\x93\x02A:\x01*2</api/v1/canvases/{canvas_id}/nodes/{node_id}/queue/{item_id}\x12\xb6\x02\n" +
 	"\x0fUpdateNodePause\x12+.Superplane.Canvases.UpdateNodePauseRequest\x1a,.Superplane.Canvases.UpdateNodePauseResponse\"\xc7\x01\x92A\x86\x01\n" +
 	"\n" +
 	"CanvasNode\x12\x1fPause or resume node processing\x1aWPauses or resumes processing for a canvas node while continuing to queue incoming items\x82\xd3\xe4\x93\x027:\x01*22/api/v1/canvases/{canvas_id}/nodes/{node_id}/pause\x12\x95\x02\n" +
@@ -3332,13 +7385,58 @@ const file_canvases_proto_rawDesc = "" +
 	"\x13ListChildExecutions\x12/.Superplane.Canvases.ListChildExecutionsRequest\x1a0.Superplane.Canvases.ListChildExecutionsResponse\"\xb2\x01\x92Ae\n" +
 	"\x13CanvasNodeExecution\x12&List child executions for an execution\x1a&List child executions for an execution\x82\xd3\xe4\x93\x02D:\x01*\"?/api/v1/canvases/{canvas_id}/executions/{execution_id}/children\x12\x8a\x02\n" +
 	"\x0fCancelExecution\x12+.Superplane.Canvases.CancelExecutionRequest\x1a,.Superplane.Canvases.CancelExecutionResponse\"\x9b\x01\x92AP\n" +
-	"\x13CanvasNodeExecution\x12\x10Cancel execution\x1a'Cancels a running canvas node execution\x82\xd3\xe4\x93\x02B:\x01*2=/api/v1/canvases/{canvas_id}/executions/{execution_id}/cancel\x12\xa0\x02\n" +
+	"\x13CanvasNodeExecution\x12\x10Cancel execution\x1a'Cancels a running canvas node execution\x82\xd3\xe4\x93\x02B:\x01*2=/api/v1/canvases/{canvas_id}/executions/{execution_id}/cancel\x12\xde\x02\n" +
+	"\x0eRerunExecution\x12*.Superplane.Canvases.RerunExecutionRequest\x1a+.Superplane.Canvases.RerunExecutionResponse\"\xf2\x01\x92A\xa7\x01\n" +
+	"\x13CanvasNodeExecution\x12\x0fRerun execution\x1a\x7fRe-runs a finished canvas node execution by queueing its input again on the node, continuing the execution chain from that node\x82\xd3\xe4\x93\x02A:\x01*\"</api/v1/canvases/{canvas_id}/executions/{execution_id}/rerun\x12\xa0\x02\n" +
 	"\x16ResolveExecutionErrors\x122.Superplane.Canvases.ResolveExecutionErrorsRequest\x1a3.Superplane.Canvases.ResolveExecutionErrorsResponse\"\x9c\x01\x92A_\n" +
 	"\x13CanvasNodeExecution\x12\x18Resolve execution errors\x1a.Marks canvas node execution errors as resolved\x82\xd3\xe4\x93\x024:\x01*2//api/v1/canvases/{canvas_id}/executions/resolve\x12\x86\x02\n" +
 	"\x10ListCanvasEvents\x12,.Superplane.Canvases.ListCanvasEventsRequest\x1a-.Superplane.Canvases.ListCanvasEventsResponse\"\x94\x01\x92Af\n" +
 	"\vCanvasEvent\x12\x12List canvas events\x1aCReturns a list of root events that triggered executions in a canvas\x82\xd3\xe4\x93\x02%\x12#/api/v1/canvases/{canvas_id}/events\x12\xa4\x02\n" +
 	"\x13ListEventExecutions\x12/.Superplane.Canvases.ListEventExecutionsRequest\x1a0.Superplane.Canvases.ListEventExecutionsResponse\"\xa9\x01\x92Ae\n" +
-	"\vCanvasEvent\x12\x15List event executions\x1a?Returns a list of all node executions triggered by a root event\x82\xd3\xe4\x93\x02;\x129/api/v1/canvases/{canvas_id}/events/{event_id}/executionsB\xc8\x01\x92A\x8c\x01\x12b\n" +
+	"\vCanvasEvent\x12\x15List event executions\x1a?Returns a list of all node executions triggered by a root event\x82\xd3\xe4\x93\x02;\x129/api/v1/canvases/{canvas_id}/events/{event_id}/executions\x12\xfd\x01\n" +
+	"\x12ListCanvasVersions\x12..Superplane.Canvases.ListCanvasVersionsRequest\x1a/.Superplane.Canvases.ListCanvasVersionsResponse\"\x85\x01\x92AU\n" +
+	"\rCanvasVersion\x12\x14List canvas versions\x1a.Returns the versions of a canvas, newest first\x82\xd3\xe4\x93\x02'\x12%/api/v1/canvases/{canvas_id}/versions\x12\x9f\x02\n" +
+	"\x15DescribeCanvasVersion\x121.Superplane.Canvases.DescribeCanvasVersionRequest\x1a2.Superplane.Canvases.DescribeCanvasVersionResponse\"\x9e\x01\x92Aa\n" +
+	"\rCanvasVersion\x12\x17Describe canvas version\x1a7Returns a canvas version, including its nodes and edges\x82\xd3\xe4\x93\x024\x122/api/v1/canvases/{canvas_id}/versions/{version_id}\x12\xd0\x02\n" +
+	"\x12DiffCanvasVersions\x12..Superplane.Canvases.DiffCanvasVersionsRequest\x1a/.Superplane.Canvases.DiffCanvasVersionsResponse\"\xd8\x01\x92A\x92\x01\n" +
+	"\rCanvasVersion\x12\x14Diff canvas versions\x1akReturns the changes between two canvas versions. If no base version is given, the previous version is used.\x82\xd3\xe4\x93\x02<\x12:/api/v1/canvases/{canvas_id}/versions/{to_version_id}/diff\x12\xc7\x02\n" +
+	"\x14RestoreCanvasVersion\x120.Superplane.Canvases.RestoreCanvasVersionRequest\x1a1.Superplane.Canvases.RestoreCanvasVersionResponse\"\xc9\x01\x92A\x80\x01\n" +
+	"\rCanvasVersion\x12\x16Restore canvas version\x1aWUpdates the canvas to the nodes and edges of a previous version, creating a new version\x82\xd3\xe4\x93\x02?:\x01*\":/api/v1/canvases/{canvas_id}/versions/{version_id}/restore\x12\xa0\x02\n" +
+	"\x13DescribeCanvasDraft\x12/.Superplane.Canvases.DescribeCanvasDraftRequest\x1a0.Superplane.Canvases.DescribeCanvasDraftResponse\"\xa5\x01\x92Ax\n" +
+	"\vCanvasDraft\x12\x15Describe canvas draft\x1aRReturns the draft of a canvas, with its validation errors and the changes it makes\x82\xd3\xe4\x93\x02$\x12\"/api/v1/canvases/{canvas_id}/draft\x12\x96\x02\n" +
+	"\x11UpdateCanvasDraft\x12-.Superplane.Canvases.UpdateCanvasDraftRequest\x1a..Superplane.Canvases.UpdateCanvasDraftResponse\"\xa1\x01\x92Aq\n" +
+	"\vCanvasDraft\x12\x13Update canvas draft\x1aMCreates or updates the draft of a canvas, without changing the running canvas\x82\xd3\xe4\x93\x02':\x01*\x1a\"/api/v1/canvases/{canvas_id}/draft\x12\xe6\x01\n" +
+	"\x12DiscardCanvasDraft\x12..Superplane.Canvases.DiscardCanvasDraftRequest\x1a/.Superplane.Canvases.DiscardCanvasDraftResponse\"o\x92AB\n" +
+	"\vCanvasDraft\x12\x14Discard canvas draft\x1a\x1dDeletes the draft of a canvas\x82\xd3\xe4\x93\x02$*\"/api/v1/canvases/{canvas_id}/draft\x12\xed\x02\n" +
+	"\x12PublishCanvasDraft\x12..Superplane.Canvases.PublishCanvasDraftRequest\x1a/.Superplane.Canvases.PublishCanvasDraftResponse\"\xf5\x01\x92A\xbc\x01\n" +
+	"\vCanvasDraft\x12\x14Publish canvas draft\x1a\x96\x01Replaces the canvas with its draft. If an approval role is given, a change request is created instead, and the draft is published once it is approved.\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/canvases/{canvas_id}/draft/publish\x12\xa2\x02\n" +
+	"\x18ListCanvasChangeRequests\x124.Superplane.Canvases.ListCanvasChangeRequestsRequest\x1a5.Superplane.Canvases.ListCanvasChangeRequestsResponse\"\x98\x01\x92Aa\n" +
+	"\vCanvasDraft\x12\x1bList canvas change requests\x1a5Returns the change requests of a canvas, newest first\x82\xd3\xe4\x93\x02.\x12,/api/v1/canvases/{canvas_id}/change-requests\x12\xdb\x02\n" +
+	"\x1aApproveCanvasChangeRequest\x126.Superplane.Canvases.ApproveCanvasChangeRequestRequest\x1a7.Superplane.Canvases.ApproveCanvasChangeRequestResponse\"\xcb\x01\x92Au\n" +
+	"\vCanvasDraft\x12\x1dApprove canvas change request\x1aGApproves a pending change request, publishing its changes to the canvas\x82\xd3\xe4\x93\x02M:\x01*\"H/api/v1/canvases/{canvas_id}/change-requests/{change_request_id}/approve\x12\xcd\x02\n" +
+	"\x19RejectCanvasChangeRequest\x125.Superplane.Canvases.RejectCanvasChangeRequestRequest\x1a6.Superplane.Canvases.RejectCanvasChangeRequestResponse\"\xc0\x01\x92Ak\n" +
+	"\vCanvasDraft\x12\x1cReject canvas change request\x1a>Rejects a pending change request, leaving the canvas unchanged\x82\xd3\xe4\x93\x02L:\x01*\"G/api/v1/canvases/{canvas_id}/change-requests/{change_request_id}/reject\x12\xc6\x02\n" +
+	"\x0eSimulateCanvas\x12*.Superplane.Canvases.SimulateCanvasRequest\x1a+.Superplane.Canvases.SimulateCanvasResponse\"\xda\x01\x92A\xa6\x01\n" +
+	"\x06Canvas\x12\x0fSimulate canvas\x1a\x8a\x01Walks the canvas from a node with a sample payload, returning the nodes it reaches and their resolved configurations, without side effects\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/canvases/{canvas_id}/simulate\x12\xf5\x01\n" +
+	"\x13ListCanvasVariables\x12/.Superplane.Canvases.ListCanvasVariablesRequest\x1a0.Superplane.Canvases.ListCanvasVariablesResponse\"{\x92AJ\n" +
+	"\x0eCanvasVariable\x12\x15List canvas variables\x1a!Returns the variables of a canvas\x82\xd3\xe4\x93\x02(\x12&/api/v1/canvases/{canvas_id}/variables\x12\xcf\x02\n" +
+	"\x11SetCanvasVariable\x12-.Superplane.Canvases.SetCanvasVariableRequest\x1a..Superplane.Canvases.SetCanvasVariableResponse\"\xda\x01\x92A\x9e\x01\n" +
+	"\x0eCanvasVariable\x12\x13Set canvas variable\x1awCreates or updates a canvas variable. If expected_version is set, the variable is only written if it is at that version\x82\xd3\xe4\x93\x022:\x01*\x1a-/api/v1/canvases/{canvas_id}/variables/{name}\x12\xcd\x02\n" +
+	"\x14DeleteCanvasVariable\x120.Superplane.Canvases.DeleteCanvasVariableRequest\x1a1.Superplane.Canvases.DeleteCanvasVariableResponse\"\xcf\x01\x92A\x96\x01\n" +
+	"\x0eCanvasVariable\x12\x16Delete canvas variable\x1alDeletes a canvas variable. If expected_version is set, the variable is only deleted if it is at that version\x82\xd3\xe4\x93\x02/*-/api/v1/canvases/{canvas_id}/variables/{name}\x12\xb0\x02\n" +
+	"\x16ListExecutionArtifacts\x122.Superplane.Canvases.ListExecutionArtifactsRequest\x1a3.Superplane.Canvases.ListExecutionArtifactsResponse\"\xac\x01\x92Aa\n" +
+	"\x13CanvasNodeExecution\x12\x18List execution artifacts\x1a0Returns the artifacts stored by a node execution\x82\xd3\xe4\x93\x02B\x12@/api/v1/canvases/{canvas_id}/executions/{execution_id}/artifacts\x12\x80\x03\n" +
+	"\x19DownloadExecutionArtifact\x125.Superplane.Canvases.DownloadExecutionArtifactRequest\x1a\x14.google.api.HttpBody\"\x95\x02\x92A\xb2\x01\n" +
+	"\x13CanvasNodeExecution\x12\x1bDownload execution artifact\x1a,Returns the content of an execution artifact:\x18application/octet-streamJ6\n" +
+	"\x03200\x12/\n" +
+	"\x1cThe content of the artifact.\x12\x0f\n" +
+	"\r\x9a\x02\x01\a\xa2\x02\x06binary\x82\xd3\xe4\x93\x02Y\x12W/api/v1/canvases/{canvas_id}/executions/{execution_id}/artifacts/{artifact_id}/download\x12\xa2\x02\n" +
+	"\x10GetExecutionLogs\x12,.Superplane.Canvases.GetExecutionLogsRequest\x1a-.Superplane.Canvases.GetExecutionLogsResponse\"\xb0\x01\x92Aj\n" +
+	"\x13CanvasNodeExecution\x12\x12Get execution logs\x1a?Returns the log lines of a node execution, after the given line\x82\xd3\xe4\x93\x02=\x12;/api/v1/canvases/{canvas_id}/executions/{execution_id}/logs\x12\xc1\x02\n" +
+	"\x17DescribeCanvasRetention\x123.Superplane.Canvases.DescribeCanvasRetentionRequest\x1a4.Superplane.Canvases.DescribeCanvasRetentionResponse\"\xba\x01\x92A\x88\x01\n" +
+	"\x0fCanvasRetention\x12\x19Describe canvas retention\x1aZReturns the retention policy of a canvas, the policy in effect for it, and retention stats\x82\xd3\xe4\x93\x02(\x12&/api/v1/canvases/{canvas_id}/retention\x12\xe9\x02\n" +
+	"\x1bUpdateCanvasRetentionPolicy\x127.Superplane.Canvases.UpdateCanvasRetentionPolicyRequest\x1a8.Superplane.Canvases.UpdateCanvasRetentionPolicyResponse\"\xd6\x01\x92A\x9a\x01\n" +
+	"\x0fCanvasRetention\x12\x1eUpdate canvas retention policy\x1agSets the retention policy of a canvas. Without a policy, the canvas uses the policy of its organization\x82\xd3\xe4\x93\x022:\x01*\x1a-/api/v1/canvases/{canvas_id}/retention/policyB\xc8\x01\x92A\x8c\x01\x12b\n" +
 	"\x17Superplane Canvases API\x12\x1bAPI for Superplane canvases\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ6github.com/superplanehq/superplane/pkg/protos/canvasesb\x06proto3"
 
@@ -3354,168 +7452,347 @@ func file_canvases_proto_rawDescGZIP() []byte {
 	return file_canvases_proto_rawDescData
 }
 
-var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
 var file_canvases_proto_goTypes = []any{
-	(CanvasNodeExecution_State)(0),            // 0: Superplane.Canvases.CanvasNodeExecution.State
-	(CanvasNodeExecution_Result)(0),           // 1: Superplane.Canvases.CanvasNodeExecution.Result
-	(CanvasNodeExecution_ResultReason)(0),     // 2: Superplane.Canvases.CanvasNodeExecution.ResultReason
-	(*ListCanvasesRequest)(nil),               // 3: Superplane.Canvases.ListCanvasesRequest
-	(*ListCanvasesResponse)(nil),              // 4: Superplane.Canvases.ListCanvasesResponse
-	(*DescribeCanvasRequest)(nil),             // 5: Superplane.Canvases.DescribeCanvasRequest
-	(*DescribeCanvasResponse)(nil),            // 6: Superplane.Canvases.DescribeCanvasResponse
-	(*CreateCanvasRequest)(nil),               // 7: Superplane.Canvases.CreateCanvasRequest
-	(*CreateCanvasResponse)(nil),              // 8: Superplane.Canvases.CreateCanvasResponse
-	(*UpdateCanvasRequest)(nil),               // 9: Superplane.Canvases.UpdateCanvasRequest
-	(*UpdateCanvasResponse)(nil),              // 10: Superplane.Canvases.UpdateCanvasResponse
-	(*DeleteCanvasRequest)(nil),               // 11: Superplane.Canvases.DeleteCanvasRequest
-	(*DeleteCanvasResponse)(nil),              // 12: Superplane.Canvases.DeleteCanvasResponse
-	(*UserRef)(nil),                           // 13: Superplane.Canvases.UserRef
-	(*Canvas)(nil),                            // 14: Superplane.Canvases.Canvas
-	(*ListNodeEventsRequest)(nil),             // 15: Superplane.Canvases.ListNodeEventsRequest
-	(*ListNodeEventsResponse)(nil),            // 16: Superplane.Canvases.ListNodeEventsResponse
-	(*EmitNodeEventRequest)(nil),              // 17: Superplane.Canvases.EmitNodeEventRequest
-	(*EmitNodeEventResponse)(nil),             // 18: Superplane.Canvases.EmitNodeEventResponse
-	(*ListNodeQueueItemsRequest)(nil),         // 19: Superplane.Canvases.ListNodeQueueItemsRequest
-	(*ListNodeQueueItemsResponse)(nil),        // 20: Superplane.Canvases.ListNodeQueueItemsResponse
-	(*DeleteNodeQueueItemRequest)(nil),        // 21: Superplane.Canvases.DeleteNodeQueueItemRequest
-	(*DeleteNodeQueueItemResponse)(nil),       // 22: Superplane.Canvases.DeleteNodeQueueItemResponse
-	(*UpdateNodePauseRequest)(nil),            // 23: Superplane.Canvases.UpdateNodePauseRequest
-	(*UpdateNodePauseResponse)(nil),           // 24: Superplane.Canvases.UpdateNodePauseResponse
-	(*ListNodeExecutionsRequest)(nil),         // 25: Superplane.Canvases.ListNodeExecutionsRequest
-	(*ListNodeExecutionsResponse)(nil),        // 26: Superplane.Canvases.ListNodeExecutionsResponse
-	(*ListChildExecutionsRequest)(nil),        // 27: Superplane.Canvases.ListChildExecutionsRequest
-	(*ListChildExecutionsResponse)(nil),       // 28: Superplane.Canvases.ListChildExecutionsResponse
-	(*CanvasNodeExecution)(nil),               // 29: Superplane.Canvases.CanvasNodeExecution
-	(*CanvasNodeQueueItem)(nil),               // 30: Superplane.Canvases.CanvasNodeQueueItem
-	(*InvokeNodeExecutionActionRequest)(nil),  // 31: Superplane.Canvases.InvokeNodeExecutionActionRequest
-	(*InvokeNodeExecutionActionResponse)(nil), // 32: Superplane.Canvases.InvokeNodeExecutionActionResponse
-	(*InvokeNodeTriggerActionRequest)(nil),    // 33: Superplane.Canvases.InvokeNodeTriggerActionRequest
-	(*InvokeNodeTriggerActionResponse)(nil),   // 34: Superplane.Canvases.InvokeNodeTriggerActionResponse
-	(*ListCanvasEventsRequest)(nil),           // 35: Superplane.Canvases.ListCanvasEventsRequest
-	(*ListCanvasEventsResponse)(nil),          // 36: Superplane.Canvases.ListCanvasEventsResponse
-	(*CanvasEvent)(nil),                       // 37: Superplane.Canvases.CanvasEvent
-	(*CanvasEventWithExecutions)(nil),         // 38: Superplane.Canvases.CanvasEventWithExecutions
-	(*ListEventExecutionsRequest)(nil),        // 39: Superplane.Canvases.ListEventExecutionsRequest
-	(*ListEventExecutionsResponse)(nil),       // 40: Superplane.Canvases.ListEventExecutionsResponse
-	(*CancelExecutionRequest)(nil),            // 41: Superplane.Canvases.CancelExecutionRequest
-	(*CancelExecutionResponse)(nil),           // 42: Superplane.Canvases.CancelExecutionResponse
-	(*ResolveExecutionErrorsRequest)(nil),     // 43: Superplane.Canvases.ResolveExecutionErrorsRequest
-	(*ResolveExecutionErrorsResponse)(nil),    // 44: Superplane.Canvases.ResolveExecutionErrorsResponse
-	(*CanvasNodeEventMessage)(nil),            // 45: Superplane.Canvases.CanvasNodeEventMessage
-	(*CanvasNodeExecutionMessage)(nil),        // 46: Superplane.Canvases.CanvasNodeExecutionMessage
-	(*CanvasNodeQueueItemMessage)(nil),        // 47: Superplane.Canvases.CanvasNodeQueueItemMessage
-	(*Canvas_Metadata)(nil),                   // 48: Superplane.Canvases.Canvas.Metadata
-	(*Canvas_Spec)(nil),                       // 49: Superplane.Canvases.Canvas.Spec
-	(*Canvas_Status)(nil),                     // 50: Superplane.Canvases.Canvas.Status
-	(*timestamp.Timestamp)(nil),               // 51: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                    // 52: google.protobuf.Struct
-	(*components.Node)(nil),                   // 53: Superplane.Components.Node
-	(*components.Edge)(nil),                   // 54: Superplane.Components.Edge
+	(CanvasChangeRequestState)(0),               // 0: Superplane.Canvases.CanvasChangeRequestState
+	(CanvasNodeExecution_State)(0),              // 1: Superplane.Canvases.CanvasNodeExecution.State
+	(CanvasNodeExecution_Result)(0),             // 2: Superplane.Canvases.CanvasNodeExecution.Result
+	(CanvasNodeExecution_ResultReason)(0),       // 3: Superplane.Canvases.CanvasNodeExecution.ResultReason
+	(CanvasVersionDiff_ChangeType)(0),           // 4: Superplane.Canvases.CanvasVersionDiff.ChangeType
+	(CanvasSimulationStep_Mode)(0),              // 5: Superplane.Canvases.CanvasSimulationStep.Mode
+	(*ListCanvasesRequest)(nil),                 // 6: Superplane.Canvases.ListCanvasesRequest
+	(*ListCanvasesResponse)(nil),                // 7: Superplane.Canvases.ListCanvasesResponse
+	(*DescribeCanvasRequest)(nil),               // 8: Superplane.Canvases.DescribeCanvasRequest
+	(*DescribeCanvasResponse)(nil),              // 9: Superplane.Canvases.DescribeCanvasResponse
+	(*CreateCanvasRequest)(nil),                 // 10: Superplane.Canvases.CreateCanvasRequest
+	(*CreateCanvasResponse)(nil),                // 11: Superplane.Canvases.CreateCanvasResponse
+	(*UpdateCanvasRequest)(nil),                 // 12: Superplane.Canvases.UpdateCanvasRequest
+	(*UpdateCanvasResponse)(nil),                // 13: Superplane.Canvases.UpdateCanvasResponse
+	(*DeleteCanvasRequest)(nil),                 // 14: Superplane.Canvases.DeleteCanvasRequest
+	(*DeleteCanvasResponse)(nil),                // 15: Superplane.Canvases.DeleteCanvasResponse
+	(*UserRef)(nil),                             // 16: Superplane.Canvases.UserRef
+	(*Canvas)(nil),                              // 17: Superplane.Canvases.Canvas
+	(*ListNodeEventsRequest)(nil),               // 18: Superplane.Canvases.ListNodeEventsRequest
+	(*ListNodeEventsResponse)(nil),              // 19: Superplane.Canvases.ListNodeEventsResponse
+	(*EmitNodeEventRequest)(nil),                // 20: Superplane.Canvases.EmitNodeEventRequest
+	(*EmitNodeEventResponse)(nil),               // 21: Superplane.Canvases.EmitNodeEventResponse
+	(*ListNodeQueueItemsRequest)(nil),           // 22: Superplane.Canvases.ListNodeQueueItemsRequest
+	(*ListNodeQueueItemsResponse)(nil),          // 23: Superplane.Canvases.ListNodeQueueItemsResponse
+	(*DeleteNodeQueueItemRequest)(nil),          // 24: Superplane.Canvases.DeleteNodeQueueItemRequest
+	(*DeleteNodeQueueItemResponse)(nil),         // 25: Superplane.Canvases.DeleteNodeQueueItemResponse
+	(*UpdateNodeQueueItemRequest)(nil),          // 26: Superplane.Canvases.UpdateNodeQueueItemRequest
+	(*UpdateNodeQueueItemResponse)(nil),         // 27: Superplane.Canvases.UpdateNodeQueueItemResponse
+	(*UpdateNodePauseRequest)(nil),              // 28: Superplane.Canvases.UpdateNodePauseRequest
+	(*UpdateNodePauseResponse)(nil),             // 29: Superplane.Canvases.UpdateNodePauseResponse
+	(*ListNodeExecutionsRequest)(nil),           // 30: Superplane.Canvases.ListNodeExecutionsRequest
+	(*ListNodeExecutionsResponse)(nil),          // 31: Superplane.Canvases.ListNodeExecutionsResponse
+	(*ListChildExecutionsRequest)(nil),          // 32: Superplane.Canvases.ListChildExecutionsRequest
+	(*ListChildExecutionsResponse)(nil),         // 33: Superplane.Canvases.ListChildExecutionsResponse
+	(*CanvasNodeExecution)(nil),                 // 34: Superplane.Canvases.CanvasNodeExecution
+	(*CanvasNodeQueueItem)(nil),                 // 35: Superplane.Canvases.CanvasNodeQueueItem
+	(*InvokeNodeExecutionActionRequest)(nil),    // 36: Superplane.Canvases.InvokeNodeExecutionActionRequest
+	(*InvokeNodeExecutionActionResponse)(nil),   // 37: Superplane.Canvases.InvokeNodeExecutionActionResponse
+	(*InvokeNodeTriggerActionRequest)(nil),      // 38: Superplane.Canvases.InvokeNodeTriggerActionRequest
+	(*InvokeNodeTriggerActionResponse)(nil),     // 39: Superplane.Canvases.InvokeNodeTriggerActionResponse
+	(*ListCanvasEventsRequest)(nil),             // 40: Superplane.Canvases.ListCanvasEventsRequest
+	(*ListCanvasEventsResponse)(nil),            // 41: Superplane.Canvases.ListCanvasEventsResponse
+	(*CanvasEvent)(nil),                         // 42: Superplane.Canvases.CanvasEvent
+	(*CanvasEventWithExecutions)(nil),           // 43: Superplane.Canvases.CanvasEventWithExecutions
+	(*ListEventExecutionsRequest)(nil),          // 44: Superplane.Canvases.ListEventExecutionsRequest
+	(*ListEventExecutionsResponse)(nil),         // 45: Superplane.Canvases.ListEventExecutionsResponse
+	(*CancelExecutionRequest)(nil),              // 46: Superplane.Canvases.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),             // 47: Superplane.Canvases.CancelExecutionResponse
+	(*RerunExecutionRequest)(nil),               // 48: Superplane.Canvases.RerunExecutionRequest
+	(*RerunExecutionResponse)(nil),              // 49: Superplane.Canvases.RerunExecutionResponse
+	(*ResolveExecutionErrorsRequest)(nil),       // 50: Superplane.Canvases.ResolveExecutionErrorsRequest
+	(*ResolveExecutionErrorsResponse)(nil),      // 51: Superplane.Canvases.ResolveExecutionErrorsResponse
+	(*ListCanvasVersionsRequest)(nil),           // 52: Superplane.Canvases.ListCanvasVersionsRequest
+	(*ListCanvasVersionsResponse)(nil),          // 53: Superplane.Canvases.ListCanvasVersionsResponse
+	(*DescribeCanvasVersionRequest)(nil),        // 54: Superplane.Canvases.DescribeCanvasVersionRequest
+	(*DescribeCanvasVersionResponse)(nil),       // 55: Superplane.Canvases.DescribeCanvasVersionResponse
+	(*DiffCanvasVersionsRequest)(nil),           // 56: Superplane.Canvases.DiffCanvasVersionsRequest
+	(*DiffCanvasVersionsResponse)(nil),          // 57: Superplane.Canvases.DiffCanvasVersionsResponse
+	(*RestoreCanvasVersionRequest)(nil),         // 58: Superplane.Canvases.RestoreCanvasVersionRequest
+	(*RestoreCanvasVersionResponse)(nil),        // 59: Superplane.Canvases.RestoreCanvasVersionResponse
+	(*CanvasVersion)(nil),                       // 60: Superplane.Canvases.CanvasVersion
+	(*CanvasVersionDiff)(nil),                   // 61: Superplane.Canvases.CanvasVersionDiff
+	(*DescribeCanvasDraftRequest)(nil),          // 62: Superplane.Canvases.DescribeCanvasDraftRequest
+	(*DescribeCanvasDraftResponse)(nil),         // 63: Superplane.Canvases.DescribeCanvasDraftResponse
+	(*UpdateCanvasDraftRequest)(nil),            // 64: Superplane.Canvases.UpdateCanvasDraftRequest
+	(*UpdateCanvasDraftResponse)(nil),           // 65: Superplane.Canvases.UpdateCanvasDraftResponse
+	(*DiscardCanvasDraftRequest)(nil),           // 66: Superplane.Canvases.DiscardCanvasDraftRequest
+	(*DiscardCanvasDraftResponse)(nil),          // 67: Superplane.Canvases.DiscardCanvasDraftResponse
+	(*PublishCanvasDraftRequest)(nil),           // 68: Superplane.Canvases.PublishCanvasDraftRequest
+	(*PublishCanvasDraftResponse)(nil),          // 69: Superplane.Canvases.PublishCanvasDraftResponse
+	(*ListCanvasChangeRequestsRequest)(nil),     // 70: Superplane.Canvases.ListCanvasChangeRequestsRequest
+	(*ListCanvasChangeRequestsResponse)(nil),    // 71: Superplane.Canvases.ListCanvasChangeRequestsResponse
+	(*ApproveCanvasChangeRequestRequest)(nil),   // 72: Superplane.Canvases.ApproveCanvasChangeRequestRequest
+	(*ApproveCanvasChangeRequestResponse)(nil),  // 73: Superplane.Canvases.ApproveCanvasChangeRequestResponse
+	(*RejectCanvasChangeRequestRequest)(nil),    // 74: Superplane.Canvases.RejectCanvasChangeRequestRequest
+	(*RejectCanvasChangeRequestResponse)(nil),   // 75: Superplane.Canvases.RejectCanvasChangeRequestResponse
+	(*CanvasDraft)(nil),                         // 76: Superplane.Canvases.CanvasDraft
+	(*CanvasChangeRequest)(nil),                 // 77: Superplane.Canvases.CanvasChangeRequest
+	(*SimulateCanvasRequest)(nil),               // 78: Superplane.Canvases.SimulateCanvasRequest
+	(*SimulateCanvasResponse)(nil),              // 79: Superplane.Canvases.SimulateCanvasResponse
+	(*CanvasSimulationStep)(nil),                // 80: Superplane.Canvases.CanvasSimulationStep
+	(*ListCanvasVariablesRequest)(nil),          // 81: Superplane.Canvases.ListCanvasVariablesRequest
+	(*ListCanvasVariablesResponse)(nil),         // 82: Superplane.Canvases.ListCanvasVariablesResponse
+	(*SetCanvasVariableRequest)(nil),            // 83: Superplane.Canvases.SetCanvasVariableRequest
+	(*SetCanvasVariableResponse)(nil),           // 84: Superplane.Canvases.SetCanvasVariableResponse
+	(*DeleteCanvasVariableRequest)(nil),         // 85: Superplane.Canvases.DeleteCanvasVariableRequest
+	(*DeleteCanvasVariableResponse)(nil),        // 86: Superplane.Canvases.DeleteCanvasVariableResponse
+	(*CanvasVariable)(nil),                      // 87: Superplane.Canvases.CanvasVariable
+	(*ListExecutionArtifactsRequest)(nil),       // 88: Superplane.Canvases.ListExecutionArtifactsRequest
+	(*ListExecutionArtifactsResponse)(nil),      // 89: Superplane.Canvases.ListExecutionArtifactsResponse
+	(*DownloadExecutionArtifactRequest)(nil),    // 90: Superplane.Canvases.DownloadExecutionArtifactRequest
+	(*ExecutionArtifact)(nil),                   // 91: Superplane.Canvases.ExecutionArtifact
+	(*GetExecutionLogsRequest)(nil),             // 92: Superplane.Canvases.GetExecutionLogsRequest
+	(*GetExecutionLogsResponse)(nil),            // 93: Superplane.Canvases.GetExecutionLogsResponse
+	(*ExecutionLogLine)(nil),                    // 94: Superplane.Canvases.ExecutionLogLine
+	(*DescribeCanvasRetentionRequest)(nil),      // 95: Superplane.Canvases.DescribeCanvasRetentionRequest
+	(*DescribeCanvasRetentionResponse)(nil),     // 96: Superplane.Canvases.DescribeCanvasRetentionResponse
+	(*UpdateCanvasRetentionPolicyRequest)(nil),  // 97: Superplane.Canvases.UpdateCanvasRetentionPolicyRequest
+	(*UpdateCanvasRetentionPolicyResponse)(nil), // 98: Superplane.Canvases.UpdateCanvasRetentionPolicyResponse
+	(*CanvasRetentionPolicy)(nil),               // 99: Superplane.Canvases.CanvasRetentionPolicy
+	(*CanvasRetentionStats)(nil),                // 100: Superplane.Canvases.CanvasRetentionStats
+	(*CanvasNodeEventMessage)(nil),              // 101: Superplane.Canvases.CanvasNodeEventMessage
+	(*CanvasNodeExecutionMessage)(nil),          // 102: Superplane.Canvases.CanvasNodeExecutionMessage
+	(*CanvasNodeExecutionLogMessage)(nil),       // 103: Superplane.Canvases.CanvasNodeExecutionLogMessage
+	(*CanvasNodeQueueItemMessage)(nil),          // 104: Superplane.Canvases.CanvasNodeQueueItemMessage
+	(*Canvas_Metadata)(nil),                     // 105: Superplane.Canvases.Canvas.Metadata
+	(*Canvas_Spec)(nil),                         // 106: Superplane.Canvases.Canvas.Spec
+	(*Canvas_Status)(nil),                       // 107: Superplane.Canvases.Canvas.Status
+	(*CanvasVersionDiff_NodeChange)(nil),        // 108: Superplane.Canvases.CanvasVersionDiff.NodeChange
+	(*CanvasVersionDiff_EdgeChange)(nil),        // 109: Superplane.Canvases.CanvasVersionDiff.EdgeChange
+	(*CanvasDraft_ValidationError)(nil),         // 110: Superplane.Canvases.CanvasDraft.ValidationError
+	(*CanvasSimulationStep_Output)(nil),         // 111: Superplane.Canvases.CanvasSimulationStep.Output
+	(*timestamp.Timestamp)(nil),                 // 112: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                      // 113: google.protobuf.Struct
+	(*components.Node)(nil),                     // 114: Superplane.Components.Node
+	(*_struct.Value)(nil),                       // 115: google.protobuf.Value
+	(*components.Edge)(nil),                     // 116: Superplane.Components.Edge
+	(*httpbody.HttpBody)(nil),                   // 117: google.api.HttpBody
 }
 var file_canvases_proto_depIdxs = []int32{
-	14, // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
-	14, // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 4: Superplane.Canvases.UpdateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 5: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	48, // 6: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
-	49, // 7: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	50, // 8: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
-	51, // 9: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
-	37, // 10: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
-	51, // 11: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	52, // 12: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
-	51, // 13: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
-	30, // 14: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	51, // 15: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	53, // 16: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
-	0,  // 17: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	1,  // 18: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	51, // 19: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
-	29, // 20: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	51, // 21: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	29, // 22: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	0,  // 23: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	1,  // 24: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	2,  // 25: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
-	52, // 26: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
-	52, // 27: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
-	51, // 28: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
-	51, // 29: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
-	52, // 30: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
-	52, // 31: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
-	29, // 32: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	37, // 33: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	13, // 34: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
-	52, // 35: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
-	37, // 36: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	51, // 37: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
-	52, // 38: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
-	52, // 39: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
-	52, // 40: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
-	51, // 41: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
-	38, // 42: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
-	51, // 43: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	52, // 44: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
-	51, // 45: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
-	52, // 46: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
-	51, // 47: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
-	29, // 48: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	29, // 49: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	51, // 50: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
-	51, // 51: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	51, // 52: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
-	51, // 53: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	51, // 54: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 55: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
-	53, // 56: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
-	54, // 57: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
-	29, // 58: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	30, // 59: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	37, // 60: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
-	3,  // 61: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
-	7,  // 62: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
-	5,  // 63: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
-	9,  // 64: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
-	11, // 65: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
-	19, // 66: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
-	21, // 67: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
-	23, // 68: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
-	25, // 69: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
-	15, // 70: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
-	17, // 71: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
-	31, // 72: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
-	33, // 73: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
-	27, // 74: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
-	41, // 75: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
-	43, // 76: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
-	35, // 77: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
-	39, // 78: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
-	4,  // 79: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
-	8,  // 80: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
-	6,  // 81: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
-	10, // 82: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
-	12, // 83: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
-	20, // 84: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
-	22, // 85: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
-	24, // 86: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
-	26, // 87: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
-	16, // 88: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
-	18, // 89: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
-	32, // 90: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
-	34, // 91: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
-	28, // 92: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
-	42, // 93: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
-	44, // 94: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
-	36, // 95: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
-	40, // 96: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
-	79, // [79:97] is the sub-list for method output_type
-	61, // [61:79] is the sub-list for method input_type
-	61, // [61:61] is the sub-list for extension type_name
-	61, // [61:61] is the sub-list for extension extendee
-	0,  // [0:61] is the sub-list for field type_name
+	17,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
+	17,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 4: Superplane.Canvases.UpdateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	17,  // 5: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	105, // 6: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
+	106, // 7: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	107, // 8: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
+	112, // 9: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
+	42,  // 10: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
+	112, // 11: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	113, // 12: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
+	112, // 13: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
+	35,  // 14: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	112, // 15: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	35,  // 16: Superplane.Canvases.UpdateNodeQueueItemResponse.item:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	114, // 17: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
+	1,   // 18: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	2,   // 19: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	112, // 20: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
+	34,  // 21: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	112, // 22: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	34,  // 23: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	1,   // 24: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	2,   // 25: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	3,   // 26: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
+	113, // 27: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
+	113, // 28: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
+	112, // 29: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
+	112, // 30: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
+	113, // 31: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
+	113, // 32: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
+	34,  // 33: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	42,  // 34: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	16,  // 35: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
+	112, // 36: Superplane.Canvases.CanvasNodeExecution.deadline_at:type_name -> google.protobuf.Timestamp
+	113, // 37: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
+	42,  // 38: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	112, // 39: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
+	113, // 40: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
+	113, // 41: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
+	113, // 42: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
+	112, // 43: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
+	43,  // 44: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
+	112, // 45: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	113, // 46: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
+	112, // 47: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
+	113, // 48: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
+	112, // 49: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
+	34,  // 50: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	34,  // 51: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	35,  // 52: Superplane.Canvases.RerunExecutionResponse.queue_item:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	60,  // 53: Superplane.Canvases.ListCanvasVersionsResponse.versions:type_name -> Superplane.Canvases.CanvasVersion
+	60,  // 54: Superplane.Canvases.DescribeCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	60,  // 55: Superplane.Canvases.DiffCanvasVersionsResponse.from:type_name -> Superplane.Canvases.CanvasVersion
+	60,  // 56: Superplane.Canvases.DiffCanvasVersionsResponse.to:type_name -> Superplane.Canvases.CanvasVersion
+	61,  // 57: Superplane.Canvases.DiffCanvasVersionsResponse.diff:type_name -> Superplane.Canvases.CanvasVersionDiff
+	17,  // 58: Superplane.Canvases.RestoreCanvasVersionResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	60,  // 59: Superplane.Canvases.RestoreCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	16,  // 60: Superplane.Canvases.CanvasVersion.created_by:type_name -> Superplane.Canvases.UserRef
+	112, // 61: Superplane.Canvases.CanvasVersion.created_at:type_name -> google.protobuf.Timestamp
+	106, // 62: Superplane.Canvases.CanvasVersion.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	108, // 63: Superplane.Canvases.CanvasVersionDiff.nodes:type_name -> Superplane.Canvases.CanvasVersionDiff.NodeChange
+	109, // 64: Superplane.Canvases.CanvasVersionDiff.edges:type_name -> Superplane.Canvases.CanvasVersionDiff.EdgeChange
+	76,  // 65: Superplane.Canvases.DescribeCanvasDraftResponse.draft:type_name -> Superplane.Canvases.CanvasDraft
+	17,  // 66: Superplane.Canvases.UpdateCanvasDraftRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	76,  // 67: Superplane.Canvases.UpdateCanvasDraftResponse.draft:type_name -> Superplane.Canvases.CanvasDraft
+	17,  // 68: Superplane.Canvases.PublishCanvasDraftResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	60,  // 69: Superplane.Canvases.PublishCanvasDraftResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	77,  // 70: Superplane.Canvases.PublishCanvasDraftResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	0,   // 71: Superplane.Canvases.ListCanvasChangeRequestsRequest.states:type_name -> Superplane.Canvases.CanvasChangeRequestState
+	77,  // 72: Superplane.Canvases.ListCanvasChangeRequestsResponse.change_requests:type_name -> Superplane.Canvases.CanvasChangeRequest
+	17,  // 73: Superplane.Canvases.ApproveCanvasChangeRequestResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	60,  // 74: Superplane.Canvases.ApproveCanvasChangeRequestResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	77,  // 75: Superplane.Canvases.ApproveCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	77,  // 76: Superplane.Canvases.RejectCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	106, // 77: Superplane.Canvases.CanvasDraft.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	16,  // 78: Superplane.Canvases.CanvasDraft.updated_by:type_name -> Superplane.Canvases.UserRef
+	112, // 79: Superplane.Canvases.CanvasDraft.created_at:type_name -> google.protobuf.Timestamp
+	112, // 80: Superplane.Canvases.CanvasDraft.updated_at:type_name -> google.protobuf.Timestamp
+	110, // 81: Superplane.Canvases.CanvasDraft.validation_errors:type_name -> Superplane.Canvases.CanvasDraft.ValidationError
+	61,  // 82: Superplane.Canvases.CanvasDraft.diff:type_name -> Superplane.Canvases.CanvasVersionDiff
+	0,   // 83: Superplane.Canvases.CanvasChangeRequest.state:type_name -> Superplane.Canvases.CanvasChangeRequestState
+	16,  // 84: Superplane.Canvases.CanvasChangeRequest.requested_by:type_name -> Superplane.Canvases.UserRef
+	16,  // 85: Superplane.Canvases.CanvasChangeRequest.reviewed_by:type_name -> Superplane.Canvases.UserRef
+	112, // 86: Superplane.Canvases.CanvasChangeRequest.reviewed_at:type_name -> google.protobuf.Timestamp
+	112, // 87: Superplane.Canvases.CanvasChangeRequest.created_at:type_name -> google.protobuf.Timestamp
+	61,  // 88: Superplane.Canvases.CanvasChangeRequest.diff:type_name -> Superplane.Canvases.CanvasVersionDiff
+	113, // 89: Superplane.Canvases.SimulateCanvasRequest.payload:type_name -> google.protobuf.Struct
+	80,  // 90: Superplane.Canvases.SimulateCanvasResponse.steps:type_name -> Superplane.Canvases.CanvasSimulationStep
+	5,   // 91: Superplane.Canvases.CanvasSimulationStep.mode:type_name -> Superplane.Canvases.CanvasSimulationStep.Mode
+	113, // 92: Superplane.Canvases.CanvasSimulationStep.configuration:type_name -> google.protobuf.Struct
+	113, // 93: Superplane.Canvases.CanvasSimulationStep.metadata:type_name -> google.protobuf.Struct
+	111, // 94: Superplane.Canvases.CanvasSimulationStep.outputs:type_name -> Superplane.Canvases.CanvasSimulationStep.Output
+	87,  // 95: Superplane.Canvases.ListCanvasVariablesResponse.variables:type_name -> Superplane.Canvases.CanvasVariable
+	115, // 96: Superplane.Canvases.SetCanvasVariableRequest.value:type_name -> google.protobuf.Value
+	87,  // 97: Superplane.Canvases.SetCanvasVariableResponse.variable:type_name -> Superplane.Canvases.CanvasVariable
+	115, // 98: Superplane.Canvases.CanvasVariable.value:type_name -> google.protobuf.Value
+	16,  // 99: Superplane.Canvases.CanvasVariable.updated_by:type_name -> Superplane.Canvases.UserRef
+	112, // 100: Superplane.Canvases.CanvasVariable.created_at:type_name -> google.protobuf.Timestamp
+	112, // 101: Superplane.Canvases.CanvasVariable.updated_at:type_name -> google.protobuf.Timestamp
+	91,  // 102: Superplane.Canvases.ListExecutionArtifactsResponse.artifacts:type_name -> Superplane.Canvases.ExecutionArtifact
+	112, // 103: Superplane.Canvases.ExecutionArtifact.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 104: Superplane.Canvases.GetExecutionLogsResponse.lines:type_name -> Superplane.Canvases.ExecutionLogLine
+	112, // 105: Superplane.Canvases.ExecutionLogLine.created_at:type_name -> google.protobuf.Timestamp
+	99,  // 106: Superplane.Canvases.DescribeCanvasRetentionResponse.policy:type_name -> Superplane.Canvases.CanvasRetentionPolicy
+	99,  // 107: Superplane.Canvases.DescribeCanvasRetentionResponse.effective_policy:type_name -> Superplane.Canvases.CanvasRetentionPolicy
+	100, // 108: Superplane.Canvases.DescribeCanvasRetentionResponse.stats:type_name -> Superplane.Canvases.CanvasRetentionStats
+	99,  // 109: Superplane.Canvases.UpdateCanvasRetentionPolicyRequest.policy:type_name -> Superplane.Canvases.CanvasRetentionPolicy
+	99,  // 110: Superplane.Canvases.UpdateCanvasRetentionPolicyResponse.policy:type_name -> Superplane.Canvases.CanvasRetentionPolicy
+	99,  // 111: Superplane.Canvases.UpdateCanvasRetentionPolicyResponse.effective_policy:type_name -> Superplane.Canvases.CanvasRetentionPolicy
+	112, // 112: Superplane.Canvases.CanvasRetentionStats.oldest_run_at:type_name -> google.protobuf.Timestamp
+	112, // 113: Superplane.Canvases.CanvasRetentionStats.last_enforced_at:type_name -> google.protobuf.Timestamp
+	112, // 114: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
+	112, // 115: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	94,  // 116: Superplane.Canvases.CanvasNodeExecutionLogMessage.lines:type_name -> Superplane.Canvases.ExecutionLogLine
+	112, // 117: Superplane.Canvases.CanvasNodeExecutionLogMessage.timestamp:type_name -> google.protobuf.Timestamp
+	112, // 118: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
+	112, // 119: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	112, // 120: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	16,  // 121: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
+	114, // 122: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
+	116, // 123: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
+	34,  // 124: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	35,  // 125: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	42,  // 126: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
+	4,   // 127: Superplane.Canvases.CanvasVersionDiff.NodeChange.type:type_name -> Superplane.Canvases.CanvasVersionDiff.ChangeType
+	114, // 128: Superplane.Canvases.CanvasVersionDiff.NodeChange.before:type_name -> Superplane.Components.Node
+	114, // 129: Superplane.Canvases.CanvasVersionDiff.NodeChange.after:type_name -> Superplane.Components.Node
+	4,   // 130: Superplane.Canvases.CanvasVersionDiff.EdgeChange.type:type_name -> Superplane.Canvases.CanvasVersionDiff.ChangeType
+	116, // 131: Superplane.Canvases.CanvasVersionDiff.EdgeChange.edge:type_name -> Superplane.Components.Edge
+	113, // 132: Superplane.Canvases.CanvasSimulationStep.Output.data:type_name -> google.protobuf.Struct
+	6,   // 133: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
+	10,  // 134: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
+	8,   // 135: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
+	12,  // 136: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
+	14,  // 137: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
+	22,  // 138: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
+	24,  // 139: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
+	26,  // 140: Superplane.Canvases.Canvases.UpdateNodeQueueItem:input_type -> Superplane.Canvases.UpdateNodeQueueItemRequest
+	28,  // 141: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
+	30,  // 142: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
+	18,  // 143: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
+	20,  // 144: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
+	36,  // 145: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
+	38,  // 146: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
+	32,  // 147: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
+	46,  // 148: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
+	48,  // 149: Superplane.Canvases.Canvases.RerunExecution:input_type -> Superplane.Canvases.RerunExecutionRequest
+	50,  // 150: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
+	40,  // 151: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
+	44,  // 152: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
+	52,  // 153: Superplane.Canvases.Canvases.ListCanvasVersions:input_type -> Superplane.Canvases.ListCanvasVersionsRequest
+	54,  // 154: Superplane.Canvases.Canvases.DescribeCanvasVersion:input_type -> Superplane.Canvases.DescribeCanvasVersionRequest
+	56,  // 155: Superplane.Canvases.Canvases.DiffCanvasVersions:input_type -> Superplane.Canvases.DiffCanvasVersionsRequest
+	58,  // 156: Superplane.Canvases.Canvases.RestoreCanvasVersion:input_type -> Superplane.Canvases.RestoreCanvasVersionRequest
+	62,  // 157: Superplane.Canvases.Canvases.DescribeCanvasDraft:input_type -> Superplane.Canvases.DescribeCanvasDraftRequest
+	64,  // 158: Superplane.Canvases.Canvases.UpdateCanvasDraft:input_type -> Superplane.Canvases.UpdateCanvasDraftRequest
+	66,  // 159: Superplane.Canvases.Canvases.DiscardCanvasDraft:input_type -> Superplane.Canvases.DiscardCanvasDraftRequest
+	68,  // 160: Superplane.Canvases.Canvases.PublishCanvasDraft:input_type -> Superplane.Canvases.PublishCanvasDraftRequest
+	70,  // 161: Superplane.Canvases.Canvases.ListCanvasChangeRequests:input_type -> Superplane.Canvases.ListCanvasChangeRequestsRequest
+	72,  // 162: Superplane.Canvases.Canvases.ApproveCanvasChangeRequest:input_type -> Superplane.Canvases.ApproveCanvasChangeRequestRequest
+	74,  // 163: Superplane.Canvases.Canvases.RejectCanvasChangeRequest:input_type -> Superplane.Canvases.RejectCanvasChangeRequestRequest
+	78,  // 164: Superplane.Canvases.Canvases.SimulateCanvas:input_type -> Superplane.Canvases.SimulateCanvasRequest
+	81,  // 165: Superplane.Canvases.Canvases.ListCanvasVariables:input_type -> Superplane.Canvases.ListCanvasVariablesRequest
+	83,  // 166: Superplane.Canvases.Canvases.SetCanvasVariable:input_type -> Superplane.Canvases.SetCanvasVariableRequest
+	85,  // 167: Superplane.Canvases.Canvases.DeleteCanvasVariable:input_type -> Superplane.Canvases.DeleteCanvasVariableRequest
+	88,  // 168: Superplane.Canvases.Canvases.ListExecutionArtifacts:input_type -> Superplane.Canvases.ListExecutionArtifactsRequest
+	90,  // 169: Superplane.Canvases.Canvases.DownloadExecutionArtifact:input_type -> Superplane.Canvases.DownloadExecutionArtifactRequest
+	92,  // 170: Superplane.Canvases.Canvases.GetExecutionLogs:input_type -> Superplane.Canvases.GetExecutionLogsRequest
+	95,  // 171: Superplane.Canvases.Canvases.DescribeCanvasRetention:input_type -> Superplane.Canvases.DescribeCanvasRetentionRequest
+	97,  // 172: Superplane.Canvases.Canvases.UpdateCanvasRetentionPolicy:input_type -> Superplane.Canvases.UpdateCanvasRetentionPolicyRequest
+	7,   // 173: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
+	11,  // 174: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
+	9,   // 175: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
+	13,  // 176: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
+	15,  // 177: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
+	23,  // 178: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
+	25,  // 179: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
+	27,  // 180: Superplane.Canvases.Canvases.UpdateNodeQueueItem:output_type -> Superplane.Canvases.UpdateNodeQueueItemResponse
+	29,  // 181: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
+	31,  // 182: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
+	19,  // 183: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
+	21,  // 184: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
+	37,  // 185: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
+	39,  // 186: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
+	33,  // 187: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
+	47,  // 188: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
+	49,  // 189: Superplane.Canvases.Canvases.RerunExecution:output_type -> Superplane.Canvases.RerunExecutionResponse
+	51,  // 190: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
+	41,  // 191: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
+	45,  // 192: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
+	53,  // 193: Superplane.Canvases.Canvases.ListCanvasVersions:output_type -> Superplane.Canvases.ListCanvasVersionsResponse
+	55,  // 194: Superplane.Canvases.Canvases.DescribeCanvasVersion:output_type -> Superplane.Canvases.DescribeCanvasVersionResponse
+	57,  // 195: Superplane.Canvases.Canvases.DiffCanvasVersions:output_type -> Superplane.Canvases.DiffCanvasVersionsResponse
+	59,  // 196: Superplane.Canvases.Canvases.RestoreCanvasVersion:output_type -> Superplane.Canvases.RestoreCanvasVersionResponse
+	63,  // 197: Superplane.Canvases.Canvases.DescribeCanvasDraft:output_type -> Superplane.Canvases.DescribeCanvasDraftResponse
+	65,  // 198: Superplane.Canvases.Canvases.UpdateCanvasDraft:output_type -> Superplane.Canvases.UpdateCanvasDraftResponse
+	67,  // 199: Superplane.Canvases.Canvases.DiscardCanvasDraft:output_type -> Superplane.Canvases.DiscardCanvasDraftResponse
+	69,  // 200: Superplane.Canvases.Canvases.PublishCanvasDraft:output_type -> Superplane.Canvases.PublishCanvasDraftResponse
+	71,  // 201: Superplane.Canvases.Canvases.ListCanvasChangeRequests:output_type -> Superplane.Canvases.ListCanvasChangeRequestsResponse
+	73,  // 202: Superplane.Canvases.Canvases.ApproveCanvasChangeRequest:output_type -> Superplane.Canvases.ApproveCanvasChangeRequestResponse
+	75,  // 203: Superplane.Canvases.Canvases.RejectCanvasChangeRequest:output_type -> Superplane.Canvases.RejectCanvasChangeRequestResponse
+	79,  // 204: Superplane.Canvases.Canvases.SimulateCanvas:output_type -> Superplane.Canvases.SimulateCanvasResponse
+	82,  // 205: Superplane.Canvases.Canvases.ListCanvasVariables:output_type -> Superplane.Canvases.ListCanvasVariablesResponse
+	84,  // 206: Superplane.Canvases.Canvases.SetCanvasVariable:output_type -> Superplane.Canvases.SetCanvasVariableResponse
+	86,  // 207: Superplane.Canvases.Canvases.DeleteCanvasVariable:output_type -> Superplane.Canvases.DeleteCanvasVariableResponse
+	89,  // 208: Superplane.Canvases.Canvases.ListExecutionArtifacts:output_type -> Superplane.Canvases.ListExecutionArtifactsResponse
+	117, // 209: Superplane.Canvases.Canvases.DownloadExecutionArtifact:output_type -> google.api.HttpBody
+	93,  // 210: Superplane.Canvases.Canvases.GetExecutionLogs:output_type -> Superplane.Canvases.GetExecutionLogsResponse
+	96,  // 211: Superplane.Canvases.Canvases.DescribeCanvasRetention:output_type -> Superplane.Canvases.DescribeCanvasRetentionResponse
+	98,  // 212: Superplane.Canvases.Canvases.UpdateCanvasRetentionPolicy:output_type -> Superplane.Canvases.UpdateCanvasRetentionPolicyResponse
+	173, // [173:213] is the sub-list for method output_type
+	133, // [133:173] is the sub-list for method input_type
+	133, // [133:133] is the sub-list for extension type_name
+	133, // [133:133] is the sub-list for extension extendee
+	0,   // [0:133] is the sub-list for field type_name
 }
 
 func init() { file_canvases_proto_init() }
@@ -3523,13 +7800,15 @@ func file_canvases_proto_init() {
 	if File_canvases_proto != nil {
 		return
 	}
+	file_canvases_proto_msgTypes[77].OneofWrappers = []any{}
+	file_canvases_proto_msgTypes[79].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_canvases_proto_rawDesc), len(file_canvases_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   48,
+			NumEnums:      6,
+			NumMessages:   106,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -8,6 +8,7 @@ package canvases
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,24 +20,46 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Canvases_ListCanvases_FullMethodName              = "/Superplane.Canvases.Canvases/ListCanvases"
-	Canvases_CreateCanvas_FullMethodName              = "/Superplane.Canvases.Canvases/CreateCanvas"
-	Canvases_DescribeCanvas_FullMethodName            = "/Superplane.Canvases.Canvases/DescribeCanvas"
-	Canvases_UpdateCanvas_FullMethodName              = "/Superplane.Canvases.Canvases/UpdateCanvas"
-	Canvases_DeleteCanvas_FullMethodName              = "/Superplane.Canvases.Canvases/DeleteCanvas"
-	Canvases_ListNodeQueueItems_FullMethodName        = "/Superplane.Canvases.Canvases/ListNodeQueueItems"
-	Canvases_DeleteNodeQueueItem_FullMethodName       = "/Superplane.Canvases.Canvases/DeleteNodeQueueItem"
-	Canvases_UpdateNodePause_FullMethodName           = "/Superplane.Canvases.Canvases/UpdateNodePause"
-	Canvases_ListNodeExecutions_FullMethodName        = "/Superplane.Canvases.Canvases/ListNodeExecutions"
-	Canvases_ListNodeEvents_FullMethodName            = "/Superplane.Canvases.Canvases/ListNodeEvents"
-	Canvases_EmitNodeEvent_FullMethodName             = "/Superplane.Canvases.Canvases/EmitNodeEvent"
-	Canvases_InvokeNodeExecutionAction_FullMethodName = "/Superplane.Canvases.Canvases/InvokeNodeExecutionAction"
-	Canvases_InvokeNodeTriggerAction_FullMethodName   = "/Superplane.Canvases.Canvases/InvokeNodeTriggerAction"
-	Canvases_ListChildExecutions_FullMethodName       = "/Superplane.Canvases.Canvases/ListChildExecutions"
-	Canvases_CancelExecution_FullMethodName           = "/Superplane.Canvases.Canvases/CancelExecution"
-	Canvases_ResolveExecutionErrors_FullMethodName    = "/Superplane.Canvases.Canvases/ResolveExecutionErrors"
-	Canvases_ListCanvasEvents_FullMethodName          = "/Superplane.Canvases.Canvases/ListCanvasEvents"
-	Canvases_ListEventExecutions_FullMethodName       = "/Superplane.Canvases.Canvases/ListEventExecutions"
+	Canvases_ListCanvases_FullMethodName                = "/Superplane.Canvases.Canvases/ListCanvases"
+	Canvases_CreateCanvas_FullMethodName                = "/Superplane.Canvases.Canvases/CreateCanvas"
+	Canvases_DescribeCanvas_FullMethodName              = "/Superplane.Canvases.Canvases/DescribeCanvas"
+	Canvases_UpdateCanvas_FullMethodName                = "/Superplane.Canvases.Canvases/UpdateCanvas"
+	Canvases_DeleteCanvas_FullMethodName                = "/Superplane.Canvases.Canvases/DeleteCanvas"
+	Canvases_ListNodeQueueItems_FullMethodName          = "/Superplane.Canvases.Canvases/ListNodeQueueItems"
+	Canvases_DeleteNodeQueueItem_FullMethodName         = "/Superplane.Canvases.Canvases/DeleteNodeQueueItem"
+	Canvases_UpdateNodeQueueItem_FullMethodName         = "/Superplane.Canvases.Canvases/UpdateNodeQueueItem"
+	Canvases_UpdateNodePause_FullMethodName             = "/Superplane.Canvases.Canvases/UpdateNodePause"
+	Canvases_ListNodeExecutions_FullMethodName          = "/Superplane.Canvases.Canvases/ListNodeExecutions"
+	Canvases_ListNodeEvents_FullMethodName              = "/Superplane.Canvases.Canvases/ListNodeEvents"
+	Canvases_EmitNodeEvent_FullMethodName               = "/Superplane.Canvases.Canvases/EmitNodeEvent"
+	Canvases_InvokeNodeExecutionAction_FullMethodName   = "/Superplane.Canvases.Canvases/InvokeNodeExecutionAction"
+	Canvases_InvokeNodeTriggerAction_FullMethodName     = "/Superplane.Canvases.Canvases/InvokeNodeTriggerAction"
+	Canvases_ListChildExecutions_FullMethodName         = "/Superplane.Canvases.Canvases/ListChildExecutions"
+	Canvases_CancelExecution_FullMethodName             = "/Superplane.Canvases.Canvases/CancelExecution"
+	Canvases_RerunExecution_FullMethodName              = "/Superplane.Canvases.Canvases/RerunExecution"
+	Canvases_ResolveExecutionErrors_FullMethodName      = "/Superplane.Canvases.Canvases/ResolveExecutionErrors"
+	Canvases_ListCanvasEvents_FullMethodName            = "/Superplane.Canvases.Canvases/ListCanvasEvents"
+	Canvases_ListEventExecutions_FullMethodName         = "/Superplane.Canvases.Canvases/ListEventExecutions"
+	Canvases_ListCanvasVersions_FullMethodName          = "/Superplane.Canvases.Canvases/ListCanvasVersions"
+	Canvases_DescribeCanvasVersion_FullMethodName       = "/Superplane.Canvases.Canvases/DescribeCanvasVersion"
+	Canvases_DiffCanvasVersions_FullMethodName          = "/Superplane.Canvases.Canvases/DiffCanvasVersions"
+	Canvases_RestoreCanvasVersion_FullMethodName        = "/Superplane.Canvases.Canvases/RestoreCanvasVersion"
+	Canvases_DescribeCanvasDraft_FullMethodName         = "/Superplane.Canvases.Canvases/DescribeCanvasDraft"
+	Canvases_UpdateCanvasDraft_FullMethodName           = "/Superplane.Canvases.Canvases/UpdateCanvasDraft"
+	Canvases_DiscardCanvasDraft_FullMethodName          = "/Superplane.Canvases.Canvases/DiscardCanvasDraft"
+	Canvases_PublishCanvasDraft_FullMethodName          = "/Superplane.Canvases.Canvases/PublishCanvasDraft"
+	Canvases_ListCanvasChangeRequests_FullMethodName    = "/Superplane.Canvases.Canvases/ListCanvasChangeRequests"
+	Canvases_ApproveCanvasChangeRequest_FullMethodName  = "/Superplane.Canvases.Canvases/ApproveCanvasChangeRequest"
+	Canvases_RejectCanvasChangeRequest_FullMethodName   = "/Superplane.Canvases.Canvases/RejectCanvasChangeRequest"
+	Canvases_SimulateCanvas_FullMethodName              = "/Superplane.Canvases.Canvases/SimulateCanvas"
+	Canvases_ListCanvasVariables_FullMethodName         = "/Superplane.Canvases.Canvases/ListCanvasVariables"
+	Canvases_SetCanvasVariable_FullMethodName           = "/Superplane.Canvases.Canvases/SetCanvasVariable"
+	Canvases_DeleteCanvasVariable_FullMethodName        = "/Superplane.Canvases.Canvases/DeleteCanvasVariable"
+	Canvases_ListExecutionArtifacts_FullMethodName      = "/Superplane.Canvases.Canvases/ListExecutionArtifacts"
+	Canvases_DownloadExecutionArtifact_FullMethodName   = "/Superplane.Canvases.Canvases/DownloadExecutionArtifact"
+	Canvases_GetExecutionLogs_FullMethodName            = "/Superplane.Canvases.Canvases/GetExecutionLogs"
+	Canvases_DescribeCanvasRetention_FullMethodName     = "/Superplane.Canvases.Canvases/DescribeCanvasRetention"
+	Canvases_UpdateCanvasRetentionPolicy_FullMethodName = "/Superplane.Canvases.Canvases/UpdateCanvasRetentionPolicy"
 )
 
 // CanvasesClient is the client API for Canvases service.
@@ -50,6 +73,7 @@ type CanvasesClient interface {
 	DeleteCanvas(ctx context.Context, in *DeleteCanvasRequest, opts ...grpc.CallOption) (*DeleteCanvasResponse, error)
 	ListNodeQueueItems(ctx context.Context, in *ListNodeQueueItemsRequest, opts ...grpc.CallOption) (*ListNodeQueueItemsResponse, error)
 	DeleteNodeQueueItem(ctx context.Context, in *DeleteNodeQueueItemRequest, opts ...grpc.CallOption) (*DeleteNodeQueueItemResponse, error)
+	UpdateNodeQueueItem(ctx context.Context, in *UpdateNodeQueueItemRequest, opts ...grpc.CallOption) (*UpdateNodeQueueItemResponse, error)
 	UpdateNodePause(ctx context.Context, in *UpdateNodePauseRequest, opts ...grpc.CallOption) (*UpdateNodePauseResponse, error)
 	ListNodeExecutions(ctx context.Context, in *ListNodeExecutionsRequest, opts ...grpc.CallOption) (*ListNodeExecutionsResponse, error)
 	ListNodeEvents(ctx context.Context, in *ListNodeEventsRequest, opts ...grpc.CallOption) (*ListNodeEventsResponse, error)
@@ -58,9 +82,30 @@ type CanvasesClient interface {
 	InvokeNodeTriggerAction(ctx context.Context, in *InvokeNodeTriggerActionRequest, opts ...grpc.CallOption) (*InvokeNodeTriggerActionResponse, error)
 	ListChildExecutions(ctx context.Context, in *ListChildExecutionsRequest, opts ...grpc.CallOption) (*ListChildExecutionsResponse, error)
 	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error)
+	RerunExecution(ctx context.Context, in *RerunExecutionRequest, opts ...grpc.CallOption) (*RerunExecutionResponse, error)
 	ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error)
 	ListCanvasEvents(ctx context.Context, in *ListCanvasEventsRequest, opts ...grpc.CallOption) (*ListCanvasEventsResponse, error)
 	ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error)
+	ListCanvasVersions(ctx context.Context, in *ListCanvasVersionsRequest, opts ...grpc.CallOption) (*ListCanvasVersionsResponse, error)
+	DescribeCanvasVersion(ctx context.Context, in *DescribeCanvasVersionRequest, opts ...grpc.CallOption) (*DescribeCanvasVersionResponse, error)
+	DiffCanvasVersions(ctx context.Context, in *DiffCanvasVersionsRequest, opts ...grpc.CallOption) (*DiffCanvasVersionsResponse, error)
+	RestoreCanvasVersion(ctx context.Context, in *RestoreCanvasVersionRequest, opts ...grpc.CallOption) (*RestoreCanvasVersionResponse, error)
+	DescribeCanvasDraft(ctx context.Context, in *DescribeCanvasDraftRequest, opts ...grpc.CallOption) (*DescribeCanvasDraftResponse, error)
+	UpdateCanvasDraft(ctx context.Context, in *UpdateCanvasDraftRequest, opts ...grpc.CallOption) (*UpdateCanvasDraftResponse, error)
+	DiscardCanvasDraft(ctx context.Context, in *DiscardCanvasDraftRequest, opts ...grpc.CallOption) (*DiscardCanvasDraftResponse, error)
+	PublishCanvasDraft(ctx context.Context, in *PublishCanvasDraftRequest, opts ...grpc.CallOption) (*PublishCanvasDraftResponse, error)
+	ListCanvasChangeRequests(ctx context.Context, in *ListCanvasChangeRequestsRequest, opts ...grpc.CallOption) (*ListCanvasChangeRequestsResponse, error)
+	ApproveCanvasChangeRequest(ctx context.Context, in *ApproveCanvasChangeRequestRequest, opts ...grpc.CallOption) (*ApproveCanvasChangeRequestResponse, error)
+	RejectCanvasChangeRequest(ctx context.Context, in *RejectCanvasChangeRequestRequest, opts ...grpc.CallOption) (*RejectCanvasChangeRequestResponse, error)
+	SimulateCanvas(ctx context.Context, in *SimulateCanvasRequest, opts ...grpc.CallOption) (*SimulateCanvasResponse, error)
+	ListCanvasVariables(ctx context.Context, in *ListCanvasVariablesRequest, opts ...grpc.CallOption) (*ListCanvasVariablesResponse, error)
+	SetCanvasVariable(ctx context.Context, in *SetCanvasVariableRequest, opts ...grpc.CallOption) (*SetCanvasVariableResponse, error)
+	DeleteCanvasVariable(ctx context.Context, in *DeleteCanvasVariableRequest, opts ...grpc.CallOption) (*DeleteCanvasVariableResponse, error)
+	ListExecutionArtifacts(ctx context.Context, in *ListExecutionArtifactsRequest, opts ...grpc.CallOption) (*ListExecutionArtifactsResponse, error)
+	DownloadExecutionArtifact(ctx context.Context, in *DownloadExecutionArtifactRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	GetExecutionLogs(ctx context.Context, in *GetExecutionLogsRequest, opts ...grpc.CallOption) (*GetExecutionLogsResponse, error)
+	DescribeCanvasRetention(ctx context.Context, in *DescribeCanvasRetentionRequest, opts ...grpc.CallOption) (*DescribeCanvasRetentionResponse, error)
+	UpdateCanvasRetentionPolicy(ctx context.Context, in *UpdateCanvasRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateCanvasRetentionPolicyResponse, error)
 }
 
 type canvasesClient struct {
@@ -141,6 +186,16 @@ func (c *canvasesClient) DeleteNodeQueueItem(ctx context.Context, in *DeleteNode
 	return out, nil
 }
 
+func (c *canvasesClient) UpdateNodeQueueItem(ctx context.Context, in *UpdateNodeQueueItemRequest, opts ...grpc.CallOption) (*UpdateNodeQueueItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNodeQueueItemResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateNodeQueueItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) UpdateNodePause(ctx context.Context, in *UpdateNodePauseRequest, opts ...grpc.CallOption) (*UpdateNodePauseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateNodePauseResponse)
@@ -221,6 +276,16 @@ func (c *canvasesClient) CancelExecution(ctx context.Context, in *CancelExecutio
 	return out, nil
 }
 
+func (c *canvasesClient) RerunExecution(ctx context.Context, in *RerunExecutionRequest, opts ...grpc.CallOption) (*RerunExecutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RerunExecutionResponse)
+	err := c.cc.Invoke(ctx, Canvases_RerunExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResolveExecutionErrorsResponse)
@@ -251,6 +316,206 @@ func (c *canvasesClient) ListEventExecutions(ctx context.Context, in *ListEventE
 	return out, nil
 }
 
+func (c *canvasesClient) ListCanvasVersions(ctx context.Context, in *ListCanvasVersionsRequest, opts ...grpc.CallOption) (*ListCanvasVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCanvasVersionsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListCanvasVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DescribeCanvasVersion(ctx context.Context, in *DescribeCanvasVersionRequest, opts ...grpc.CallOption) (*DescribeCanvasVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeCanvasVersionResponse)
+	err := c.cc.Invoke(ctx, Canvases_DescribeCanvasVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DiffCanvasVersions(ctx context.Context, in *DiffCanvasVersionsRequest, opts ...grpc.CallOption) (*DiffCanvasVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffCanvasVersionsResponse)
+	err := c.cc.Invoke(ctx, Canvases_DiffCanvasVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) RestoreCanvasVersion(ctx context.Context, in *RestoreCanvasVersionRequest, opts ...grpc.CallOption) (*RestoreCanvasVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCanvasVersionResponse)
+	err := c.cc.Invoke(ctx, Canvases_RestoreCanvasVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DescribeCanvasDraft(ctx context.Context, in *DescribeCanvasDraftRequest, opts ...grpc.CallOption) (*DescribeCanvasDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeCanvasDraftResponse)
+	err := c.cc.Invoke(ctx, Canvases_DescribeCanvasDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) UpdateCanvasDraft(ctx context.Context, in *UpdateCanvasDraftRequest, opts ...grpc.CallOption) (*UpdateCanvasDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasDraftResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DiscardCanvasDraft(ctx context.Context, in *DiscardCanvasDraftRequest, opts ...grpc.CallOption) (*DiscardCanvasDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardCanvasDraftResponse)
+	err := c.cc.Invoke(ctx, Canvases_DiscardCanvasDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) PublishCanvasDraft(ctx context.Context, in *PublishCanvasDraftRequest, opts ...grpc.CallOption) (*PublishCanvasDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishCanvasDraftResponse)
+	err := c.cc.Invoke(ctx, Canvases_PublishCanvasDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ListCanvasChangeRequests(ctx context.Context, in *ListCanvasChangeRequestsRequest, opts ...grpc.CallOption) (*ListCanvasChangeRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCanvasChangeRequestsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListCanvasChangeRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ApproveCanvasChangeRequest(ctx context.Context, in *ApproveCanvasChangeRequestRequest, opts ...grpc.CallOption) (*ApproveCanvasChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveCanvasChangeRequestResponse)
+	err := c.cc.Invoke(ctx, Canvases_ApproveCanvasChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) RejectCanvasChangeRequest(ctx context.Context, in *RejectCanvasChangeRequestRequest, opts ...grpc.CallOption) (*RejectCanvasChangeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectCanvasChangeRequestResponse)
+	err := c.cc.Invoke(ctx, Canvases_RejectCanvasChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) SimulateCanvas(ctx context.Context, in *SimulateCanvasRequest, opts ...grpc.CallOption) (*SimulateCanvasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateCanvasResponse)
+	err := c.cc.Invoke(ctx, Canvases_SimulateCanvas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ListCanvasVariables(ctx context.Context, in *ListCanvasVariablesRequest, opts ...grpc.CallOption) (*ListCanvasVariablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCanvasVariablesResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListCanvasVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) SetCanvasVariable(ctx context.Context, in *SetCanvasVariableRequest, opts ...grpc.CallOption) (*SetCanvasVariableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCanvasVariableResponse)
+	err := c.cc.Invoke(ctx, Canvases_SetCanvasVariable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DeleteCanvasVariable(ctx context.Context, in *DeleteCanvasVariableRequest, opts ...grpc.CallOption) (*DeleteCanvasVariableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCanvasVariableResponse)
+	err := c.cc.Invoke(ctx, Canvases_DeleteCanvasVariable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ListExecutionArtifacts(ctx context.Context, in *ListExecutionArtifactsRequest, opts ...grpc.CallOption) (*ListExecutionArtifactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExecutionArtifactsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListExecutionArtifacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DownloadExecutionArtifact(ctx context.Context, in *DownloadExecutionArtifactRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, Canvases_DownloadExecutionArtifact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) GetExecutionLogs(ctx context.Context, in *GetExecutionLogsRequest, opts ...grpc.CallOption) (*GetExecutionLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, Canvases_GetExecutionLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) DescribeCanvasRetention(ctx context.Context, in *DescribeCanvasRetentionRequest, opts ...grpc.CallOption) (*DescribeCanvasRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeCanvasRetentionResponse)
+	err := c.cc.Invoke(ctx, Canvases_DescribeCanvasRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) UpdateCanvasRetentionPolicy(ctx context.Context, in *UpdateCanvasRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateCanvasRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CanvasesServer is the server API for Canvases service.
 // All implementations should embed UnimplementedCanvasesServer
 // for forward compatibility.
@@ -262,6 +527,7 @@ type CanvasesServer interface {
 	DeleteCanvas(context.Context, *DeleteCanvasRequest) (*DeleteCanvasResponse, error)
 	ListNodeQueueItems(context.Context, *ListNodeQueueItemsRequest) (*ListNodeQueueItemsResponse, error)
 	DeleteNodeQueueItem(context.Context, *DeleteNodeQueueItemRequest) (*DeleteNodeQueueItemResponse, error)
+	UpdateNodeQueueItem(context.Context, *UpdateNodeQueueItemRequest) (*UpdateNodeQueueItemResponse, error)
 	UpdateNodePause(context.Context, *UpdateNodePauseRequest) (*UpdateNodePauseResponse, error)
 	ListNodeExecutions(context.Context, *ListNodeExecutionsRequest) (*ListNodeExecutionsResponse, error)
 	ListNodeEvents(context.Context, *ListNodeEventsRequest) (*ListNodeEventsResponse, error)
@@ -270,9 +536,30 @@ type CanvasesServer interface {
 	InvokeNodeTriggerAction(context.Context, *InvokeNodeTriggerActionRequest) (*InvokeNodeTriggerActionResponse, error)
 	ListChildExecutions(context.Context, *ListChildExecutionsRequest) (*ListChildExecutionsResponse, error)
 	CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error)
+	RerunExecution(context.Context, *RerunExecutionRequest) (*RerunExecutionResponse, error)
 	ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error)
 	ListCanvasEvents(context.Context, *ListCanvasEventsRequest) (*ListCanvasEventsResponse, error)
 	ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error)
+	ListCanvasVersions(context.Context, *ListCanvasVersionsRequest) (*ListCanvasVersionsResponse, error)
+	DescribeCanvasVersion(context.Context, *DescribeCanvasVersionRequest) (*DescribeCanvasVersionResponse, error)
+	DiffCanvasVersions(context.Context, *DiffCanvasVersionsRequest) (*DiffCanvasVersionsResponse, error)
+	RestoreCanvasVersion(context.Context, *RestoreCanvasVersionRequest) (*RestoreCanvasVersionResponse, error)
+	DescribeCanvasDraft(context.Context, *DescribeCanvasDraftRequest) (*DescribeCanvasDraftResponse, error)
+	UpdateCanvasDraft(context.Context, *UpdateCanvasDraftRequest) (*UpdateCanvasDraftResponse, error)
+	DiscardCanvasDraft(context.Context, *DiscardCanvasDraftRequest) (*DiscardCanvasDraftResponse, error)
+	PublishCanvasDraft(context.Context, *PublishCanvasDraftRequest) (*PublishCanvasDraftResponse, error)
+	ListCanvasChangeRequests(context.Context, *ListCanvasChangeRequestsRequest) (*ListCanvasChangeRequestsResponse, error)
+	ApproveCanvasChangeRequest(context.Context, *ApproveCanvasChangeRequestRequest) (*ApproveCanvasChangeRequestResponse, error)
+	RejectCanvasChangeRequest(context.Context, *RejectCanvasChangeRequestRequest) (*RejectCanvasChangeRequestResponse, error)
+	SimulateCanvas(context.Context, *SimulateCanvasRequest) (*SimulateCanvasResponse, error)
+	ListCanvasVariables(context.Context, *ListCanvasVariablesRequest) (*ListCanvasVariablesResponse, error)
+	SetCanvasVariable(context.Context, *SetCanvasVariableRequest) (*SetCanvasVariableResponse, error)
+	DeleteCanvasVariable(context.Context, *DeleteCanvasVariableRequest) (*DeleteCanvasVariableResponse, error)
+	ListExecutionArtifacts(context.Context, *ListExecutionArtifactsRequest) (*ListExecutionArtifactsResponse, error)
+	DownloadExecutionArtifact(context.Context, *DownloadExecutionArtifactRequest) (*httpbody.HttpBody, error)
+	GetExecutionLogs(context.Context, *GetExecutionLogsRequest) (*GetExecutionLogsResponse, error)
+	DescribeCanvasRetention(context.Context, *DescribeCanvasRetentionRequest) (*DescribeCanvasRetentionResponse, error)
+	UpdateCanvasRetentionPolicy(context.Context, *UpdateCanvasRetentionPolicyRequest) (*UpdateCanvasRetentionPolicyResponse, error)
 }
 
 // UnimplementedCanvasesServer should be embedded to have
@@ -303,6 +590,9 @@ func (UnimplementedCanvasesServer) ListNodeQueueItems(context.Context, *ListNode
 func (UnimplementedCanvasesServer) DeleteNodeQueueItem(context.Context, *DeleteNodeQueueItemRequest) (*DeleteNodeQueueItemResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteNodeQueueItem not implemented")
 }
+func (UnimplementedCanvasesServer) UpdateNodeQueueItem(context.Context, *UpdateNodeQueueItemRequest) (*UpdateNodeQueueItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateNodeQueueItem not implemented")
+}
 func (UnimplementedCanvasesServer) UpdateNodePause(context.Context, *UpdateNodePauseRequest) (*UpdateNodePauseResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateNodePause not implemented")
 }
@@ -327,6 +617,9 @@ func (UnimplementedCanvasesServer) ListChildExecutions(context.Context, *ListChi
 func (UnimplementedCanvasesServer) CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelExecution not implemented")
 }
+func (UnimplementedCanvasesServer) RerunExecution(context.Context, *RerunExecutionRequest) (*RerunExecutionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RerunExecution not implemented")
+}
 func (UnimplementedCanvasesServer) ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResolveExecutionErrors not implemented")
 }
@@ -336,6 +629,66 @@ func (UnimplementedCanvasesServer) ListCanvasEvents(context.Context, *ListCanvas
 func (UnimplementedCanvasesServer) ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEventExecutions not implemented")
 }
+func (UnimplementedCanvasesServer) ListCanvasVersions(context.Context, *ListCanvasVersionsRequest) (*ListCanvasVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCanvasVersions not implemented")
+}
+func (UnimplementedCanvasesServer) DescribeCanvasVersion(context.Context, *DescribeCanvasVersionRequest) (*DescribeCanvasVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeCanvasVersion not implemented")
+}
+func (UnimplementedCanvasesServer) DiffCanvasVersions(context.Context, *DiffCanvasVersionsRequest) (*DiffCanvasVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffCanvasVersions not implemented")
+}
+func (UnimplementedCanvasesServer) RestoreCanvasVersion(context.Context, *RestoreCanvasVersionRequest) (*RestoreCanvasVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreCanvasVersion not implemented")
+}
+func (UnimplementedCanvasesServer) DescribeCanvasDraft(context.Context, *DescribeCanvasDraftRequest) (*DescribeCanvasDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeCanvasDraft not implemented")
+}
+func (UnimplementedCanvasesServer) UpdateCanvasDraft(context.Context, *UpdateCanvasDraftRequest) (*UpdateCanvasDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasDraft not implemented")
+}
+func (UnimplementedCanvasesServer) DiscardCanvasDraft(context.Context, *DiscardCanvasDraftRequest) (*DiscardCanvasDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscardCanvasDraft not implemented")
+}
+func (UnimplementedCanvasesServer) PublishCanvasDraft(context.Context, *PublishCanvasDraftRequest) (*PublishCanvasDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishCanvasDraft not implemented")
+}
+func (UnimplementedCanvasesServer) ListCanvasChangeRequests(context.Context, *ListCanvasChangeRequestsRequest) (*ListCanvasChangeRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCanvasChangeRequests not implemented")
+}
+func (UnimplementedCanvasesServer) ApproveCanvasChangeRequest(context.Context, *ApproveCanvasChangeRequestRequest) (*ApproveCanvasChangeRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveCanvasChangeRequest not implemented")
+}
+func (UnimplementedCanvasesServer) RejectCanvasChangeRequest(context.Context, *RejectCanvasChangeRequestRequest) (*RejectCanvasChangeRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectCanvasChangeRequest not implemented")
+}
+func (UnimplementedCanvasesServer) SimulateCanvas(context.Context, *SimulateCanvasRequest) (*SimulateCanvasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateCanvas not implemented")
+}
+func (UnimplementedCanvasesServer) ListCanvasVariables(context.Context, *ListCanvasVariablesRequest) (*ListCanvasVariablesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCanvasVariables not implemented")
+}
+func (UnimplementedCanvasesServer) SetCanvasVariable(context.Context, *SetCanvasVariableRequest) (*SetCanvasVariableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCanvasVariable not implemented")
+}
+func (UnimplementedCanvasesServer) DeleteCanvasVariable(context.Context, *DeleteCanvasVariableRequest) (*DeleteCanvasVariableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCanvasVariable not implemented")
+}
+func (UnimplementedCanvasesServer) ListExecutionArtifacts(context.Context, *ListExecutionArtifactsRequest) (*ListExecutionArtifactsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExecutionArtifacts not implemented")
+}
+func (UnimplementedCanvasesServer) DownloadExecutionArtifact(context.Context, *DownloadExecutionArtifactRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Error(codes.Unimplemented, "method DownloadExecutionArtifact not implemented")
+}
+func (UnimplementedCanvasesServer) GetExecutionLogs(context.Context, *GetExecutionLogsRequest) (*GetExecutionLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExecutionLogs not implemented")
+}
+func (UnimplementedCanvasesServer) DescribeCanvasRetention(context.Context, *DescribeCanvasRetentionRequest) (*DescribeCanvasRetentionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeCanvasRetention not implemented")
+}
+func (UnimplementedCanvasesServer) UpdateCanvasRetentionPolicy(context.Context, *UpdateCanvasRetentionPolicyRequest) (*UpdateCanvasRetentionPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasRetentionPolicy not implemented")
+}
 func (UnimplementedCanvasesServer) testEmbeddedByValue() {}
 
 // UnsafeCanvasesServer may be embedded to opt out of forward compatibility for this service.
@@ -482,6 +835,24 @@ func _Canvases_DeleteNodeQueueItem_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_UpdateNodeQueueItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeQueueItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateNodeQueueItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateNodeQueueItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateNodeQueueItem(ctx, req.(*UpdateNodeQueueItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_UpdateNodePause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateNodePauseRequest)
 	if err := dec(in); err != nil {
@@ -626,6 +997,24 @@ func _Canvases_CancelExecution_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_RerunExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RerunExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RerunExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RerunExecution(ctx, req.(*RerunExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ResolveExecutionErrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveExecutionErrorsRequest)
 	if err := dec(in); err != nil {
@@ -680,6 +1069,366 @@ func _Canvases_ListEventExecutions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_ListCanvasVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanvasVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListCanvasVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListCanvasVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListCanvasVersions(ctx, req.(*ListCanvasVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DescribeCanvasVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCanvasVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DescribeCanvasVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DescribeCanvasVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DescribeCanvasVersion(ctx, req.(*DescribeCanvasVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DiffCanvasVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCanvasVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DiffCanvasVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DiffCanvasVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DiffCanvasVersions(ctx, req.(*DiffCanvasVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_RestoreCanvasVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCanvasVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RestoreCanvasVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RestoreCanvasVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RestoreCanvasVersion(ctx, req.(*RestoreCanvasVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DescribeCanvasDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCanvasDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DescribeCanvasDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DescribeCanvasDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DescribeCanvasDraft(ctx, req.(*DescribeCanvasDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_UpdateCanvasDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasDraft(ctx, req.(*UpdateCanvasDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DiscardCanvasDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardCanvasDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DiscardCanvasDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DiscardCanvasDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DiscardCanvasDraft(ctx, req.(*DiscardCanvasDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_PublishCanvasDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishCanvasDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).PublishCanvasDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_PublishCanvasDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).PublishCanvasDraft(ctx, req.(*PublishCanvasDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ListCanvasChangeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanvasChangeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListCanvasChangeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListCanvasChangeRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListCanvasChangeRequests(ctx, req.(*ListCanvasChangeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ApproveCanvasChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCanvasChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ApproveCanvasChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ApproveCanvasChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ApproveCanvasChangeRequest(ctx, req.(*ApproveCanvasChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_RejectCanvasChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectCanvasChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RejectCanvasChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RejectCanvasChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RejectCanvasChangeRequest(ctx, req.(*RejectCanvasChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_SimulateCanvas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateCanvasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).SimulateCanvas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_SimulateCanvas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).SimulateCanvas(ctx, req.(*SimulateCanvasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ListCanvasVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanvasVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListCanvasVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListCanvasVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListCanvasVariables(ctx, req.(*ListCanvasVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_SetCanvasVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCanvasVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).SetCanvasVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_SetCanvasVariable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).SetCanvasVariable(ctx, req.(*SetCanvasVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DeleteCanvasVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCanvasVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DeleteCanvasVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DeleteCanvasVariable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DeleteCanvasVariable(ctx, req.(*DeleteCanvasVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ListExecutionArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExecutionArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListExecutionArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListExecutionArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListExecutionArtifacts(ctx, req.(*ListExecutionArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DownloadExecutionArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadExecutionArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DownloadExecutionArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DownloadExecutionArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DownloadExecutionArtifact(ctx, req.(*DownloadExecutionArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_GetExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).GetExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_GetExecutionLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).GetExecutionLogs(ctx, req.(*GetExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_DescribeCanvasRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCanvasRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).DescribeCanvasRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_DescribeCanvasRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).DescribeCanvasRetention(ctx, req.(*DescribeCanvasRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_UpdateCanvasRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasRetentionPolicy(ctx, req.(*UpdateCanvasRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Canvases_ServiceDesc is the grpc.ServiceDesc for Canvases service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -715,6 +1464,10 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNodeQueueItem",
 			Handler:    _Canvases_DeleteNodeQueueItem_Handler,
 		},
+		{
+			MethodName: "UpdateNodeQueueItem",
+			Handler:    _Canvases_UpdateNodeQueueItem_Handler,
+		},
 		{
 			MethodName: "UpdateNodePause",
 			Handler:    _Canvases_UpdateNodePause_Handler,
@@ -747,6 +1500,10 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelExecution",
 			Handler:    _Canvases_CancelExecution_Handler,
 		},
+		{
+			MethodName: "RerunExecution",
+			Handler:    _Canvases_RerunExecution_Handler,
+		},
 		{
 			MethodName: "ResolveExecutionErrors",
 			Handler:    _Canvases_ResolveExecutionErrors_Handler,
@@ -759,6 +1516,86 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEventExecutions",
 			Handler:    _Canvases_ListEventExecutions_Handler,
 		},
+		{
+			MethodName: "ListCanvasVersions",
+			Handler:    _Canvases_ListCanvasVersions_Handler,
+		},
+		{
+			MethodName: "DescribeCanvasVersion",
+			Handler:    _Canvases_DescribeCanvasVersion_Handler,
+		},
+		{
+			MethodName: "DiffCanvasVersions",
+			Handler:    _Canvases_DiffCanvasVersions_Handler,
+		},
+		{
+			MethodName: "RestoreCanvasVersion",
+			Handler:    _Canvases_RestoreCanvasVersion_Handler,
+		},
+		{
+			MethodName: "DescribeCanvasDraft",
+			Handler:    _Canvases_DescribeCanvasDraft_Handler,
+		},
+		{
+			MethodName: "UpdateCanvasDraft",
+			Handler:    _Canvases_UpdateCanvasDraft_Handler,
+		},
+		{
+			MethodName: "DiscardCanvasDraft",
+			Handler:    _Canvases_DiscardCanvasDraft_Handler,
+		},
+		{
+			MethodName: "PublishCanvasDraft",
+			Handler:    _Canvases_PublishCanvasDraft_Handler,
+		},
+		{
+			MethodName: "ListCanvasChangeRequests",
+			Handler:    _Canvases_ListCanvasChangeRequests_Handler,
+		},
+		{
+			MethodName: "ApproveCanvasChangeRequest",
+			Handler:    _Canvases_ApproveCanvasChangeRequest_Handler,
+		},
+		{
+			MethodName: "RejectCanvasChangeRequest",
+			Handler:    _Canvases_RejectCanvasChangeRequest_Handler,
+		},
+		{
+			MethodName: "SimulateCanvas",
+			Handler:    _Canvases_SimulateCanvas_Handler,
+		},
+		{
+			MethodName: "ListCanvasVariables",
+			Handler:    _Canvases_ListCanvasVariables_Handler,
+		},
+		{
+			MethodName: "SetCanvasVariable",
+			Handler:    _Canvases_SetCanvasVariable_Handler,
+		},
+		{
+			MethodName: "DeleteCanvasVariable",
+			Handler:    _Canvases_DeleteCanvasVariable_Handler,
+		},
+		{
+			MethodName: "ListExecutionArtifacts",
+			Handler:    _Canvases_ListExecutionArtifacts_Handler,
+		},
+		{
+			MethodName: "DownloadExecutionArtifact",
+			Handler:    _Canvases_DownloadExecutionArtifact_Handler,
+		},
+		{
+			MethodName: "GetExecutionLogs",
+			Handler:    _Canvases_GetExecutionLogs_Handler,
+		},
+		{
+			MethodName: "DescribeCanvasRetention",
+			Handler:    _Canvases_DescribeCanvasRetention_Handler,
+		},
+		{
+			MethodName: "UpdateCanvasRetentionPolicy",
+			Handler:    _Canvases_UpdateCanvasRetentionPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canvases.proto",
@@ -78,6 +78,107 @@ func (Node_Type) EnumDescriptor() ([]byte, []int) {
 	return file_components_proto_rawDescGZIP(), []int{9, 0}
 }
 
+type Node_ConcurrencyGroup_Policy int32
+
+const (
+	Node_ConcurrencyGroup_POLICY_QUEUE              Node_ConcurrencyGroup_Policy = 0
+	Node_ConcurrencyGroup_POLICY_CANCEL_IN_PROGRESS Node_ConcurrencyGroup_Policy = 1
+	Node_ConcurrencyGroup_POLICY_SKIP_IF_RUNNING    Node_ConcurrencyGroup_Policy = 2
+)
+
+// Enum value maps for Node_ConcurrencyGroup_Policy.
+var (
+	Node_ConcurrencyGroup_Policy_name = map[int32]string{
+		0: "POLICY_QUEUE",
+		1: "POLICY_CANCEL_IN_PROGRESS",
+		2: "POLICY_SKIP_IF_RUNNING",
+	}
+	Node_ConcurrencyGroup_Policy_value = map[string]int32{
+		"POLICY_QUEUE":              0,
+		"POLICY_CANCEL_IN_PROGRESS": 1,
+		"POLICY_SKIP_IF_RUNNING":    2,
+	}
+)
+
+func (x Node_ConcurrencyGroup_Policy) Enum() *Node_ConcurrencyGroup_Policy {
+	p := new(Node_ConcurrencyGroup_Policy)
+	*p = x
+	return p
+}
+
+func (x Node_ConcurrencyGroup_Policy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Node_ConcurrencyGroup_Policy) Descriptor() protoreflect.EnumDescriptor {
+	return file_components_proto_enumTypes[1].Descriptor()
+}
+
+func (Node_ConcurrencyGroup_Policy) Type() protoreflect.EnumType {
+	return &file_components_proto_enumTypes[1]
+}
+
+func (x Node_ConcurrencyGroup_Policy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Node_ConcurrencyGroup_Policy.Descriptor instead.
+func (Node_ConcurrencyGroup_Policy) EnumDescriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{9, 4, 0}
+}
+
+type Node_QueuePolicy_Strategy int32
+
+const (
+	Node_QueuePolicy_STRATEGY_NONE            Node_QueuePolicy_Strategy = 0
+	Node_QueuePolicy_STRATEGY_DEBOUNCE        Node_QueuePolicy_Strategy = 1
+	Node_QueuePolicy_STRATEGY_COALESCE        Node_QueuePolicy_Strategy = 2
+	Node_QueuePolicy_STRATEGY_DROP_DUPLICATES Node_QueuePolicy_Strategy = 3
+)
+
+// Enum value maps for Node_QueuePolicy_Strategy.
+var (
+	Node_QueuePolicy_Strategy_name = map[int32]string{
+		0: "STRATEGY_NONE",
+		1: "STRATEGY_DEBOUNCE",
+		2: "STRATEGY_COALESCE",
+		3: "STRATEGY_DROP_DUPLICATES",
+	}
+	Node_QueuePolicy_Strategy_value = map[string]int32{
+		"STRATEGY_NONE":            0,
+		"STRATEGY_DEBOUNCE":        1,
+		"STRATEGY_COALESCE":        2,
+		"STRATEGY_DROP_DUPLICATES": 3,
+	}
+)
+
+func (x Node_QueuePolicy_Strategy) Enum() *Node_QueuePolicy_Strategy {
+	p := new(Node_QueuePolicy_Strategy)
+	*p = x
+	return p
+}
+
+func (x Node_QueuePolicy_Strategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Node_QueuePolicy_Strategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_components_proto_enumTypes[2].Descriptor()
+}
+
+func (Node_QueuePolicy_Strategy) Type() protoreflect.EnumType {
+	return &file_components_proto_enumTypes[2]
+}
+
+func (x Node_QueuePolicy_Strategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Node_QueuePolicy_Strategy.Descriptor instead.
+func (Node_QueuePolicy_Strategy) EnumDescriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{9, 6, 0}
+}
+
 type ListComponentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -555,24 +656,29 @@ func (x *ListComponentActionsResponse) GetActions() []*ComponentAction {
 }
 
 type Node struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type           Node_Type              `protobuf:"varint,3,opt,name=type,proto3,enum=Superplane.Components.Node_Type" json:"type,omitempty"`
-	Configuration  *_struct.Struct        `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	Metadata       *_struct.Struct        `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Position       *Position              `protobuf:"bytes,6,opt,name=position,proto3" json:"position,omitempty"`
-	Component      *Node_ComponentRef     `protobuf:"bytes,7,opt,name=component,proto3" json:"component,omitempty"`
-	Blueprint      *Node_BlueprintRef     `protobuf:"bytes,8,opt,name=blueprint,proto3" json:"blueprint,omitempty"`
-	Trigger        *Node_TriggerRef       `protobuf:"bytes,9,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	Widget         *Node_WidgetRef        `protobuf:"bytes,10,opt,name=widget,proto3" json:"widget,omitempty"`
-	IsCollapsed    bool                   `protobuf:"varint,11,opt,name=is_collapsed,json=isCollapsed,proto3" json:"is_collapsed,omitempty"`
-	Integration    *IntegrationRef        `protobuf:"bytes,12,opt,name=integration,proto3" json:"integration,omitempty"`
-	ErrorMessage   string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	WarningMessage string                 `protobuf:"bytes,14,opt,name=warning_message,json=warningMessage,proto3" json:"warning_message,omitempty"`
-	Paused         bool                   `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                    Node_Type              `protobuf:"varint,3,opt,name=type,proto3,enum=Superplane.Components.Node_Type" json:"type,omitempty"`
+	Configuration           *_struct.Struct        `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Metadata                *_struct.Struct        `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Position                *Position              `protobuf:"bytes,6,opt,name=position,proto3" json:"position,omitempty"`
+	Component               *Node_ComponentRef     `protobuf:"bytes,7,opt,name=component,proto3" json:"component,omitempty"`
+	Blueprint               *Node_BlueprintRef     `protobuf:"bytes,8,opt,name=blueprint,proto3" json:"blueprint,omitempty"`
+	Trigger                 *Node_TriggerRef       `protobuf:"bytes,9,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Widget                  *Node_WidgetRef        `protobuf:"bytes,10,opt,name=widget,proto3" json:"widget,omitempty"`
+	IsCollapsed             bool                   `protobuf:"varint,11,opt,name=is_collapsed,json=isCollapsed,proto3" json:"is_collapsed,omitempty"`
+	Integration             *IntegrationRef        `protobuf:"bytes,12,opt,name=integration,proto3" json:"integration,omitempty"`
+	ErrorMessage            string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	WarningMessage          string                 `protobuf:"bytes,14,opt,name=warning_message,json=warningMessage,proto3" json:"warning_message,omitempty"`
+	Paused                  bool                   `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	ConcurrencyGroup        *Node_ConcurrencyGroup `protobuf:"bytes,16,opt,name=concurrency_group,json=concurrencyGroup,proto3" json:"concurrency_group,omitempty"`
+	RetryPolicy             *Node_RetryPolicy      `protobuf:"bytes,17,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	ExecutionTimeoutSeconds int32                  `protobuf:"varint,18,opt,name=execution_timeout_seconds,json=executionTimeoutSeconds,proto3" json:"execution_timeout_seconds,omitempty"`
+	QueuePolicy             *Node_QueuePolicy      `protobuf:"bytes,19,opt,name=queue_policy,json=queuePolicy,proto3" json:"queue_policy,omitempty"`
+	RateLimit               *Node_RateLimit        `protobuf:"bytes,20,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *Node) Reset() {
@@ -710,6 +816,41 @@ func (x *Node) GetPaused() bool {
 	return false
 }
 
+func (x *Node) GetConcurrencyGroup() *Node_ConcurrencyGroup {
+	if x != nil {
+		return x.ConcurrencyGroup
+	}
+	return nil
+}
+
+func (x *Node) GetRetryPolicy() *Node_RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Node) GetExecutionTimeoutSeconds() int32 {
+	if x != nil {
+		return x.ExecutionTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Node) GetQueuePolicy() *Node_QueuePolicy {
+	if x != nil {
+		return x.QueuePolicy
+	}
+	return nil
+}
+
+func (x *Node) GetRateLimit() *Node_RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
 type Position struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
@@ -1159,6 +1300,254 @@ func (x *Node_BlueprintRef) GetId() string {
 	return ""
 }
 
+type Node_ConcurrencyGroup struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Name          string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Policy        Node_ConcurrencyGroup_Policy `protobuf:"varint,2,opt,name=policy,proto3,enum=Superplane.Components.Node_ConcurrencyGroup_Policy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node_ConcurrencyGroup) Reset() {
+	*x = Node_ConcurrencyGroup{}
+	mi := &file_components_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node_ConcurrencyGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node_ConcurrencyGroup) ProtoMessage() {}
+
+func (x *Node_ConcurrencyGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node_ConcurrencyGroup.ProtoReflect.Descriptor instead.
+func (*Node_ConcurrencyGroup) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{9, 4}
+}
+
+func (x *Node_ConcurrencyGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node_ConcurrencyGroup) GetPolicy() Node_ConcurrencyGroup_Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return Node_ConcurrencyGroup_POLICY_QUEUE
+}
+
+type Node_RetryPolicy struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts         int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	InitialDelaySeconds int32                  `protobuf:"varint,2,opt,name=initial_delay_seconds,json=initialDelaySeconds,proto3" json:"initial_delay_seconds,omitempty"`
+	MaxDelaySeconds     int32                  `protobuf:"varint,3,opt,name=max_delay_seconds,json=maxDelaySeconds,proto3" json:"max_delay_seconds,omitempty"`
+	BackoffMultiplier   float64                `protobuf:"fixed64,4,opt,name=backoff_multiplier,json=backoffMultiplier,proto3" json:"backoff_multiplier,omitempty"`
+	Jitter              float64                `protobuf:"fixed64,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	RetryOn             []string               `protobuf:"bytes,6,rep,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Node_RetryPolicy) Reset() {
+	*x = Node_RetryPolicy{}
+	mi := &file_components_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node_RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node_RetryPolicy) ProtoMessage() {}
+
+func (x *Node_RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node_RetryPolicy.ProtoReflect.Descriptor instead.
+func (*Node_RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{9, 5}
+}
+
+func (x *Node_RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Node_RetryPolicy) GetInitialDelaySeconds() int32 {
+	if x != nil {
+		return x.InitialDelaySeconds
+	}
+	return 0
+}
+
+func (x *Node_RetryPolicy) GetMaxDelaySeconds() int32 {
+	if x != nil {
+		return x.MaxDelaySeconds
+	}
+	return 0
+}
+
+func (x *Node_RetryPolicy) GetBackoffMultiplier() float64 {
+	if x != nil {
+		return x.BackoffMultiplier
+	}
+	return 0
+}
+
+func (x *Node_RetryPolicy) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *Node_RetryPolicy) GetRetryOn() []string {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
+type Node_QueuePolicy struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	Strategy        Node_QueuePolicy_Strategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=Superplane.Components.Node_QueuePolicy_Strategy" json:"strategy,omitempty"`
+	DebounceSeconds int32                     `protobuf:"varint,2,opt,name=debounce_seconds,json=debounceSeconds,proto3" json:"debounce_seconds,omitempty"`
+	Key             string                    `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Node_QueuePolicy) Reset() {
+	*x = Node_QueuePolicy{}
+	mi := &file_components_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node_QueuePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node_QueuePolicy) ProtoMessage() {}
+
+func (x *Node_QueuePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node_QueuePolicy.ProtoReflect.Descriptor instead.
+func (*Node_QueuePolicy) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{9, 6}
+}
+
+func (x *Node_QueuePolicy) GetStrategy() Node_QueuePolicy_Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return Node_QueuePolicy_STRATEGY_NONE
+}
+
+func (x *Node_QueuePolicy) GetDebounceSeconds() int32 {
+	if x != nil {
+		return x.DebounceSeconds
+	}
+	return 0
+}
+
+func (x *Node_QueuePolicy) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type Node_RateLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	PeriodSeconds int32                  `protobuf:"varint,2,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node_RateLimit) Reset() {
+	*x = Node_RateLimit{}
+	mi := &file_components_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node_RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node_RateLimit) ProtoMessage() {}
+
+func (x *Node_RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node_RateLimit.ProtoReflect.Descriptor instead.
+func (*Node_RateLimit) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{9, 7}
+}
+
+func (x *Node_RateLimit) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Node_RateLimit) GetPeriodSeconds() int32 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
 var File_components_proto protoreflect.FileDescriptor
 
 const file_components_proto_rawDesc = "" +
@@ -1195,7 +1584,7 @@ const file_components_proto_rawDesc = "" +
 	"parameters\x18\x03 \x03(\v2\x1f.Superplane.Configuration.FieldR\n" +
 	"parameters\"`\n" +
 	"\x1cListComponentActionsResponse\x12@\n" +
-	"\aactions\x18\x01 \x03(\v2&.Superplane.Components.ComponentActionR\aactions\"\xce\a\n" +
+	"\aactions\x18\x01 \x03(\v2&.Superplane.Components.ComponentActionR\aactions\"\xd5\x10\n" +
 	"\x04Node\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
@@ -1212,7 +1601,13 @@ const file_components_proto_rawDesc = "" +
 	"\vintegration\x18\f \x01(\v2%.Superplane.Components.IntegrationRefR\vintegration\x12#\n" +
 	"\rerror_message\x18\r \x01(\tR\ferrorMessage\x12'\n" +
 	"\x0fwarning_message\x18\x0e \x01(\tR\x0ewarningMessage\x12\x16\n" +
-	"\x06paused\x18\x0f \x01(\bR\x06paused\x1a\"\n" +
+	"\x06paused\x18\x0f \x01(\bR\x06paused\x12Y\n" +
+	"\x11concurrency_group\x18\x10 \x01(\v2,.Superplane.Components.Node.ConcurrencyGroupR\x10concurrencyGroup\x12J\n" +
+	"\fretry_policy\x18\x11 \x01(\v2'.Superplane.Components.Node.RetryPolicyR\vretryPolicy\x12:\n" +
+	"\x19execution_timeout_seconds\x18\x12 \x01(\x05R\x17executionTimeoutSeconds\x12J\n" +
+	"\fqueue_policy\x18\x13 \x01(\v2'.Superplane.Components.Node.QueuePolicyR\vqueuePolicy\x12D\n" +
+	"\n" +
+	"rate_limit\x18\x14 \x01(\v2%.Superplane.Components.Node.RateLimitR\trateLimit\x1a\"\n" +
 	"\fComponentRef\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x1a \n" +
 	"\n" +
@@ -1221,7 +1616,33 @@ const file_components_proto_rawDesc = "" +
 	"\tWidgetRef\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x1a\x1e\n" +
 	"\fBlueprintRef\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x1a\xca\x01\n" +
+	"\x10ConcurrencyGroup\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12K\n" +
+	"\x06policy\x18\x02 \x01(\x0e23.Superplane.Components.Node.ConcurrencyGroup.PolicyR\x06policy\"U\n" +
+	"\x06Policy\x12\x10\n" +
+	"\fPOLICY_QUEUE\x10\x00\x12\x1d\n" +
+	"\x19POLICY_CANCEL_IN_PROGRESS\x10\x01\x12\x1a\n" +
+	"\x16POLICY_SKIP_IF_RUNNING\x10\x02\x1a\xf2\x01\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x122\n" +
+	"\x15initial_delay_seconds\x18\x02 \x01(\x05R\x13initialDelaySeconds\x12*\n" +
+	"\x11max_delay_seconds\x18\x03 \x01(\x05R\x0fmaxDelaySeconds\x12-\n" +
+	"\x12backoff_multiplier\x18\x04 \x01(\x01R\x11backoffMultiplier\x12\x16\n" +
+	"\x06jitter\x18\x05 \x01(\x01R\x06jitter\x12\x19\n" +
+	"\bretry_on\x18\x06 \x03(\tR\aretryOn\x1a\x83\x02\n" +
+	"\vQueuePolicy\x12L\n" +
+	"\bstrategy\x18\x01 \x01(\x0e20.Superplane.Components.Node.QueuePolicy.StrategyR\bstrategy\x12)\n" +
+	"\x10debounce_seconds\x18\x02 \x01(\x05R\x0fdebounceSeconds\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\"i\n" +
+	"\bStrategy\x12\x11\n" +
+	"\rSTRATEGY_NONE\x10\x00\x12\x15\n" +
+	"\x11STRATEGY_DEBOUNCE\x10\x01\x12\x15\n" +
+	"\x11STRATEGY_COALESCE\x10\x02\x12\x1c\n" +
+	"\x18STRATEGY_DROP_DUPLICATES\x10\x03\x1aH\n" +
+	"\tRateLimit\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12%\n" +
+	"\x0eperiod_seconds\x18\x02 \x01(\x05R\rperiodSeconds\"Q\n" +
 	"\x04Type\x12\x12\n" +
 	"\x0eTYPE_COMPONENT\x10\x00\x12\x12\n" +
 	"\x0eTYPE_BLUEPRINT\x10\x01\x12\x10\n" +
@@ -1270,61 +1691,73 @@ func file_components_proto_rawDescGZIP() []byte {
 	return file_components_proto_rawDescData
 }
 
-var file_components_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_components_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_components_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_components_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_components_proto_goTypes = []any{
 	(Node_Type)(0),                       // 0: Superplane.Components.Node.Type
-	(*ListComponentsRequest)(nil),        // 1: Superplane.Components.ListComponentsRequest
-	(*ListComponentsResponse)(nil),       // 2: Superplane.Components.ListComponentsResponse
-	(*DescribeComponentRequest)(nil),     // 3: Superplane.Components.DescribeComponentRequest
-	(*DescribeComponentResponse)(nil),    // 4: Superplane.Components.DescribeComponentResponse
-	(*Component)(nil),                    // 5: Superplane.Components.Component
-	(*OutputChannel)(nil),                // 6: Superplane.Components.OutputChannel
-	(*ListComponentActionsRequest)(nil),  // 7: Superplane.Components.ListComponentActionsRequest
-	(*ComponentAction)(nil),              // 8: Superplane.Components.ComponentAction
-	(*ListComponentActionsResponse)(nil), // 9: Superplane.Components.ListComponentActionsResponse
-	(*Node)(nil),                         // 10: Superplane.Components.Node
-	(*Position)(nil),                     // 11: Superplane.Components.Position
-	(*Edge)(nil),                         // 12: Superplane.Components.Edge
-	(*IntegrationRef)(nil),               // 13: Superplane.Components.IntegrationRef
-	(*NotificationEmailRequested)(nil),   // 14: Superplane.Components.NotificationEmailRequested
-	(*Node_ComponentRef)(nil),            // 15: Superplane.Components.Node.ComponentRef
-	(*Node_TriggerRef)(nil),              // 16: Superplane.Components.Node.TriggerRef
-	(*Node_WidgetRef)(nil),               // 17: Superplane.Components.Node.WidgetRef
-	(*Node_BlueprintRef)(nil),            // 18: Superplane.Components.Node.BlueprintRef
-	(*configuration.Field)(nil),          // 19: Superplane.Configuration.Field
-	(*_struct.Struct)(nil),               // 20: google.protobuf.Struct
-	(*timestamp.Timestamp)(nil),          // 21: google.protobuf.Timestamp
+	(Node_ConcurrencyGroup_Policy)(0),    // 1: Superplane.Components.Node.ConcurrencyGroup.Policy
+	(Node_QueuePolicy_Strategy)(0),       // 2: Superplane.Components.Node.QueuePolicy.Strategy
+	(*ListComponentsRequest)(nil),        // 3: Superplane.Components.ListComponentsRequest
+	(*ListComponentsResponse)(nil),       // 4: Superplane.Components.ListComponentsResponse
+	(*DescribeComponentRequest)(nil),     // 5: Superplane.Components.DescribeComponentRequest
+	(*DescribeComponentResponse)(nil),    // 6: Superplane.Components.DescribeComponentResponse
+	(*Component)(nil),                    // 7: Superplane.Components.Component
+	(*OutputChannel)(nil),                // 8: Superplane.Components.OutputChannel
+	(*ListComponentActionsRequest)(nil),  // 9: Superplane.Components.ListComponentActionsRequest
+	(*ComponentAction)(nil),              // 10: Superplane.Components.ComponentAction
+	(*ListComponentActionsResponse)(nil), // 11: Superplane.Components.ListComponentActionsResponse
+	(*Node)(nil),                         // 12: Superplane.Components.Node
+	(*Position)(nil),                     // 13: Superplane.Components.Position
+	(*Edge)(nil),                         // 14: Superplane.Components.Edge
+	(*IntegrationRef)(nil),               // 15: Superplane.Components.IntegrationRef
+	(*NotificationEmailRequested)(nil),   // 16: Superplane.Components.NotificationEmailRequested
+	(*Node_ComponentRef)(nil),            // 17: Superplane.Components.Node.ComponentRef
+	(*Node_TriggerRef)(nil),              // 18: Superplane.Components.Node.TriggerRef
+	(*Node_WidgetRef)(nil),               // 19: Superplane.Components.Node.WidgetRef
+	(*Node_BlueprintRef)(nil),            // 20: Superplane.Components.Node.BlueprintRef
+	(*Node_ConcurrencyGroup)(nil),        // 21: Superplane.Components.Node.ConcurrencyGroup
+	(*Node_RetryPolicy)(nil),             // 22: Superplane.Components.Node.RetryPolicy
+	(*Node_QueuePolicy)(nil),             // 23: Superplane.Components.Node.QueuePolicy
+	(*Node_RateLimit)(nil),               // 24: Superplane.Components.Node.RateLimit
+	(*configuration.Field)(nil),          // 25: Superplane.Configuration.Field
+	(*_struct.Struct)(nil),               // 26: google.protobuf.Struct
+	(*timestamp.Timestamp)(nil),          // 27: google.protobuf.Timestamp
 }
 var file_components_proto_depIdxs = []int32{
-	5,  // 0: Superplane.Components.ListComponentsResponse.components:type_name -> Superplane.Components.Component
-	5,  // 1: Superplane.Components.DescribeComponentResponse.component:type_name -> Superplane.Components.Component
-	19, // 2: Superplane.Components.Component.configuration:type_name -> Superplane.Configuration.Field
-	6,  // 3: Superplane.Components.Component.output_channels:type_name -> Superplane.Components.OutputChannel
-	20, // 4: Superplane.Components.Component.example_output:type_name -> google.protobuf.Struct
-	19, // 5: Superplane.Components.ComponentAction.parameters:type_name -> Superplane.Configuration.Field
-	8,  // 6: Superplane.Components.ListComponentActionsResponse.actions:type_name -> Superplane.Components.ComponentAction
+	7,  // 0: Superplane.Components.ListComponentsResponse.components:type_name -> Superplane.Components.Component
+	7,  // 1: Superplane.Components.DescribeComponentResponse.component:type_name -> Superplane.Components.Component
+	25, // 2: Superplane.Components.Component.configuration:type_name -> Superplane.Configuration.Field
+	8,  // 3: Superplane.Components.Component.output_channels:type_name -> Superplane.Components.OutputChannel
+	26, // 4: Superplane.Components.Component.example_output:type_name -> google.protobuf.Struct
+	25, // 5: Superplane.Components.ComponentAction.parameters:type_name -> Superplane.Configuration.Field
+	10, // 6: Superplane.Components.ListComponentActionsResponse.actions:type_name -> Superplane.Components.ComponentAction
 	0,  // 7: Superplane.Components.Node.type:type_name -> Superplane.Components.Node.Type
-	20, // 8: Superplane.Components.Node.configuration:type_name -> google.protobuf.Struct
-	20, // 9: Superplane.Components.Node.metadata:type_name -> google.protobuf.Struct
-	11, // 10: Superplane.Components.Node.position:type_name -> Superplane.Components.Position
-	15, // 11: Superplane.Components.Node.component:type_name -> Superplane.Components.Node.ComponentRef
-	18, // 12: Superplane.Components.Node.blueprint:type_name -> Superplane.Components.Node.BlueprintRef
-	16, // 13: Superplane.Components.Node.trigger:type_name -> Superplane.Components.Node.TriggerRef
-	17, // 14: Superplane.Components.Node.widget:type_name -> Superplane.Components.Node.WidgetRef
-	13, // 15: Superplane.Components.Node.integration:type_name -> Superplane.Components.IntegrationRef
-	21, // 16: Superplane.Components.NotificationEmailRequested.timestamp:type_name -> google.protobuf.Timestamp
-	1,  // 17: Superplane.Components.Components.ListComponents:input_type -> Superplane.Components.ListComponentsRequest
-	3,  // 18: Superplane.Components.Components.DescribeComponent:input_type -> Superplane.Components.DescribeComponentRequest
-	7,  // 19: Superplane.Components.Components.ListComponentActions:input_type -> Superplane.Components.ListComponentActionsRequest
-	2,  // 20: Superplane.Components.Components.ListComponents:output_type -> Superplane.Components.ListComponentsResponse
-	4,  // 21: Superplane.Components.Components.DescribeComponent:output_type -> Superplane.Components.DescribeComponentResponse
-	9,  // 22: Superplane.Components.Components.ListComponentActions:output_type -> Superplane.Components.ListComponentActionsResponse
-	20, // [20:23] is the sub-list for method output_type
-	17, // [17:20] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	26, // 8: Superplane.Components.Node.configuration:type_name -> google.protobuf.Struct
+	26, // 9: Superplane.Components.Node.metadata:type_name -> google.protobuf.Struct
+	13, // 10: Superplane.Components.Node.position:type_name -> Superplane.Components.Position
+	17, // 11: Superplane.Components.Node.component:type_name -> Superplane.Components.Node.ComponentRef
+	20, // 12: Superplane.Components.Node.blueprint:type_name -> Superplane.Components.Node.BlueprintRef
+	18, // 13: Superplane.Components.Node.trigger:type_name -> Superplane.Components.Node.TriggerRef
+	19, // 14: Superplane.Components.Node.widget:type_name -> Superplane.Components.Node.WidgetRef
+	15, // 15: Superplane.Components.Node.integration:type_name -> Superplane.Components.IntegrationRef
+	21, // 16: Superplane.Components.Node.concurrency_group:type_name -> Superplane.Components.Node.ConcurrencyGroup
+	22, // 17: Superplane.Components.Node.retry_policy:type_name -> Superplane.Components.Node.RetryPolicy
+	23, // 18: Superplane.Components.Node.queue_policy:type_name -> Superplane.Components.Node.QueuePolicy
+	24, // 19: Superplane.Components.Node.rate_limit:type_name -> Superplane.Components.Node.RateLimit
+	27, // 20: Superplane.Components.NotificationEmailRequested.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 21: Superplane.Components.Node.ConcurrencyGroup.policy:type_name -> Superplane.Components.Node.ConcurrencyGroup.Policy
+	2,  // 22: Superplane.Components.Node.QueuePolicy.strategy:type_name -> Superplane.Components.Node.QueuePolicy.Strategy
+	3,  // 23: Superplane.Components.Components.ListComponents:input_type -> Superplane.Components.ListComponentsRequest
+	5,  // 24: Superplane.Components.Components.DescribeComponent:input_type -> Superplane.Components.DescribeComponentRequest
+	9,  // 25: Superplane.Components.Components.ListComponentActions:input_type -> Superplane.Components.ListComponentActionsRequest
+	4,  // 26: Superplane.Components.Components.ListComponents:output_type -> Superplane.Components.ListComponentsResponse
+	6,  // 27: Superplane.Components.Components.DescribeComponent:output_type -> Superplane.Components.DescribeComponentResponse
+	11, // 28: Superplane.Components.Components.ListComponentActions:output_type -> Superplane.Components.ListComponentActionsResponse
+	26, // [26:29] is the sub-list for method output_type
+	23, // [23:26] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_components_proto_init() }
@@ -1337,8 +1770,8 @@ func file_components_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_components_proto_rawDesc), len(file_components_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   18,
+			NumEnums:      3,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
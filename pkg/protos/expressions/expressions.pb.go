@@ -0,0 +1,272 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.15.8
+// source: expressions.proto
+
+package expressions
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListExpressionFunctionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpressionFunctionsRequest) Reset() {
+	*x = ListExpressionFunctionsRequest{}
+	mi := &file_expressions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpressionFunctionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpressionFunctionsRequest) ProtoMessage() {}
+
+func (x *ListExpressionFunctionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expressions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpressionFunctionsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpressionFunctionsRequest) Descriptor() ([]byte, []int) {
+	return file_expressions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListExpressionFunctionsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ListExpressionFunctionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Functions     []*ExpressionFunction  `protobuf:"bytes,1,rep,name=functions,proto3" json:"functions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpressionFunctionsResponse) Reset() {
+	*x = ListExpressionFunctionsResponse{}
+	mi := &file_expressions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpressionFunctionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpressionFunctionsResponse) ProtoMessage() {}
+
+func (x *ListExpressionFunctionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expressions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpressionFunctionsResponse.ProtoReflect.Descriptor instead.
+func (*ListExpressionFunctionsResponse) Descriptor() ([]byte, []int) {
+	return file_expressions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListExpressionFunctionsResponse) GetFunctions() []*ExpressionFunction {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+type ExpressionFunction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Example       string                 `protobuf:"bytes,5,opt,name=example,proto3" json:"example,omitempty"`
+	Builtin       bool                   `protobuf:"varint,6,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpressionFunction) Reset() {
+	*x = ExpressionFunction{}
+	mi := &file_expressions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpressionFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpressionFunction) ProtoMessage() {}
+
+func (x *ExpressionFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_expressions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpressionFunction.ProtoReflect.Descriptor instead.
+func (*ExpressionFunction) Descriptor() ([]byte, []int) {
+	return file_expressions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExpressionFunction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExpressionFunction) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ExpressionFunction) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ExpressionFunction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExpressionFunction) GetExample() string {
+	if x != nil {
+		return x.Example
+	}
+	return ""
+}
+
+func (x *ExpressionFunction) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+var File_expressions_proto protoreflect.FileDescriptor
+
+const file_expressions_proto_rawDesc = "" +
+	"\n" +
+	"\x11expressions.proto\x12\x16Superplane.Expressions\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"<\n" +
+	"\x1eListExpressionFunctionsRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\"k\n" +
+	"\x1fListExpressionFunctionsResponse\x12H\n" +
+	"\tfunctions\x18\x01 \x03(\v2*.Superplane.Expressions.ExpressionFunctionR\tfunctions\"\xb8\x01\n" +
+	"\x12ExpressionFunction\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aexample\x18\x05 \x01(\tR\aexample\x12\x18\n" +
+	"\abuiltin\x18\x06 \x01(\bR\abuiltin2\x9b\x02\n" +
+	"\vExpressions\x12\x8b\x02\n" +
+	"\x17ListExpressionFunctions\x126.Superplane.Expressions.ListExpressionFunctionsRequest\x1a7.Superplane.Expressions.ListExpressionFunctionsResponse\"\x7f\x92AW\n" +
+	"\n" +
+	"Expression\x12\x19List expression functions\x1a.Returns the functions available in expressions\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/expressions/functionsB\xd1\x01\x92A\x92\x01\x12h\n" +
+	"\x1aSuperplane Expressions API\x12\x1eAPI for Superplane Expressions\"%\n" +
+	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ9github.com/superplanehq/superplane/pkg/protos/expressionsb\x06proto3"
+
+var (
+	file_expressions_proto_rawDescOnce sync.Once
+	file_expressions_proto_rawDescData []byte
+)
+
+func file_expressions_proto_rawDescGZIP() []byte {
+	file_expressions_proto_rawDescOnce.Do(func() {
+		file_expressions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_expressions_proto_rawDesc), len(file_expressions_proto_rawDesc)))
+	})
+	return file_expressions_proto_rawDescData
+}
+
+var file_expressions_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_expressions_proto_goTypes = []any{
+	(*ListExpressionFunctionsRequest)(nil),  // 0: Superplane.Expressions.ListExpressionFunctionsRequest
+	(*ListExpressionFunctionsResponse)(nil), // 1: Superplane.Expressions.ListExpressionFunctionsResponse
+	(*ExpressionFunction)(nil),              // 2: Superplane.Expressions.ExpressionFunction
+}
+var file_expressions_proto_depIdxs = []int32{
+	2, // 0: Superplane.Expressions.ListExpressionFunctionsResponse.functions:type_name -> Superplane.Expressions.ExpressionFunction
+	0, // 1: Superplane.Expressions.Expressions.ListExpressionFunctions:input_type -> Superplane.Expressions.ListExpressionFunctionsRequest
+	1, // 2: Superplane.Expressions.Expressions.ListExpressionFunctions:output_type -> Superplane.Expressions.ListExpressionFunctionsResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_expressions_proto_init() }
+func file_expressions_proto_init() {
+	if File_expressions_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_expressions_proto_rawDesc), len(file_expressions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_expressions_proto_goTypes,
+		DependencyIndexes: file_expressions_proto_depIdxs,
+		MessageInfos:      file_expressions_proto_msgTypes,
+	}.Build()
+	File_expressions_proto = out.File
+	file_expressions_proto_goTypes = nil
+	file_expressions_proto_depIdxs = nil
+}
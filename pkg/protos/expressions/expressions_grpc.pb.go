@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.15.8
+// source: expressions.proto
+
+package expressions
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Expressions_ListExpressionFunctions_FullMethodName = "/Superplane.Expressions.Expressions/ListExpressionFunctions"
+)
+
+// ExpressionsClient is the client API for Expressions service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExpressionsClient interface {
+	ListExpressionFunctions(ctx context.Context, in *ListExpressionFunctionsRequest, opts ...grpc.CallOption) (*ListExpressionFunctionsResponse, error)
+}
+
+type expressionsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExpressionsClient(cc grpc.ClientConnInterface) ExpressionsClient {
+	return &expressionsClient{cc}
+}
+
+func (c *expressionsClient) ListExpressionFunctions(ctx context.Context, in *ListExpressionFunctionsRequest, opts ...grpc.CallOption) (*ListExpressionFunctionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpressionFunctionsResponse)
+	err := c.cc.Invoke(ctx, Expressions_ListExpressionFunctions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExpressionsServer is the server API for Expressions service.
+// All implementations should embed UnimplementedExpressionsServer
+// for forward compatibility.
+type ExpressionsServer interface {
+	ListExpressionFunctions(context.Context, *ListExpressionFunctionsRequest) (*ListExpressionFunctionsResponse, error)
+}
+
+// UnimplementedExpressionsServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExpressionsServer struct{}
+
+func (UnimplementedExpressionsServer) ListExpressionFunctions(context.Context, *ListExpressionFunctionsRequest) (*ListExpressionFunctionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExpressionFunctions not implemented")
+}
+func (UnimplementedExpressionsServer) testEmbeddedByValue() {}
+
+// UnsafeExpressionsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExpressionsServer will
+// result in compilation errors.
+type UnsafeExpressionsServer interface {
+	mustEmbedUnimplementedExpressionsServer()
+}
+
+func RegisterExpressionsServer(s grpc.ServiceRegistrar, srv ExpressionsServer) {
+	// If the following call panics, it indicates UnimplementedExpressionsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Expressions_ServiceDesc, srv)
+}
+
+func _Expressions_ListExpressionFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpressionFunctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpressionsServer).ListExpressionFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Expressions_ListExpressionFunctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpressionsServer).ListExpressionFunctions(ctx, req.(*ListExpressionFunctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Expressions_ServiceDesc is the grpc.ServiceDesc for Expressions service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Expressions_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Superplane.Expressions.Expressions",
+	HandlerType: (*ExpressionsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListExpressionFunctions",
+			Handler:    _Expressions_ListExpressionFunctions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "expressions.proto",
+}
@@ -30,6 +30,7 @@ type Secret_Provider int32
 const (
 	Secret_PROVIDER_UNKNOWN Secret_Provider = 0
 	Secret_PROVIDER_LOCAL   Secret_Provider = 1
+	Secret_PROVIDER_VAULT   Secret_Provider = 2
 )
 
 // Enum value maps for Secret_Provider.
@@ -37,10 +38,12 @@ var (
 	Secret_Provider_name = map[int32]string{
 		0: "PROVIDER_UNKNOWN",
 		1: "PROVIDER_LOCAL",
+		2: "PROVIDER_VAULT",
 	}
 	Secret_Provider_value = map[string]int32{
 		"PROVIDER_UNKNOWN": 0,
 		"PROVIDER_LOCAL":   1,
+		"PROVIDER_VAULT":   2,
 	}
 )
 
@@ -71,6 +74,119 @@ func (Secret_Provider) EnumDescriptor() ([]byte, []int) {
 	return file_secrets_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type SecretAccessEvent_Action int32
+
+const (
+	SecretAccessEvent_ACTION_UNKNOWN  SecretAccessEvent_Action = 0
+	SecretAccessEvent_ACTION_READ     SecretAccessEvent_Action = 1
+	SecretAccessEvent_ACTION_DENIED   SecretAccessEvent_Action = 2
+	SecretAccessEvent_ACTION_DESCRIBE SecretAccessEvent_Action = 3
+	SecretAccessEvent_ACTION_CREATE   SecretAccessEvent_Action = 4
+	SecretAccessEvent_ACTION_UPDATE   SecretAccessEvent_Action = 5
+	SecretAccessEvent_ACTION_DELETE   SecretAccessEvent_Action = 6
+)
+
+// Enum value maps for SecretAccessEvent_Action.
+var (
+	SecretAccessEvent_Action_name = map[int32]string{
+		0: "ACTION_UNKNOWN",
+		1: "ACTION_READ",
+		2: "ACTION_DENIED",
+		3: "ACTION_DESCRIBE",
+		4: "ACTION_CREATE",
+		5: "ACTION_UPDATE",
+		6: "ACTION_DELETE",
+	}
+	SecretAccessEvent_Action_value = map[string]int32{
+		"ACTION_UNKNOWN":  0,
+		"ACTION_READ":     1,
+		"ACTION_DENIED":   2,
+		"ACTION_DESCRIBE": 3,
+		"ACTION_CREATE":   4,
+		"ACTION_UPDATE":   5,
+		"ACTION_DELETE":   6,
+	}
+)
+
+func (x SecretAccessEvent_Action) Enum() *SecretAccessEvent_Action {
+	p := new(SecretAccessEvent_Action)
+	*p = x
+	return p
+}
+
+func (x SecretAccessEvent_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretAccessEvent_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_secrets_proto_enumTypes[1].Descriptor()
+}
+
+func (SecretAccessEvent_Action) Type() protoreflect.EnumType {
+	return &file_secrets_proto_enumTypes[1]
+}
+
+func (x SecretAccessEvent_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretAccessEvent_Action.Descriptor instead.
+func (SecretAccessEvent_Action) EnumDescriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type SecretAccessEvent_ActorType int32
+
+const (
+	SecretAccessEvent_ACTOR_TYPE_UNKNOWN         SecretAccessEvent_ActorType = 0
+	SecretAccessEvent_ACTOR_TYPE_USER            SecretAccessEvent_ActorType = 1
+	SecretAccessEvent_ACTOR_TYPE_SERVICE_ACCOUNT SecretAccessEvent_ActorType = 2
+	SecretAccessEvent_ACTOR_TYPE_EXECUTION       SecretAccessEvent_ActorType = 3
+)
+
+// Enum value maps for SecretAccessEvent_ActorType.
+var (
+	SecretAccessEvent_ActorType_name = map[int32]string{
+		0: "ACTOR_TYPE_UNKNOWN",
+		1: "ACTOR_TYPE_USER",
+		2: "ACTOR_TYPE_SERVICE_ACCOUNT",
+		3: "ACTOR_TYPE_EXECUTION",
+	}
+	SecretAccessEvent_ActorType_value = map[string]int32{
+		"ACTOR_TYPE_UNKNOWN":         0,
+		"ACTOR_TYPE_USER":            1,
+		"ACTOR_TYPE_SERVICE_ACCOUNT": 2,
+		"ACTOR_TYPE_EXECUTION":       3,
+	}
+)
+
+func (x SecretAccessEvent_ActorType) Enum() *SecretAccessEvent_ActorType {
+	p := new(SecretAccessEvent_ActorType)
+	*p = x
+	return p
+}
+
+func (x SecretAccessEvent_ActorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretAccessEvent_ActorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_secrets_proto_enumTypes[2].Descriptor()
+}
+
+func (SecretAccessEvent_ActorType) Type() protoreflect.EnumType {
+	return &file_secrets_proto_enumTypes[2]
+}
+
+func (x SecretAccessEvent_ActorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretAccessEvent_ActorType.Descriptor instead.
+func (SecretAccessEvent_ActorType) EnumDescriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{18, 1}
+}
+
 type Secret struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metadata      *Secret_Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -400,8 +516,12 @@ func (x *DescribeSecretRequest) GetIdOrName() string {
 }
 
 type DescribeSecretResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Secret *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	//
+	// Canvas nodes with a configuration referencing the secret.
+	//
+	References    []*SecretReference `protobuf:"bytes,2,rep,name=references,proto3" json:"references,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -443,6 +563,89 @@ func (x *DescribeSecretResponse) GetSecret() *Secret {
 	return nil
 }
 
+func (x *DescribeSecretResponse) GetReferences() []*SecretReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+type SecretReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	CanvasName    string                 `protobuf:"bytes,2,opt,name=canvas_name,json=canvasName,proto3" json:"canvas_name,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                 `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Key           string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretReference) Reset() {
+	*x = SecretReference{}
+	mi := &file_secrets_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretReference) ProtoMessage() {}
+
+func (x *SecretReference) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretReference.ProtoReflect.Descriptor instead.
+func (*SecretReference) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SecretReference) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *SecretReference) GetCanvasName() string {
+	if x != nil {
+		return x.CanvasName
+	}
+	return ""
+}
+
+func (x *SecretReference) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SecretReference) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *SecretReference) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
 type ListSecretsRequest struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	DomainType    authorization.DomainType `protobuf:"varint,1,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
@@ -453,7 +656,7 @@ type ListSecretsRequest struct {
 
 func (x *ListSecretsRequest) Reset() {
 	*x = ListSecretsRequest{}
-	mi := &file_secrets_proto_msgTypes[7]
+	mi := &file_secrets_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +668,7 @@ func (x *ListSecretsRequest) String() string {
 func (*ListSecretsRequest) ProtoMessage() {}
 
 func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[7]
+	mi := &file_secrets_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +681,7 @@ func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
 func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{7}
+	return file_secrets_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListSecretsRequest) GetDomainType() authorization.DomainType {
@@ -504,7 +707,7 @@ type ListSecretsResponse struct {
 
 func (x *ListSecretsResponse) Reset() {
 	*x = ListSecretsResponse{}
-	mi := &file_secrets_proto_msgTypes[8]
+	mi := &file_secrets_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +719,7 @@ func (x *ListSecretsResponse) String() string {
 func (*ListSecretsResponse) ProtoMessage() {}
 
 func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[8]
+	mi := &file_secrets_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +732,7 @@ func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
 func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{8}
+	return file_secrets_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListSecretsResponse) GetSecrets() []*Secret {
@@ -550,7 +753,7 @@ type DeleteSecretRequest struct {
 
 func (x *DeleteSecretRequest) Reset() {
 	*x = DeleteSecretRequest{}
-	mi := &file_secrets_proto_msgTypes[9]
+	mi := &file_secrets_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -562,7 +765,7 @@ func (x *DeleteSecretRequest) String() string {
 func (*DeleteSecretRequest) ProtoMessage() {}
 
 func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[9]
+	mi := &file_secrets_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +778,7 @@ func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{9}
+	return file_secrets_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteSecretRequest) GetDomainType() authorization.DomainType {
@@ -607,7 +810,7 @@ type DeleteSecretResponse struct {
 
 func (x *DeleteSecretResponse) Reset() {
 	*x = DeleteSecretResponse{}
-	mi := &file_secrets_proto_msgTypes[10]
+	mi := &file_secrets_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -619,7 +822,7 @@ func (x *DeleteSecretResponse) String() string {
 func (*DeleteSecretResponse) ProtoMessage() {}
 
 func (x *DeleteSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[10]
+	mi := &file_secrets_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,7 +835,7 @@ func (x *DeleteSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSecretResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSecretResponse) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{10}
+	return file_secrets_proto_rawDescGZIP(), []int{11}
 }
 
 type SetSecretKeyRequest struct {
@@ -648,7 +851,7 @@ type SetSecretKeyRequest struct {
 
 func (x *SetSecretKeyRequest) Reset() {
 	*x = SetSecretKeyRequest{}
-	mi := &file_secrets_proto_msgTypes[11]
+	mi := &file_secrets_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -660,7 +863,7 @@ func (x *SetSecretKeyRequest) String() string {
 func (*SetSecretKeyRequest) ProtoMessage() {}
 
 func (x *SetSecretKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[11]
+	mi := &file_secrets_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +876,7 @@ func (x *SetSecretKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSecretKeyRequest.ProtoReflect.Descriptor instead.
 func (*SetSecretKeyRequest) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{11}
+	return file_secrets_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SetSecretKeyRequest) GetIdOrName() string {
@@ -720,7 +923,7 @@ type SetSecretKeyResponse struct {
 
 func (x *SetSecretKeyResponse) Reset() {
 	*x = SetSecretKeyResponse{}
-	mi := &file_secrets_proto_msgTypes[12]
+	mi := &file_secrets_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -732,7 +935,7 @@ func (x *SetSecretKeyResponse) String() string {
 func (*SetSecretKeyResponse) ProtoMessage() {}
 
 func (x *SetSecretKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[12]
+	mi := &file_secrets_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +948,7 @@ func (x *SetSecretKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSecretKeyResponse.ProtoReflect.Descriptor instead.
 func (*SetSecretKeyResponse) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{12}
+	return file_secrets_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SetSecretKeyResponse) GetSecret() *Secret {
@@ -767,7 +970,7 @@ type DeleteSecretKeyRequest struct {
 
 func (x *DeleteSecretKeyRequest) Reset() {
 	*x = DeleteSecretKeyRequest{}
-	mi := &file_secrets_proto_msgTypes[13]
+	mi := &file_secrets_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +982,7 @@ func (x *DeleteSecretKeyRequest) String() string {
 func (*DeleteSecretKeyRequest) ProtoMessage() {}
 
 func (x *DeleteSecretKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[13]
+	mi := &file_secrets_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +995,7 @@ func (x *DeleteSecretKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSecretKeyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSecretKeyRequest) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{13}
+	return file_secrets_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteSecretKeyRequest) GetIdOrName() string {
@@ -832,7 +1035,7 @@ type DeleteSecretKeyResponse struct {
 
 func (x *DeleteSecretKeyResponse) Reset() {
 	*x = DeleteSecretKeyResponse{}
-	mi := &file_secrets_proto_msgTypes[14]
+	mi := &file_secrets_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +1047,7 @@ func (x *DeleteSecretKeyResponse) String() string {
 func (*DeleteSecretKeyResponse) ProtoMessage() {}
 
 func (x *DeleteSecretKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[14]
+	mi := &file_secrets_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1060,7 @@ func (x *DeleteSecretKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSecretKeyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSecretKeyResponse) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{14}
+	return file_secrets_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteSecretKeyResponse) GetSecret() *Secret {
@@ -879,7 +1082,7 @@ type UpdateSecretNameRequest struct {
 
 func (x *UpdateSecretNameRequest) Reset() {
 	*x = UpdateSecretNameRequest{}
-	mi := &file_secrets_proto_msgTypes[15]
+	mi := &file_secrets_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -891,7 +1094,7 @@ func (x *UpdateSecretNameRequest) String() string {
 func (*UpdateSecretNameRequest) ProtoMessage() {}
 
 func (x *UpdateSecretNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[15]
+	mi := &file_secrets_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,7 +1107,7 @@ func (x *UpdateSecretNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSecretNameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSecretNameRequest) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{15}
+	return file_secrets_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateSecretNameRequest) GetIdOrName() string {
@@ -944,7 +1147,7 @@ type UpdateSecretNameResponse struct {
 
 func (x *UpdateSecretNameResponse) Reset() {
 	*x = UpdateSecretNameResponse{}
-	mi := &file_secrets_proto_msgTypes[16]
+	mi := &file_secrets_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -956,7 +1159,7 @@ func (x *UpdateSecretNameResponse) String() string {
 func (*UpdateSecretNameResponse) ProtoMessage() {}
 
 func (x *UpdateSecretNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[16]
+	mi := &file_secrets_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1172,7 @@ func (x *UpdateSecretNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSecretNameResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSecretNameResponse) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{16}
+	return file_secrets_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateSecretNameResponse) GetSecret() *Secret {
@@ -979,6 +1182,274 @@ func (x *UpdateSecretNameResponse) GetSecret() *Secret {
 	return nil
 }
 
+type SecretAccessEvent struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Id            string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SecretId      string                      `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	SecretName    string                      `protobuf:"bytes,3,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	Action        SecretAccessEvent_Action    `protobuf:"varint,4,opt,name=action,proto3,enum=Superplane.Secrets.SecretAccessEvent_Action" json:"action,omitempty"`
+	KeyName       string                      `protobuf:"bytes,5,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	ActorType     SecretAccessEvent_ActorType `protobuf:"varint,6,opt,name=actor_type,json=actorType,proto3,enum=Superplane.Secrets.SecretAccessEvent_ActorType" json:"actor_type,omitempty"`
+	ActorId       string                      `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CanvasId      string                      `protobuf:"bytes,8,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                      `protobuf:"bytes,9,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ExecutionId   string                      `protobuf:"bytes,10,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	CreatedAt     *timestamp.Timestamp        `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretAccessEvent) Reset() {
+	*x = SecretAccessEvent{}
+	mi := &file_secrets_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretAccessEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretAccessEvent) ProtoMessage() {}
+
+func (x *SecretAccessEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretAccessEvent.ProtoReflect.Descriptor instead.
+func (*SecretAccessEvent) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SecretAccessEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SecretAccessEvent) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *SecretAccessEvent) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *SecretAccessEvent) GetAction() SecretAccessEvent_Action {
+	if x != nil {
+		return x.Action
+	}
+	return SecretAccessEvent_ACTION_UNKNOWN
+}
+
+func (x *SecretAccessEvent) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *SecretAccessEvent) GetActorType() SecretAccessEvent_ActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return SecretAccessEvent_ACTOR_TYPE_UNKNOWN
+}
+
+func (x *SecretAccessEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *SecretAccessEvent) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *SecretAccessEvent) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SecretAccessEvent) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *SecretAccessEvent) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSecretAccessEventsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	DomainType    authorization.DomainType `protobuf:"varint,1,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
+	DomainId      string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	IdOrName      string                   `protobuf:"bytes,3,opt,name=id_or_name,json=idOrName,proto3" json:"id_or_name,omitempty"`
+	Limit         uint32                   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp     `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After         *timestamp.Timestamp     `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretAccessEventsRequest) Reset() {
+	*x = ListSecretAccessEventsRequest{}
+	mi := &file_secrets_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretAccessEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretAccessEventsRequest) ProtoMessage() {}
+
+func (x *ListSecretAccessEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretAccessEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretAccessEventsRequest) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListSecretAccessEventsRequest) GetDomainType() authorization.DomainType {
+	if x != nil {
+		return x.DomainType
+	}
+	return authorization.DomainType(0)
+}
+
+func (x *ListSecretAccessEventsRequest) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *ListSecretAccessEventsRequest) GetIdOrName() string {
+	if x != nil {
+		return x.IdOrName
+	}
+	return ""
+}
+
+func (x *ListSecretAccessEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSecretAccessEventsRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListSecretAccessEventsRequest) GetAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type ListSecretAccessEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*SecretAccessEvent   `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,2,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretAccessEventsResponse) Reset() {
+	*x = ListSecretAccessEventsResponse{}
+	mi := &file_secrets_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretAccessEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretAccessEventsResponse) ProtoMessage() {}
+
+func (x *ListSecretAccessEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretAccessEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretAccessEventsResponse) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListSecretAccessEventsResponse) GetEvents() []*SecretAccessEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListSecretAccessEventsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListSecretAccessEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
 // Local secrets are stored and managed by SuperPlane itself.
 type Secret_Local struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -989,7 +1460,7 @@ type Secret_Local struct {
 
 func (x *Secret_Local) Reset() {
 	*x = Secret_Local{}
-	mi := &file_secrets_proto_msgTypes[17]
+	mi := &file_secrets_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1001,7 +1472,7 @@ func (x *Secret_Local) String() string {
 func (*Secret_Local) ProtoMessage() {}
 
 func (x *Secret_Local) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[17]
+	mi := &file_secrets_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,6 +1495,169 @@ func (x *Secret_Local) GetData() map[string]string {
 	return nil
 }
 
+// Vault secrets only hold a reference to a path in the KV v2 secrets engine
+// of the configured Vault server. Values are read from Vault when used.
+type Secret_Vault struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Mount         string                 `protobuf:"bytes,2,opt,name=mount,proto3" json:"mount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_Vault) Reset() {
+	*x = Secret_Vault{}
+	mi := &file_secrets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_Vault) ProtoMessage() {}
+
+func (x *Secret_Vault) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_Vault.ProtoReflect.Descriptor instead.
+func (*Secret_Vault) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Secret_Vault) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+// Scoped secrets can only be read by the nodes of a canvas,
+// or by a single node of it, if node_id is also set.
+type Secret_Scope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_Scope) Reset() {
+	*x = Secret_Scope{}
+	mi := &file_secrets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_Scope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_Scope) ProtoMessage() {}
+
+func (x *Secret_Scope) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_Scope.ProtoReflect.Descriptor instead.
+func (*Secret_Scope) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Secret_Scope) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *Secret_Scope) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+// Access policies restrict which canvases and components can read the secret.
+// Components are referenced by name, e.g. ssh or github.runWorkflow.
+// Empty lists allow all canvases or components.
+type Secret_AccessPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasIds     []string               `protobuf:"bytes,1,rep,name=canvas_ids,json=canvasIds,proto3" json:"canvas_ids,omitempty"`
+	Components    []string               `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_AccessPolicy) Reset() {
+	*x = Secret_AccessPolicy{}
+	mi := &file_secrets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_AccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_AccessPolicy) ProtoMessage() {}
+
+func (x *Secret_AccessPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_AccessPolicy.ProtoReflect.Descriptor instead.
+func (*Secret_AccessPolicy) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *Secret_AccessPolicy) GetCanvasIds() []string {
+	if x != nil {
+		return x.CanvasIds
+	}
+	return nil
+}
+
+func (x *Secret_AccessPolicy) GetComponents() []string {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
 type Secret_Metadata struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1037,7 +1671,7 @@ type Secret_Metadata struct {
 
 func (x *Secret_Metadata) Reset() {
 	*x = Secret_Metadata{}
-	mi := &file_secrets_proto_msgTypes[18]
+	mi := &file_secrets_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1683,7 @@ func (x *Secret_Metadata) String() string {
 func (*Secret_Metadata) ProtoMessage() {}
 
 func (x *Secret_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[18]
+	mi := &file_secrets_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1696,7 @@ func (x *Secret_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret_Metadata.ProtoReflect.Descriptor instead.
 func (*Secret_Metadata) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{0, 1}
+	return file_secrets_proto_rawDescGZIP(), []int{0, 4}
 }
 
 func (x *Secret_Metadata) GetId() string {
@@ -1101,16 +1735,23 @@ func (x *Secret_Metadata) GetCreatedAt() *timestamp.Timestamp {
 }
 
 type Secret_Spec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      Secret_Provider        `protobuf:"varint,1,opt,name=provider,proto3,enum=Superplane.Secrets.Secret_Provider" json:"provider,omitempty"`
-	Local         *Secret_Local          `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider Secret_Provider        `protobuf:"varint,1,opt,name=provider,proto3,enum=Superplane.Secrets.Secret_Provider" json:"provider,omitempty"`
+	Local    *Secret_Local          `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	Vault    *Secret_Vault          `protobuf:"bytes,3,opt,name=vault,proto3" json:"vault,omitempty"`
+	//
+	// On updates, the scope and access policy
+	// are only changed if they are set.
+	//
+	Scope         *Secret_Scope        `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	AccessPolicy  *Secret_AccessPolicy `protobuf:"bytes,5,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Secret_Spec) Reset() {
 	*x = Secret_Spec{}
-	mi := &file_secrets_proto_msgTypes[19]
+	mi := &file_secrets_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1763,7 @@ func (x *Secret_Spec) String() string {
 func (*Secret_Spec) ProtoMessage() {}
 
 func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[19]
+	mi := &file_secrets_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1776,7 @@ func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret_Spec.ProtoReflect.Descriptor instead.
 func (*Secret_Spec) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{0, 2}
+	return file_secrets_proto_rawDescGZIP(), []int{0, 5}
 }
 
 func (x *Secret_Spec) GetProvider() Secret_Provider {
@@ -1152,11 +1793,32 @@ func (x *Secret_Spec) GetLocal() *Secret_Local {
 	return nil
 }
 
+func (x *Secret_Spec) GetVault() *Secret_Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+func (x *Secret_Spec) GetScope() *Secret_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *Secret_Spec) GetAccessPolicy() *Secret_AccessPolicy {
+	if x != nil {
+		return x.AccessPolicy
+	}
+	return nil
+}
+
 var File_secrets_proto protoreflect.FileDescriptor
 
 const file_secrets_proto_rawDesc = "" +
 	"\n" +
-	"\rsecrets.proto\x12\x12Superplane.Secrets\x1a\x13authorization.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x88\x05\n" +
+	"\rsecrets.proto\x12\x12Superplane.Secrets\x1a\x13authorization.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9c\b\n" +
 	"\x06Secret\x12?\n" +
 	"\bmetadata\x18\x01 \x01(\v2#.Superplane.Secrets.Secret.MetadataR\bmetadata\x123\n" +
 	"\x04spec\x18\x02 \x01(\v2\x1f.Superplane.Secrets.Secret.SpecR\x04spec\x1a\x80\x01\n" +
@@ -1164,7 +1826,19 @@ const file_secrets_proto_rawDesc = "" +
 	"\x04data\x18\x01 \x03(\v2*.Superplane.Secrets.Secret.Local.DataEntryR\x04data\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xcd\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a1\n" +
+	"\x05Vault\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05mount\x18\x02 \x01(\tR\x05mount\x1a=\n" +
+	"\x05Scope\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x1aM\n" +
+	"\fAccessPolicy\x12\x1d\n" +
+	"\n" +
+	"canvas_ids\x18\x01 \x03(\tR\tcanvasIds\x12\x1e\n" +
+	"\n" +
+	"components\x18\x02 \x03(\tR\n" +
+	"components\x1a\xcd\x01\n" +
 	"\bMetadata\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12E\n" +
@@ -1172,13 +1846,17 @@ const file_secrets_proto_rawDesc = "" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\x7f\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\xbd\x02\n" +
 	"\x04Spec\x12?\n" +
 	"\bprovider\x18\x01 \x01(\x0e2#.Superplane.Secrets.Secret.ProviderR\bprovider\x126\n" +
-	"\x05local\x18\x02 \x01(\v2 .Superplane.Secrets.Secret.LocalR\x05local\"4\n" +
+	"\x05local\x18\x02 \x01(\v2 .Superplane.Secrets.Secret.LocalR\x05local\x126\n" +
+	"\x05vault\x18\x03 \x01(\v2 .Superplane.Secrets.Secret.VaultR\x05vault\x126\n" +
+	"\x05scope\x18\x04 \x01(\v2 .Superplane.Secrets.Secret.ScopeR\x05scope\x12L\n" +
+	"\raccess_policy\x18\x05 \x01(\v2'.Superplane.Secrets.Secret.AccessPolicyR\faccessPolicy\"H\n" +
 	"\bProvider\x12\x14\n" +
 	"\x10PROVIDER_UNKNOWN\x10\x00\x12\x12\n" +
-	"\x0ePROVIDER_LOCAL\x10\x01\"\xad\x01\n" +
+	"\x0ePROVIDER_LOCAL\x10\x01\x12\x12\n" +
+	"\x0ePROVIDER_VAULT\x10\x02\"\xad\x01\n" +
 	"\x13CreateSecretRequest\x122\n" +
 	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\x12E\n" +
 	"\vdomain_type\x18\x02 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
@@ -1200,9 +1878,19 @@ const file_secrets_proto_rawDesc = "" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x02 \x01(\tR\bdomainId\x12\x1c\n" +
 	"\n" +
-	"id_or_name\x18\x03 \x01(\tR\bidOrName\"L\n" +
+	"id_or_name\x18\x03 \x01(\tR\bidOrName\"\x91\x01\n" +
 	"\x16DescribeSecretResponse\x122\n" +
-	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\"x\n" +
+	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\x12C\n" +
+	"\n" +
+	"references\x18\x02 \x03(\v2#.Superplane.Secrets.SecretReferenceR\n" +
+	"references\"\x97\x01\n" +
+	"\x0fSecretReference\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1f\n" +
+	"\vcanvas_name\x18\x02 \x01(\tR\n" +
+	"canvasName\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x04 \x01(\tR\bnodeName\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\"x\n" +
 	"\x12ListSecretsRequest\x12E\n" +
 	"\vdomain_type\x18\x01 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
 	"domainType\x12\x1b\n" +
@@ -1243,7 +1931,49 @@ const file_secrets_proto_rawDesc = "" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\"N\n" +
 	"\x18UpdateSecretNameResponse\x122\n" +
-	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret2\x9f\x0e\n" +
+	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\"\xc6\x05\n" +
+	"\x11SecretAccessEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\x12\x1f\n" +
+	"\vsecret_name\x18\x03 \x01(\tR\n" +
+	"secretName\x12D\n" +
+	"\x06action\x18\x04 \x01(\x0e2,.Superplane.Secrets.SecretAccessEvent.ActionR\x06action\x12\x19\n" +
+	"\bkey_name\x18\x05 \x01(\tR\akeyName\x12N\n" +
+	"\n" +
+	"actor_type\x18\x06 \x01(\x0e2/.Superplane.Secrets.SecretAccessEvent.ActorTypeR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\tR\aactorId\x12\x1b\n" +
+	"\tcanvas_id\x18\b \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\t \x01(\tR\x06nodeId\x12!\n" +
+	"\fexecution_id\x18\n" +
+	" \x01(\tR\vexecutionId\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8e\x01\n" +
+	"\x06Action\x12\x12\n" +
+	"\x0eACTION_UNKNOWN\x10\x00\x12\x0f\n" +
+	"\vACTION_READ\x10\x01\x12\x11\n" +
+	"\rACTION_DENIED\x10\x02\x12\x13\n" +
+	"\x0fACTION_DESCRIBE\x10\x03\x12\x11\n" +
+	"\rACTION_CREATE\x10\x04\x12\x11\n" +
+	"\rACTION_UPDATE\x10\x05\x12\x11\n" +
+	"\rACTION_DELETE\x10\x06\"r\n" +
+	"\tActorType\x12\x16\n" +
+	"\x12ACTOR_TYPE_UNKNOWN\x10\x00\x12\x13\n" +
+	"\x0fACTOR_TYPE_USER\x10\x01\x12\x1e\n" +
+	"\x1aACTOR_TYPE_SERVICE_ACCOUNT\x10\x02\x12\x18\n" +
+	"\x14ACTOR_TYPE_EXECUTION\x10\x03\"\x9d\x02\n" +
+	"\x1dListSecretAccessEventsRequest\x12E\n" +
+	"\vdomain_type\x18\x01 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
+	"domainType\x12\x1b\n" +
+	"\tdomain_id\x18\x02 \x01(\tR\bdomainId\x12\x1c\n" +
+	"\n" +
+	"id_or_name\x18\x03 \x01(\tR\bidOrName\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\x122\n" +
+	"\x06before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x120\n" +
+	"\x05after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\"\xc6\x01\n" +
+	"\x1eListSecretAccessEventsResponse\x12=\n" +
+	"\x06events\x18\x01 \x03(\v2%.Superplane.Secrets.SecretAccessEventR\x06events\x12\"\n" +
+	"\rhas_next_page\x18\x02 \x01(\bR\vhasNextPage\x12A\n" +
+	"\x0elast_timestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp2\xe6\x10\n" +
 	"\aSecrets\x12\xb3\x01\n" +
 	"\fCreateSecret\x12'.Superplane.Secrets.CreateSecretRequest\x1a(.Superplane.Secrets.CreateSecretResponse\"P\x92A3\n" +
 	"\x06Secret\x12\x13Create a new secret\x1a\x14Creates a new secret\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/secrets\x12\xd6\x01\n" +
@@ -1260,7 +1990,9 @@ const file_secrets_proto_rawDesc = "" +
 	"\x0fDeleteSecretKey\x12*.Superplane.Secrets.DeleteSecretKeyRequest\x1a+.Superplane.Secrets.DeleteSecretKeyResponse\"\xaa\x01\x92As\n" +
 	"\x06Secret\x12\x1aRemove a key from a secret\x1aMRemoves one key from the secret. Secret must have at least one key remaining.\x82\xd3\xe4\x93\x02.*,/api/v1/secrets/{id_or_name}/keys/{key_name}\x12\x88\x02\n" +
 	"\x10UpdateSecretName\x12+.Superplane.Secrets.UpdateSecretNameRequest\x1a,.Superplane.Secrets.UpdateSecretNameResponse\"\x98\x01\x92Ai\n" +
-	"\x06Secret\x12\x12Update secret name\x1aKUpdates only the name of the secret. Name must be unique within the domain.\x82\xd3\xe4\x93\x02&:\x01*2!/api/v1/secrets/{id_or_name}/nameB\xc5\x01\x92A\x8a\x01\x12`\n" +
+	"\x06Secret\x12\x12Update secret name\x1aKUpdates only the name of the secret. Name must be unique within the domain.\x82\xd3\xe4\x93\x02&:\x01*2!/api/v1/secrets/{id_or_name}/name\x12\xc4\x02\n" +
+	"\x16ListSecretAccessEvents\x121.Superplane.Secrets.ListSecretAccessEventsRequest\x1a2.Superplane.Secrets.ListSecretAccessEventsResponse\"\xc2\x01\x92A\x8c\x01\n" +
+	"\x06Secret\x12\x19List secret access events\x1agReturns the reads and writes of a secret, most recent first. Deleted secrets can be referenced by name.\x82\xd3\xe4\x93\x02,\x12*/api/v1/secrets/{id_or_name}/access-eventsB\xc5\x01\x92A\x8a\x01\x12`\n" +
 	"\x16Superplane Secrets API\x12\x1aAPI for Superplane Secrets\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ5github.com/superplanehq/superplane/pkg/protos/secretsb\x06proto3"
 
@@ -1276,80 +2008,103 @@ func file_secrets_proto_rawDescGZIP() []byte {
 	return file_secrets_proto_rawDescData
 }
 
-var file_secrets_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_secrets_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_secrets_proto_goTypes = []any{
-	(Secret_Provider)(0),             // 0: Superplane.Secrets.Secret.Provider
-	(*Secret)(nil),                   // 1: Superplane.Secrets.Secret
-	(*CreateSecretRequest)(nil),      // 2: Superplane.Secrets.CreateSecretRequest
-	(*CreateSecretResponse)(nil),     // 3: Superplane.Secrets.CreateSecretResponse
-	(*UpdateSecretRequest)(nil),      // 4: Superplane.Secrets.UpdateSecretRequest
-	(*UpdateSecretResponse)(nil),     // 5: Superplane.Secrets.UpdateSecretResponse
-	(*DescribeSecretRequest)(nil),    // 6: Superplane.Secrets.DescribeSecretRequest
-	(*DescribeSecretResponse)(nil),   // 7: Superplane.Secrets.DescribeSecretResponse
-	(*ListSecretsRequest)(nil),       // 8: Superplane.Secrets.ListSecretsRequest
-	(*ListSecretsResponse)(nil),      // 9: Superplane.Secrets.ListSecretsResponse
-	(*DeleteSecretRequest)(nil),      // 10: Superplane.Secrets.DeleteSecretRequest
-	(*DeleteSecretResponse)(nil),     // 11: Superplane.Secrets.DeleteSecretResponse
-	(*SetSecretKeyRequest)(nil),      // 12: Superplane.Secrets.SetSecretKeyRequest
-	(*SetSecretKeyResponse)(nil),     // 13: Superplane.Secrets.SetSecretKeyResponse
-	(*DeleteSecretKeyRequest)(nil),   // 14: Superplane.Secrets.DeleteSecretKeyRequest
-	(*DeleteSecretKeyResponse)(nil),  // 15: Superplane.Secrets.DeleteSecretKeyResponse
-	(*UpdateSecretNameRequest)(nil),  // 16: Superplane.Secrets.UpdateSecretNameRequest
-	(*UpdateSecretNameResponse)(nil), // 17: Superplane.Secrets.UpdateSecretNameResponse
-	(*Secret_Local)(nil),             // 18: Superplane.Secrets.Secret.Local
-	(*Secret_Metadata)(nil),          // 19: Superplane.Secrets.Secret.Metadata
-	(*Secret_Spec)(nil),              // 20: Superplane.Secrets.Secret.Spec
-	nil,                              // 21: Superplane.Secrets.Secret.Local.DataEntry
-	(authorization.DomainType)(0),    // 22: Superplane.Authorization.DomainType
-	(*timestamp.Timestamp)(nil),      // 23: google.protobuf.Timestamp
+	(Secret_Provider)(0),                   // 0: Superplane.Secrets.Secret.Provider
+	(SecretAccessEvent_Action)(0),          // 1: Superplane.Secrets.SecretAccessEvent.Action
+	(SecretAccessEvent_ActorType)(0),       // 2: Superplane.Secrets.SecretAccessEvent.ActorType
+	(*Secret)(nil),                         // 3: Superplane.Secrets.Secret
+	(*CreateSecretRequest)(nil),            // 4: Superplane.Secrets.CreateSecretRequest
+	(*CreateSecretResponse)(nil),           // 5: Superplane.Secrets.CreateSecretResponse
+	(*UpdateSecretRequest)(nil),            // 6: Superplane.Secrets.UpdateSecretRequest
+	(*UpdateSecretResponse)(nil),           // 7: Superplane.Secrets.UpdateSecretResponse
+	(*DescribeSecretRequest)(nil),          // 8: Superplane.Secrets.DescribeSecretRequest
+	(*DescribeSecretResponse)(nil),         // 9: Superplane.Secrets.DescribeSecretResponse
+	(*SecretReference)(nil),                // 10: Superplane.Secrets.SecretReference
+	(*ListSecretsRequest)(nil),             // 11: Superplane.Secrets.ListSecretsRequest
+	(*ListSecretsResponse)(nil),            // 12: Superplane.Secrets.ListSecretsResponse
+	(*DeleteSecretRequest)(nil),            // 13: Superplane.Secrets.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),           // 14: Superplane.Secrets.DeleteSecretResponse
+	(*SetSecretKeyRequest)(nil),            // 15: Superplane.Secrets.SetSecretKeyRequest
+	(*SetSecretKeyResponse)(nil),           // 16: Superplane.Secrets.SetSecretKeyResponse
+	(*DeleteSecretKeyRequest)(nil),         // 17: Superplane.Secrets.DeleteSecretKeyRequest
+	(*DeleteSecretKeyResponse)(nil),        // 18: Superplane.Secrets.DeleteSecretKeyResponse
+	(*UpdateSecretNameRequest)(nil),        // 19: Superplane.Secrets.UpdateSecretNameRequest
+	(*UpdateSecretNameResponse)(nil),       // 20: Superplane.Secrets.UpdateSecretNameResponse
+	(*SecretAccessEvent)(nil),              // 21: Superplane.Secrets.SecretAccessEvent
+	(*ListSecretAccessEventsRequest)(nil),  // 22: Superplane.Secrets.ListSecretAccessEventsRequest
+	(*ListSecretAccessEventsResponse)(nil), // 23: Superplane.Secrets.ListSecretAccessEventsResponse
+	(*Secret_Local)(nil),                   // 24: Superplane.Secrets.Secret.Local
+	(*Secret_Vault)(nil),                   // 25: Superplane.Secrets.Secret.Vault
+	(*Secret_Scope)(nil),                   // 26: Superplane.Secrets.Secret.Scope
+	(*Secret_AccessPolicy)(nil),            // 27: Superplane.Secrets.Secret.AccessPolicy
+	(*Secret_Metadata)(nil),                // 28: Superplane.Secrets.Secret.Metadata
+	(*Secret_Spec)(nil),                    // 29: Superplane.Secrets.Secret.Spec
+	nil,                                    // 30: Superplane.Secrets.Secret.Local.DataEntry
+	(authorization.DomainType)(0),          // 31: Superplane.Authorization.DomainType
+	(*timestamp.Timestamp)(nil),            // 32: google.protobuf.Timestamp
 }
 var file_secrets_proto_depIdxs = []int32{
-	19, // 0: Superplane.Secrets.Secret.metadata:type_name -> Superplane.Secrets.Secret.Metadata
-	20, // 1: Superplane.Secrets.Secret.spec:type_name -> Superplane.Secrets.Secret.Spec
-	1,  // 2: Superplane.Secrets.CreateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
-	22, // 3: Superplane.Secrets.CreateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 4: Superplane.Secrets.CreateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	1,  // 5: Superplane.Secrets.UpdateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
-	22, // 6: Superplane.Secrets.UpdateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 7: Superplane.Secrets.UpdateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 8: Superplane.Secrets.DescribeSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 9: Superplane.Secrets.DescribeSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 10: Superplane.Secrets.ListSecretsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 11: Superplane.Secrets.ListSecretsResponse.secrets:type_name -> Superplane.Secrets.Secret
-	22, // 12: Superplane.Secrets.DeleteSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	22, // 13: Superplane.Secrets.SetSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 14: Superplane.Secrets.SetSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 15: Superplane.Secrets.DeleteSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 16: Superplane.Secrets.DeleteSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 17: Superplane.Secrets.UpdateSecretNameRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 18: Superplane.Secrets.UpdateSecretNameResponse.secret:type_name -> Superplane.Secrets.Secret
-	21, // 19: Superplane.Secrets.Secret.Local.data:type_name -> Superplane.Secrets.Secret.Local.DataEntry
-	22, // 20: Superplane.Secrets.Secret.Metadata.domain_type:type_name -> Superplane.Authorization.DomainType
-	23, // 21: Superplane.Secrets.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 22: Superplane.Secrets.Secret.Spec.provider:type_name -> Superplane.Secrets.Secret.Provider
-	18, // 23: Superplane.Secrets.Secret.Spec.local:type_name -> Superplane.Secrets.Secret.Local
-	2,  // 24: Superplane.Secrets.Secrets.CreateSecret:input_type -> Superplane.Secrets.CreateSecretRequest
-	6,  // 25: Superplane.Secrets.Secrets.DescribeSecret:input_type -> Superplane.Secrets.DescribeSecretRequest
-	8,  // 26: Superplane.Secrets.Secrets.ListSecrets:input_type -> Superplane.Secrets.ListSecretsRequest
-	4,  // 27: Superplane.Secrets.Secrets.UpdateSecret:input_type -> Superplane.Secrets.UpdateSecretRequest
-	10, // 28: Superplane.Secrets.Secrets.DeleteSecret:input_type -> Superplane.Secrets.DeleteSecretRequest
-	12, // 29: Superplane.Secrets.Secrets.SetSecretKey:input_type -> Superplane.Secrets.SetSecretKeyRequest
-	14, // 30: Superplane.Secrets.Secrets.DeleteSecretKey:input_type -> Superplane.Secrets.DeleteSecretKeyRequest
-	16, // 31: Superplane.Secrets.Secrets.UpdateSecretName:input_type -> Superplane.Secrets.UpdateSecretNameRequest
-	3,  // 32: Superplane.Secrets.Secrets.CreateSecret:output_type -> Superplane.Secrets.CreateSecretResponse
-	7,  // 33: Superplane.Secrets.Secrets.DescribeSecret:output_type -> Superplane.Secrets.DescribeSecretResponse
-	9,  // 34: Superplane.Secrets.Secrets.ListSecrets:output_type -> Superplane.Secrets.ListSecretsResponse
-	5,  // 35: Superplane.Secrets.Secrets.UpdateSecret:output_type -> Superplane.Secrets.UpdateSecretResponse
-	11, // 36: Superplane.Secrets.Secrets.DeleteSecret:output_type -> Superplane.Secrets.DeleteSecretResponse
-	13, // 37: Superplane.Secrets.Secrets.SetSecretKey:output_type -> Superplane.Secrets.SetSecretKeyResponse
-	15, // 38: Superplane.Secrets.Secrets.DeleteSecretKey:output_type -> Superplane.Secrets.DeleteSecretKeyResponse
-	17, // 39: Superplane.Secrets.Secrets.UpdateSecretName:output_type -> Superplane.Secrets.UpdateSecretNameResponse
-	32, // [32:40] is the sub-list for method output_type
-	24, // [24:32] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	28, // 0: Superplane.Secrets.Secret.metadata:type_name -> Superplane.Secrets.Secret.Metadata
+	29, // 1: Superplane.Secrets.Secret.spec:type_name -> Superplane.Secrets.Secret.Spec
+	3,  // 2: Superplane.Secrets.CreateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
+	31, // 3: Superplane.Secrets.CreateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	3,  // 4: Superplane.Secrets.CreateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
+	3,  // 5: Superplane.Secrets.UpdateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
+	31, // 6: Superplane.Secrets.UpdateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	3,  // 7: Superplane.Secrets.UpdateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
+	31, // 8: Superplane.Secrets.DescribeSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	3,  // 9: Superplane.Secrets.DescribeSecretResponse.secret:type_name -> Superplane.Secrets.Secret
+	10, // 10: Superplane.Secrets.DescribeSecretResponse.references:type_name -> Superplane.Secrets.SecretReference
+	31, // 11: Superplane.Secrets.ListSecretsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	3,  // 12: Superplane.Secrets.ListSecretsResponse.secrets:type_name -> Superplane.Secrets.Secret
+	31, // 13: Superplane.Secrets.DeleteSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	31, // 14: Superplane.Secrets.SetSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	3,  // 15: Superplane.Secrets.SetSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
+	31, // 16: Superplane.Secrets.DeleteSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	3,  // 17: Superplane.Secrets.DeleteSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
+	31, // 18: Superplane.Secrets.UpdateSecretNameRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	3,  // 19: Superplane.Secrets.UpdateSecretNameResponse.secret:type_name -> Superplane.Secrets.Secret
+	1,  // 20: Superplane.Secrets.SecretAccessEvent.action:type_name -> Superplane.Secrets.SecretAccessEvent.Action
+	2,  // 21: Superplane.Secrets.SecretAccessEvent.actor_type:type_name -> Superplane.Secrets.SecretAccessEvent.ActorType
+	32, // 22: Superplane.Secrets.SecretAccessEvent.created_at:type_name -> google.protobuf.Timestamp
+	31, // 23: Superplane.Secrets.ListSecretAccessEventsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	32, // 24: Superplane.Secrets.ListSecretAccessEventsRequest.before:type_name -> google.protobuf.Timestamp
+	32, // 25: Superplane.Secrets.ListSecretAccessEventsRequest.after:type_name -> google.protobuf.Timestamp
+	21, // 26: Superplane.Secrets.ListSecretAccessEventsResponse.events:type_name -> Superplane.Secrets.SecretAccessEvent
+	32, // 27: Superplane.Secrets.ListSecretAccessEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	30, // 28: Superplane.Secrets.Secret.Local.data:type_name -> Superplane.Secrets.Secret.Local.DataEntry
+	31, // 29: Superplane.Secrets.Secret.Metadata.domain_type:type_name -> Superplane.Authorization.DomainType
+	32, // 30: Superplane.Secrets.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 31: Superplane.Secrets.Secret.Spec.provider:type_name -> Superplane.Secrets.Secret.Provider
+	24, // 32: Superplane.Secrets.Secret.Spec.local:type_name -> Superplane.Secrets.Secret.Local
+	25, // 33: Superplane.Secrets.Secret.Spec.vault:type_name -> Superplane.Secrets.Secret.Vault
+	26, // 34: Superplane.Secrets.Secret.Spec.scope:type_name -> Superplane.Secrets.Secret.Scope
+	27, // 35: Superplane.Secrets.Secret.Spec.access_policy:type_name -> Superplane.Secrets.Secret.AccessPolicy
+	4,  // 36: Superplane.Secrets.Secrets.CreateSecret:input_type -> Superplane.Secrets.CreateSecretRequest
+	8,  // 37: Superplane.Secrets.Secrets.DescribeSecret:input_type -> Superplane.Secrets.DescribeSecretRequest
+	11, // 38: Superplane.Secrets.Secrets.ListSecrets:input_type -> Superplane.Secrets.ListSecretsRequest
+	6,  // 39: Superplane.Secrets.Secrets.UpdateSecret:input_type -> Superplane.Secrets.UpdateSecretRequest
+	13, // 40: Superplane.Secrets.Secrets.DeleteSecret:input_type -> Superplane.Secrets.DeleteSecretRequest
+	15, // 41: Superplane.Secrets.Secrets.SetSecretKey:input_type -> Superplane.Secrets.SetSecretKeyRequest
+	17, // 42: Superplane.Secrets.Secrets.DeleteSecretKey:input_type -> Superplane.Secrets.DeleteSecretKeyRequest
+	19, // 43: Superplane.Secrets.Secrets.UpdateSecretName:input_type -> Superplane.Secrets.UpdateSecretNameRequest
+	22, // 44: Superplane.Secrets.Secrets.ListSecretAccessEvents:input_type -> Superplane.Secrets.ListSecretAccessEventsRequest
+	5,  // 45: Superplane.Secrets.Secrets.CreateSecret:output_type -> Superplane.Secrets.CreateSecretResponse
+	9,  // 46: Superplane.Secrets.Secrets.DescribeSecret:output_type -> Superplane.Secrets.DescribeSecretResponse
+	12, // 47: Superplane.Secrets.Secrets.ListSecrets:output_type -> Superplane.Secrets.ListSecretsResponse
+	7,  // 48: Superplane.Secrets.Secrets.UpdateSecret:output_type -> Superplane.Secrets.UpdateSecretResponse
+	14, // 49: Superplane.Secrets.Secrets.DeleteSecret:output_type -> Superplane.Secrets.DeleteSecretResponse
+	16, // 50: Superplane.Secrets.Secrets.SetSecretKey:output_type -> Superplane.Secrets.SetSecretKeyResponse
+	18, // 51: Superplane.Secrets.Secrets.DeleteSecretKey:output_type -> Superplane.Secrets.DeleteSecretKeyResponse
+	20, // 52: Superplane.Secrets.Secrets.UpdateSecretName:output_type -> Superplane.Secrets.UpdateSecretNameResponse
+	23, // 53: Superplane.Secrets.Secrets.ListSecretAccessEvents:output_type -> Superplane.Secrets.ListSecretAccessEventsResponse
+	45, // [45:54] is the sub-list for method output_type
+	36, // [36:45] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_secrets_proto_init() }
@@ -1362,8 +2117,8 @@ func file_secrets_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_secrets_proto_rawDesc), len(file_secrets_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   21,
+			NumEnums:      3,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
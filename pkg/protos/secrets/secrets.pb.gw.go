@@ -403,6 +403,57 @@ func local_request_Secrets_UpdateSecretName_0(ctx context.Context, marshaler run
 	return msg, metadata, err
 }
 
+var filter_Secrets_ListSecretAccessEvents_0 = &utilities.DoubleArray{Encoding: map[string]int{"id_or_name": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Secrets_ListSecretAccessEvents_0(ctx context.Context, marshaler runtime.Marshaler, client SecretsClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ListSecretAccessEventsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	io.Copy(io.Discard, req.Body)
+	val, ok := pathParams["id_or_name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id_or_name")
+	}
+	protoReq.IdOrName, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id_or_name", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Secrets_ListSecretAccessEvents_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.ListSecretAccessEvents(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Secrets_ListSecretAccessEvents_0(ctx context.Context, marshaler runtime.Marshaler, server SecretsServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ListSecretAccessEventsRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id_or_name"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id_or_name")
+	}
+	protoReq.IdOrName, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id_or_name", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Secrets_ListSecretAccessEvents_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.ListSecretAccessEvents(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterSecretsHandlerServer registers the http handlers for service Secrets to "mux".
 // UnaryRPC     :call SecretsServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -569,6 +620,26 @@ func RegisterSecretsHandlerServer(ctx context.Context, mux *runtime.ServeMux, se
 		}
 		forward_Secrets_UpdateSecretName_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Secrets_ListSecretAccessEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/Superplane.Secrets.Secrets/ListSecretAccessEvents", runtime.WithHTTPPathPattern("/api/v1/secrets/{id_or_name}/access-events"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Secrets_ListSecretAccessEvents_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Secrets_ListSecretAccessEvents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -745,27 +816,46 @@ func RegisterSecretsHandlerClient(ctx context.Context, mux *runtime.ServeMux, cl
 		}
 		forward_Secrets_UpdateSecretName_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Secrets_ListSecretAccessEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/Superplane.Secrets.Secrets/ListSecretAccessEvents", runtime.WithHTTPPathPattern("/api/v1/secrets/{id_or_name}/access-events"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Secrets_ListSecretAccessEvents_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Secrets_ListSecretAccessEvents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
-	pattern_Secrets_CreateSecret_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "v1", "secrets"}, ""))
-	pattern_Secrets_DescribeSecret_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "secrets", "id_or_name"}, ""))
-	pattern_Secrets_ListSecrets_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "v1", "secrets"}, ""))
-	pattern_Secrets_UpdateSecret_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "secrets", "id_or_name"}, ""))
-	pattern_Secrets_DeleteSecret_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "secrets", "id_or_name"}, ""))
-	pattern_Secrets_SetSecretKey_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "secrets", "id_or_name", "keys", "key_name"}, ""))
-	pattern_Secrets_DeleteSecretKey_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "secrets", "id_or_name", "keys", "key_name"}, ""))
-	pattern_Secrets_UpdateSecretName_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"api", "v1", "secrets", "id_or_name", "name"}, ""))
+	pattern_Secrets_CreateSecret_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "v1", "secrets"}, ""))
+	pattern_Secrets_DescribeSecret_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "secrets", "id_or_name"}, ""))
+	pattern_Secrets_ListSecrets_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "v1", "secrets"}, ""))
+	pattern_Secrets_UpdateSecret_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "secrets", "id_or_name"}, ""))
+	pattern_Secrets_DeleteSecret_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "secrets", "id_or_name"}, ""))
+	pattern_Secrets_SetSecretKey_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "secrets", "id_or_name", "keys", "key_name"}, ""))
+	pattern_Secrets_DeleteSecretKey_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "secrets", "id_or_name", "keys", "key_name"}, ""))
+	pattern_Secrets_UpdateSecretName_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"api", "v1", "secrets", "id_or_name", "name"}, ""))
+	pattern_Secrets_ListSecretAccessEvents_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"api", "v1", "secrets", "id_or_name", "access-events"}, ""))
 )
 
 var (
-	forward_Secrets_CreateSecret_0     = runtime.ForwardResponseMessage
-	forward_Secrets_DescribeSecret_0   = runtime.ForwardResponseMessage
-	forward_Secrets_ListSecrets_0      = runtime.ForwardResponseMessage
-	forward_Secrets_UpdateSecret_0     = runtime.ForwardResponseMessage
-	forward_Secrets_DeleteSecret_0     = runtime.ForwardResponseMessage
-	forward_Secrets_SetSecretKey_0     = runtime.ForwardResponseMessage
-	forward_Secrets_DeleteSecretKey_0  = runtime.ForwardResponseMessage
-	forward_Secrets_UpdateSecretName_0 = runtime.ForwardResponseMessage
+	forward_Secrets_CreateSecret_0           = runtime.ForwardResponseMessage
+	forward_Secrets_DescribeSecret_0         = runtime.ForwardResponseMessage
+	forward_Secrets_ListSecrets_0            = runtime.ForwardResponseMessage
+	forward_Secrets_UpdateSecret_0           = runtime.ForwardResponseMessage
+	forward_Secrets_DeleteSecret_0           = runtime.ForwardResponseMessage
+	forward_Secrets_SetSecretKey_0           = runtime.ForwardResponseMessage
+	forward_Secrets_DeleteSecretKey_0        = runtime.ForwardResponseMessage
+	forward_Secrets_UpdateSecretName_0       = runtime.ForwardResponseMessage
+	forward_Secrets_ListSecretAccessEvents_0 = runtime.ForwardResponseMessage
 )
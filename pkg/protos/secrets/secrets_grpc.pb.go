@@ -19,14 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Secrets_CreateSecret_FullMethodName     = "/Superplane.Secrets.Secrets/CreateSecret"
-	Secrets_DescribeSecret_FullMethodName   = "/Superplane.Secrets.Secrets/DescribeSecret"
-	Secrets_ListSecrets_FullMethodName      = "/Superplane.Secrets.Secrets/ListSecrets"
-	Secrets_UpdateSecret_FullMethodName     = "/Superplane.Secrets.Secrets/UpdateSecret"
-	Secrets_DeleteSecret_FullMethodName     = "/Superplane.Secrets.Secrets/DeleteSecret"
-	Secrets_SetSecretKey_FullMethodName     = "/Superplane.Secrets.Secrets/SetSecretKey"
-	Secrets_DeleteSecretKey_FullMethodName  = "/Superplane.Secrets.Secrets/DeleteSecretKey"
-	Secrets_UpdateSecretName_FullMethodName = "/Superplane.Secrets.Secrets/UpdateSecretName"
+	Secrets_CreateSecret_FullMethodName           = "/Superplane.Secrets.Secrets/CreateSecret"
+	Secrets_DescribeSecret_FullMethodName         = "/Superplane.Secrets.Secrets/DescribeSecret"
+	Secrets_ListSecrets_FullMethodName            = "/Superplane.Secrets.Secrets/ListSecrets"
+	Secrets_UpdateSecret_FullMethodName           = "/Superplane.Secrets.Secrets/UpdateSecret"
+	Secrets_DeleteSecret_FullMethodName           = "/Superplane.Secrets.Secrets/DeleteSecret"
+	Secrets_SetSecretKey_FullMethodName           = "/Superplane.Secrets.Secrets/SetSecretKey"
+	Secrets_DeleteSecretKey_FullMethodName        = "/Superplane.Secrets.Secrets/DeleteSecretKey"
+	Secrets_UpdateSecretName_FullMethodName       = "/Superplane.Secrets.Secrets/UpdateSecretName"
+	Secrets_ListSecretAccessEvents_FullMethodName = "/Superplane.Secrets.Secrets/ListSecretAccessEvents"
 )
 
 // SecretsClient is the client API for Secrets service.
@@ -41,6 +42,7 @@ type SecretsClient interface {
 	SetSecretKey(ctx context.Context, in *SetSecretKeyRequest, opts ...grpc.CallOption) (*SetSecretKeyResponse, error)
 	DeleteSecretKey(ctx context.Context, in *DeleteSecretKeyRequest, opts ...grpc.CallOption) (*DeleteSecretKeyResponse, error)
 	UpdateSecretName(ctx context.Context, in *UpdateSecretNameRequest, opts ...grpc.CallOption) (*UpdateSecretNameResponse, error)
+	ListSecretAccessEvents(ctx context.Context, in *ListSecretAccessEventsRequest, opts ...grpc.CallOption) (*ListSecretAccessEventsResponse, error)
 }
 
 type secretsClient struct {
@@ -131,6 +133,16 @@ func (c *secretsClient) UpdateSecretName(ctx context.Context, in *UpdateSecretNa
 	return out, nil
 }
 
+func (c *secretsClient) ListSecretAccessEvents(ctx context.Context, in *ListSecretAccessEventsRequest, opts ...grpc.CallOption) (*ListSecretAccessEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecretAccessEventsResponse)
+	err := c.cc.Invoke(ctx, Secrets_ListSecretAccessEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecretsServer is the server API for Secrets service.
 // All implementations should embed UnimplementedSecretsServer
 // for forward compatibility.
@@ -143,6 +155,7 @@ type SecretsServer interface {
 	SetSecretKey(context.Context, *SetSecretKeyRequest) (*SetSecretKeyResponse, error)
 	DeleteSecretKey(context.Context, *DeleteSecretKeyRequest) (*DeleteSecretKeyResponse, error)
 	UpdateSecretName(context.Context, *UpdateSecretNameRequest) (*UpdateSecretNameResponse, error)
+	ListSecretAccessEvents(context.Context, *ListSecretAccessEventsRequest) (*ListSecretAccessEventsResponse, error)
 }
 
 // UnimplementedSecretsServer should be embedded to have
@@ -176,6 +189,9 @@ func (UnimplementedSecretsServer) DeleteSecretKey(context.Context, *DeleteSecret
 func (UnimplementedSecretsServer) UpdateSecretName(context.Context, *UpdateSecretNameRequest) (*UpdateSecretNameResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateSecretName not implemented")
 }
+func (UnimplementedSecretsServer) ListSecretAccessEvents(context.Context, *ListSecretAccessEventsRequest) (*ListSecretAccessEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSecretAccessEvents not implemented")
+}
 func (UnimplementedSecretsServer) testEmbeddedByValue() {}
 
 // UnsafeSecretsServer may be embedded to opt out of forward compatibility for this service.
@@ -340,6 +356,24 @@ func _Secrets_UpdateSecretName_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Secrets_ListSecretAccessEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretAccessEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ListSecretAccessEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Secrets_ListSecretAccessEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ListSecretAccessEvents(ctx, req.(*ListSecretAccessEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Secrets_ServiceDesc is the grpc.ServiceDesc for Secrets service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -379,6 +413,10 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSecretName",
 			Handler:    _Secrets_UpdateSecretName_Handler,
 		},
+		{
+			MethodName: "ListSecretAccessEvents",
+			Handler:    _Secrets_ListSecretAccessEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secrets.proto",
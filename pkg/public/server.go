@@ -34,9 +34,11 @@ import (
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/oidc"
+	pbAudit "github.com/superplanehq/superplane/pkg/protos/audit"
 	pbBlueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
 	pbComponents "github.com/superplanehq/superplane/pkg/protos/components"
+	pbExpressions "github.com/superplanehq/superplane/pkg/protos/expressions"
 	pbGroups "github.com/superplanehq/superplane/pkg/protos/groups"
 	pbIntegrations "github.com/superplanehq/superplane/pkg/protos/integrations"
 	pbMe "github.com/superplanehq/superplane/pkg/protos/me"
@@ -61,6 +63,10 @@ const (
 
 	// The size of the stage execution outputs can be up to 4k
 	MaxExecutionOutputsSize = 4 * 1024
+
+	// Responses from the internal API can be up to 32MB in size,
+	// enough for the largest artifact download
+	MaxGatewayMessageSize = 32 * 1024 * 1024
 )
 
 type Server struct {
@@ -169,10 +175,18 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 
 	grpcGatewayMux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(headersMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeadersMatcher),
 		runtime.SetQueryParameterParser(&grpc.QueryParser{}),
 	)
 
-	opts := []grpcLib.DialOption{grpcLib.WithTransportCredentials(insecure.NewCredentials())}
+	//
+	// Artifact downloads are returned in a single message,
+	// so the gateway needs to accept messages bigger than the 4MB default.
+	//
+	opts := []grpcLib.DialOption{
+		grpcLib.WithTransportCredentials(insecure.NewCredentials()),
+		grpcLib.WithDefaultCallOptions(grpcLib.MaxCallRecvMsgSize(MaxGatewayMessageSize)),
+	}
 
 	err := pbUsers.RegisterUsersHandlerFromEndpoint(ctx, grpcGatewayMux, grpcServerAddr, opts)
 	if err != nil {
@@ -224,6 +238,11 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 		return err
 	}
 
+	err = pbExpressions.RegisterExpressionsHandlerFromEndpoint(ctx, grpcGatewayMux, grpcServerAddr, opts)
+	if err != nil {
+		return err
+	}
+
 	err = pbBlueprints.RegisterBlueprintsHandlerFromEndpoint(ctx, grpcGatewayMux, grpcServerAddr, opts)
 	if err != nil {
 		return err
@@ -239,6 +258,11 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 		return err
 	}
 
+	err = pbAudit.RegisterAuditHandlerFromEndpoint(ctx, grpcGatewayMux, grpcServerAddr, opts)
+	if err != nil {
+		return err
+	}
+
 	// Public health check
 	s.Router.HandleFunc("/api/v1/canvases/is-alive", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -263,8 +287,10 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 	s.Router.PathPrefix("/api/v1/components").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/triggers").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/widgets").Handler(protectedGRPCHandler)
+	s.Router.PathPrefix("/api/v1/expressions").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/blueprints").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/service-accounts").Handler(protectedGRPCHandler)
+	s.Router.PathPrefix("/api/v1/audit-log").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/workflows").Handler(protectedGRPCHandler)
 
 	return nil
@@ -279,6 +305,16 @@ func headersMatcher(key string) (string, bool) {
 	}
 }
 
+// Content-Disposition is set on artifact downloads,
+// and needs to reach the client as is, without the Grpc-Metadata- prefix.
+func outgoingHeadersMatcher(key string) (string, bool) {
+	if key == "content-disposition" {
+		return "Content-Disposition", true
+	}
+
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
 func (s *Server) grpcGatewayHandler(grpcGatewayMux *runtime.ServeMux) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		user, ok := middleware.GetUserFromContext(r.Context())
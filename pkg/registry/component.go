@@ -65,6 +65,11 @@ func (s *PanicableComponent) OutputChannels(config any) []core.OutputChannel {
 	return s.underlying.OutputChannels(config)
 }
 
+func (s *PanicableComponent) Simulatable() bool {
+	simulatable, ok := s.underlying.(core.SimulatableComponent)
+	return ok && simulatable.Simulatable()
+}
+
 /*
  * Panicking methods.
  * These are where the component logic is implemented,
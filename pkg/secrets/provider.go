@@ -12,6 +12,7 @@ import (
 
 const (
 	ProviderLocal = "local"
+	ProviderVault = "vault"
 )
 
 type Provider interface {
@@ -30,9 +31,20 @@ func NewProvider(tx *gorm.DB, encryptor crypto.Encryptor, name, domainType strin
 		return nil, fmt.Errorf("error finding secret %s: %v", name, err)
 	}
 
+	return NewProviderForSecret(tx, encryptor, secret)
+}
+
+func NewProviderForSecret(tx *gorm.DB, encryptor crypto.Encryptor, secret *models.Secret) (Provider, error) {
 	switch secret.Provider {
 	case ProviderLocal:
 		return NewLocalProvider(tx, encryptor, secret), nil
+	case ProviderVault:
+		client := vaultClient.Load()
+		if client == nil {
+			return nil, fmt.Errorf("vault is not configured")
+		}
+
+		return NewVaultProvider(client, secret), nil
 	default:
 		return nil, fmt.Errorf("provider not supported: %s", secret.Provider)
 	}
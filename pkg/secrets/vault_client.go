@@ -0,0 +1,353 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultVaultMount        = "secret"
+	DefaultVaultAppRoleMount = "approle"
+	DefaultVaultCacheTTL     = 30 * time.Second
+)
+
+type VaultOptions struct {
+	Address   string
+	Namespace string
+
+	//
+	// Either a token, or an AppRole role and secret ID, is used to authenticate.
+	// AppRole tokens are renewed by logging in again before they expire.
+	//
+	Token        string
+	RoleID       string
+	SecretID     string
+	AppRoleMount string
+
+	//
+	// KV v2 mount used by references that do not specify one.
+	//
+	DefaultMount string
+
+	//
+	// How long values read from Vault are reused for.
+	// Zero disables caching.
+	//
+	CacheTTL time.Duration
+
+	HTTPClient *http.Client
+}
+
+// VaultClient reads secrets from the KV v2 secrets engine of a Vault server.
+type VaultClient struct {
+	options VaultOptions
+
+	mu    sync.Mutex
+	token string
+
+	// Zero for tokens without a lease, which are used until Vault rejects them.
+	tokenExpiresAt time.Time
+	cache          map[string]cachedVaultSecret
+}
+
+type cachedVaultSecret struct {
+	values    map[string]string
+	expiresAt time.Time
+}
+
+func NewVaultClient(options VaultOptions) (*VaultClient, error) {
+	if options.Address == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+
+	if options.Token == "" && (options.RoleID == "" || options.SecretID == "") {
+		return nil, fmt.Errorf("vault token or AppRole credentials are required")
+	}
+
+	options.Address = strings.TrimRight(options.Address, "/")
+	if options.AppRoleMount == "" {
+		options.AppRoleMount = DefaultVaultAppRoleMount
+	}
+
+	if options.DefaultMount == "" {
+		options.DefaultMount = DefaultVaultMount
+	}
+
+	if options.HTTPClient == nil {
+		options.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &VaultClient{
+		options: options,
+		token:   options.Token,
+		cache:   map[string]cachedVaultSecret{},
+	}, nil
+}
+
+// NewVaultClientFromEnv returns nil if VAULT_ADDR is not set,
+// since the Vault provider is optional.
+func NewVaultClientFromEnv() (*VaultClient, error) {
+	address := os.Getenv("VAULT_ADDR")
+	if address == "" {
+		return nil, nil
+	}
+
+	cacheTTL := DefaultVaultCacheTTL
+	if value := os.Getenv("VAULT_CACHE_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl < 0 {
+			return nil, fmt.Errorf("invalid VAULT_CACHE_TTL %q", value)
+		}
+
+		cacheTTL = ttl
+	}
+
+	return NewVaultClient(VaultOptions{
+		Address:      address,
+		Namespace:    os.Getenv("VAULT_NAMESPACE"),
+		Token:        os.Getenv("VAULT_TOKEN"),
+		RoleID:       os.Getenv("VAULT_ROLE_ID"),
+		SecretID:     os.Getenv("VAULT_SECRET_ID"),
+		AppRoleMount: os.Getenv("VAULT_APPROLE_MOUNT"),
+		DefaultMount: os.Getenv("VAULT_KV_MOUNT"),
+		CacheTTL:     cacheTTL,
+	})
+}
+
+// Read returns the latest version of the values at the reference.
+func (c *VaultClient) Read(ctx context.Context, ref *VaultReference) (map[string]string, error) {
+	mount := ref.Mount
+	if mount == "" {
+		mount = c.options.DefaultMount
+	}
+
+	cacheKey := mount + "/" + ref.Path
+	if values, ok := c.cached(cacheKey); ok {
+		return values, nil
+	}
+
+	values, err := c.read(ctx, mount, ref.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.options.CacheTTL > 0 {
+		c.mu.Lock()
+		c.cache[cacheKey] = cachedVaultSecret{values: values, expiresAt: time.Now().Add(c.options.CacheTTL)}
+		c.mu.Unlock()
+	}
+
+	return values, nil
+}
+
+func (c *VaultClient) cached(key string) (map[string]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.cache[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(entry.expiresAt) {
+		delete(c.cache, key)
+		return nil, false
+	}
+
+	return entry.values, true
+}
+
+func (c *VaultClient) read(ctx context.Context, mount, path string) (map[string]string, error) {
+	endpoint := fmt.Sprintf("/v1/%s/data/%s", strings.Trim(mount, "/"), strings.Trim(path, "/"))
+
+	response, err := c.authenticatedRequest(ctx, http.MethodGet, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+
+	err = json.Unmarshal(response, &body)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding vault response: %v", err)
+	}
+
+	values := make(map[string]string, len(body.Data.Data))
+	for key, value := range body.Data.Data {
+		if s, ok := value.(string); ok {
+			values[key] = s
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding vault value %s: %v", key, err)
+		}
+
+		values[key] = string(encoded)
+	}
+
+	return values, nil
+}
+
+// authenticatedRequest sends a request with the current token.
+// If an AppRole token is rejected, we log in again and retry once,
+// since the token might have been revoked before it expired.
+func (c *VaultClient) authenticatedRequest(ctx context.Context, method, endpoint string) ([]byte, error) {
+	token, err := c.currentToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, response, err := c.do(ctx, method, endpoint, token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == http.StatusForbidden && c.usesAppRole() {
+		c.resetToken()
+		token, err = c.currentToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		status, response, err = c.do(ctx, method, endpoint, token, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if status == http.StatusNotFound {
+		return nil, fmt.Errorf("vault secret %s not found", endpoint)
+	}
+
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("vault request %s failed with status %d", endpoint, status)
+	}
+
+	return response, nil
+}
+
+func (c *VaultClient) usesAppRole() bool {
+	return c.options.Token == ""
+}
+
+func (c *VaultClient) resetToken() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = ""
+}
+
+func (c *VaultClient) currentToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.usesAppRole() {
+		return c.token, nil
+	}
+
+	if c.token != "" && (c.tokenExpiresAt.IsZero() || time.Now().Before(c.tokenExpiresAt)) {
+		return c.token, nil
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"role_id":   c.options.RoleID,
+		"secret_id": c.options.SecretID,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("/v1/auth/%s/login", strings.Trim(c.options.AppRoleMount, "/"))
+	status, response, err := c.do(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return "", err
+	}
+
+	if status != http.StatusOK {
+		return "", fmt.Errorf("vault AppRole login failed with status %d", status)
+	}
+
+	var body struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int    `json:"lease_duration"`
+		} `json:"auth"`
+	}
+
+	err = json.Unmarshal(response, &body)
+	if err != nil {
+		return "", fmt.Errorf("error decoding vault login response: %v", err)
+	}
+
+	if body.Auth.ClientToken == "" {
+		return "", fmt.Errorf("vault AppRole login returned no token")
+	}
+
+	//
+	// Tokens are renewed once 80% of their lease has passed,
+	// so requests in flight do not use an expired token.
+	// A lease of 0 means the token does not expire, e.g. periodic tokens,
+	// so it is only renewed once Vault rejects it.
+	//
+	c.token = body.Auth.ClientToken
+	c.tokenExpiresAt = time.Time{}
+	if body.Auth.LeaseDuration > 0 {
+		lease := time.Duration(body.Auth.LeaseDuration) * time.Second
+		c.tokenExpiresAt = time.Now().Add(lease * 8 / 10)
+	}
+
+	return c.token, nil
+}
+
+func (c *VaultClient) do(ctx context.Context, method, endpoint, token string, payload []byte) (int, []byte, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, c.options.Address+(&url.URL{Path: endpoint}).EscapedPath(), body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if token != "" {
+		request.Header.Set("X-Vault-Token", token)
+	}
+
+	if c.options.Namespace != "" {
+		request.Header.Set("X-Vault-Namespace", c.options.Namespace)
+	}
+
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := c.options.HTTPClient.Do(request)
+	if err != nil {
+		return 0, nil, fmt.Errorf("vault request failed: %v", err)
+	}
+
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, 1024*1024))
+	if err != nil {
+		return 0, nil, fmt.Errorf("error reading vault response: %v", err)
+	}
+
+	return response.StatusCode, data, nil
+}
@@ -0,0 +1,177 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+type vaultStandIn struct {
+	server *httptest.Server
+	logins atomic.Int32
+	reads  atomic.Int32
+	lease  atomic.Int32
+}
+
+func newVaultStandIn(t *testing.T) *vaultStandIn {
+	v := &vaultStandIn{}
+	v.lease.Store(3600)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/auth/approle/login", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["role_id"] != "role" || body["secret_id"] != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		v.logins.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"auth": map[string]any{"client_token": "approle-token", "lease_duration": v.lease.Load()},
+		})
+	})
+
+	mux.HandleFunc("GET /v1/secret/data/ci/github", func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("X-Vault-Token")
+		if token != "root" && token != "approle-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		v.reads.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"data":     map[string]any{"token": "abc", "port": 8080},
+				"metadata": map[string]any{"version": 1},
+			},
+		})
+	})
+
+	v.server = httptest.NewServer(mux)
+	t.Cleanup(v.server.Close)
+	return v
+}
+
+func Test__VaultClient(t *testing.T) {
+	t.Run("options are validated", func(t *testing.T) {
+		_, err := NewVaultClient(VaultOptions{Token: "root"})
+		require.ErrorContains(t, err, "vault address is required")
+
+		_, err = NewVaultClient(VaultOptions{Address: "http://vault", RoleID: "role"})
+		require.ErrorContains(t, err, "vault token or AppRole credentials are required")
+	})
+
+	t.Run("reads values with a token", func(t *testing.T) {
+		vault := newVaultStandIn(t)
+		client, err := NewVaultClient(VaultOptions{Address: vault.server.URL, Token: "root"})
+		require.NoError(t, err)
+
+		values, err := client.Read(context.Background(), &VaultReference{Path: "ci/github"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"token": "abc", "port": "8080"}, values)
+		assert.Zero(t, vault.logins.Load())
+	})
+
+	t.Run("logs in with AppRole", func(t *testing.T) {
+		vault := newVaultStandIn(t)
+		client, err := NewVaultClient(VaultOptions{Address: vault.server.URL, RoleID: "role", SecretID: "secret"})
+		require.NoError(t, err)
+
+		values, err := client.Read(context.Background(), &VaultReference{Path: "ci/github"})
+		require.NoError(t, err)
+		assert.Equal(t, "abc", values["token"])
+		assert.Equal(t, int32(1), vault.logins.Load())
+	})
+
+	t.Run("AppRole token without a lease is reused", func(t *testing.T) {
+		vault := newVaultStandIn(t)
+		vault.lease.Store(0)
+		client, err := NewVaultClient(VaultOptions{Address: vault.server.URL, RoleID: "role", SecretID: "secret"})
+		require.NoError(t, err)
+
+		for range 3 {
+			_, err := client.Read(context.Background(), &VaultReference{Path: "ci/github"})
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(3), vault.reads.Load())
+		assert.Equal(t, int32(1), vault.logins.Load())
+	})
+
+	t.Run("values are cached", func(t *testing.T) {
+		vault := newVaultStandIn(t)
+		client, err := NewVaultClient(VaultOptions{Address: vault.server.URL, Token: "root", CacheTTL: time.Minute})
+		require.NoError(t, err)
+
+		for range 3 {
+			_, err := client.Read(context.Background(), &VaultReference{Path: "ci/github"})
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(1), vault.reads.Load())
+	})
+
+	t.Run("values are not cached without a TTL", func(t *testing.T) {
+		vault := newVaultStandIn(t)
+		client, err := NewVaultClient(VaultOptions{Address: vault.server.URL, Token: "root"})
+		require.NoError(t, err)
+
+		for range 2 {
+			_, err := client.Read(context.Background(), &VaultReference{Path: "ci/github"})
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(2), vault.reads.Load())
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		vault := newVaultStandIn(t)
+		client, err := NewVaultClient(VaultOptions{Address: vault.server.URL, Token: "root"})
+		require.NoError(t, err)
+
+		_, err = client.Read(context.Background(), &VaultReference{Path: "ci/gitlab"})
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("rejected token", func(t *testing.T) {
+		vault := newVaultStandIn(t)
+		client, err := NewVaultClient(VaultOptions{Address: vault.server.URL, Token: "wrong"})
+		require.NoError(t, err)
+
+		_, err = client.Read(context.Background(), &VaultReference{Path: "ci/github"})
+		require.ErrorContains(t, err, "status 403")
+	})
+}
+
+func Test__VaultProvider(t *testing.T) {
+	vault := newVaultStandIn(t)
+	client, err := NewVaultClient(VaultOptions{Address: vault.server.URL, Token: "root"})
+	require.NoError(t, err)
+
+	record := &models.Secret{
+		Name:     "github",
+		Provider: ProviderVault,
+		Data:     []byte(`{"path": "ci/github"}`),
+	}
+
+	t.Run("loads values from vault", func(t *testing.T) {
+		values, err := NewVaultProvider(client, record).Load(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "abc", values["token"])
+	})
+
+	t.Run("vault is not configured", func(t *testing.T) {
+		ConfigureVault(nil)
+		_, err := NewProviderForSecret(nil, nil, record)
+		require.ErrorContains(t, err, "vault is not configured")
+	})
+}
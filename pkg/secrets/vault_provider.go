@@ -0,0 +1,74 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// VaultReference is what vault secrets store in Secret.Data.
+// The values themselves never leave Vault, except when they are loaded.
+type VaultReference struct {
+	Mount string `json:"mount,omitempty"`
+	Path  string `json:"path"`
+}
+
+func (r *VaultReference) Validate() error {
+	if strings.Trim(r.Path, "/ ") == "" {
+		return fmt.Errorf("vault path is required")
+	}
+
+	if strings.Contains(r.Path, "..") || strings.Contains(r.Mount, "..") {
+		return fmt.Errorf("invalid vault path")
+	}
+
+	return nil
+}
+
+func ParseVaultReference(data []byte) (*VaultReference, error) {
+	var ref VaultReference
+	err := json.Unmarshal(data, &ref)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling vault reference: %v", err)
+	}
+
+	return &ref, nil
+}
+
+var vaultClient atomic.Pointer[VaultClient]
+
+// ConfigureVault sets the client used by vault secrets.
+// Without one, loading vault secrets fails.
+func ConfigureVault(client *VaultClient) {
+	vaultClient.Store(client)
+}
+
+type VaultProvider struct {
+	client *VaultClient
+	record *models.Secret
+}
+
+func NewVaultProvider(client *VaultClient, record *models.Secret) *VaultProvider {
+	return &VaultProvider{
+		client: client,
+		record: record,
+	}
+}
+
+func (p *VaultProvider) Load(ctx context.Context) (map[string]string, error) {
+	ref, err := ParseVaultReference(p.record.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error loading secret %s: %v", p.record.Name, err)
+	}
+
+	values, err := p.client.Read(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("error loading secret %s: %v", p.record.Name, err)
+	}
+
+	return values, nil
+}
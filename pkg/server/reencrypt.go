@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/crypto/rotation"
+	registry "github.com/superplanehq/superplane/pkg/registry"
+)
+
+// ReEncrypt re-encrypts all the values stored in the database
+// with the active key from ENCRYPTION_ACTIVE_KEY_ID.
+//
+// It is run with `superplane reencrypt` after a new key is made active,
+// and once it succeeds, the previous key can be removed from ENCRYPTION_KEYS.
+func ReEncrypt(args []string) {
+	configureLogging()
+
+	flags := flag.NewFlagSet("reencrypt", flag.ExitOnError)
+	batchSize := flags.Int("batch-size", rotation.DefaultBatchSize, "number of rows re-encrypted per transaction")
+	_ = flags.Parse(args)
+
+	encryptor, err := newEncryptor()
+	if err != nil {
+		log.Fatalf("failed to create encryptor: %v", err)
+	}
+
+	envelopeEncryptor, ok := encryptor.(*crypto.EnvelopeEncryptor)
+	if !ok {
+		log.Fatal("ENCRYPTION_KEYS must be set to re-encrypt values")
+	}
+
+	registry, err := registry.NewRegistry(encryptor, registry.HTTPOptions{
+		BlockedHosts:     getBlockedHTTPHosts(),
+		PrivateIPRanges:  getPrivateIPRanges(),
+		MaxResponseBytes: DefaultMaxHTTPResponseBytes,
+	})
+
+	if err != nil {
+		log.Fatalf("failed to create registry: %v", err)
+	}
+
+	results, err := rotation.NewRotator(envelopeEncryptor, registry, *batchSize).Run(context.Background())
+	if err != nil {
+		log.Fatalf("failed to re-encrypt values: %v", err)
+	}
+
+	failed := 0
+	for _, result := range results {
+		failed += result.Failed
+	}
+
+	if failed > 0 {
+		log.Errorf("%d values could not be re-encrypted", failed)
+		os.Exit(1)
+	}
+
+	log.Info("All values are encrypted with the active key")
+}
@@ -3,12 +3,16 @@ package server
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/config"
 	"github.com/superplanehq/superplane/pkg/crypto"
@@ -17,6 +21,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/public"
 	registry "github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"github.com/superplanehq/superplane/pkg/services"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/templates"
@@ -25,11 +30,17 @@ import (
 	// Import integrations, components and triggers to register them via init()
 	_ "github.com/superplanehq/superplane/pkg/components/approval"
 	_ "github.com/superplanehq/superplane/pkg/components/filter"
+	_ "github.com/superplanehq/superplane/pkg/components/foreach"
+	_ "github.com/superplanehq/superplane/pkg/components/gather"
+	_ "github.com/superplanehq/superplane/pkg/components/getvariable"
 	_ "github.com/superplanehq/superplane/pkg/components/http"
 	_ "github.com/superplanehq/superplane/pkg/components/if"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
+	_ "github.com/superplanehq/superplane/pkg/components/runcanvas"
+	_ "github.com/superplanehq/superplane/pkg/components/setvariable"
 	_ "github.com/superplanehq/superplane/pkg/components/ssh"
+	_ "github.com/superplanehq/superplane/pkg/components/switch"
 	_ "github.com/superplanehq/superplane/pkg/components/timegate"
 	_ "github.com/superplanehq/superplane/pkg/components/wait"
 	_ "github.com/superplanehq/superplane/pkg/integrations/aws"
@@ -62,7 +73,7 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/widgets/annotation"
 )
 
-func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, authService authorization.Authorization) {
+func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, artifactStorage artifacts.Storage, oidcProvider oidc.Provider, baseURL string, authService authorization.Authorization) {
 	log.Println("Starting Workers")
 
 	rabbitMQURL, err := config.RabbitMQURL()
@@ -85,14 +96,14 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		log.Println("Starting Node Executor")
 
 		webhookBaseURL := getWebhookBaseURL(baseURL)
-		w := workers.NewNodeExecutor(encryptor, registry, baseURL, webhookBaseURL)
+		w := workers.NewNodeExecutor(encryptor, registry, artifactStorage, baseURL, webhookBaseURL)
 		go w.Start(context.Background())
 	}
 
 	if os.Getenv("START_NODE_REQUEST_WORKER") == "yes" {
 		log.Println("Starting Node Request Worker")
 
-		w := workers.NewNodeRequestWorker(encryptor, registry)
+		w := workers.NewNodeRequestWorker(encryptor, registry, artifactStorage)
 		go w.Start(context.Background())
 	}
 
@@ -139,6 +150,24 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		w := workers.NewCanvasCleanupWorker()
 		go w.Start(context.Background())
 	}
+
+	if os.Getenv("START_RETENTION_WORKER") == "yes" {
+		log.Println("Starting Retention Worker")
+
+		w := workers.NewRetentionWorker(artifactStorage)
+		go w.Start(context.Background())
+	}
+
+	//
+	// Objects left behind by rolled back executions pile up otherwise,
+	// so this worker runs unless explicitly disabled.
+	//
+	if os.Getenv("START_ARTIFACT_UPLOAD_CLEANUP_WORKER") != "no" {
+		log.Println("Starting Artifact Upload Cleanup Worker")
+
+		w := workers.NewArtifactUploadCleanupWorker(artifactStorage)
+		go w.Start(context.Background())
+	}
 }
 
 func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, baseURL string, authService authorization.Authorization) {
@@ -178,9 +207,36 @@ func startEmailConsumersWithService(rabbitMQURL string, emailService services.Em
 	go notificationEmailConsumer.Start()
 }
 
-func startInternalAPI(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry, oidcProvider oidc.Provider) {
+func startInternalAPI(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry, artifactStorage artifacts.Storage, oidcProvider oidc.Provider) {
 	log.Println("Starting Internal API")
-	grpc.RunServer(baseURL, webhooksBaseURL, basePath, encryptor, authService, registry, oidcProvider, lookupInternalAPIPort())
+	grpc.RunServer(baseURL, webhooksBaseURL, basePath, encryptor, authService, registry, artifactStorage, oidcProvider, lookupInternalAPIPort(), setupExecutionMetrics())
+}
+
+// setupExecutionMetrics returns the handler for the /metrics endpoint
+// served on the internal API port, or nil if Prometheus metrics are not enabled.
+func setupExecutionMetrics() http.Handler {
+	if os.Getenv("PROMETHEUS_METRICS_ENABLED") != "yes" {
+		return nil
+	}
+
+	maxSeries := telemetry.DefaultExecutionMetricsMaxSeries
+	if v := os.Getenv("PROMETHEUS_METRICS_MAX_SERIES"); v != "" {
+		if n, errConv := strconv.Atoi(v); errConv == nil && n > 0 {
+			maxSeries = n
+		} else {
+			log.Warnf("Invalid PROMETHEUS_METRICS_MAX_SERIES %q, falling back to %d", v, maxSeries)
+		}
+	}
+
+	metrics, err := telemetry.NewExecutionMetrics(maxSeries)
+	if err != nil {
+		log.Warnf("Failed to initialize Prometheus metrics: %v", err)
+		return nil
+	}
+
+	metrics.Start(context.Background(), telemetry.DefaultExecutionMetricsRefreshInterval)
+	log.Infof("Prometheus metrics initialized, with up to %d series per metric", maxSeries)
+	return metrics.Handler()
 }
 
 func startPublicAPI(baseURL, basePath string, encryptor crypto.Encryptor, registry *registry.Registry, jwtSigner *jwt.Signer, oidcProvider oidc.Provider, authService authorization.Authorization) {
@@ -295,26 +351,49 @@ func setupOtelMetrics() {
 	}
 }
 
+func setupOtelTracing() func() {
+	if os.Getenv("OTEL_ENABLED") != "yes" {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	shutdown, err := telemetry.InitTracing(ctx)
+	if err != nil {
+		log.Warnf("Failed to initialize OpenTelemetry tracing: %v", err)
+		return func() {}
+	}
+
+	log.Info("OpenTelemetry tracing initialized")
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := shutdown(ctx); err != nil {
+			log.Warnf("Failed to flush OpenTelemetry traces: %v", err)
+		}
+	}
+}
+
 func Start() {
 	configureLogging()
 	setupOtelMetrics()
+	shutdownOtelTracing := setupOtelTracing()
 
 	telemetry.InitSentry()
 	telemetry.StartBeacon()
 
-	encryptionKey := os.Getenv("ENCRYPTION_KEY")
-	if encryptionKey == "" {
+	if os.Getenv("ENCRYPTION_KEY") == "" && os.Getenv("ENCRYPTION_KEYS") == "" {
 		panic("ENCRYPTION_KEY can't be empty")
 	}
 
 	log.SetLevel(log.DebugLevel)
 
-	var encryptorInstance crypto.Encryptor
-	if os.Getenv("NO_ENCRYPTION") == "yes" {
-		log.Warn("NO_ENCRYPTION is set to yes, using NoOpEncryptor")
-		encryptorInstance = crypto.NewNoOpEncryptor()
-	} else {
-		encryptorInstance = crypto.NewAESGCMEncryptor([]byte(encryptionKey))
+	encryptorInstance, err := newEncryptor()
+	if err != nil {
+		panic(fmt.Sprintf("failed to create encryptor: %v", err))
 	}
 
 	authService, err := authorization.NewAuthService()
@@ -361,19 +440,36 @@ func Start() {
 
 	templates.Setup(registry)
 
+	artifactStorage, err := artifacts.NewStorageFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("failed to set up artifact storage: %v", err))
+	}
+
+	vaultClient, err := secrets.NewVaultClientFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("failed to set up vault: %v", err))
+	}
+
+	secrets.ConfigureVault(vaultClient)
+
 	if os.Getenv("START_PUBLIC_API") == "yes" {
 		go startPublicAPI(baseURL, basePath, encryptorInstance, registry, jwtSigner, oidcProvider, authService)
 	}
 
 	if os.Getenv("START_INTERNAL_API") == "yes" {
-		go startInternalAPI(baseURL, webhooksBaseURL, basePath, encryptorInstance, authService, registry, oidcProvider)
+		go startInternalAPI(baseURL, webhooksBaseURL, basePath, encryptorInstance, authService, registry, artifactStorage, oidcProvider)
 	}
 
-	startWorkers(encryptorInstance, registry, oidcProvider, baseURL, authService)
+	startWorkers(encryptorInstance, registry, artifactStorage, oidcProvider, baseURL, authService)
 
 	log.Println("SuperPlane is UP.")
 
-	select {}
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	log.Println("SuperPlane is shutting down.")
+	shutdownOtelTracing()
 }
 
 // getWebhookBaseURL returns the webhook base URL, using the same pattern as SyncContext.
@@ -388,6 +484,48 @@ func getWebhookBaseURL(baseURL string) string {
 	return webhookBaseURL
 }
 
+// newEncryptor returns the encryptor used for secrets and other stored credentials.
+// With ENCRYPTION_KEYS set, it uses envelope encryption, and ENCRYPTION_KEY is only
+// used to decrypt values encrypted before that, until they are re-encrypted
+// with the reencrypt command. Without it, ENCRYPTION_KEY is used directly.
+func newEncryptor() (crypto.Encryptor, error) {
+	if os.Getenv("NO_ENCRYPTION") == "yes" {
+		log.Warn("NO_ENCRYPTION is set to yes, using NoOpEncryptor")
+		return crypto.NewNoOpEncryptor(), nil
+	}
+
+	var legacy crypto.Encryptor
+	if encryptionKey := os.Getenv("ENCRYPTION_KEY"); encryptionKey != "" {
+		legacy = crypto.NewAESGCMEncryptor([]byte(encryptionKey))
+	}
+
+	encryptionKeys := os.Getenv("ENCRYPTION_KEYS")
+	if encryptionKeys == "" {
+		if legacy == nil {
+			return nil, fmt.Errorf("ENCRYPTION_KEY can't be empty")
+		}
+
+		return legacy, nil
+	}
+
+	keys, err := crypto.ParseKeys(encryptionKeys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ENCRYPTION_KEYS: %v", err)
+	}
+
+	activeKeyID := os.Getenv("ENCRYPTION_ACTIVE_KEY_ID")
+	if activeKeyID == "" {
+		return nil, fmt.Errorf("ENCRYPTION_ACTIVE_KEY_ID must be set")
+	}
+
+	keySource, err := crypto.NewStaticKeySource(activeKeyID, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.NewEnvelopeEncryptor(keySource, legacy), nil
+}
+
 /*
  * 512KB is the default maximum response size for HTTP responses.
  * This prevents component/trigger implementations from using too much memory,
@@ -0,0 +1,408 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	DefaultExecutionMetricsMaxSeries       = 2000
+	DefaultExecutionMetricsRefreshInterval = 15 * time.Second
+
+	//
+	// Executions are read by the time they finished, but a transaction
+	// finishing an execution can commit a little after that time.
+	// Every refresh looks back this far, and skips executions it already counted.
+	//
+	executionMetricsLookback = time.Minute
+	executionMetricsPageSize = 1000
+
+	// Label value used for nodes over the series limit.
+	OverflowLabelValue = "_other"
+)
+
+var executionMetricsLabels = []string{"organization_id", "canvas_id", "node_id", "component"}
+
+var executionDurationBuckets = []float64{
+	0.1, 0.5, 1, 5, 15, 30, 60, 300, 900, 1800,
+	3600, 3 * 3600, 6 * 3600, 12 * 3600, 24 * 3600, 72 * 3600,
+}
+
+//
+// ExecutionMetrics exposes per-node execution metrics in Prometheus format.
+//
+// Executions finish in the workers, which might not run in the process
+// serving the metrics, so everything is read from the database:
+// finished executions are read incrementally, by the time they finished,
+// and the queue gauges are replaced on every refresh.
+//
+// Counters start from zero when the process starts, like any Prometheus counter.
+// Every node is one series for each metric, so to keep large installs safe,
+// nodes over the series limit are reported together, under the "_other" label value.
+//
+
+type ExecutionMetrics struct {
+	registry  *prometheus.Registry
+	maxSeries int
+
+	executions *prometheus.CounterVec
+	durations  *prometheus.HistogramVec
+	queueItems *prometheus.GaugeVec
+	queueAge   *prometheus.GaugeVec
+
+	mu        sync.Mutex
+	startedAt time.Time
+	watermark time.Time
+	counted   map[uuid.UUID]time.Time
+	series    map[string]struct{}
+	warned    bool
+}
+
+func NewExecutionMetrics(maxSeries int) (*ExecutionMetrics, error) {
+	if maxSeries <= 0 {
+		maxSeries = DefaultExecutionMetricsMaxSeries
+	}
+
+	m := &ExecutionMetrics{
+		registry:  prometheus.NewRegistry(),
+		maxSeries: maxSeries,
+		counted:   map[uuid.UUID]time.Time{},
+		series:    map[string]struct{}{},
+
+		executions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "superplane_node_executions_total",
+			Help: "Number of finished node executions, by result and result reason.",
+		}, []string{"organization_id", "canvas_id", "node_id", "component", "result", "result_reason"}),
+
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "superplane_node_execution_duration_seconds",
+			Help:    "Time from the creation of a node execution until it finished.",
+			Buckets: executionDurationBuckets,
+		}, []string{"organization_id", "canvas_id", "node_id", "component", "result"}),
+
+		queueItems: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "superplane_node_queue_items",
+			Help: "Number of items waiting in the queue of a node.",
+		}, executionMetricsLabels),
+
+		queueAge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "superplane_node_queue_oldest_item_age_seconds",
+			Help: "Age of the oldest item waiting in the queue of a node.",
+		}, executionMetricsLabels),
+	}
+
+	registered := []prometheus.Collector{
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.executions,
+		m.durations,
+		m.queueItems,
+		m.queueAge,
+	}
+
+	for _, collector := range registered {
+		if err := m.registry.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	watermark, err := lastExecutionFinishedAt()
+	if err != nil {
+		return nil, err
+	}
+
+	m.startedAt = watermark
+	m.watermark = watermark
+	return m, nil
+}
+
+func (m *ExecutionMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *ExecutionMetrics) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := m.Refresh(); err != nil {
+					log.Errorf("Error refreshing execution metrics: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (m *ExecutionMetrics) Refresh() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	err := m.refreshExecutions()
+	if err != nil {
+		return err
+	}
+
+	return m.refreshQueues()
+}
+
+type finishedExecutionRow struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	WorkflowID     uuid.UUID
+	NodeID         string
+	Component      string
+	Result         string
+	ResultReason   string
+	CreatedAt      time.Time
+	FinishedAt     time.Time
+}
+
+func (m *ExecutionMetrics) refreshExecutions() error {
+	after := m.watermark.Add(-executionMetricsLookback)
+	afterID := uuid.Nil
+
+	for {
+		rows, err := listFinishedExecutions(after, afterID, executionMetricsPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			m.countExecution(row)
+		}
+
+		if len(rows) < executionMetricsPageSize {
+			break
+		}
+
+		last := rows[len(rows)-1]
+		after = last.FinishedAt
+		afterID = last.ID
+	}
+
+	//
+	// Executions that finished before the lookback window
+	// will not be read again, so we can forget about them.
+	//
+	for id, finishedAt := range m.counted {
+		if finishedAt.Before(m.watermark.Add(-executionMetricsLookback)) {
+			delete(m.counted, id)
+		}
+	}
+
+	return nil
+}
+
+func (m *ExecutionMetrics) countExecution(row finishedExecutionRow) {
+	if !row.FinishedAt.After(m.startedAt) {
+		return
+	}
+
+	if _, ok := m.counted[row.ID]; ok {
+		return
+	}
+
+	m.counted[row.ID] = row.FinishedAt
+	if row.FinishedAt.After(m.watermark) {
+		m.watermark = row.FinishedAt
+	}
+
+	reason := row.ResultReason
+	if reason == "" {
+		reason = models.CanvasNodeExecutionResultReasonOk
+	}
+
+	labels := m.labelsFor(row.OrganizationID, row.WorkflowID, row.NodeID, row.Component)
+	m.executions.With(withLabels(labels, "result", row.Result, "result_reason", reason)).Inc()
+
+	duration := row.FinishedAt.Sub(row.CreatedAt)
+	if duration >= 0 {
+		m.durations.With(withLabels(labels, "result", row.Result)).Observe(duration.Seconds())
+	}
+}
+
+// labelsFor returns the labels for a node,
+// or the overflow labels, if the node is over the series limit.
+func (m *ExecutionMetrics) labelsFor(organizationID, canvasID uuid.UUID, nodeID, component string) prometheus.Labels {
+	key := strings.Join([]string{organizationID.String(), canvasID.String(), nodeID, component}, "/")
+	if _, ok := m.series[key]; !ok {
+		if len(m.series) >= m.maxSeries {
+			if !m.warned {
+				log.Warnf("Execution metrics series limit of %d reached - reporting new nodes as %s", m.maxSeries, OverflowLabelValue)
+				m.warned = true
+			}
+
+			return overflowLabels(component)
+		}
+
+		m.series[key] = struct{}{}
+	}
+
+	return prometheus.Labels{
+		"organization_id": organizationID.String(),
+		"canvas_id":       canvasID.String(),
+		"node_id":         nodeID,
+		"component":       component,
+	}
+}
+
+type queueRow struct {
+	OrganizationID uuid.UUID
+	WorkflowID     uuid.UUID
+	NodeID         string
+	Component      string
+	Depth          int64
+	OldestAt       time.Time
+}
+
+func (m *ExecutionMetrics) refreshQueues() error {
+	rows, err := listQueueDepths()
+	if err != nil {
+		return err
+	}
+
+	m.queueItems.Reset()
+	m.queueAge.Reset()
+
+	//
+	// Queues are ordered by depth, so when there are more
+	// queues than the series limit, only the shortest ones are folded together.
+	//
+	overflowDepth := map[string]int64{}
+	overflowAge := map[string]float64{}
+	for i, row := range rows {
+		age := time.Since(row.OldestAt).Seconds()
+		if i >= m.maxSeries {
+			overflowDepth[row.Component] += row.Depth
+			overflowAge[row.Component] = max(overflowAge[row.Component], age)
+			continue
+		}
+
+		labels := prometheus.Labels{
+			"organization_id": row.OrganizationID.String(),
+			"canvas_id":       row.WorkflowID.String(),
+			"node_id":         row.NodeID,
+			"component":       row.Component,
+		}
+
+		m.queueItems.With(labels).Set(float64(row.Depth))
+		m.queueAge.With(labels).Set(age)
+	}
+
+	for component, depth := range overflowDepth {
+		m.queueItems.With(overflowLabels(component)).Set(float64(depth))
+		m.queueAge.With(overflowLabels(component)).Set(overflowAge[component])
+	}
+
+	return nil
+}
+
+func overflowLabels(component string) prometheus.Labels {
+	return prometheus.Labels{
+		"organization_id": OverflowLabelValue,
+		"canvas_id":       OverflowLabelValue,
+		"node_id":         OverflowLabelValue,
+		"component":       component,
+	}
+}
+
+func withLabels(labels prometheus.Labels, pairs ...string) prometheus.Labels {
+	result := prometheus.Labels{}
+	for k, v := range labels {
+		result[k] = v
+	}
+
+	for i := 0; i+1 < len(pairs); i += 2 {
+		result[pairs[i]] = pairs[i+1]
+	}
+
+	return result
+}
+
+// Nodes reference a component, a trigger or a blueprint.
+// For blueprint nodes, the component label is just "blueprint".
+const nodeComponentColumn = `COALESCE(n.ref->'component'->>'name', n.ref->'trigger'->>'name', n.type, '')`
+
+func lastExecutionFinishedAt() (time.Time, error) {
+	var finishedAt *time.Time
+	err := database.Conn().
+		Raw(`SELECT MAX(finished_at) FROM workflow_node_executions`).
+		Scan(&finishedAt).
+		Error
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if finishedAt == nil {
+		return time.Time{}, nil
+	}
+
+	return *finishedAt, nil
+}
+
+func listFinishedExecutions(after time.Time, afterID uuid.UUID, limit int) ([]finishedExecutionRow, error) {
+	var rows []finishedExecutionRow
+	err := database.Conn().
+		Raw(`
+			SELECT
+				e.id, w.organization_id, e.workflow_id, e.node_id,
+				`+nodeComponentColumn+` AS component,
+				e.result, e.result_reason, e.created_at, e.finished_at
+			FROM workflow_node_executions e
+			INNER JOIN workflows w ON w.id = e.workflow_id
+			LEFT JOIN workflow_nodes n ON n.workflow_id = e.workflow_id AND n.node_id = e.node_id
+			WHERE (e.finished_at, e.id) > (?, ?)
+			ORDER BY e.finished_at ASC, e.id ASC
+			LIMIT ?
+		`, after, afterID, limit).
+		Scan(&rows).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func listQueueDepths() ([]queueRow, error) {
+	var rows []queueRow
+	err := database.Conn().
+		Raw(`
+			SELECT
+				w.organization_id, q.workflow_id, q.node_id,
+				` + nodeComponentColumn + ` AS component,
+				COUNT(*) AS depth,
+				MIN(q.created_at) AS oldest_at
+			FROM workflow_node_queue_items q
+			INNER JOIN workflows w ON w.id = q.workflow_id
+			LEFT JOIN workflow_nodes n ON n.workflow_id = q.workflow_id AND n.node_id = q.node_id
+			GROUP BY w.organization_id, q.workflow_id, q.node_id, component
+			ORDER BY depth DESC
+		`).
+		Scan(&rows).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,181 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/datatypes"
+)
+
+func Test__ExecutionMetrics_CountsFinishedExecutionsOnce(t *testing.T) {
+	database.TruncateTables()
+
+	steps := executionMetricsTestSteps{t: t}
+	steps.CreateWorkflow()
+	node := steps.CreateComponentNode("node-1", "noop")
+	steps.CreateRootEvent(node)
+
+	metrics, err := NewExecutionMetrics(10)
+	require.NoError(t, err)
+
+	steps.CreateFinishedExecution(node, models.CanvasNodeExecutionResultPassed, "", 30*time.Second)
+	steps.CreateFinishedExecution(node, models.CanvasNodeExecutionResultFailed, models.CanvasNodeExecutionResultReasonError, time.Second)
+
+	//
+	// Executions already counted are not counted again,
+	// even if they are read again in the lookback window.
+	//
+	require.NoError(t, metrics.Refresh())
+	require.NoError(t, metrics.Refresh())
+
+	labels := steps.Labels(node)
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.executions.With(withLabels(labels, "result", "passed", "result_reason", "ok"))))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.executions.With(withLabels(labels, "result", "failed", "result_reason", "error"))))
+	require.Equal(t, 2, testutil.CollectAndCount(metrics.durations))
+}
+
+func Test__ExecutionMetrics_IgnoresExecutionsFinishedBeforeStart(t *testing.T) {
+	database.TruncateTables()
+
+	steps := executionMetricsTestSteps{t: t}
+	steps.CreateWorkflow()
+	node := steps.CreateComponentNode("node-1", "noop")
+	steps.CreateRootEvent(node)
+	steps.CreateFinishedExecution(node, models.CanvasNodeExecutionResultPassed, "", time.Second)
+
+	metrics, err := NewExecutionMetrics(10)
+	require.NoError(t, err)
+	require.NoError(t, metrics.Refresh())
+	require.Equal(t, 0, testutil.CollectAndCount(metrics.executions))
+}
+
+func Test__ExecutionMetrics_QueueDepthAndAge(t *testing.T) {
+	database.TruncateTables()
+
+	steps := executionMetricsTestSteps{t: t}
+	steps.CreateWorkflow()
+	node := steps.CreateComponentNode("node-1", "approval")
+	steps.CreateRootEvent(node)
+	steps.CreateQueueItem(node, time.Now().Add(-time.Hour))
+	steps.CreateQueueItem(node, time.Now())
+
+	metrics, err := NewExecutionMetrics(10)
+	require.NoError(t, err)
+	require.NoError(t, metrics.Refresh())
+
+	labels := steps.Labels(node)
+	require.Equal(t, 2.0, testutil.ToFloat64(metrics.queueItems.With(labels)))
+	require.GreaterOrEqual(t, testutil.ToFloat64(metrics.queueAge.With(labels)), time.Hour.Seconds())
+
+	//
+	// Gauges are replaced on every refresh,
+	// so empty queues are no longer reported.
+	//
+	require.NoError(t, database.Conn().Where("1 = 1").Delete(&models.CanvasNodeQueueItem{}).Error)
+	require.NoError(t, metrics.Refresh())
+	require.Equal(t, 0, testutil.CollectAndCount(metrics.queueItems))
+}
+
+func Test__ExecutionMetrics_NodesOverTheSeriesLimitAreReportedTogether(t *testing.T) {
+	database.TruncateTables()
+
+	steps := executionMetricsTestSteps{t: t}
+	steps.CreateWorkflow()
+	first := steps.CreateComponentNode("node-1", "noop")
+	second := steps.CreateComponentNode("node-2", "noop")
+	steps.CreateRootEvent(first)
+
+	metrics, err := NewExecutionMetrics(1)
+	require.NoError(t, err)
+
+	steps.CreateFinishedExecution(first, models.CanvasNodeExecutionResultPassed, "", time.Second)
+	require.NoError(t, metrics.Refresh())
+	steps.CreateFinishedExecution(second, models.CanvasNodeExecutionResultPassed, "", time.Second)
+	require.NoError(t, metrics.Refresh())
+
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.executions.With(withLabels(steps.Labels(first), "result", "passed", "result_reason", "ok"))))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.executions.With(withLabels(overflowLabels("noop"), "result", "passed", "result_reason", "ok"))))
+	require.Equal(t, 2, testutil.CollectAndCount(metrics.executions))
+}
+
+type executionMetricsTestSteps struct {
+	t         *testing.T
+	workflow  *models.Canvas
+	rootEvent *models.CanvasEvent
+}
+
+func (s *executionMetricsTestSteps) CreateWorkflow() {
+	s.workflow = &models.Canvas{
+		OrganizationID: uuid.New(),
+		Name:           "Test Workflow",
+	}
+
+	require.NoError(s.t, database.Conn().Create(s.workflow).Error)
+}
+
+func (s *executionMetricsTestSteps) CreateComponentNode(nodeID, component string) *models.CanvasNode {
+	node := &models.CanvasNode{
+		WorkflowID: s.workflow.ID,
+		NodeID:     nodeID,
+		Type:       models.NodeTypeComponent,
+		Ref:        datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: component}}),
+	}
+
+	require.NoError(s.t, database.Conn().Create(node).Error)
+	return node
+}
+
+func (s *executionMetricsTestSteps) CreateRootEvent(node *models.CanvasNode) {
+	s.rootEvent = &models.CanvasEvent{
+		WorkflowID: s.workflow.ID,
+		NodeID:     node.NodeID,
+		Channel:    "default",
+		Data:       datatypes.JSONType[any]{},
+		State:      models.CanvasEventStateRouted,
+	}
+
+	require.NoError(s.t, database.Conn().Create(s.rootEvent).Error)
+}
+
+func (s *executionMetricsTestSteps) CreateFinishedExecution(node *models.CanvasNode, result, reason string, duration time.Duration) {
+	finishedAt := time.Now()
+	createdAt := finishedAt.Add(-duration)
+
+	require.NoError(s.t, database.Conn().Create(&models.CanvasNodeExecution{
+		WorkflowID:   s.workflow.ID,
+		NodeID:       node.NodeID,
+		RootEventID:  s.rootEvent.ID,
+		EventID:      s.rootEvent.ID,
+		State:        models.CanvasNodeExecutionStateFinished,
+		Result:       result,
+		ResultReason: reason,
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &finishedAt,
+		FinishedAt:   &finishedAt,
+	}).Error)
+}
+
+func (s *executionMetricsTestSteps) CreateQueueItem(node *models.CanvasNode, createdAt time.Time) {
+	require.NoError(s.t, database.Conn().Create(&models.CanvasNodeQueueItem{
+		WorkflowID:  s.workflow.ID,
+		NodeID:      node.NodeID,
+		RootEventID: s.rootEvent.ID,
+		EventID:     s.rootEvent.ID,
+		CreatedAt:   &createdAt,
+	}).Error)
+}
+
+func (s *executionMetricsTestSteps) Labels(node *models.CanvasNode) prometheus.Labels {
+	return prometheus.Labels{
+		"organization_id": s.workflow.OrganizationID.String(),
+		"canvas_id":       s.workflow.ID.String(),
+		"node_id":         node.NodeID,
+		"component":       node.Ref.Data().Component.Name,
+	}
+}
@@ -31,6 +31,7 @@ var (
 
 	workflowCleanupWorkerTickHistogram          metric.Float64Histogram
 	workflowCleanupWorkerCanvasesCountHistogram metric.Int64Histogram
+	retentionWorkerTickHistogram                metric.Float64Histogram
 
 	dbLocksCountHistogram       metric.Int64Histogram
 	dbLongQueriesCountHistogram metric.Int64Histogram
@@ -141,6 +142,15 @@ func InitMetrics(ctx context.Context) error {
 		return err
 	}
 
+	retentionWorkerTickHistogram, err = meter.Float64Histogram(
+		"retention_worker.tick.duration.seconds",
+		metric.WithDescription("Duration of each RetentionWorker tick"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
 	dbLocksCountHistogram, err = meter.Int64Histogram(
 		"db.locks.count",
 		metric.WithDescription("Number of database locks"),
@@ -260,6 +270,14 @@ func RecordWorkflowCleanupWorkerCanvasesCount(ctx context.Context, count int) {
 	workflowCleanupWorkerCanvasesCountHistogram.Record(ctx, int64(count))
 }
 
+func RecordRetentionWorkerTickDuration(ctx context.Context, d time.Duration) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	retentionWorkerTickHistogram.Record(ctx, d.Seconds())
+}
+
 func RecordDBLocksCount(ctx context.Context, count int64) {
 	if !metricsReady.Load() {
 		return
@@ -0,0 +1,159 @@
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//
+// A trace is started for every root event, and its context is carried
+// from row to row, as W3C traceparent strings: from the event to the queue items
+// created for it, from the queue items to the executions, and from the
+// executions to the events they emit. Each worker that picks up one of those
+// rows continues the trace from there.
+//
+// If tracing is not initialized, the global no-op provider is used,
+// and no traceparent is ever produced, so nothing is stored either.
+//
+
+const traceparentHeader = "traceparent"
+const serviceName = "superplane"
+
+var propagator = propagation.TraceContext{}
+
+// InitTracing sets up the global tracer provider, exporting spans with OTLP.
+// The returned function flushes the spans still buffered, and must be called before exiting.
+func InitTracing(ctx context.Context) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES
+	// are applied last, so they can override the service name.
+	//
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithFromEnv(),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	return provider.Shutdown, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(serviceName)
+}
+
+// StartSpan starts a span continuing the trace in the given traceparent.
+// If traceparent is nil, the span starts a new trace.
+func StartSpan(traceparent *string, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer().Start(
+		ContextFromTraceparent(traceparent),
+		name,
+		trace.WithAttributes(attributes...),
+	)
+}
+
+// StartChildSpan starts a span under the span in the given context.
+func StartChildSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer().Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// FailSpan records the error in the span, and marks it as failed.
+func FailSpan(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// EndSpan ends the span, marking it as failed if err is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		FailSpan(span, err)
+	}
+
+	span.End()
+}
+
+// RecordSpan records a span that already happened, like the time an item spent in a queue.
+func RecordSpan(traceparent *string, name string, start, end time.Time, attributes ...attribute.KeyValue) {
+	_, span := tracer().Start(
+		ContextFromTraceparent(traceparent),
+		name,
+		trace.WithTimestamp(start),
+		trace.WithAttributes(attributes...),
+	)
+
+	span.End(trace.WithTimestamp(end))
+}
+
+// StartTrace records the span that starts the trace for a root event,
+// returning the traceparent to store with the event.
+// If parent is not nil, like for events emitted by a Run Canvas execution
+// in another canvas, the event continues that trace instead.
+func StartTrace(parent *string, name string, attributes ...attribute.KeyValue) *string {
+	ctx, span := StartSpan(parent, name, attributes...)
+	defer span.End()
+
+	return Traceparent(ctx)
+}
+
+// ContextFromTraceparent returns a context with the remote span in traceparent.
+func ContextFromTraceparent(traceparent *string) context.Context {
+	if traceparent == nil || *traceparent == "" {
+		return context.Background()
+	}
+
+	carrier := propagation.MapCarrier{traceparentHeader: *traceparent}
+	return propagator.Extract(context.Background(), carrier)
+}
+
+// Traceparent returns the traceparent for the span in the context,
+// or nil if there is no valid span in it.
+func Traceparent(ctx context.Context) *string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	traceparent := carrier.Get(traceparentHeader)
+	if traceparent == "" {
+		return nil
+	}
+
+	return &traceparent
+}
+
+// TraceID returns the trace ID in the traceparent, or an empty string.
+func TraceID(traceparent *string) string {
+	spanContext := trace.SpanContextFromContext(ContextFromTraceparent(traceparent))
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+
+	return spanContext.TraceID().String()
+}
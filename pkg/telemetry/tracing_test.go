@@ -0,0 +1,67 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func useTestTracerProvider(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+}
+
+func Test__Tracing_TraceIsCarriedThroughTraceparent(t *testing.T) {
+	useTestTracerProvider(t)
+
+	root := StartTrace(nil, "event.emit")
+	require.NotNil(t, root)
+	traceID := TraceID(root)
+	require.Len(t, traceID, 32)
+
+	ctx, span := StartSpan(root, "event.route")
+	span.End()
+
+	routed := Traceparent(ctx)
+	require.NotNil(t, routed)
+	assert.NotEqual(t, *root, *routed)
+	assert.Equal(t, traceID, TraceID(routed))
+
+	//
+	// A new root event starts a new trace,
+	// unless it continues the trace of an execution.
+	//
+	other := StartTrace(nil, "event.emit")
+	require.NotNil(t, other)
+	assert.NotEqual(t, traceID, TraceID(other))
+
+	invoked := StartTrace(routed, "event.emit")
+	require.NotNil(t, invoked)
+	assert.Equal(t, traceID, TraceID(invoked))
+}
+
+func Test__Tracing_NothingIsStoredWithoutTracing(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(noop.NewTracerProvider())
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	assert.Nil(t, StartTrace(nil, "event.emit"))
+
+	ctx, span := StartSpan(nil, "node.execute")
+	span.End()
+	assert.Nil(t, Traceparent(ctx))
+}
+
+func Test__Tracing_TraceID(t *testing.T) {
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", TraceID(&traceparent))
+
+	invalid := "not-a-traceparent"
+	assert.Empty(t, TraceID(&invalid))
+	assert.Empty(t, TraceID(nil))
+}
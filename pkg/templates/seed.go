@@ -139,7 +139,7 @@ func deleteAllTemplateWorkflows(tx *gorm.DB) error {
 
 func createTemplateCanvas(tx *gorm.DB, registry *registry.Registry, template *pb.Canvas) error {
 	organizationID := models.TemplateOrganizationID.String()
-	nodes, edges, err := canvases.ParseCanvas(registry, organizationID, template)
+	nodes, edges, err := canvases.ParseCanvas(registry, organizationID, "", template)
 	if err != nil {
 		return err
 	}
@@ -166,6 +166,10 @@ func createTemplateCanvas(tx *gorm.DB, registry *registry.Registry, template *pb
 		return err
 	}
 
+	if _, err := models.CreateCanvasVersionInTransaction(tx, &canvas, nil, "Initial version"); err != nil {
+		return err
+	}
+
 	for _, node := range expandedNodes {
 		var parentNodeID *string
 		if idx := strings.Index(node.ID, ":"); idx != -1 {
@@ -187,6 +191,11 @@ func createTemplateCanvas(tx *gorm.DB, registry *registry.Registry, template *pb
 			UpdatedAt:     &now,
 		}
 
+		canvasNode.SetConcurrencyGroup(node.ConcurrencyGroup)
+		canvasNode.SetRetryPolicy(node.RetryPolicy)
+		canvasNode.SetExecutionTimeout(node.ExecutionTimeoutSeconds)
+		canvasNode.SetQueuePolicy(node.QueuePolicy)
+		canvasNode.SetRateLimit(node.RateLimit)
 		if err := tx.Create(&canvasNode).Error; err != nil {
 			return err
 		}
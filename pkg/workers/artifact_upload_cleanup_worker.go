@@ -0,0 +1,88 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+//
+// ArtifactUploadCleanupWorker deletes artifact objects left behind
+// by execution transactions that rolled back after the object was uploaded.
+//
+// Every object written to the storage is recorded as an upload,
+// outside of the execution transaction. Once an upload is old enough,
+// its object is deleted if no artifact references it, and the upload is forgotten.
+//
+// Unlike the retention worker, it runs by default,
+// since any execution creating artifacts can leave objects behind.
+//
+
+type ArtifactUploadCleanupWorker struct {
+	logger  *log.Entry
+	storage artifacts.Storage
+
+	//
+	// Uploads are only checked once they are older than this,
+	// so the transactions that created them are done.
+	//
+	gracePeriod       time.Duration
+	maxUploadsPerTick int
+}
+
+func NewArtifactUploadCleanupWorker(storage artifacts.Storage) *ArtifactUploadCleanupWorker {
+	return &ArtifactUploadCleanupWorker{
+		logger:            log.WithFields(log.Fields{"worker": "ArtifactUploadCleanupWorker"}),
+		storage:           storage,
+		gracePeriod:       time.Hour,
+		maxUploadsPerTick: 100,
+	}
+}
+
+func (w *ArtifactUploadCleanupWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.CleanupArtifactUploads(); err != nil {
+				w.logger.Errorf("Error cleaning up artifact uploads: %v", err)
+			}
+		}
+	}
+}
+
+// CleanupArtifactUploads deletes the objects of uploads that no artifact references,
+// and forgets about the uploads that were checked.
+func (w *ArtifactUploadCleanupWorker) CleanupArtifactUploads() error {
+	uploads, err := models.ListStaleArtifactUploads(w.storage.Name(), time.Now().Add(-w.gracePeriod), w.maxUploadsPerTick)
+	if err != nil {
+		return fmt.Errorf("failed to find artifact uploads: %w", err)
+	}
+
+	for _, upload := range uploads {
+		if !upload.Referenced {
+			err := w.storage.Delete(context.Background(), upload.StorageKey)
+			if err != nil && !errors.Is(err, artifacts.ErrArtifactNotFound) {
+				w.logger.Errorf("Error deleting unreferenced artifact %s: %v", upload.StorageKey, err)
+				continue
+			}
+
+			w.logger.Infof("Deleted unreferenced artifact %s", upload.StorageKey)
+		}
+
+		if err := models.DeleteArtifactUpload(upload.ID); err != nil {
+			return fmt.Errorf("failed to delete artifact upload %s: %w", upload.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__ArtifactUploadCleanupWorker(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas := createRetentionTestCanvas(t, r)
+	_, execution := createRetentionTestRun(t, canvas.ID, time.Now(), true)
+
+	storage := &retentionTestStorage{objects: map[string][]byte{
+		"referenced":   []byte("kept"),
+		"unreferenced": []byte("orphaned"),
+	}}
+
+	worker := NewArtifactUploadCleanupWorker(storage)
+	worker.gracePeriod = -time.Minute
+
+	require.NoError(t, models.CreateNodeExecutionArtifactInTransaction(database.Conn(), &models.CanvasNodeExecutionArtifact{
+		WorkflowID:  canvas.ID,
+		NodeID:      "node-1",
+		ExecutionID: execution.ID,
+		Name:        "report.txt",
+		ContentType: "text/plain",
+		Size:        4,
+		Storage:     storage.Name(),
+		StorageKey:  "referenced",
+	}))
+
+	require.NoError(t, models.CreateArtifactUpload(storage.Name(), "referenced"))
+	require.NoError(t, models.CreateArtifactUpload(storage.Name(), "unreferenced"))
+
+	require.NoError(t, worker.CleanupArtifactUploads())
+
+	assert.Contains(t, storage.objects, "referenced")
+	assert.NotContains(t, storage.objects, "unreferenced")
+
+	var count int64
+	require.NoError(t, database.Conn().Model(&models.ArtifactUpload{}).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+}
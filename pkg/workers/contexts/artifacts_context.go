@@ -0,0 +1,171 @@
+package contexts
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+const MaxArtifactNameLength = 255
+
+// ArtifactsContext stores the artifacts of an execution.
+// The content goes to the artifact storage, and the metadata to the database.
+type ArtifactsContext struct {
+	tx        *gorm.DB
+	storage   artifacts.Storage
+	execution *models.CanvasNodeExecution
+	maxSize   int64
+}
+
+func NewArtifactsContext(tx *gorm.DB, storage artifacts.Storage, execution *models.CanvasNodeExecution) *ArtifactsContext {
+	return &ArtifactsContext{
+		tx:        tx,
+		storage:   storage,
+		execution: execution,
+		maxSize:   artifacts.MaxSizeFromEnv(),
+	}
+}
+
+// Upload implements core.ArtifactsContext.
+func (c *ArtifactsContext) Upload(name, contentType string, content io.Reader) (*core.Artifact, error) {
+	if c.storage == nil {
+		return nil, fmt.Errorf("artifact storage is not configured")
+	}
+
+	name = strings.TrimSpace(name)
+	err := validateArtifactName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	existing, err := models.ListNodeExecutionArtifactsInTransaction(c.tx, c.execution.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, artifact := range existing {
+		if artifact.Name == name {
+			return nil, fmt.Errorf("%w: %s", core.ErrArtifactAlreadyExists, name)
+		}
+	}
+
+	data, err := io.ReadAll(io.LimitReader(content, c.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading artifact %s: %v", name, err)
+	}
+
+	if int64(len(data)) > c.maxSize {
+		return nil, fmt.Errorf("artifact %s is too large (max %d bytes)", name, c.maxSize)
+	}
+
+	//
+	// Keys use the artifact ID instead of its name,
+	// so names never need to be escaped for the storage.
+	//
+	hash := sha256.Sum256(data)
+	artifact := models.CanvasNodeExecutionArtifact{
+		ID:          uuid.New(),
+		WorkflowID:  c.execution.WorkflowID,
+		NodeID:      c.execution.NodeID,
+		ExecutionID: c.execution.ID,
+		Name:        name,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		SHA256:      hex.EncodeToString(hash[:]),
+		Storage:     c.storage.Name(),
+	}
+
+	artifact.StorageKey = fmt.Sprintf("%s/%s/%s", artifact.WorkflowID, artifact.ExecutionID, artifact.ID)
+
+	//
+	// The object is written before the transaction creating the artifact row commits.
+	// The upload is recorded outside of it, so if it rolls back,
+	// the retention worker can still find the object and delete it.
+	//
+	err = models.CreateArtifactUpload(artifact.Storage, artifact.StorageKey)
+	if err != nil {
+		return nil, fmt.Errorf("error recording upload for artifact %s: %v", name, err)
+	}
+
+	err = c.storage.Put(context.Background(), artifact.StorageKey, bytes.NewReader(data), artifact.Size, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("error storing artifact %s: %v", name, err)
+	}
+
+	err = models.CreateNodeExecutionArtifactInTransaction(c.tx, &artifact)
+	if err != nil {
+		if errors.Is(err, models.ErrNameAlreadyUsed) {
+			return nil, fmt.Errorf("%w: %s", core.ErrArtifactAlreadyExists, name)
+		}
+
+		return nil, err
+	}
+
+	reference := ArtifactReference(artifact)
+	return &reference, nil
+}
+
+// List implements core.ArtifactsContext.
+func (c *ArtifactsContext) List() ([]core.Artifact, error) {
+	return listArtifactReferences(c.tx, c.execution.ID)
+}
+
+// ArtifactReference is how an artifact is exposed to components and in emitted events.
+func ArtifactReference(artifact models.CanvasNodeExecutionArtifact) core.Artifact {
+	return core.Artifact{
+		ID:          artifact.ID.String(),
+		Name:        artifact.Name,
+		ContentType: artifact.ContentType,
+		Size:        artifact.Size,
+		SHA256:      artifact.SHA256,
+	}
+}
+
+func listArtifactReferences(tx *gorm.DB, executionID uuid.UUID) ([]core.Artifact, error) {
+	artifacts, err := models.ListNodeExecutionArtifactsInTransaction(tx, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	references := make([]core.Artifact, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		references = append(references, ArtifactReference(artifact))
+	}
+
+	return references, nil
+}
+
+func validateArtifactName(name string) error {
+	if name == "" {
+		return fmt.Errorf("artifact name is required")
+	}
+
+	if len(name) > MaxArtifactNameLength {
+		return fmt.Errorf("artifact name cannot be longer than %d characters", MaxArtifactNameLength)
+	}
+
+	if strings.ContainsAny(name, "/\\") || strings.ContainsFunc(name, isControlCharacter) {
+		return fmt.Errorf("invalid artifact name %q: cannot contain slashes or control characters", name)
+	}
+
+	return nil
+}
+
+func isControlCharacter(r rune) bool {
+	return r < 0x20 || r == 0x7f
+}
@@ -0,0 +1,220 @@
+package contexts
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type CanvasesContext struct {
+	tx             *gorm.DB
+	organizationID uuid.UUID
+	execution      *models.CanvasNodeExecution
+}
+
+func NewCanvasesContext(tx *gorm.DB, organizationID uuid.UUID, execution *models.CanvasNodeExecution) *CanvasesContext {
+	return &CanvasesContext{
+		tx:             tx,
+		organizationID: organizationID,
+		execution:      execution,
+	}
+}
+
+func (c *CanvasesContext) Invoke(canvasID, nodeID string, payload any) (string, error) {
+	id, err := uuid.Parse(canvasID)
+	if err != nil {
+		return "", fmt.Errorf("invalid canvas ID %s: %w", canvasID, err)
+	}
+
+	canvas, err := models.FindCanvasInTransaction(c.tx, c.organizationID, id)
+	if err != nil || canvas.IsTemplate {
+		return "", fmt.Errorf("canvas %s not found", canvasID)
+	}
+
+	node, err := models.FindCanvasNode(c.tx, canvas.ID, nodeID)
+	if err != nil {
+		return "", fmt.Errorf("node %s not found in canvas %s", nodeID, canvas.Name)
+	}
+
+	if node.Type != models.NodeTypeTrigger {
+		return "", fmt.Errorf("node %s in canvas %s is not a trigger", nodeID, canvas.Name)
+	}
+
+	err = models.ValidateCanvasInvocation(c.tx, c.execution, canvas.ID)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	event := models.CanvasEvent{
+		WorkflowID:           canvas.ID,
+		NodeID:               node.NodeID,
+		Channel:              "default",
+		Data:                 datatypes.NewJSONType(payload),
+		State:                models.CanvasEventStatePending,
+		InvokedByExecutionID: &c.execution.ID,
+		Priority:             c.execution.Priority,
+		CreatedAt:            &now,
+		Traceparent: telemetry.StartTrace(c.execution.Traceparent, "event.emit",
+			attribute.String("canvas.id", canvas.ID.String()),
+			attribute.String("node.id", node.NodeID),
+		),
+	}
+
+	err = c.tx.Create(&event).Error
+	if err != nil {
+		return "", fmt.Errorf("failed to create event: %w", err)
+	}
+
+	return event.ID.String(), nil
+}
+
+// CompleteCanvasInvocationIfNeeded finishes the Run Canvas execution
+// that emitted a root event into another canvas,
+// once nothing in the execution tree for that root event can make progress anymore.
+//
+// The invoking execution passes on the 'passed' channel if every execution in the tree passed,
+// and on the 'failed' channel otherwise. Either way, the payload carries
+// the outputs of the terminal nodes of the invoked canvas.
+//
+// Returns the invoking execution, if it was finished.
+func CompleteCanvasInvocationIfNeeded(tx *gorm.DB, rootEventID uuid.UUID) (*models.CanvasNodeExecution, error) {
+	rootEvent, err := models.FindCanvasEventInTransaction(tx, rootEventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find root event %s: %w", rootEventID, err)
+	}
+
+	if rootEvent.InvokedByExecutionID == nil {
+		return nil, nil
+	}
+
+	//
+	// Lock the invoking execution, waiting for the lock if needed.
+	// This makes sure concurrent checks for executions in the same tree
+	// see each other's changes, and only one of them completes the invocation.
+	//
+	invoker, err := models.LockInvokingExecution(tx, *rootEvent.InvokedByExecutionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if invoker.State == models.CanvasNodeExecutionStateFinished {
+		return nil, nil
+	}
+
+	active, err := models.HasActiveWorkForRootEvent(tx, rootEvent.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if active {
+		return nil, nil
+	}
+
+	payload, passed, err := buildCanvasInvocationPayload(tx, rootEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := models.CanvasInvocationChannelPassed
+	if !passed {
+		channel = models.CanvasInvocationChannelFailed
+	}
+
+	err = NewExecutionStateContext(tx, invoker).Emit(channel, models.CanvasInvocationPayloadType, []any{payload})
+	if err != nil {
+		return invoker, invoker.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, fmt.Sprintf("error emitting canvas outputs: %v", err))
+	}
+
+	return invoker, nil
+}
+
+func buildCanvasInvocationPayload(tx *gorm.DB, rootEvent *models.CanvasEvent) (map[string]any, bool, error) {
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, rootEvent.WorkflowID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find canvas %s: %w", rootEvent.WorkflowID, err)
+	}
+
+	executions, err := models.ListTopLevelExecutionsForRootEventInTransaction(tx, rootEvent.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	//
+	// Failed executions which were retried do not count,
+	// since the result of the tree depends on the retries.
+	//
+	retried := map[uuid.UUID]bool{}
+	for _, execution := range executions {
+		if execution.RetryOfExecutionID != nil {
+			retried[*execution.RetryOfExecutionID] = true
+		}
+	}
+
+	passed := true
+	failures := []map[string]any{}
+	passedIDs := []uuid.UUID{}
+	for _, execution := range executions {
+		if retried[execution.ID] {
+			continue
+		}
+
+		if execution.Result == models.CanvasNodeExecutionResultPassed {
+			passedIDs = append(passedIDs, execution.ID)
+			continue
+		}
+
+		passed = false
+		failures = append(failures, map[string]any{
+			"nodeId":  execution.NodeID,
+			"result":  execution.Result,
+			"reason":  execution.ResultReason,
+			"message": execution.ResultMessage,
+		})
+	}
+
+	events, err := models.ListCanvasEventsForExecutionsInTransaction(tx, passedIDs)
+	if err != nil {
+		return nil, false, err
+	}
+
+	//
+	// Outputs from terminal nodes are the ones
+	// emitted on channels without any outgoing edges.
+	//
+	outputs := map[string][]any{}
+	for _, event := range events {
+		if len(canvas.FindEdges(event.NodeID, event.Channel)) > 0 {
+			continue
+		}
+
+		outputs[event.NodeID] = append(outputs[event.NodeID], event.Data.Data())
+	}
+
+	result := models.CanvasNodeExecutionResultPassed
+	if !passed {
+		result = models.CanvasNodeExecutionResultFailed
+	}
+
+	return map[string]any{
+		"canvas": map[string]any{
+			"id":   canvas.ID.String(),
+			"name": canvas.Name,
+		},
+		"rootEventId": rootEvent.ID.String(),
+		"result":      result,
+		"outputs":     outputs,
+		"failures":    failures,
+	}, passed, nil
+}
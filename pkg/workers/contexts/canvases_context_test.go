@@ -0,0 +1,89 @@
+package contexts
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__CanvasesContext__Invoke(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNodeID := "trigger-1"
+	componentNodeID := "component-1"
+	nodes := []models.CanvasNode{
+		{
+			NodeID: triggerNodeID,
+			Name:   triggerNodeID,
+			Type:   models.NodeTypeTrigger,
+			Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+		},
+		{
+			NodeID: componentNodeID,
+			Name:   componentNodeID,
+			Type:   models.NodeTypeComponent,
+			Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "runCanvas"}}),
+		},
+	}
+
+	edges := []models.Edge{
+		{SourceID: triggerNodeID, TargetID: componentNodeID, Channel: "default"},
+	}
+
+	caller, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, edges)
+	target, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, edges)
+
+	rootEvent := support.EmitCanvasEventForNode(t, caller.ID, triggerNodeID, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, caller.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+
+	t.Run("emits root event linked to execution", func(t *testing.T) {
+		ctx := NewCanvasesContext(database.Conn(), r.Organization.ID, execution)
+		eventID, err := ctx.Invoke(target.ID.String(), triggerNodeID, map[string]any{"hello": "world"})
+		require.NoError(t, err)
+
+		event, err := models.FindCanvasEvent(uuid.MustParse(eventID))
+		require.NoError(t, err)
+		assert.Equal(t, target.ID, event.WorkflowID)
+		assert.Equal(t, triggerNodeID, event.NodeID)
+		assert.Nil(t, event.ExecutionID)
+		require.NotNil(t, event.InvokedByExecutionID)
+		assert.Equal(t, execution.ID, *event.InvokedByExecutionID)
+		assert.Equal(t, map[string]any{"hello": "world"}, event.Data.Data())
+	})
+
+	t.Run("canvas from another organization is not found", func(t *testing.T) {
+		ctx := NewCanvasesContext(database.Conn(), uuid.New(), execution)
+		_, err := ctx.Invoke(target.ID.String(), triggerNodeID, nil)
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("node must be a trigger", func(t *testing.T) {
+		ctx := NewCanvasesContext(database.Conn(), r.Organization.ID, execution)
+		_, err := ctx.Invoke(target.ID.String(), componentNodeID, nil)
+		require.ErrorContains(t, err, "is not a trigger")
+	})
+
+	t.Run("canvas cannot invoke itself", func(t *testing.T) {
+		ctx := NewCanvasesContext(database.Conn(), r.Organization.ID, execution)
+		_, err := ctx.Invoke(caller.ID.String(), triggerNodeID, nil)
+		require.ErrorIs(t, err, models.ErrRecursiveCanvasInvocation)
+	})
+
+	t.Run("invoked canvas cannot invoke a canvas in the chain", func(t *testing.T) {
+		ctx := NewCanvasesContext(database.Conn(), r.Organization.ID, execution)
+		eventID, err := ctx.Invoke(target.ID.String(), triggerNodeID, nil)
+		require.NoError(t, err)
+
+		invokedExecution := support.CreateCanvasNodeExecution(t, target.ID, componentNodeID, uuid.MustParse(eventID), uuid.MustParse(eventID), nil)
+		ctx = NewCanvasesContext(database.Conn(), r.Organization.ID, invokedExecution)
+		_, err = ctx.Invoke(caller.ID.String(), triggerNodeID, nil)
+		require.ErrorIs(t, err, models.ErrRecursiveCanvasInvocation)
+	})
+}
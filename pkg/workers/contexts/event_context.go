@@ -3,10 +3,13 @@ package contexts
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
@@ -49,6 +52,10 @@ func (s *EventContext) Emit(payloadType string, payload any) error {
 		Data:       datatypes.NewJSONType[any](json.RawMessage(data)),
 		State:      models.CanvasEventStatePending,
 		CreatedAt:  &now,
+		Traceparent: telemetry.StartTrace(nil, "event.emit",
+			attribute.String("canvas.id", s.node.WorkflowID.String()),
+			attribute.String("node.id", s.node.NodeID),
+		),
 	}
 
 	wrappedPayload := map[string]any{"data": payload}
@@ -57,9 +64,55 @@ func (s *EventContext) Emit(payloadType string, payload any) error {
 		event.CustomName = customName
 	}
 
+	priority, err := ResolveRunPriority(s.tx, s.node, wrappedPayload)
+	if err == nil {
+		event.Priority = priority
+	}
+
 	return s.tx.Create(&event).Error
 }
 
+// ResolveRunPriority returns the priority for a run started by the trigger node,
+// from its priority setting, which can be a number, or an expression using the payload,
+// like {{ $.data.headers["X-Priority"][0] }}. Without a setting, the priority is 0.
+func ResolveRunPriority(tx *gorm.DB, node *models.CanvasNode, payload any) (int, error) {
+	config := node.Configuration.Data()
+	if config == nil {
+		return 0, nil
+	}
+
+	raw, ok := config["priority"]
+	if !ok || raw == nil {
+		return 0, nil
+	}
+
+	value := raw
+	if template, ok := raw.(string); ok {
+		template = strings.TrimSpace(template)
+		if template == "" {
+			return 0, nil
+		}
+
+		builder := NewNodeConfigurationBuilder(tx, node.WorkflowID).
+			WithNodeID(node.NodeID).
+			WithInput(map[string]any{node.NodeID: payload})
+
+		resolved, err := builder.ResolveExpression(template)
+		if err != nil {
+			return 0, err
+		}
+
+		value = resolved
+	}
+
+	priority, err := strconv.Atoi(strings.TrimSpace(fmt.Sprintf("%v", value)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid priority %v: %w", value, err)
+	}
+
+	return models.ClampQueuePriority(priority), nil
+}
+
 func (s *EventContext) resolveCustomName(payload any) (*string, error) {
 	config := s.node.Configuration.Data()
 	if config == nil {
@@ -44,4 +44,16 @@ func Test__EventContext__Emit(t *testing.T) {
 		assert.Contains(t, err.Error(), "event payload too large")
 		support.VerifyCanvasEventsCount(t, canvas.ID, 0)
 	})
+
+	t.Run("sets priority from configuration", func(t *testing.T) {
+		node := nodes[0]
+		node.Configuration = datatypes.NewJSONType(map[string]any{"priority": "250"})
+
+		ctx := NewEventContext(database.Conn(), &node)
+		require.NoError(t, ctx.Emit("test.payload", map[string]any{}))
+
+		var event models.CanvasEvent
+		require.NoError(t, database.Conn().Where("workflow_id = ?", canvas.ID).Order("created_at DESC").First(&event).Error)
+		assert.Equal(t, models.MaxQueuePriority, event.Priority)
+	})
 }
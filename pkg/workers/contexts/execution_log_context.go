@@ -0,0 +1,246 @@
+package contexts
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	ExecutionLogBatchSize     = 100
+	ExecutionLogFlushInterval = 500 * time.Millisecond
+	MaxExecutionLogLineSize   = 16 * 1024
+	MaxExecutionLogLines      = 10000
+)
+
+// ExecutionLogContext captures the log of an execution: lines logged through
+// its Logger, and output streamed through its writers. Lines are buffered and
+// written in batches, on their own connection, and published for live tailing.
+// Close must be called when the component is done, to write what is left.
+type ExecutionLogContext struct {
+	execution *models.CanvasNodeExecution
+
+	mu        sync.Mutex
+	pending   []models.CanvasNodeExecutionLog
+	count     int
+	truncated bool
+	writers   []*executionLogWriter
+
+	flushMu   sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func NewExecutionLogContext(execution *models.CanvasNodeExecution) *ExecutionLogContext {
+	c := &ExecutionLogContext{
+		execution: execution,
+		done:      make(chan struct{}),
+	}
+
+	go c.flushPeriodically()
+	return c
+}
+
+// Logger returns a copy of the given logger, which also
+// writes everything logged through it to the execution log.
+func (c *ExecutionLogContext) Logger(entry *log.Entry) *log.Entry {
+	logger := log.New()
+	logger.SetOutput(entry.Logger.Out)
+	logger.SetFormatter(entry.Logger.Formatter)
+	logger.SetLevel(entry.Logger.GetLevel())
+	logger.SetReportCaller(entry.Logger.ReportCaller)
+
+	for _, hooks := range entry.Logger.Hooks {
+		for _, hook := range hooks {
+			logger.AddHook(hook)
+		}
+	}
+
+	logger.AddHook(&executionLogHook{context: c})
+	return log.NewEntry(logger).WithFields(entry.Data)
+}
+
+// Writer implements core.LogsContext.
+func (c *ExecutionLogContext) Writer(stream string) io.Writer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	writer := &executionLogWriter{context: c, stream: stream}
+	c.writers = append(c.writers, writer)
+	return writer
+}
+
+// Close writes what is still buffered,
+// including partial lines, and stops the periodic flushing.
+func (c *ExecutionLogContext) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+
+		c.mu.Lock()
+		writers := c.writers
+		c.mu.Unlock()
+
+		for _, writer := range writers {
+			writer.flush()
+		}
+
+		c.Flush()
+	})
+}
+
+// Flush writes the buffered lines to the database and publishes them.
+// Errors are only logged, since they should never fail the execution.
+func (c *ExecutionLogContext) Flush() {
+	c.flushMu.Lock()
+	defer c.flushMu.Unlock()
+
+	c.mu.Lock()
+	lines := c.pending
+	c.pending = nil
+	c.mu.Unlock()
+
+	if len(lines) == 0 {
+		return
+	}
+
+	err := models.CreateNodeExecutionLogs(lines)
+	if err != nil {
+		log.Errorf("error writing %d log lines for execution %s: %v", len(lines), c.execution.ID, err)
+		return
+	}
+
+	err = messages.NewCanvasExecutionLogMessage(c.execution, lines).Publish()
+	if err != nil {
+		log.Errorf("error publishing log lines for execution %s: %v", c.execution.ID, err)
+	}
+}
+
+func (c *ExecutionLogContext) flushPeriodically() {
+	ticker := time.NewTicker(ExecutionLogFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			c.Flush()
+		}
+	}
+}
+
+func (c *ExecutionLogContext) append(stream, level, message string, createdAt time.Time) {
+	if len(message) > MaxExecutionLogLineSize {
+		message = message[:MaxExecutionLogLineSize]
+	}
+
+	//
+	// Output from commands is not guaranteed to be valid UTF-8,
+	// and Postgres rejects invalid text and NUL characters.
+	//
+	message = strings.ToValidUTF8(message, "\uFFFD")
+	message = strings.ReplaceAll(message, "\x00", "")
+
+	c.mu.Lock()
+
+	if c.truncated {
+		c.mu.Unlock()
+		return
+	}
+
+	//
+	// Runaway output should not fill up the database,
+	// so after too many lines, we only record that the log was truncated.
+	//
+	if c.count >= MaxExecutionLogLines {
+		c.truncated = true
+		stream = models.CanvasNodeExecutionLogStreamLog
+		level = log.WarnLevel.String()
+		message = fmt.Sprintf("log truncated after %d lines", MaxExecutionLogLines)
+	}
+
+	c.count++
+	c.pending = append(c.pending, models.CanvasNodeExecutionLog{
+		WorkflowID:  c.execution.WorkflowID,
+		NodeID:      c.execution.NodeID,
+		ExecutionID: c.execution.ID,
+		Stream:      stream,
+		Level:       level,
+		Message:     message,
+		CreatedAt:   &createdAt,
+	})
+
+	full := len(c.pending) >= ExecutionLogBatchSize
+	c.mu.Unlock()
+
+	if full {
+		c.Flush()
+	}
+}
+
+type executionLogHook struct {
+	context *ExecutionLogContext
+}
+
+func (h *executionLogHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (h *executionLogHook) Fire(entry *log.Entry) error {
+	h.context.append(models.CanvasNodeExecutionLogStreamLog, entry.Level.String(), entry.Message, entry.Time)
+	return nil
+}
+
+// executionLogWriter splits what is written to it into lines.
+// Partial lines are kept until the rest arrives, or until the context is closed.
+type executionLogWriter struct {
+	context *ExecutionLogContext
+	stream  string
+
+	mu     sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (w *executionLogWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buffer.Write(p)
+
+	for {
+		i := bytes.IndexByte(w.buffer.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+
+		line := string(w.buffer.Next(i + 1))
+		w.appendLine(line)
+	}
+
+	if w.buffer.Len() >= MaxExecutionLogLineSize {
+		w.appendLine(string(w.buffer.Next(w.buffer.Len())))
+	}
+
+	return len(p), nil
+}
+
+func (w *executionLogWriter) flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.buffer.Len() > 0 {
+		w.appendLine(string(w.buffer.Next(w.buffer.Len())))
+	}
+}
+
+func (w *executionLogWriter) appendLine(line string) {
+	line = strings.TrimRight(line, "\r\n")
+	w.context.append(w.stream, log.InfoLevel.String(), line, time.Now())
+}
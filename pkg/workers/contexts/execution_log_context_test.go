@@ -0,0 +1,124 @@
+package contexts
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__ExecutionLogContext(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNodeID := "trigger-1"
+	componentNodeID := "component-1"
+	nodes := []models.CanvasNode{
+		{
+			NodeID: triggerNodeID,
+			Name:   triggerNodeID,
+			Type:   models.NodeTypeTrigger,
+			Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+		},
+		{
+			NodeID: componentNodeID,
+			Name:   componentNodeID,
+			Type:   models.NodeTypeComponent,
+			Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+		},
+	}
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, []models.Edge{})
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNodeID, "default", nil)
+
+	newExecution := func() *models.CanvasNodeExecution {
+		return support.CreateCanvasNodeExecution(t, canvas.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+	}
+
+	t.Run("logger and streamed output are written in order", func(t *testing.T) {
+		execution := newExecution()
+		ctx := NewExecutionLogContext(execution)
+
+		logger := ctx.Logger(log.NewEntry(log.StandardLogger()))
+		logger.Info("connecting")
+		_, _ = ctx.Writer(core.LogStreamStdout).Write([]byte("line 1\n"))
+		_, _ = ctx.Writer(core.LogStreamStderr).Write([]byte("warning\n"))
+		logger.Errorf("command failed with %d", 1)
+		ctx.Close()
+
+		logs, err := models.ListNodeExecutionLogs(execution.ID, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, logs, 4)
+		assert.Equal(t, "connecting", logs[0].Message)
+		assert.Equal(t, models.CanvasNodeExecutionLogStreamLog, logs[0].Stream)
+		assert.Equal(t, "line 1", logs[1].Message)
+		assert.Equal(t, core.LogStreamStdout, logs[1].Stream)
+		assert.Equal(t, "warning", logs[2].Message)
+		assert.Equal(t, core.LogStreamStderr, logs[2].Stream)
+		assert.Equal(t, "command failed with 1", logs[3].Message)
+		assert.Equal(t, "error", logs[3].Level)
+
+		logs, err = models.ListNodeExecutionLogs(execution.ID, logs[1].ID, 100)
+		require.NoError(t, err)
+		require.Len(t, logs, 2)
+		assert.Equal(t, "warning", logs[0].Message)
+	})
+
+	t.Run("partial lines are written on close", func(t *testing.T) {
+		execution := newExecution()
+		ctx := NewExecutionLogContext(execution)
+
+		writer := ctx.Writer(core.LogStreamStdout)
+		_, _ = writer.Write([]byte("half a "))
+		_, _ = writer.Write([]byte("line\ndone"))
+		ctx.Close()
+
+		logs, err := models.ListNodeExecutionLogs(execution.ID, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, logs, 2)
+		assert.Equal(t, "half a line", logs[0].Message)
+		assert.Equal(t, "done", logs[1].Message)
+	})
+
+	t.Run("invalid UTF-8 is replaced", func(t *testing.T) {
+		execution := newExecution()
+		ctx := NewExecutionLogContext(execution)
+
+		_, _ = ctx.Writer(core.LogStreamStdout).Write([]byte("bad \xff byte\x00\n"))
+		ctx.Close()
+
+		logs, err := models.ListNodeExecutionLogs(execution.ID, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, "bad � byte", logs[0].Message)
+	})
+
+	t.Run("log is truncated after too many lines", func(t *testing.T) {
+		execution := newExecution()
+		ctx := NewExecutionLogContext(execution)
+
+		writer := ctx.Writer(core.LogStreamStdout)
+		for i := 0; i < MaxExecutionLogLines+10; i++ {
+			_, _ = fmt.Fprintf(writer, "line %d\n", i)
+		}
+		ctx.Close()
+
+		var count int64
+		require.NoError(t, database.Conn().Model(&models.CanvasNodeExecutionLog{}).Where("execution_id = ?", execution.ID).Count(&count).Error)
+		assert.Equal(t, int64(MaxExecutionLogLines+1), count)
+
+		logs, err := models.ListNodeExecutionLogs(execution.ID, 0, MaxExecutionLogLines+1)
+		require.NoError(t, err)
+		last := logs[len(logs)-1]
+		assert.True(t, strings.HasPrefix(last.Message, "log truncated"))
+		assert.Equal(t, models.CanvasNodeExecutionLogStreamLog, last.Stream)
+	})
+}
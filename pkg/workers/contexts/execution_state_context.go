@@ -33,10 +33,51 @@ func (s *ExecutionStateContext) Pass() error {
 }
 
 func (s *ExecutionStateContext) Emit(channel, payloadType string, payloads []any) error {
+	outputs, err := s.buildOutputs(channel, payloadType, payloads)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.execution.PassInTransaction(s.tx, outputs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *ExecutionStateContext) Stream(channel, payloadType string, payloads []any) error {
+	if s.IsFinished() {
+		return fmt.Errorf("execution %s is already finished", s.execution.ID)
+	}
+
+	outputs, err := s.buildOutputs(channel, payloadType, payloads)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.execution.EmitInTransaction(s.tx, outputs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *ExecutionStateContext) buildOutputs(channel, payloadType string, payloads []any) (map[string][]any, error) {
 	outputs := map[string][]any{
 		channel: {},
 	}
 
+	//
+	// Events carry references to the artifacts of the execution,
+	// so the next nodes can find them without knowing the execution.
+	//
+	artifacts, err := listArtifactReferences(s.tx, s.execution.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+
 	for _, payload := range payloads {
 		event := map[string]any{
 			"type":      payloadType,
@@ -44,24 +85,23 @@ func (s *ExecutionStateContext) Emit(channel, payloadType string, payloads []any
 			"data":      payload,
 		}
 
+		if len(artifacts) > 0 {
+			event["artifacts"] = artifacts
+		}
+
 		data, err := json.Marshal(event)
 		if err != nil {
-			return fmt.Errorf("failed to marshal payload: %w", err)
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
 		}
 
 		if len(data) > s.maxPayloadSize {
-			return fmt.Errorf("event payload too large: %d bytes (max %d)", len(data), s.maxPayloadSize)
+			return nil, fmt.Errorf("event payload too large: %d bytes (max %d)", len(data), s.maxPayloadSize)
 		}
 
 		outputs[channel] = append(outputs[channel], json.RawMessage(data))
 	}
 
-	_, err := s.execution.PassInTransaction(s.tx, outputs)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return outputs, nil
 }
 
 func (s *ExecutionStateContext) Fail(reason, message string) error {
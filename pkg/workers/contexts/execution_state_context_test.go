@@ -55,3 +55,57 @@ func Test__ExecutionStateContext__Emit(t *testing.T) {
 		support.VerifyCanvasNodeEventsCount(t, canvas.ID, componentNodeID, 0)
 	})
 }
+
+func Test__ExecutionStateContext__Stream(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNodeID := "trigger-1"
+	componentNodeID := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNodeID,
+				Name:   triggerNodeID,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: componentNodeID,
+				Name:   componentNodeID,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNodeID, TargetID: componentNodeID, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNodeID, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+	require.NoError(t, database.Conn().Model(execution).Update("state", models.CanvasNodeExecutionStateStarted).Error)
+
+	t.Run("emits payloads without finishing the execution", func(t *testing.T) {
+		ctx := NewExecutionStateContext(database.Conn(), execution)
+		require.NoError(t, ctx.Stream("default", "test.payload", []any{"a", "b"}))
+		support.VerifyCanvasNodeEventsCount(t, canvas.ID, componentNodeID, 2)
+
+		execution, err := models.FindNodeExecutionInTransaction(database.Conn(), canvas.ID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateStarted, execution.State)
+	})
+
+	t.Run("finished execution cannot stream", func(t *testing.T) {
+		require.NoError(t, NewExecutionStateContext(database.Conn(), execution).Pass())
+
+		execution, err := models.FindNodeExecutionInTransaction(database.Conn(), canvas.ID, execution.ID)
+		require.NoError(t, err)
+
+		ctx := NewExecutionStateContext(database.Conn(), execution)
+		require.ErrorContains(t, ctx.Stream("default", "test.payload", []any{"c"}), "already finished")
+	})
+}
@@ -13,12 +13,15 @@ import (
 	"github.com/expr-lang/expr/parser"
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/expressions"
 	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
 var expressionRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
 var previousDepthRegex = regexp.MustCompile(`\bprevious\s*\(([^)]*)\)`)
+var variablesRegex = regexp.MustCompile(`\bvars\b`)
 
 type NodeConfigurationBuilder struct {
 	tx                  *gorm.DB
@@ -29,6 +32,26 @@ type NodeConfigurationBuilder struct {
 	input               any
 	parentBlueprintNode *models.CanvasNode
 	configurationFields []configuration.Field
+
+	//
+	// When a canvas is simulated, there are no events and executions
+	// in the database, so the root event and the outputs of the nodes
+	// in the chain are given to the builder directly.
+	//
+	simulatedRootEvent *models.CanvasEvent
+	simulatedChain     []SimulatedOutput
+
+	//
+	// Canvas variables are loaded once,
+	// the first time an expression uses them.
+	//
+	variables map[string]any
+}
+
+// SimulatedOutput is the output of a node in a simulated execution chain.
+type SimulatedOutput struct {
+	NodeID string
+	Data   any
 }
 
 func NewNodeConfigurationBuilder(tx *gorm.DB, workflowID uuid.UUID) *NodeConfigurationBuilder {
@@ -63,6 +86,25 @@ func (b *NodeConfigurationBuilder) WithInput(input any) *NodeConfigurationBuilde
 	return b
 }
 
+// WithSimulatedRoot uses the given payload, emitted by the given node,
+// as the root event, instead of fetching it from the database.
+func (b *NodeConfigurationBuilder) WithSimulatedRoot(nodeID string, data any) *NodeConfigurationBuilder {
+	b.simulatedRootEvent = &models.CanvasEvent{
+		WorkflowID: b.workflowID,
+		NodeID:     nodeID,
+		Data:       datatypes.NewJSONType(data),
+	}
+
+	return b
+}
+
+// WithSimulatedChain uses the given outputs, ordered from newest to oldest,
+// as the execution chain, instead of fetching it from the database.
+func (b *NodeConfigurationBuilder) WithSimulatedChain(chain []SimulatedOutput) *NodeConfigurationBuilder {
+	b.simulatedChain = chain
+	return b
+}
+
 func (b *NodeConfigurationBuilder) WithConfigurationFields(fields []configuration.Field) *NodeConfigurationBuilder {
 	b.configurationFields = fields
 	return b
@@ -261,6 +303,11 @@ func (b *NodeConfigurationBuilder) BuildExpressionEnv(expression string) (map[st
 
 	env := map[string]any{"$": messageChain}
 
+	err = b.addVariables(env, expression)
+	if err != nil {
+		return nil, err
+	}
+
 	if strings.Contains(expression, "root(") {
 		rootPayload, err := b.resolveRootPayload()
 		if err != nil {
@@ -288,6 +335,26 @@ func (b *NodeConfigurationBuilder) BuildExpressionEnv(expression string) (map[st
 	return env, nil
 }
 
+// addVariables exposes the canvas variables to the expression as vars,
+// if the expression uses them.
+func (b *NodeConfigurationBuilder) addVariables(env map[string]any, expression string) error {
+	if !variablesRegex.MatchString(expression) {
+		return nil
+	}
+
+	if b.variables == nil {
+		variables, err := models.CanvasVariableValues(b.tx, b.workflowID)
+		if err != nil {
+			return fmt.Errorf("error loading canvas variables: %w", err)
+		}
+
+		b.variables = variables
+	}
+
+	env["vars"] = b.variables
+	return nil
+}
+
 func (b *NodeConfigurationBuilder) resolveExpression(expression string) (any, error) {
 	referencedNodes, err := parseReferencedNodes(expression)
 	if err != nil {
@@ -305,35 +372,20 @@ func (b *NodeConfigurationBuilder) resolveExpression(expression string) (any, er
 		env["config"] = b.parentBlueprintNode.Configuration.Data()
 	}
 
+	err = b.addVariables(env, expression)
+	if err != nil {
+		return "", err
+	}
+
 	exprOptions := []expr.Option{
 		expr.Env(env),
 		expr.AsAny(),
 		expr.WithContext("ctx"),
 		expr.Timezone(time.UTC.String()),
-		expr.Function("root", func(params ...any) (any, error) {
-			if len(params) != 0 {
-				return nil, fmt.Errorf("root() takes no arguments")
-			}
-
-			return b.resolveRootPayload()
-		}),
-		expr.Function("previous", func(params ...any) (any, error) {
-			depth := 1
-			if len(params) > 1 {
-				return nil, fmt.Errorf("previous() accepts zero or one argument")
-			}
-			if len(params) == 1 {
-				parsedDepth, err := parseDepth(params[0])
-				if err != nil {
-					return nil, err
-				}
-				depth = parsedDepth
-			}
-
-			return b.resolvePreviousPayload(depth)
-		}),
 	}
 
+	exprOptions = append(exprOptions, expressions.ContextOptions(b.resolveRootPayload, b.resolvePreviousPayload)...)
+
 	vm, err := expr.Compile(expression, exprOptions...)
 	if err != nil {
 		return "", err
@@ -376,6 +428,10 @@ func (b *NodeConfigurationBuilder) buildMessageChain(referencedNodes []string) (
 		}
 	}
 
+	for _, output := range b.simulatedChain {
+		executionChainNodeIDs = append(executionChainNodeIDs, output.NodeID)
+	}
+
 	// Also include the root event's node (triggers don't create executions)
 	if rootEvent != nil && rootEvent.NodeID != "" {
 		executionChainNodeIDs = append(executionChainNodeIDs, rootEvent.NodeID)
@@ -392,6 +448,10 @@ func (b *NodeConfigurationBuilder) buildMessageChain(referencedNodes []string) (
 		return messageChain, nil
 	}
 
+	if b.simulatedChain != nil {
+		return messageChain, populateFromSimulatedChain(messageChain, chainRefs, b.simulatedChain)
+	}
+
 	if b.previousExecutionID == nil {
 		return nil, fmt.Errorf("node name %s not found in execution chain", firstChainRef(chainRefs))
 	}
@@ -490,6 +550,10 @@ func (b *NodeConfigurationBuilder) resolveNodeRefs(nodeRefs []string, executionC
 }
 
 func (b *NodeConfigurationBuilder) fetchRootEvent() (*models.CanvasEvent, error) {
+	if b.simulatedRootEvent != nil {
+		return b.simulatedRootEvent, nil
+	}
+
 	if b.rootEventID == nil {
 		return nil, nil
 	}
@@ -538,16 +602,18 @@ func populateFromInputOrRoot(messageChain map[string]any, inputMap map[string]an
 }
 
 func (b *NodeConfigurationBuilder) populateFromExecutions(messageChain map[string]any, chainRefs map[string]string) error {
-	executionsInChain, err := b.listExecutionsInChain()
+	linearExecutions, err := b.listLinearExecutionsInChain()
 	if err != nil {
 		return err
 	}
 
-	executionByNode := make(map[string]models.CanvasNodeExecution, len(executionsInChain))
-	for _, execution := range executionsInChain {
-		executionByNode[execution.NodeID] = execution
+	executionsInChain, err := b.withUpstreamExecutions(linearExecutions)
+	if err != nil {
+		return err
 	}
 
+	executionByNode := executionsByNode(linearExecutions, executionsInChain)
+
 	executionIDs := make([]uuid.UUID, 0, len(chainRefs))
 	executionIDByRef := make(map[string]uuid.UUID, len(chainRefs))
 	for nodeRef, nodeID := range chainRefs {
@@ -564,8 +630,20 @@ func (b *NodeConfigurationBuilder) populateFromExecutions(messageChain map[strin
 		return err
 	}
 
+	//
+	// Executions can emit more than one event, e.g. one per item of a list.
+	// For executions in the linear chain, we know the exact event
+	// that led to the current node, so we use that one.
+	// For the others, we use their latest event.
+	//
+	consumedByExecution := consumedEventByExecution(linearExecutions, events)
 	latestByExecution := latestEventByExecution(events, executionIDs)
 	for nodeRef, executionID := range executionIDByRef {
+		if event, ok := consumedByExecution[executionID]; ok {
+			messageChain[nodeRef] = event.Data.Data()
+			continue
+		}
+
 		event, ok := latestByExecution[executionID]
 		if !ok {
 			return fmt.Errorf("node %s has no outputs", nodeRef)
@@ -577,6 +655,86 @@ func (b *NodeConfigurationBuilder) populateFromExecutions(messageChain map[strin
 	return nil
 }
 
+func populateFromSimulatedChain(messageChain map[string]any, chainRefs map[string]string, chain []SimulatedOutput) error {
+	for nodeRef, nodeID := range chainRefs {
+		found := false
+		for _, output := range chain {
+			if output.NodeID == nodeID {
+				messageChain[nodeRef] = output.Data
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("node %s not found in execution chain", nodeRef)
+		}
+	}
+
+	return nil
+}
+
+// consumedEventByExecution maps the executions in the linear chain
+// to the event from them that the next execution in the chain used as input.
+func consumedEventByExecution(linearExecutions []models.CanvasNodeExecution, events []models.CanvasEvent) map[uuid.UUID]models.CanvasEvent {
+	eventsByID := make(map[uuid.UUID]models.CanvasEvent, len(events))
+	for _, event := range events {
+		eventsByID[event.ID] = event
+	}
+
+	consumedByExecution := map[uuid.UUID]models.CanvasEvent{}
+	for _, execution := range linearExecutions {
+		if execution.PreviousExecutionID == nil {
+			continue
+		}
+
+		event, ok := eventsByID[execution.EventID]
+		if !ok || event.ExecutionID == nil || *event.ExecutionID != *execution.PreviousExecutionID {
+			continue
+		}
+
+		consumedByExecution[*execution.PreviousExecutionID] = event
+	}
+
+	return consumedByExecution
+}
+
+// executionsByNode picks the execution used for each node in the message chain.
+// A node can have more than one execution for the same root event,
+// when its executions are retried or re-run. Executions in the linear chain
+// take precedence, then the most recent passed execution, then the most recent one.
+// Both lists are expected to be sorted from newest to oldest.
+func executionsByNode(linearExecutions, executionsInChain []models.CanvasNodeExecution) map[string]models.CanvasNodeExecution {
+	executionByNode := make(map[string]models.CanvasNodeExecution, len(executionsInChain))
+	inLinearChain := map[string]bool{}
+	for _, execution := range linearExecutions {
+		if _, ok := executionByNode[execution.NodeID]; ok {
+			continue
+		}
+
+		executionByNode[execution.NodeID] = execution
+		inLinearChain[execution.NodeID] = true
+	}
+
+	for _, execution := range executionsInChain {
+		if inLinearChain[execution.NodeID] {
+			continue
+		}
+
+		current, ok := executionByNode[execution.NodeID]
+		if !ok {
+			executionByNode[execution.NodeID] = execution
+			continue
+		}
+
+		if current.Result != models.CanvasNodeExecutionResultPassed && execution.Result == models.CanvasNodeExecutionResultPassed {
+			executionByNode[execution.NodeID] = execution
+		}
+	}
+
+	return executionByNode
+}
+
 func latestEventByExecution(events []models.CanvasEvent, executionIDs []uuid.UUID) map[uuid.UUID]models.CanvasEvent {
 	latestByExecution := make(map[uuid.UUID]models.CanvasEvent, len(executionIDs))
 	for _, event := range events {
@@ -630,32 +788,6 @@ func parsePreviousDepths(expression string) ([]int, error) {
 	return depths, nil
 }
 
-func parseDepth(param any) (int, error) {
-	switch value := param.(type) {
-	case int:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return value, nil
-	case int64:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return int(value), nil
-	case float64:
-		parsed := int(value)
-		if value != float64(parsed) {
-			return 0, fmt.Errorf("depth must be an integer")
-		}
-		if parsed < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return parsed, nil
-	default:
-		return 0, fmt.Errorf("depth must be an integer")
-	}
-}
-
 func (b *NodeConfigurationBuilder) resolvePreviousPayload(depth int) (any, error) {
 	if depth < 1 {
 		return nil, fmt.Errorf("depth must be >= 1")
@@ -685,6 +817,10 @@ func (b *NodeConfigurationBuilder) resolvePreviousPayload(depth int) (any, error
 }
 
 func (b *NodeConfigurationBuilder) resolveFromExecutions(depth int, step int, hasInput bool) (int, any, error) {
+	if b.simulatedChain != nil {
+		return resolveFromSimulatedChain(b.simulatedChain, depth, step, hasInput)
+	}
+
 	if b.previousExecutionID == nil {
 		return step, nil, nil
 	}
@@ -712,6 +848,7 @@ func (b *NodeConfigurationBuilder) resolveFromExecutions(depth int, step int, ha
 		return step, nil, err
 	}
 
+	consumedByExecution := consumedEventByExecution(executionsInChain, events)
 	latestByExecution := latestEventByExecution(events, executionIDs)
 	for _, execution := range executionsInChain[startIndex:] {
 		step++
@@ -719,7 +856,11 @@ func (b *NodeConfigurationBuilder) resolveFromExecutions(depth int, step int, ha
 			continue
 		}
 
-		event, exists := latestByExecution[execution.ID]
+		event, exists := consumedByExecution[execution.ID]
+		if !exists {
+			event, exists = latestByExecution[execution.ID]
+		}
+
 		if !exists {
 			continue
 		}
@@ -732,6 +873,26 @@ func (b *NodeConfigurationBuilder) resolveFromExecutions(depth int, step int, ha
 	return step, nil, nil
 }
 
+func resolveFromSimulatedChain(chain []SimulatedOutput, depth int, step int, hasInput bool) (int, any, error) {
+	startIndex := 0
+	if hasInput && len(chain) > 0 {
+		startIndex = 1
+	}
+
+	for _, output := range chain[startIndex:] {
+		step++
+		if step < depth {
+			continue
+		}
+
+		if output.Data != nil {
+			return step, output.Data, nil
+		}
+	}
+
+	return step, nil, nil
+}
+
 func (b *NodeConfigurationBuilder) resolveFromRoot(depth int, step int) (any, error) {
 	rootEvent, err := b.fetchRootEvent()
 	if err != nil {
@@ -775,6 +936,10 @@ func (b *NodeConfigurationBuilder) listExecutionsInChain() ([]models.CanvasNodeE
 		return nil, err
 	}
 
+	return b.withUpstreamExecutions(executions)
+}
+
+func (b *NodeConfigurationBuilder) withUpstreamExecutions(executions []models.CanvasNodeExecution) ([]models.CanvasNodeExecution, error) {
 	if b.nodeID == "" || b.rootEventID == nil {
 		return executions, nil
 	}
@@ -452,6 +452,79 @@ func Test_NodeConfigurationBuilder_WorkflowLevelNode_Chain(t *testing.T) {
 	assert.Equal(t, "2", result["from_node2"])
 }
 
+func Test_NodeConfigurationBuilder_Chain_UsesConsumedEvent(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNode := "trigger-1"
+	node1 := "node-1"
+	node2 := "node-2"
+	node3 := "node-3"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Name:   triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: node1,
+				Name:   node1,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: node2,
+				Name:   node2,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: node3,
+				Name:   node3,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: node1, TargetID: node2, Channel: "default"},
+			{SourceID: node2, TargetID: node3, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNodeWithData(t, canvas.ID, triggerNode, "default", nil, map[string]any{"root": "data"})
+
+	//
+	// First node emits one event per item,
+	// and the second node runs for the first one.
+	//
+	execution1 := support.CreateCanvasNodeExecution(t, canvas.ID, node1, rootEvent.ID, rootEvent.ID, nil)
+	firstItem := support.EmitCanvasEventForNodeWithData(t, canvas.ID, node1, "default", &execution1.ID, map[string]any{"item": "a"})
+	support.EmitCanvasEventForNodeWithData(t, canvas.ID, node1, "default", &execution1.ID, map[string]any{"item": "b"})
+
+	execution2 := support.CreateNextNodeExecution(t, canvas.ID, node2, rootEvent.ID, firstItem.ID, &execution1.ID)
+	node2Data := map[string]any{"step": 2}
+	support.EmitCanvasEventForNodeWithData(t, canvas.ID, node2, "default", &execution2.ID, node2Data)
+
+	builder := NewNodeConfigurationBuilder(database.Conn(), canvas.ID).
+		WithPreviousExecution(&execution2.ID).
+		WithRootEvent(&rootEvent.ID).
+		WithInput(map[string]any{node2: node2Data})
+
+	result, err := builder.Build(map[string]any{
+		"item":     "{{ $[\"" + node1 + "\"].item }}",
+		"previous": "{{ previous(2).item }}",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "a", result["item"])
+	assert.Equal(t, "a", result["previous"])
+}
+
 func Test_NodeConfigurationBuilder_Chain_IncludesParallelUpstreamExecutions(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
@@ -541,6 +614,90 @@ func Test_NodeConfigurationBuilder_Chain_IncludesParallelUpstreamExecutions(t *t
 	assert.Equal(t, "from-action-3", result["action3"])
 }
 
+func Test_NodeConfigurationBuilder_Chain_UsesRerunExecutions(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	startNode := "start"
+	action1Node := "action-1"
+	action2Node := "action-2"
+	mergeNode := "merge"
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: startNode,
+				Name:   startNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: action1Node,
+				Name:   action1Node,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: action2Node,
+				Name:   action2Node,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: mergeNode,
+				Name:   mergeNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "merge"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: startNode, TargetID: action1Node, Channel: "default"},
+			{SourceID: startNode, TargetID: action2Node, Channel: "default"},
+			{SourceID: action1Node, TargetID: mergeNode, Channel: "default"},
+			{SourceID: action2Node, TargetID: mergeNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, startNode, "default", nil)
+
+	//
+	// Both upstream nodes failed once and were re-run for the same root event.
+	//
+	failed1 := support.CreateCanvasNodeExecution(t, canvas.ID, action1Node, rootEvent.ID, rootEvent.ID, nil)
+	require.NoError(t, failed1.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+	failed2 := support.CreateCanvasNodeExecution(t, canvas.ID, action2Node, rootEvent.ID, rootEvent.ID, nil)
+	require.NoError(t, failed2.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+	rerun1 := support.CreateCanvasNodeExecution(t, canvas.ID, action1Node, rootEvent.ID, rootEvent.ID, nil)
+	action1Data := map[string]any{"value": "from-action-1"}
+	_, err := rerun1.Pass(map[string][]any{"default": {action1Data}})
+	require.NoError(t, err)
+
+	rerun2 := support.CreateCanvasNodeExecution(t, canvas.ID, action2Node, rootEvent.ID, rootEvent.ID, nil)
+	action2Data := map[string]any{"value": "from-action-2"}
+	_, err = rerun2.Pass(map[string][]any{"default": {action2Data}})
+	require.NoError(t, err)
+
+	builder := NewNodeConfigurationBuilder(database.Conn(), canvas.ID).
+		WithNodeID(mergeNode).
+		WithPreviousExecution(&rerun1.ID).
+		WithRootEvent(&rootEvent.ID).
+		WithInput(map[string]any{action1Node: action1Data})
+
+	configuration := map[string]any{
+		"action1": "{{ $[\"action-1\"].value }}",
+		"action2": "{{ $[\"action-2\"].value }}",
+	}
+
+	result, err := builder.Build(configuration)
+	require.NoError(t, err)
+	assert.Equal(t, "from-action-1", result["action1"])
+	assert.Equal(t, "from-action-2", result["action2"])
+}
+
 func Test_NodeConfigurationBuilder_WorkflowLevelNode_Previous(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
@@ -1056,6 +1213,48 @@ func Test_NodeConfigurationBuilder_BlueprintLevelNode_Config_NotAvailableForWork
 	assert.Contains(t, err.Error(), "error resolving field field: unknown name config")
 }
 
+func Test_NodeConfigurationBuilder_Variables(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "node-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	require.NoError(t, models.SetCanvasVariableInTransaction(database.Conn(), &models.CanvasVariable{
+		WorkflowID: canvas.ID,
+		Name:       "deployed",
+		Value:      datatypes.NewJSONType[any]("1.4.2"),
+	}, nil))
+
+	builder := NewNodeConfigurationBuilder(database.Conn(), canvas.ID).
+		WithInput(map[string]any{"node-1": map[string]any{}})
+
+	result, err := builder.Build(map[string]any{
+		"current": "{{ vars.deployed }}",
+		"missing": "{{ vars.missing ?? \"none\" }}",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.4.2", result["current"])
+	assert.Equal(t, "none", result["missing"])
+
+	env, err := builder.BuildExpressionEnv(`vars.deployed == "1.4.2"`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"deployed": "1.4.2"}, env["vars"])
+}
+
 func Test_NodeConfigurationBuilder_ComplexNesting(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
@@ -5,10 +5,13 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
@@ -29,6 +32,47 @@ func (e *ConfigurationBuildError) Unwrap() error {
 	return e.Err
 }
 
+// CreateQueueItem puts the queue item in the queue of the node.
+// If the node has a queue policy with a key, the key is resolved now,
+// so the queue worker can group the items in the queue without resolving it again.
+// If it cannot be resolved, the item is queued without a key,
+// and the queue policy processes it on its own.
+func CreateQueueItem(tx *gorm.DB, node *models.CanvasNode, queueItem *models.CanvasNodeQueueItem) error {
+	policy := node.GetQueuePolicy()
+	if policy != nil && policy.Key != "" {
+		key, err := ResolveQueueItemKey(tx, node, queueItem, policy.Key)
+		if err != nil {
+			log.Warnf("Error resolving queue policy key for node %s: %v", node.NodeID, err)
+		} else {
+			queueItem.QueueKey = &key
+		}
+	}
+
+	return tx.Create(queueItem).Error
+}
+
+// ResolveQueueItemKey resolves the key expression of a queue policy
+// against the event that created the queue item.
+func ResolveQueueItemKey(tx *gorm.DB, node *models.CanvasNode, queueItem *models.CanvasNodeQueueItem, expression string) (string, error) {
+	event, err := models.FindCanvasEventInTransaction(tx, queueItem.EventID)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := NewNodeConfigurationBuilder(tx, queueItem.WorkflowID).
+		WithNodeID(node.NodeID).
+		WithRootEvent(&queueItem.RootEventID).
+		WithPreviousExecution(event.ExecutionID).
+		WithInput(map[string]any{event.NodeID: event.Data.Data()}).
+		ResolveExpression(expression)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%v", value), nil
+}
+
 func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *models.CanvasNode, queueItem *models.CanvasNodeQueueItem, configFields []configuration.Field) (*core.ProcessQueueContext, error) {
 	event, err := models.FindCanvasEventInTransaction(tx, queueItem.EventID)
 	if err != nil {
@@ -93,6 +137,8 @@ func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *model
 			RootEventID:         queueItem.RootEventID,
 			EventID:             event.ID,
 			PreviousExecutionID: event.ExecutionID,
+			Traceparent:         queueItem.Traceparent,
+			Priority:            queueItem.Priority,
 			State:               models.CanvasNodeExecutionStatePending,
 			Configuration:       datatypes.NewJSONType(config),
 			CreatedAt:           &now,
@@ -131,7 +177,20 @@ func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *model
 	}
 
 	ctx.DequeueItem = func() error {
-		return queueItem.Delete(tx)
+		err := queueItem.Delete(tx)
+		if err != nil {
+			return err
+		}
+
+		if queueItem.CreatedAt != nil {
+			telemetry.RecordSpan(queueItem.Traceparent, "queue.wait", *queueItem.CreatedAt, time.Now(),
+				attribute.String("canvas.id", queueItem.WorkflowID.String()),
+				attribute.String("node.id", queueItem.NodeID),
+				attribute.String("queue_item.id", queueItem.ID.String()),
+			)
+		}
+
+		return nil
 	}
 
 	ctx.UpdateNodeState = func(state string) error {
@@ -226,5 +285,58 @@ func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *model
 		}, nil
 	}
 
+	ctx.FindUpstreamEvent = func(payloadType string) (*core.UpstreamEvent, error) {
+		upstream, err := findUpstreamEvent(tx, event, payloadType)
+		if err != nil || upstream == nil {
+			return nil, err
+		}
+
+		return &core.UpstreamEvent{
+			ExecutionID: upstream.ExecutionID,
+			Data:        upstream.Data.Data(),
+		}, nil
+	}
+
+	ctx.ScheduleExecutionAction = func(executionID uuid.UUID, actionName string, parameters map[string]any) error {
+		execution, err := models.FindNodeExecutionInTransaction(tx, node.WorkflowID, executionID)
+		if err != nil {
+			return fmt.Errorf("execution %s not found: %w", executionID, err)
+		}
+
+		now := time.Now()
+		return execution.CreateRequest(tx, models.NodeRequestTypeInvokeAction, models.NodeExecutionRequestSpec{
+			InvokeAction: &models.InvokeAction{
+				ActionName: actionName,
+				Parameters: parameters,
+			},
+		}, &now)
+	}
+
 	return ctx, nil
 }
+
+// findUpstreamEvent walks up the chain of events that led to an event,
+// going from each event to the input event of the execution that emitted it,
+// until it finds one with the specified payload type.
+func findUpstreamEvent(tx *gorm.DB, event *models.CanvasEvent, payloadType string) (*models.CanvasEvent, error) {
+	current := event
+	for {
+		if data, ok := current.Data.Data().(map[string]any); ok && data["type"] == payloadType {
+			return current, nil
+		}
+
+		if current.ExecutionID == nil {
+			return nil, nil
+		}
+
+		execution, err := models.FindNodeExecutionInTransaction(tx, current.WorkflowID, *current.ExecutionID)
+		if err != nil {
+			return nil, fmt.Errorf("execution %s not found: %w", *current.ExecutionID, err)
+		}
+
+		current, err = models.FindCanvasEventInTransaction(tx, execution.EventID)
+		if err != nil {
+			return nil, fmt.Errorf("event %s not found: %w", execution.EventID, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package contexts
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// DefaultRetryAfter is how long requests to an integration are held back
+// after a 429 response without a valid Retry-After header.
+const DefaultRetryAfter = time.Minute
+
+// MaxRetryAfter caps how long a single 429 response can hold back
+// all the requests to an integration.
+const MaxRetryAfter = time.Hour
+
+type RateLimitError struct {
+	RetryAfter time.Duration
+
+	// AfterSideEffects is set when a request that might have changed something,
+	// e.g. a POST, went through the same context before the limit was reached.
+	// Running the work again would repeat it, so it is not delayed.
+	AfterSideEffects bool
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit reached, retry after %s", e.RetryAfter.Round(time.Second))
+}
+
+// RateLimitedHTTPContext takes a token from the rate limit bucket
+// of an integration for every request made through it.
+//
+// Requests made without a token available, or answered with a 429,
+// fail with a RateLimitError. If the component returns it, wrapped with %w or not,
+// the caller delays the work instead of failing it, unless an earlier request
+// with side effects went through this context.
+type RateLimitedHTTPContext struct {
+	http        core.HTTPContext
+	key         string
+	limit       *models.RateLimit
+	sideEffects bool
+}
+
+func NewRateLimitedHTTPContext(http core.HTTPContext, integration *models.Integration) *RateLimitedHTTPContext {
+	return &RateLimitedHTTPContext{
+		http:  http,
+		key:   models.IntegrationRateLimitKey(integration.ID),
+		limit: integration.GetRateLimit(),
+	}
+}
+
+func (c *RateLimitedHTTPContext) Do(request *http.Request) (*http.Response, error) {
+	now := time.Now()
+	wait, err := models.TakeRateLimitToken(c.key, c.limit, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check rate limit: %w", err)
+	}
+
+	if wait > 0 {
+		return nil, &RateLimitError{RetryAfter: wait, AfterSideEffects: c.sideEffects}
+	}
+
+	response, err := c.http.Do(request)
+	if err != nil {
+		c.sideEffects = c.sideEffects || !isSafeMethod(request.Method)
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusTooManyRequests {
+		c.sideEffects = c.sideEffects || !isSafeMethod(request.Method)
+		return response, nil
+	}
+
+	wait = ParseRetryAfter(response.Header.Get("Retry-After"), now)
+	response.Body.Close()
+
+	err = models.BlockRateLimitBucket(c.key, now.Add(wait))
+	if err != nil {
+		return nil, fmt.Errorf("failed to block rate limit bucket: %w", err)
+	}
+
+	return nil, &RateLimitError{RetryAfter: wait, AfterSideEffects: c.sideEffects}
+}
+
+// isSafeMethod returns whether requests with the method
+// are expected to not change anything on the server.
+func isSafeMethod(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseRetryAfter parses a Retry-After header value,
+// which is either a number of seconds or an HTTP date.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return DefaultRetryAfter
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		wait = date.Sub(now)
+	} else {
+		return DefaultRetryAfter
+	}
+
+	if wait <= 0 {
+		return time.Second
+	}
+
+	return min(wait, MaxRetryAfter)
+}
@@ -0,0 +1,135 @@
+package contexts
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	supportcontexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__RateLimitedHTTPContext(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	newIntegration := func(limit *models.RateLimit) *models.Integration {
+		integration, err := models.CreateIntegration(uuid.New(), r.Organization.ID, "dummy", support.RandomName("integration"), map[string]any{})
+		require.NoError(t, err)
+		integration.SetRateLimit(limit)
+		return integration
+	}
+
+	response := func(status int, headers map[string]string) *http.Response {
+		response := &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+
+		for k, v := range headers {
+			response.Header.Set(k, v)
+		}
+
+		return response
+	}
+
+	t.Run("requests over the limit are not sent", func(t *testing.T) {
+		mock := &supportcontexts.HTTPContext{
+			Responses: []*http.Response{response(http.StatusOK, nil)},
+		}
+
+		ctx := NewRateLimitedHTTPContext(mock, newIntegration(&models.RateLimit{Limit: 1, PeriodSeconds: 60}))
+
+		request, _ := http.NewRequest(http.MethodGet, "https://api.example.com", nil)
+		_, err := ctx.Do(request)
+		require.NoError(t, err)
+
+		_, err = ctx.Do(request)
+		var rateLimitErr *RateLimitError
+		require.True(t, errors.As(err, &rateLimitErr))
+		assert.InDelta(t, time.Minute, rateLimitErr.RetryAfter, float64(time.Second))
+		assert.Len(t, mock.Requests, 1)
+	})
+
+	t.Run("429 blocks the integration until Retry-After", func(t *testing.T) {
+		mock := &supportcontexts.HTTPContext{
+			Responses: []*http.Response{response(http.StatusTooManyRequests, map[string]string{"Retry-After": "120"})},
+		}
+
+		integration := newIntegration(nil)
+		ctx := NewRateLimitedHTTPContext(mock, integration)
+
+		request, _ := http.NewRequest(http.MethodGet, "https://api.example.com", nil)
+		_, err := ctx.Do(request)
+		var rateLimitErr *RateLimitError
+		require.True(t, errors.As(err, &rateLimitErr))
+		assert.Equal(t, 2*time.Minute, rateLimitErr.RetryAfter)
+
+		//
+		// Other contexts for the same integration are blocked too.
+		//
+		other := NewRateLimitedHTTPContext(mock, integration)
+		_, err = other.Do(request)
+		require.True(t, errors.As(err, &rateLimitErr))
+		assert.InDelta(t, 2*time.Minute, rateLimitErr.RetryAfter, float64(time.Second))
+		assert.Len(t, mock.Requests, 1)
+	})
+
+	t.Run("limit reached after a request with side effects is marked", func(t *testing.T) {
+		mock := &supportcontexts.HTTPContext{
+			Responses: []*http.Response{response(http.StatusOK, nil), response(http.StatusOK, nil)},
+		}
+
+		ctx := NewRateLimitedHTTPContext(mock, newIntegration(&models.RateLimit{Limit: 2, PeriodSeconds: 60}))
+
+		get, _ := http.NewRequest(http.MethodGet, "https://api.example.com", nil)
+		_, err := ctx.Do(get)
+		require.NoError(t, err)
+
+		post, _ := http.NewRequest(http.MethodPost, "https://api.example.com", nil)
+		_, err = ctx.Do(post)
+		require.NoError(t, err)
+
+		_, err = ctx.Do(get)
+		var rateLimitErr *RateLimitError
+		require.True(t, errors.As(err, &rateLimitErr))
+		assert.True(t, rateLimitErr.AfterSideEffects)
+	})
+
+	t.Run("limit reached after requests without side effects is not marked", func(t *testing.T) {
+		mock := &supportcontexts.HTTPContext{
+			Responses: []*http.Response{response(http.StatusOK, nil)},
+		}
+
+		ctx := NewRateLimitedHTTPContext(mock, newIntegration(&models.RateLimit{Limit: 1, PeriodSeconds: 60}))
+
+		get, _ := http.NewRequest(http.MethodGet, "https://api.example.com", nil)
+		_, err := ctx.Do(get)
+		require.NoError(t, err)
+
+		post, _ := http.NewRequest(http.MethodPost, "https://api.example.com", nil)
+		_, err = ctx.Do(post)
+		var rateLimitErr *RateLimitError
+		require.True(t, errors.As(err, &rateLimitErr))
+		assert.False(t, rateLimitErr.AfterSideEffects)
+	})
+}
+
+func Test__ParseRetryAfter(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, 30*time.Second, ParseRetryAfter("30", now))
+	assert.Equal(t, DefaultRetryAfter, ParseRetryAfter("", now))
+	assert.Equal(t, DefaultRetryAfter, ParseRetryAfter("soon", now))
+	assert.Equal(t, time.Second, ParseRetryAfter("0", now))
+	assert.Equal(t, MaxRetryAfter, ParseRetryAfter("86400", now))
+	assert.InDelta(t, 90*time.Second, ParseRetryAfter(now.Add(90*time.Second).UTC().Format(http.TimeFormat), now), float64(time.Second))
+}
@@ -2,28 +2,32 @@ package contexts
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"gorm.io/gorm"
 )
 
 // SecretsContext resolves organization secret key values for component execution.
+// Every read, and every read denied by the secret access policy, is recorded.
 type SecretsContext struct {
 	tx             *gorm.DB
 	organizationID uuid.UUID
+	accessor       models.SecretAccessor
 	encryptor      crypto.Encryptor
+	events         []*models.SecretAccessEvent
 }
 
 // NewSecretsContext returns a SecretsContext that looks up secrets in the given transaction
-// for the given organization.
-func NewSecretsContext(tx *gorm.DB, organizationID uuid.UUID, encryptor crypto.Encryptor) *SecretsContext {
+// for the given organization. Only the secrets the accessor can read are returned.
+func NewSecretsContext(tx *gorm.DB, organizationID uuid.UUID, accessor models.SecretAccessor, encryptor crypto.Encryptor) *SecretsContext {
 	return &SecretsContext{
 		tx:             tx,
 		organizationID: organizationID,
+		accessor:       accessor,
 		encryptor:      encryptor,
 	}
 }
@@ -39,7 +43,21 @@ func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 		return nil, err
 	}
 
-	data, err := c.decryptSecretData(secret)
+	if !secret.CanBeReadBy(c.accessor) {
+		err = c.record(models.NewSecretAccessEventForNode(secret, models.SecretAccessActionDenied, keyName, c.accessor))
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, models.ErrSecretAccessDenied
+	}
+
+	provider, err := secrets.NewProviderForSecret(c.tx, c.encryptor, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := provider.Load(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -49,21 +67,37 @@ func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 		return nil, core.ErrSecretKeyNotFound
 	}
 
+	//
+	// Values are only returned if the read is recorded.
+	//
+	err = c.record(models.NewSecretAccessEventForNode(secret, models.SecretAccessActionRead, keyName, c.accessor))
+	if err != nil {
+		return nil, err
+	}
+
 	return []byte(val), nil
 }
 
-func (c *SecretsContext) decryptSecretData(secret *models.Secret) (map[string]string, error) {
-	plain, err := c.encryptor.Decrypt(context.Background(), secret.Data, []byte(secret.Name))
+func (c *SecretsContext) record(event *models.SecretAccessEvent) error {
+	err := event.CreateInTransaction(c.tx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var data map[string]string
-	if len(plain) == 0 {
-		return make(map[string]string), nil
-	}
-	if err := json.Unmarshal(plain, &data); err != nil {
-		return nil, err
+	c.events = append(c.events, event)
+	return nil
+}
+
+// RestoreAccessEvents creates again the access events recorded through the context,
+// after the transaction was rolled back to a savepoint created before them.
+// The values read were already handed out, so the reads must stay recorded.
+func (c *SecretsContext) RestoreAccessEvents() error {
+	for _, event := range c.events {
+		err := c.tx.Create(event).Error
+		if err != nil {
+			return err
+		}
 	}
-	return data, nil
+
+	return nil
 }
@@ -0,0 +1,114 @@
+package contexts
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/gorm"
+)
+
+func Test__SecretsContext__GetKey(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	encryptor := &crypto.NoOpEncryptor{}
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	executionID := uuid.New()
+	accessor := models.SecretAccessor{WorkflowID: canvas.ID, NodeID: "node-1", Component: "ssh", ExecutionID: &executionID}
+
+	createSecret := func(access models.SecretAccess) *models.Secret {
+		data, err := json.Marshal(map[string]string{"password": "hello"})
+		require.NoError(t, err)
+		secret, err := models.CreateSecretWithAccess(support.RandomName("secret"), secrets.ProviderLocal, r.User.String(), models.DomainTypeOrganization, r.Organization.ID, data, access)
+		require.NoError(t, err)
+		return secret
+	}
+
+	t.Run("organization secret", func(t *testing.T) {
+		secret := createSecret(models.SecretAccess{})
+		ctx := NewSecretsContext(database.Conn(), r.Organization.ID, accessor, encryptor)
+
+		value, err := ctx.GetKey(secret.Name, "password")
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(value))
+
+		_, err = ctx.GetKey(secret.Name, "missing")
+		assert.ErrorIs(t, err, core.ErrSecretKeyNotFound)
+
+		events, err := models.ListSecretAccessEvents(r.Organization.ID, models.SecretAccessEventFilter{SecretID: &secret.ID}, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.SecretAccessActionRead, events[0].Action)
+		assert.Equal(t, models.SecretAccessActorExecution, events[0].ActorType)
+		assert.Equal(t, executionID, *events[0].ExecutionID)
+		assert.Equal(t, canvas.ID, *events[0].WorkflowID)
+		assert.Equal(t, "node-1", *events[0].NodeID)
+		assert.Equal(t, "password", *events[0].KeyName)
+	})
+
+	t.Run("secret scoped to the node", func(t *testing.T) {
+		nodeID := "node-1"
+		secret := createSecret(models.SecretAccess{WorkflowID: &canvas.ID, NodeID: &nodeID})
+
+		value, err := NewSecretsContext(database.Conn(), r.Organization.ID, accessor, encryptor).GetKey(secret.Name, "password")
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(value))
+
+		other := models.SecretAccessor{WorkflowID: canvas.ID, NodeID: "node-2", Component: "ssh"}
+		_, err = NewSecretsContext(database.Conn(), r.Organization.ID, other, encryptor).GetKey(secret.Name, "password")
+		assert.ErrorIs(t, err, models.ErrSecretAccessDenied)
+	})
+
+	t.Run("secret not allowed for canvas", func(t *testing.T) {
+		secret := createSecret(models.SecretAccess{
+			Policy: &models.SecretAccessPolicy{Canvases: []string{uuid.NewString()}},
+		})
+
+		_, err := NewSecretsContext(database.Conn(), r.Organization.ID, accessor, encryptor).GetKey(secret.Name, "password")
+		assert.ErrorIs(t, err, models.ErrSecretAccessDenied)
+
+		events, err := models.ListSecretAccessEvents(r.Organization.ID, models.SecretAccessEventFilter{SecretID: &secret.ID}, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.SecretAccessActionDenied, events[0].Action)
+	})
+
+	t.Run("secret not allowed for component", func(t *testing.T) {
+		secret := createSecret(models.SecretAccess{
+			Policy: &models.SecretAccessPolicy{Components: []string{"http"}},
+		})
+
+		_, err := NewSecretsContext(database.Conn(), r.Organization.ID, accessor, encryptor).GetKey(secret.Name, "password")
+		assert.ErrorIs(t, err, models.ErrSecretAccessDenied)
+	})
+	t.Run("reads are restored after rolling back to a savepoint", func(t *testing.T) {
+		secret := createSecret(models.SecretAccess{})
+
+		err := database.Conn().Transaction(func(tx *gorm.DB) error {
+			require.NoError(t, tx.SavePoint("before-read").Error)
+
+			ctx := NewSecretsContext(tx, r.Organization.ID, accessor, encryptor)
+			_, err := ctx.GetKey(secret.Name, "password")
+			require.NoError(t, err)
+
+			require.NoError(t, tx.RollbackTo("before-read").Error)
+			return ctx.RestoreAccessEvents()
+		})
+
+		require.NoError(t, err)
+
+		events, err := models.ListSecretAccessEvents(r.Organization.ID, models.SecretAccessEventFilter{SecretID: &secret.ID}, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.SecretAccessActionRead, events[0].Action)
+	})
+}
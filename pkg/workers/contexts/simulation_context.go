@@ -0,0 +1,123 @@
+package contexts
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+/*
+ * The contexts below are used when a canvas is simulated.
+ * They keep everything in memory, so executing a component
+ * with them does not change anything in the database.
+ */
+
+type SimulatedEvent struct {
+	Channel string
+	Data    any
+}
+
+type SimulatedExecutionStateContext struct {
+	Events         []SimulatedEvent
+	Finished       bool
+	FailureReason  string
+	FailureMessage string
+}
+
+func NewSimulatedExecutionStateContext() *SimulatedExecutionStateContext {
+	return &SimulatedExecutionStateContext{}
+}
+
+func (s *SimulatedExecutionStateContext) IsFinished() bool {
+	return s.Finished
+}
+
+func (s *SimulatedExecutionStateContext) Pass() error {
+	s.Finished = true
+	return nil
+}
+
+func (s *SimulatedExecutionStateContext) Emit(channel, payloadType string, payloads []any) error {
+	err := s.Stream(channel, payloadType, payloads)
+	if err != nil {
+		return err
+	}
+
+	s.Finished = true
+	return nil
+}
+
+func (s *SimulatedExecutionStateContext) Stream(channel, payloadType string, payloads []any) error {
+	if s.Finished {
+		return fmt.Errorf("execution is already finished")
+	}
+
+	for _, payload := range payloads {
+		data, err := NormalizeSimulatedPayload(map[string]any{
+			"type":      payloadType,
+			"timestamp": time.Now(),
+			"data":      payload,
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to marshal payload: %w", err)
+		}
+
+		s.Events = append(s.Events, SimulatedEvent{Channel: channel, Data: data})
+	}
+
+	return nil
+}
+
+func (s *SimulatedExecutionStateContext) Fail(reason, message string) error {
+	s.Finished = true
+	s.FailureReason = reason
+	s.FailureMessage = message
+	return nil
+}
+
+func (s *SimulatedExecutionStateContext) SetKV(key, value string) error {
+	return nil
+}
+
+type SimulatedMetadataContext struct {
+	value any
+}
+
+func NewSimulatedMetadataContext(value any) *SimulatedMetadataContext {
+	return &SimulatedMetadataContext{value: value}
+}
+
+func (m *SimulatedMetadataContext) Get() any {
+	return m.value
+}
+
+func (m *SimulatedMetadataContext) Set(value any) error {
+	normalized, err := NormalizeSimulatedPayload(value)
+	if err != nil {
+		return err
+	}
+
+	m.value = normalized
+	return nil
+}
+
+/*
+ * NormalizeSimulatedPayload makes a payload look like
+ * it was stored in the database and read back from it,
+ * so expressions see the same types they would see in a real execution.
+ */
+func NormalizeSimulatedPayload(payload any) (any, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized any
+	err = json.Unmarshal(data, &normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
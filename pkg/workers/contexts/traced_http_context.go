@@ -0,0 +1,45 @@
+package contexts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// TracedHTTPContext records a span for every request made through it,
+// under the span in the given context. Only the method and host are recorded,
+// since paths and query strings can carry tokens.
+type TracedHTTPContext struct {
+	ctx  context.Context
+	http core.HTTPContext
+}
+
+func NewTracedHTTPContext(ctx context.Context, http core.HTTPContext) *TracedHTTPContext {
+	return &TracedHTTPContext{ctx: ctx, http: http}
+}
+
+func (c *TracedHTTPContext) Do(request *http.Request) (*http.Response, error) {
+	_, span := telemetry.StartChildSpan(c.ctx, "http.request",
+		attribute.String("http.request.method", request.Method),
+		attribute.String("server.address", request.URL.Hostname()),
+	)
+
+	response, err := c.http.Do(request)
+	if err != nil {
+		telemetry.EndSpan(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode))
+	if response.StatusCode >= 500 {
+		telemetry.EndSpan(span, fmt.Errorf("request failed with status %d", response.StatusCode))
+		return response, nil
+	}
+
+	span.End()
+	return response, nil
+}
@@ -0,0 +1,75 @@
+package contexts
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// VariablesContext reads and writes the variables of the canvas
+// of an execution. Writes record the execution that made them.
+type VariablesContext struct {
+	tx          *gorm.DB
+	canvasID    uuid.UUID
+	executionID *uuid.UUID
+}
+
+func NewVariablesContext(tx *gorm.DB, canvasID uuid.UUID, executionID *uuid.UUID) *VariablesContext {
+	return &VariablesContext{
+		tx:          tx,
+		canvasID:    canvasID,
+		executionID: executionID,
+	}
+}
+
+// Get implements core.VariablesContext.
+func (c *VariablesContext) Get(name string) (*core.Variable, error) {
+	variable, err := models.FindCanvasVariableInTransaction(c.tx, c.canvasID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, core.ErrVariableNotFound
+		}
+
+		return nil, err
+	}
+
+	return &core.Variable{
+		Name:    variable.Name,
+		Value:   variable.Value.Data(),
+		Version: variable.Version,
+	}, nil
+}
+
+// Set implements core.VariablesContext.
+func (c *VariablesContext) Set(name string, value any, expectedVersion *int) (*core.Variable, error) {
+	err := models.ValidateCanvasVariableName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	variable := &models.CanvasVariable{
+		WorkflowID:           c.canvasID,
+		Name:                 name,
+		Value:                datatypes.NewJSONType(value),
+		UpdatedByExecutionID: c.executionID,
+	}
+
+	err = models.SetCanvasVariableInTransaction(c.tx, variable, expectedVersion)
+	if err != nil {
+		if errors.Is(err, models.ErrCanvasVariableVersionConflict) {
+			return nil, core.ErrVariableVersionConflict
+		}
+
+		return nil, err
+	}
+
+	return &core.Variable{
+		Name:    variable.Name,
+		Value:   value,
+		Version: variable.Version,
+	}, nil
+}
@@ -55,6 +55,7 @@ func (e *EventDistributer) Start() error {
 	}{
 		{messages.WorkflowExchange, messages.WorkflowEventCreatedRoutingKey, e.createHandler(eventdistributer.HandleCanvasEventCreated)},
 		{messages.WorkflowExchange, messages.WorkflowExecutionRoutingKey, e.createHandler(eventdistributer.HandleCanvasExecution)},
+		{messages.WorkflowExchange, messages.WorkflowExecutionLogRoutingKey, e.createHandler(eventdistributer.HandleCanvasExecutionLog)},
 		{messages.WorkflowExchange, messages.WorkflowQueueItemCreatedRoutingKey, e.createHandler(eventdistributer.HandleQueueItemCreated)},
 		{messages.WorkflowExchange, messages.WorkflowQueueItemConsumedRoutingKey, e.createHandler(eventdistributer.HandleQueueItemConsumed)},
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/semaphore"
 	"gorm.io/gorm"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/telemetry"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
 )
 
 type EventRouter struct {
@@ -70,6 +72,7 @@ func (w *EventRouter) Start(ctx context.Context) {
 func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.CanvasEvent) error {
 	var createdQueueItems []models.CanvasNodeQueueItem
 	var execution *models.CanvasNodeExecution
+	var invoker *models.CanvasNodeExecution
 	err := database.Conn().Transaction(func(tx *gorm.DB) error {
 		e, err := models.LockCanvasEvent(tx, event.ID)
 		if err != nil {
@@ -77,7 +80,16 @@ func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.Canvas
 			return nil
 		}
 
-		createdQueueItems, execution, err = w.processEvent(tx, logger, e)
+		_, span := telemetry.StartSpan(e.Traceparent, "event.route",
+			attribute.String("canvas.id", e.WorkflowID.String()),
+			attribute.String("node.id", e.NodeID),
+			attribute.String("event.id", e.ID.String()),
+			attribute.String("event.channel", e.Channel),
+		)
+
+		createdQueueItems, execution, invoker, err = w.processEvent(tx, logger, e)
+		span.SetAttributes(attribute.Int("queue_items.count", len(createdQueueItems)))
+		telemetry.EndSpan(span, err)
 		if err != nil {
 			return err
 		}
@@ -109,31 +121,67 @@ func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.Canvas
 		).Publish()
 	}
 
+	if invoker != nil {
+		messages.NewCanvasExecutionMessage(
+			invoker.WorkflowID.String(),
+			invoker.ID.String(),
+			invoker.NodeID,
+		).Publish()
+	}
+
 	return nil
 }
 
-func (w *EventRouter) processEvent(tx *gorm.DB, logger *log.Entry, event *models.CanvasEvent) ([]models.CanvasNodeQueueItem, *models.CanvasNodeExecution, error) {
+// processEvent routes the event, returning the queue items created for it,
+// the execution that needs to be published, and the Run Canvas execution
+// in another canvas that was finished because of it, if any.
+func (w *EventRouter) processEvent(tx *gorm.DB, logger *log.Entry, event *models.CanvasEvent) ([]models.CanvasNodeQueueItem, *models.CanvasNodeExecution, *models.CanvasNodeExecution, error) {
 	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, event.WorkflowID)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if event.ExecutionID == nil {
 		queueItems, err := w.processRootEvent(tx, canvas, event)
-		return queueItems, nil, err
+		if err != nil || len(queueItems) > 0 {
+			return queueItems, nil, nil, err
+		}
+
+		//
+		// If the root event was emitted by a Run Canvas execution
+		// and nothing is connected to the trigger, the invocation is already done.
+		//
+		invoker, err := contexts.CompleteCanvasInvocationIfNeeded(tx, event.ID)
+		return queueItems, nil, invoker, err
 	}
 
 	execution, err := models.FindNodeExecutionInTransaction(tx, event.WorkflowID, *event.ExecutionID)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if execution.ParentExecutionID != nil {
-		return w.processChildExecutionEvent(tx, logger, canvas, execution, event)
+		queueItems, parent, err := w.processChildExecutionEvent(tx, logger, canvas, execution, event)
+		return queueItems, parent, nil, err
 	}
 
 	queueItems, err := w.processExecutionEvent(tx, logger, canvas, execution, event)
-	return queueItems, execution, err
+	if err != nil || len(queueItems) > 0 {
+		return queueItems, execution, nil, err
+	}
+
+	//
+	// The event did not move the execution tree forward,
+	// so if it was started by a Run Canvas execution, the invocation might be done,
+	// and executions waiting for its branch will not be reached by it.
+	//
+	err = models.ScheduleBranchesCheck(tx, execution.RootEventID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	invoker, err := contexts.CompleteCanvasInvocationIfNeeded(tx, execution.RootEventID)
+	return queueItems, execution, invoker, err
 }
 
 func (w *EventRouter) processRootEvent(tx *gorm.DB, canvas *models.Canvas, event *models.CanvasEvent) ([]models.CanvasNodeQueueItem, error) {
@@ -158,10 +206,12 @@ func (w *EventRouter) processRootEvent(tx *gorm.DB, canvas *models.Canvas, event
 			NodeID:      targetNode.NodeID,
 			RootEventID: event.ID,
 			EventID:     event.ID,
+			Traceparent: event.Traceparent,
+			Priority:    event.Priority,
 			CreatedAt:   &now,
 		}
 
-		if err := tx.Create(&queueItem).Error; err != nil {
+		if err := contexts.CreateQueueItem(tx, targetNode, &queueItem); err != nil {
 			return nil, err
 		}
 
@@ -199,10 +249,12 @@ func (w *EventRouter) processExecutionEvent(tx *gorm.DB, logger *log.Entry, canv
 			NodeID:      targetNode.NodeID,
 			RootEventID: execution.RootEventID,
 			EventID:     event.ID,
+			Traceparent: event.Traceparent,
+			Priority:    event.Priority,
 			CreatedAt:   &now,
 		}
 
-		if err := tx.Create(&queueItem).Error; err != nil {
+		if err := contexts.CreateQueueItem(tx, targetNode, &queueItem); err != nil {
 			return nil, err
 		}
 
@@ -291,10 +343,12 @@ func (w *EventRouter) processChildExecutionEvent(tx *gorm.DB, logger *log.Entry,
 			NodeID:      targetNodeID,
 			RootEventID: execution.RootEventID,
 			EventID:     event.ID,
+			Traceparent: event.Traceparent,
+			Priority:    event.Priority,
 			CreatedAt:   &now,
 		}
 
-		if err := tx.Create(&queueItem).Error; err != nil {
+		if err := contexts.CreateQueueItem(tx, targetNode, &queueItem); err != nil {
 			logger.Errorf("Error creating queue item: %v", err)
 			return nil, nil, err
 		}
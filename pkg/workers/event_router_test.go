@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	testconsumer "github.com/superplanehq/superplane/test/consumer"
@@ -348,3 +349,152 @@ func filterEventsByChannel(events []models.CanvasEvent, channel string) []models
 	}
 	return filtered
 }
+
+func Test__EventRouter_CompletesCanvasInvocation(t *testing.T) {
+	router := NewEventRouter()
+	logger := log.NewEntry(log.New())
+	r := support.Setup(t)
+
+	//
+	// Canvas with a Run Canvas node, and a started execution for it.
+	//
+	callerTrigger := "trigger-1"
+	callerNode := "run-canvas-1"
+	caller, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: callerTrigger, Type: models.NodeTypeTrigger},
+			{NodeID: callerNode, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: callerTrigger, TargetID: callerNode, Channel: "default"},
+		},
+	)
+
+	callerEvent := support.EmitCanvasEventForNode(t, caller.ID, callerTrigger, "default", nil)
+	invoker := support.CreateCanvasNodeExecution(t, caller.ID, callerNode, callerEvent.ID, callerEvent.ID, nil)
+	require.NoError(t, invoker.Start())
+
+	//
+	// Invoked canvas with a trigger and a single terminal component.
+	//
+	trigger := "trigger-1"
+	node := "component-1"
+	invoked, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: trigger, Type: models.NodeTypeTrigger},
+			{NodeID: node, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: trigger, TargetID: node, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, invoked.ID, trigger, "default", nil)
+	require.NoError(t, database.Conn().Model(rootEvent).Updates(map[string]any{
+		"invoked_by_execution_id": invoker.ID,
+		"state":                   models.CanvasEventStateRouted,
+	}).Error)
+
+	execution := support.CreateCanvasNodeExecution(t, invoked.ID, node, rootEvent.ID, rootEvent.ID, nil)
+	_, err := execution.Pass(map[string][]any{"default": {map[string]any{"result": "ok"}}})
+	require.NoError(t, err)
+
+	//
+	// Routing the terminal output event finishes the invoking execution,
+	// with the outputs of the terminal nodes.
+	//
+	events, err := models.ListCanvasEvents(invoked.ID, node, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.NoError(t, router.LockAndProcessEvent(logger, events[0]))
+
+	invoker, err = models.FindNodeExecution(caller.ID, invoker.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, invoker.State)
+	assert.Equal(t, models.CanvasNodeExecutionResultPassed, invoker.Result)
+
+	outputs, err := invoker.GetOutputs()
+	require.NoError(t, err)
+	require.Len(t, outputs, 1)
+	assert.Equal(t, models.CanvasInvocationChannelPassed, outputs[0].Channel)
+
+	payload := outputs[0].Data.Data().(map[string]any)
+	assert.Equal(t, models.CanvasInvocationPayloadType, payload["type"])
+	data := payload["data"].(map[string]any)
+	assert.Equal(t, rootEvent.ID.String(), data["rootEventId"])
+	assert.Equal(t, map[string]any{node: []any{map[string]any{"result": "ok"}}}, data["outputs"])
+}
+
+func Test__EventRouter_DoesNotCompleteCanvasInvocationWithActiveWork(t *testing.T) {
+	router := NewEventRouter()
+	logger := log.NewEntry(log.New())
+	r := support.Setup(t)
+
+	callerTrigger := "trigger-1"
+	callerNode := "run-canvas-1"
+	caller, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: callerTrigger, Type: models.NodeTypeTrigger},
+			{NodeID: callerNode, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: callerTrigger, TargetID: callerNode, Channel: "default"},
+		},
+	)
+
+	callerEvent := support.EmitCanvasEventForNode(t, caller.ID, callerTrigger, "default", nil)
+	invoker := support.CreateCanvasNodeExecution(t, caller.ID, callerNode, callerEvent.ID, callerEvent.ID, nil)
+	require.NoError(t, invoker.Start())
+
+	//
+	// Invoked canvas with two branches: one finished, and one still running.
+	//
+	trigger := "trigger-1"
+	node1 := "component-1"
+	node2 := "component-2"
+	invoked, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: trigger, Type: models.NodeTypeTrigger},
+			{NodeID: node1, Type: models.NodeTypeComponent},
+			{NodeID: node2, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: trigger, TargetID: node1, Channel: "default"},
+			{SourceID: trigger, TargetID: node2, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, invoked.ID, trigger, "default", nil)
+	require.NoError(t, database.Conn().Model(rootEvent).Updates(map[string]any{
+		"invoked_by_execution_id": invoker.ID,
+		"state":                   models.CanvasEventStateRouted,
+	}).Error)
+
+	execution := support.CreateCanvasNodeExecution(t, invoked.ID, node1, rootEvent.ID, rootEvent.ID, nil)
+	_, err := execution.Pass(map[string][]any{"default": {map[string]any{}}})
+	require.NoError(t, err)
+
+	running := support.CreateCanvasNodeExecution(t, invoked.ID, node2, rootEvent.ID, rootEvent.ID, nil)
+	require.NoError(t, running.Start())
+
+	events, err := models.ListCanvasEvents(invoked.ID, node1, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.NoError(t, router.LockAndProcessEvent(logger, events[0]))
+
+	invoker, err = models.FindNodeExecution(caller.ID, invoker.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateStarted, invoker.State)
+}
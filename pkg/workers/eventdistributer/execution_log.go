@@ -0,0 +1,47 @@
+package eventdistributer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/public/ws"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const ExecutionLogEvent = "execution_log"
+
+//
+// Log lines are sent as they come in the message,
+// without going back to the database, since they are only appended.
+//
+
+func HandleCanvasExecutionLog(messageBody []byte, wsHub *ws.Hub) error {
+	log.Debugf("Received execution log event")
+
+	pbMsg := &pb.CanvasNodeExecutionLogMessage{}
+	if err := proto.Unmarshal(messageBody, pbMsg); err != nil {
+		return fmt.Errorf("failed to unmarshal execution log event: %w", err)
+	}
+
+	payload, err := protojson.Marshal(pbMsg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal execution log: %w", err)
+	}
+
+	event, err := json.Marshal(ExecutionStateWebsocketEvent{
+		Event:   ExecutionLogEvent,
+		Payload: json.RawMessage(payload),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to marshal websocket event: %w", err)
+	}
+
+	wsHub.BroadcastToWorkflow(pbMsg.CanvasId, event)
+	log.Debugf("Broadcasted %d log lines for execution %s to workflow %s", len(pbMsg.Lines), pbMsg.ExecutionId, pbMsg.CanvasId)
+
+	return nil
+}
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/semaphore"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
@@ -29,16 +31,18 @@ var ErrRecordLocked = errors.New("record locked")
 type NodeExecutor struct {
 	encryptor      crypto.Encryptor
 	registry       *registry.Registry
+	artifacts      artifacts.Storage
 	baseURL        string
 	webhookBaseURL string
 	semaphore      *semaphore.Weighted
 	logger         *logrus.Entry
 }
 
-func NewNodeExecutor(encryptor crypto.Encryptor, registry *registry.Registry, baseURL string, webhookBaseURL string) *NodeExecutor {
+func NewNodeExecutor(encryptor crypto.Encryptor, registry *registry.Registry, artifactStorage artifacts.Storage, baseURL string, webhookBaseURL string) *NodeExecutor {
 	return &NodeExecutor{
 		encryptor:      encryptor,
 		registry:       registry,
+		artifacts:      artifactStorage,
 		baseURL:        baseURL,
 		webhookBaseURL: webhookBaseURL,
 		semaphore:      semaphore.NewWeighted(25),
@@ -146,7 +150,19 @@ func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.Canva
 	return w.executeComponentNode(tx, execution, node)
 }
 
-func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) (err error) {
+	//
+	// The child executions continue the trace
+	// under the span of the blueprint execution.
+	//
+	spanCtx, span := telemetry.StartSpan(execution.Traceparent, "blueprint.execute", executionSpanAttributes(execution, node)...)
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	err = execution.UpdateTraceparent(tx, telemetry.Traceparent(spanCtx))
+	if err != nil {
+		return fmt.Errorf("failed to update traceparent: %w", err)
+	}
+
 	ref := node.Ref.Data()
 	blueprint, err := models.FindUnscopedBlueprintInTransaction(tx, ref.Blueprint.ID)
 	if err != nil {
@@ -216,6 +232,16 @@ func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.Canva
 	return err
 }
 
+func executionSpanAttributes(execution *models.CanvasNodeExecution, node *models.CanvasNode) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("canvas.id", execution.WorkflowID.String()),
+		attribute.String("node.id", execution.NodeID),
+		attribute.String("node.type", node.Type),
+		attribute.String("execution.id", execution.ID.String()),
+		attribute.Int("execution.attempt", execution.GetAttempt()),
+	}
+}
+
 func (w *NodeExecutor) configurationFieldsForBlueprintNode(tx *gorm.DB, node models.Node) ([]configuration.Field, error) {
 	switch {
 	case node.Ref.Component != nil && node.Ref.Component.Name != "":
@@ -241,19 +267,51 @@ func (w *NodeExecutor) configurationFieldsForBlueprintNode(tx *gorm.DB, node mod
 	}
 }
 
-func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) (err error) {
 	logger := logging.WithExecution(
 		logging.WithNode(w.logger, *node),
 		execution,
 		nil,
 	)
 
-	err := execution.StartInTransaction(tx)
+	//
+	// The span only covers the Execute call, but the execution keeps its
+	// traceparent, so anything that happens to it later, like the events it emits
+	// when a webhook or a scheduled action finishes it, stays in the same trace.
+	//
+	spanCtx, span := telemetry.StartSpan(execution.Traceparent, "node.execute", executionSpanAttributes(execution, node)...)
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	err = execution.UpdateTraceparent(tx, telemetry.Traceparent(spanCtx))
+	if err != nil {
+		logger.Errorf("failed to update traceparent: %v", err)
+		return fmt.Errorf("failed to update traceparent: %w", err)
+	}
+
+	//
+	// If the execution fails because the integration it uses is rate limited,
+	// we roll back to this point, and delay the execution instead.
+	//
+	pending := *execution
+	err = tx.SavePoint(rateLimitSavePoint).Error
+	if err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	err = execution.StartInTransaction(tx)
 	if err != nil {
 		logger.Errorf("failed to start execution: %v", err)
 		return fmt.Errorf("failed to start execution: %w", err)
 	}
 
+	if timeout := node.GetExecutionTimeout(); timeout > 0 {
+		err = execution.ScheduleTimeout(tx, timeout)
+		if err != nil {
+			logger.Errorf("failed to schedule execution timeout: %v", err)
+			return fmt.Errorf("failed to schedule execution timeout: %w", err)
+		}
+	}
+
 	ref := node.Ref.Data()
 	component, err := w.registry.GetComponent(ref.Component.Name)
 	if err != nil {
@@ -275,6 +333,7 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		return fmt.Errorf("failed to find workflow: %v", err)
 	}
 
+	secretsCtx := contexts.NewSecretsContext(tx, workflow.OrganizationID, models.NewSecretAccessor(node).ForExecution(execution.ID), w.encryptor)
 	ctx := core.ExecutionContext{
 		ID:             execution.ID,
 		WorkflowID:     execution.WorkflowID.String(),
@@ -284,15 +343,18 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		BaseURL:        w.baseURL,
 		Configuration:  execution.Configuration.Data(),
 		Data:           input,
-		HTTP:           w.registry.HTTPContext(),
+		HTTP:           contexts.NewTracedHTTPContext(spanCtx, w.registry.HTTPContext()),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		NodeMetadata:   contexts.NewNodeMetadataContext(tx, node),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
 		Notifications:  contexts.NewNotificationContext(tx, workflow.OrganizationID, execution.WorkflowID),
-		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Secrets:        secretsCtx,
 		Webhook:        contexts.NewNodeWebhookContext(context.Background(), tx, w.encryptor, node, w.webhookBaseURL),
+		Canvases:       contexts.NewCanvasesContext(tx, workflow.OrganizationID, execution),
+		Variables:      contexts.NewVariablesContext(tx, execution.WorkflowID, &execution.ID),
+		Artifacts:      contexts.NewArtifactsContext(tx, w.artifacts, execution),
 	}
 	ctx.ExpressionEnv = func(expression string) (map[string]any, error) {
 		builder := contexts.NewNodeConfigurationBuilder(tx, execution.WorkflowID).
@@ -319,16 +381,58 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 
 		logger = logging.WithIntegration(logger, *instance)
 		ctx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry)
+		ctx.HTTP = contexts.NewRateLimitedHTTPContext(ctx.HTTP, instance)
 	}
 
+	//
+	// Everything the component logs, or streams through ctx.Logs,
+	// is also captured in the execution log.
+	//
+	executionLog := contexts.NewExecutionLogContext(execution)
+	defer executionLog.Close()
+
+	logger = executionLog.Logger(logger)
 	ctx.Logger = logger
-	if err := component.Execute(ctx); err != nil {
-		logger.Errorf("failed to execute component: %v", err)
-		err = execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, err.Error())
-		return err
+	ctx.Logs = executionLog
+	executeErr := component.Execute(ctx)
+	if retryAfter := rateLimitRetryAfter(executeErr); retryAfter > 0 {
+		logger.Infof("Integration rate limit reached - delaying execution for %s", retryAfter)
+		err = tx.RollbackTo(rateLimitSavePoint).Error
+		if err != nil {
+			return fmt.Errorf("failed to roll back rate limited execution: %w", err)
+		}
+
+		err = secretsCtx.RestoreAccessEvents()
+		if err != nil {
+			return fmt.Errorf("failed to restore secret access events: %w", err)
+		}
+
+		*execution = pending
+		return execution.DelayInTransaction(tx, time.Now().Add(retryAfter))
+	}
+
+	if executeErr != nil {
+		logger.Errorf("failed to execute component: %v", executeErr)
+		telemetry.FailSpan(span, executeErr)
+		return execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, executeErr.Error())
 	}
 
 	logger.Info("Component executed successfully")
 
 	return tx.Save(execution).Error
 }
+
+const rateLimitSavePoint = "rate_limit"
+
+// rateLimitRetryAfter returns how long to wait before trying again,
+// if the work failed because an integration it used is rate limited.
+// Only failures caused by the rate limit are retried, and only if no request
+// with side effects was made before, since the work is done again from the start.
+func rateLimitRetryAfter(err error) time.Duration {
+	var rateLimitErr *contexts.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.AfterSideEffects {
+		return 0
+	}
+
+	return rateLimitErr.RetryAfter
+}
@@ -1,13 +1,22 @@
 package workers
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/jira"
+	"github.com/superplanehq/superplane/pkg/integrations/pagerduty"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"github.com/superplanehq/superplane/test/support"
+	supportcontexts "github.com/superplanehq/superplane/test/support/contexts"
 	"gorm.io/datatypes"
 )
 
@@ -56,12 +65,12 @@ func Test__NodeExecutor_PreventsConcurrentProcessing(t *testing.T) {
 	// Create two workers and have them try to process the execution concurrently.
 	//
 	go func() {
-		executor1 := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+		executor1 := NewNodeExecutor(r.Encryptor, r.Registry, r.ArtifactStorage, "http://localhost", "http://localhost")
 		results <- executor1.LockAndProcessNodeExecution(execution.ID)
 	}()
 
 	go func() {
-		executor2 := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+		executor2 := NewNodeExecutor(r.Encryptor, r.Registry, r.ArtifactStorage, "http://localhost", "http://localhost")
 		results <- executor2.LockAndProcessNodeExecution(execution.ID)
 	}()
 
@@ -146,7 +155,7 @@ func Test__NodeExecutor_BlueprintNodeExecution(t *testing.T) {
 	// Process the execution and verify the blueprint node creates a child execution
 	// and moves the parent execution to started state.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, r.ArtifactStorage, "http://localhost", "http://localhost")
 	err := executor.LockAndProcessNodeExecution(execution.ID)
 	require.NoError(t, err)
 
@@ -224,7 +233,7 @@ func Test__NodeExecutor_ComponentNodeWithoutStateChange(t *testing.T) {
 	// Process the execution and verify the execution is started but NOT finished.
 	// The approval component doesn't call Pass() in Execute(), so it should remain in started state.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, r.ArtifactStorage, "http://localhost", "http://localhost")
 	err = executor.LockAndProcessNodeExecution(execution.ID)
 	require.NoError(t, err)
 
@@ -291,7 +300,7 @@ func Test__NodeExecutor_ComponentNodeWithStateChange(t *testing.T) {
 	// Process the execution and verify the execution is both started AND finished.
 	// The noop component calls Pass() in Execute(), which should finish the execution.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, r.ArtifactStorage, "http://localhost", "http://localhost")
 	err := executor.LockAndProcessNodeExecution(execution.ID)
 	require.NoError(t, err)
 
@@ -372,7 +381,7 @@ func Test__NodeExecutor_BlueprintNodeExecutionFailsWhenConfigurationCannotBeBuil
 	// LockAndProcessNodeExecution should not return an error,
 	// since this isn't a runtime error, but a configuration error.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, r.ArtifactStorage, "http://localhost", "http://localhost")
 	err := executor.LockAndProcessNodeExecution(execution.ID)
 	require.NoError(t, err)
 
@@ -399,3 +408,51 @@ func countConcurrentExecutionResults(t *testing.T, results []error) (successCoun
 	}
 	return successCount, lockedCount
 }
+
+func Test__RateLimitRetryAfter(t *testing.T) {
+	rateLimitErr := &contexts.RateLimitError{RetryAfter: time.Minute}
+
+	assert.Equal(t, time.Minute, rateLimitRetryAfter(rateLimitErr))
+	assert.Equal(t, time.Minute, rateLimitRetryAfter(fmt.Errorf("failed to create issue: %w", rateLimitErr)))
+	assert.Zero(t, rateLimitRetryAfter(errors.New("repository not found")))
+	assert.Zero(t, rateLimitRetryAfter(nil))
+	assert.Zero(t, rateLimitRetryAfter(&contexts.RateLimitError{RetryAfter: time.Minute, AfterSideEffects: true}))
+}
+
+// rateLimitedHTTPContext fails every request the way
+// contexts.RateLimitedHTTPContext does when the limit is reached.
+type rateLimitedHTTPContext struct{}
+
+func (c *rateLimitedHTTPContext) Do(request *http.Request) (*http.Response, error) {
+	return nil, &contexts.RateLimitError{RetryAfter: time.Minute}
+}
+
+func Test__RateLimitRetryAfter_Integrations(t *testing.T) {
+	t.Run("pagerduty create incident", func(t *testing.T) {
+		err := (&pagerduty.CreateIncident{}).Execute(core.ExecutionContext{
+			Configuration: map[string]any{"title": "Down", "urgency": "high", "service": "PX123456"},
+			HTTP:          &rateLimitedHTTPContext{},
+			Integration: &supportcontexts.IntegrationContext{
+				Configuration: map[string]any{"authType": pagerduty.AuthTypeAPIToken, "apiToken": "test-token"},
+			},
+			ExecutionState: &supportcontexts.ExecutionStateContext{},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, time.Minute, rateLimitRetryAfter(err))
+	})
+
+	t.Run("jira create issue", func(t *testing.T) {
+		err := (&jira.CreateIssue{}).Execute(core.ExecutionContext{
+			Configuration: map[string]any{"project": "TEST", "issueType": "Task", "summary": "New task"},
+			HTTP:          &rateLimitedHTTPContext{},
+			Integration: &supportcontexts.IntegrationContext{
+				Configuration: map[string]any{"baseUrl": "https://test.atlassian.net", "email": "test@example.com", "apiToken": "test-token"},
+			},
+			ExecutionState: &supportcontexts.ExecutionStateContext{},
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, time.Minute, rateLimitRetryAfter(err))
+	})
+}
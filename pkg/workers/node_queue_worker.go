@@ -22,6 +22,10 @@ import (
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 )
 
+// maxQueuePolicyItems is how many items in the queue of a node
+// are considered when applying its queue policy.
+const maxQueuePolicyItems = 500
+
 type NodeQueueWorker struct {
 	registry  *registry.Registry
 	semaphore *semaphore.Weighted
@@ -76,7 +80,7 @@ func (w *NodeQueueWorker) Start(ctx context.Context) {
 
 func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.CanvasNode) error {
 	var executionIDs []*uuid.UUID
-	var queueItem *models.CanvasNodeQueueItem
+	var queueItems []*models.CanvasNodeQueueItem
 	err := database.Conn().Transaction(func(tx *gorm.DB) error {
 		n, err := models.LockCanvasNode(tx, node.WorkflowID, node.NodeID)
 		if err != nil {
@@ -84,7 +88,7 @@ func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.Canv
 			return nil
 		}
 
-		executionIDs, queueItem, err = w.processNode(tx, logger, n)
+		executionIDs, queueItems, err = w.processNode(tx, logger, n)
 		return err
 	})
 
@@ -103,7 +107,7 @@ func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.Canv
 			}
 		}
 
-		if queueItem != nil {
+		for _, queueItem := range queueItems {
 			messages.NewCanvasQueueItemMessage(
 				queueItem.WorkflowID.String(),
 				queueItem.ID.String(),
@@ -115,7 +119,61 @@ func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.Canv
 	return err
 }
 
-func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode) ([]*uuid.UUID, *models.CanvasNodeQueueItem, error) {
+// processNode processes the next item in the queue of the node,
+// returning the executions created or updated, and the queue items consumed.
+func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode) ([]*uuid.UUID, []*models.CanvasNodeQueueItem, error) {
+	if node.State == models.CanvasNodeStateProcessing {
+		return w.processNodeWithRunningExecution(tx, logger, node)
+	}
+
+	policy := node.GetQueuePolicy()
+	if policy == nil {
+		queueItem, err := node.FirstQueueItem(tx)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, nil
+			}
+
+			return nil, nil, err
+		}
+
+		executionIDs, consumed, err := w.processQueueItem(tx, logger, node, queueItem)
+		return executionIDs, queueItemsOrNil(consumed), err
+	}
+
+	//
+	// The queue policy of the node decides which item is processed next,
+	// and which ones are skipped. Skipped items are consumed
+	// in the same transaction, even if the next item has to wait.
+	//
+	result, err := w.applyQueuePolicy(tx, logger, node, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if result.next == nil {
+		return result.executionIDs, result.skipped, nil
+	}
+
+	executionIDs, consumed, err := w.processQueueItem(tx, logger, node, result.next)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return append(result.executionIDs, executionIDs...), append(result.skipped, queueItemsOrNil(consumed)...), nil
+}
+
+// processNodeWithRunningExecution applies the concurrency group policy
+// of a node still processing an execution to the next item in its queue.
+// The running execution is active in the group, so the item is skipped
+// or the execution is cancelled, and once the node is ready again,
+// the item is processed as usual.
+func (w *NodeQueueWorker) processNodeWithRunningExecution(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode) ([]*uuid.UUID, []*models.CanvasNodeQueueItem, error) {
+	group := node.GetConcurrencyGroup()
+	if group == nil {
+		return nil, nil, nil
+	}
+
 	queueItem, err := node.FirstQueueItem(tx)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -125,9 +183,77 @@ func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *mode
 		return nil, nil, err
 	}
 
+	logger = logging.WithQueueItem(logger, *queueItem)
+	_, skippedExecutionID, err := w.applyConcurrencyGroupPolicy(tx, logger, node, queueItem, group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if skippedExecutionID != nil {
+		return []*uuid.UUID{skippedExecutionID}, queueItemsOrNil(queueItem), nil
+	}
+
+	return nil, nil, nil
+}
+
+func queueItemsOrNil(queueItem *models.CanvasNodeQueueItem) []*models.CanvasNodeQueueItem {
+	if queueItem == nil {
+		return nil
+	}
+
+	return []*models.CanvasNodeQueueItem{queueItem}
+}
+
+func (w *NodeQueueWorker) processQueueItem(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode, queueItem *models.CanvasNodeQueueItem) ([]*uuid.UUID, *models.CanvasNodeQueueItem, error) {
 	logger = logging.WithQueueItem(logger, *queueItem)
 	logger.Info("Processing queue item")
 
+	//
+	// Nodes with a rate limit keep their queue items
+	// until a token is available to create the execution.
+	// The token is only taken once the concurrency group lets the item through,
+	// and if none is available, whatever the group did for it is rolled back.
+	//
+	limit := node.GetRateLimit()
+	if limit != nil {
+		err := tx.SavePoint(rateLimitSavePoint).Error
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create savepoint: %w", err)
+		}
+	}
+
+	//
+	// Nodes in a concurrency group have their executions serialized
+	// with all the other nodes in the same group, across all canvases
+	// in the organization, so we check the group policy before processing.
+	//
+	if group := node.GetConcurrencyGroup(); group != nil {
+		proceed, skippedExecutionID, err := w.applyConcurrencyGroupPolicy(tx, logger, node, queueItem, group)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !proceed {
+			if skippedExecutionID != nil {
+				return []*uuid.UUID{skippedExecutionID}, queueItem, nil
+			}
+
+			return nil, nil, nil
+		}
+	}
+
+	if limit != nil {
+		wait, err := models.TakeRateLimitTokenInTransaction(tx, models.NodeRateLimitKey(node.WorkflowID, node.NodeID), limit, time.Now())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check rate limit: %w", err)
+		}
+
+		if wait > 0 {
+			logger.Infof("Node rate limit reached - next item in %s", wait)
+			return nil, nil, tx.RollbackTo(rateLimitSavePoint).Error
+		}
+	}
+
 	configFields, err := w.configurationFieldsForNode(tx, node)
 	if err != nil {
 		return nil, nil, err
@@ -183,6 +309,272 @@ func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *mode
 	return []*uuid.UUID{executionID}, queueItem, err
 }
 
+type queuePolicyResult struct {
+	next         *models.CanvasNodeQueueItem
+	skipped      []*models.CanvasNodeQueueItem
+	executionIDs []*uuid.UUID
+}
+
+// applyQueuePolicy groups the items in the queue of the node by the key
+// stored in them when they were queued, and applies the queue policy
+// to the first group with an item ready to be processed:
+//
+//   - debounce: if an item was added to the group recently, the group waits,
+//     and the next group is considered. Otherwise, the latest item is processed,
+//     and the others are skipped.
+//   - coalesce: the latest item is processed, and the others are skipped.
+//   - drop-duplicates: the first item is processed, and the others are skipped.
+//
+// It returns the item to process, if any, and the items skipped,
+// with the executions recording them.
+func (w *NodeQueueWorker) applyQueuePolicy(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode, policy *models.QueuePolicy) (*queuePolicyResult, error) {
+	queueItems, err := node.ListQueueItemsInTransaction(tx, maxQueuePolicyItems)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queue items: %w", err)
+	}
+
+	for _, group := range groupQueueItems(queueItems, policy) {
+		next := group[0]
+		if policy.Strategy == models.QueuePolicyStrategyDebounce || policy.Strategy == models.QueuePolicyStrategyCoalesce {
+			next = latestQueueItem(group)
+		}
+
+		if policy.Strategy == models.QueuePolicyStrategyDebounce && time.Since(*next.CreatedAt) < policy.DebounceDuration() {
+			logger.Infof("Debouncing %d queue items", len(group))
+			continue
+		}
+
+		return w.skipQueueItemGroup(tx, logger, node, policy, group, next)
+	}
+
+	return &queuePolicyResult{}, nil
+}
+
+// groupQueueItems groups the queue items by their key,
+// keeping the order of the items, and of the groups, by their first item.
+// Items without a key are grouped on their own.
+// If the queue policy has no key, all the items are in the same group.
+func groupQueueItems(queueItems []models.CanvasNodeQueueItem, policy *models.QueuePolicy) [][]*models.CanvasNodeQueueItem {
+	if len(queueItems) == 0 {
+		return nil
+	}
+
+	if policy.Key == "" {
+		group := make([]*models.CanvasNodeQueueItem, len(queueItems))
+		for i := range queueItems {
+			group[i] = &queueItems[i]
+		}
+
+		return [][]*models.CanvasNodeQueueItem{group}
+	}
+
+	groups := [][]*models.CanvasNodeQueueItem{}
+	indexes := map[string]int{}
+	for i := range queueItems {
+		item := &queueItems[i]
+		if item.QueueKey == nil {
+			groups = append(groups, []*models.CanvasNodeQueueItem{item})
+			continue
+		}
+
+		index, ok := indexes[*item.QueueKey]
+		if !ok {
+			indexes[*item.QueueKey] = len(groups)
+			groups = append(groups, []*models.CanvasNodeQueueItem{item})
+			continue
+		}
+
+		groups[index] = append(groups[index], item)
+	}
+
+	return groups
+}
+
+func latestQueueItem(group []*models.CanvasNodeQueueItem) *models.CanvasNodeQueueItem {
+	latest := group[0]
+	for _, item := range group[1:] {
+		if item.CreatedAt.After(*latest.CreatedAt) {
+			latest = item
+		}
+	}
+
+	return latest
+}
+
+// skipQueueItemGroup skips all the items in the group, except the next one.
+func (w *NodeQueueWorker) skipQueueItemGroup(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode, policy *models.QueuePolicy, group []*models.CanvasNodeQueueItem, next *models.CanvasNodeQueueItem) (*queuePolicyResult, error) {
+	result := &queuePolicyResult{next: next}
+	if len(group) == 1 {
+		return result, nil
+	}
+
+	var reason string
+	switch policy.Strategy {
+	case models.QueuePolicyStrategyDebounce:
+		reason = "Skipped by the debounce queue policy: a newer event was queued for the node"
+	case models.QueuePolicyStrategyCoalesce:
+		reason = "Skipped by the coalesce queue policy: a newer event with the same key was queued for the node"
+	case models.QueuePolicyStrategyDropDuplicates:
+		reason = "Skipped by the drop-duplicates queue policy: an event with the same key was already queued for the node"
+	default:
+		return result, nil
+	}
+
+	for _, item := range group {
+		if item.ID == next.ID {
+			continue
+		}
+
+		executionID, err := w.skipQueueItem(tx, node, item, reason)
+		if err != nil {
+			return nil, fmt.Errorf("failed to skip queue item %s: %w", item.ID, err)
+		}
+
+		result.skipped = append(result.skipped, item)
+		result.executionIDs = append(result.executionIDs, executionID)
+	}
+
+	logger.Infof("Queue policy %s skipped %d queue items", policy.Strategy, len(result.skipped))
+	return result, nil
+}
+
+// skipQueueItem deletes the queue item, and records it
+// as a cancelled execution, with the skipped result reason.
+func (w *NodeQueueWorker) skipQueueItem(tx *gorm.DB, node *models.CanvasNode, queueItem *models.CanvasNodeQueueItem, message string) (*uuid.UUID, error) {
+	event, err := models.FindCanvasEventInTransaction(tx, queueItem.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queueItem.Delete(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	execution := models.CanvasNodeExecution{
+		WorkflowID:          queueItem.WorkflowID,
+		NodeID:              node.NodeID,
+		RootEventID:         queueItem.RootEventID,
+		EventID:             event.ID,
+		PreviousExecutionID: event.ExecutionID,
+		Traceparent:         queueItem.Traceparent,
+		Priority:            queueItem.Priority,
+		State:               models.CanvasNodeExecutionStateFinished,
+		Configuration:       node.Configuration,
+		Result:              models.CanvasNodeExecutionResultCancelled,
+		ResultReason:        models.CanvasNodeExecutionResultReasonSkipped,
+		ResultMessage:       message,
+		CreatedAt:           &now,
+		UpdatedAt:           &now,
+		FinishedAt:          &now,
+	}
+
+	err = tx.Create(&execution).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = models.ScheduleCanvasInvocationCheck(tx, &execution)
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution.ID, nil
+}
+
+// applyConcurrencyGroupPolicy checks if there are active executions
+// for the nodes in the concurrency group, and applies the group policy:
+//
+//   - queue: the queue item stays in the queue until the group is free.
+//   - skip-if-running: the queue item is skipped.
+//   - cancel-in-progress: the active executions are cancelled, and the queue item is processed.
+//
+// It returns whether the queue item should be processed,
+// and the execution recording it, if it was skipped.
+func (w *NodeQueueWorker) applyConcurrencyGroupPolicy(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode, queueItem *models.CanvasNodeQueueItem, group *models.ConcurrencyGroup) (bool, *uuid.UUID, error) {
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, node.WorkflowID)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to find canvas: %w", err)
+	}
+
+	err = models.LockConcurrencyGroup(tx, canvas.OrganizationID, group.Name)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to lock concurrency group %s: %w", group.Name, err)
+	}
+
+	executions, err := models.ListActiveExecutionsInConcurrencyGroup(tx, canvas.OrganizationID, group.Name)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to list executions for concurrency group %s: %w", group.Name, err)
+	}
+
+	if len(executions) == 0 {
+		return true, nil, nil
+	}
+
+	switch group.Policy {
+	case models.ConcurrencyPolicySkipIfRunning:
+		logger.Infof("Concurrency group %s has %d active executions - skipping queue item", group.Name, len(executions))
+		executionID, err := w.skipQueueItem(tx, node, queueItem, fmt.Sprintf("Skipped by the skip-if-running policy of concurrency group %s: an execution in the group is running", group.Name))
+		if err != nil {
+			return false, nil, err
+		}
+
+		return false, executionID, nil
+
+	case models.ConcurrencyPolicyCancelInProgress:
+		logger.Infof("Concurrency group %s has %d active executions - cancelling them", group.Name, len(executions))
+		for _, execution := range executions {
+			err := w.cancelExecutionInConcurrencyGroup(tx, &execution)
+			if err != nil {
+				return false, nil, fmt.Errorf("failed to cancel execution %s: %w", execution.ID, err)
+			}
+		}
+
+		return true, nil, nil
+
+	default:
+		logger.Infof("Concurrency group %s has %d active executions - waiting", group.Name, len(executions))
+		return false, nil, nil
+	}
+}
+
+// cancelExecutionInConcurrencyGroup cancels an active execution,
+// and its child executions, if it is a blueprint node execution.
+// Components are notified about the cancellation through a node request,
+// since the queue worker does not have access to the integrations,
+// and the request worker publishes the execution updates once that happens.
+func (w *NodeQueueWorker) cancelExecutionInConcurrencyGroup(tx *gorm.DB, execution *models.CanvasNodeExecution) error {
+	children, err := models.FindChildExecutionsInTransaction(
+		tx,
+		execution.ID,
+		[]string{models.CanvasNodeExecutionStatePending, models.CanvasNodeExecutionStateStarted},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		err := w.cancelExecution(tx, &child)
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.cancelExecution(tx, execution)
+}
+
+func (w *NodeQueueWorker) cancelExecution(tx *gorm.DB, execution *models.CanvasNodeExecution) error {
+	now := time.Now()
+	err := execution.CreateRequest(tx, models.NodeRequestTypeCancelExecution, models.NodeExecutionRequestSpec{}, &now)
+	if err != nil {
+		return err
+	}
+
+	return execution.CancelInTransaction(tx, nil)
+}
+
 func (w *NodeQueueWorker) configurationFieldsForNode(tx *gorm.DB, node *models.CanvasNode) ([]configuration.Field, error) {
 	ref := node.Ref.Data()
 	switch node.Type {
@@ -251,6 +643,8 @@ func (w *NodeQueueWorker) handleNodeConfigurationError(tx *gorm.DB, logger *log.
 		EventID:             configErr.Event.ID,
 		PreviousExecutionID: configErr.Event.ExecutionID,
 		ParentExecutionID:   parentExecutionID,
+		Traceparent:         configErr.QueueItem.Traceparent,
+		Priority:            configErr.QueueItem.Priority,
 		State:               models.CanvasNodeExecutionStateFinished,
 		Configuration:       configErr.Node.Configuration,
 		Result:              models.CanvasNodeExecutionResultFailed,
@@ -258,6 +652,7 @@ func (w *NodeQueueWorker) handleNodeConfigurationError(tx *gorm.DB, logger *log.
 		ResultMessage:       configErr.Err.Error(),
 		CreatedAt:           &now,
 		UpdatedAt:           &now,
+		FinishedAt:          &now,
 	}
 
 	err = tx.Create(&execution).Error
@@ -266,6 +661,11 @@ func (w *NodeQueueWorker) handleNodeConfigurationError(tx *gorm.DB, logger *log.
 	}
 
 	if parentExecutionID == nil {
+		err = models.ScheduleCanvasInvocationCheck(tx, &execution)
+		if err != nil {
+			return nil, err
+		}
+
 		return []*uuid.UUID{&execution.ID}, nil
 	}
 
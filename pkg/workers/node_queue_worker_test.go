@@ -12,6 +12,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	testconsumer "github.com/superplanehq/superplane/test/consumer"
 	"github.com/superplanehq/superplane/test/support"
 	"gorm.io/datatypes"
@@ -805,3 +806,455 @@ func Test__WorkflowNodeQueueWorker_ConfigurationBuildFailure_PropagateToParent(t
 	assert.Equal(t, models.CanvasNodeExecutionResultFailed, updatedParent.Result)
 	assert.Equal(t, models.CanvasNodeExecutionResultReasonError, updatedParent.ResultReason)
 }
+
+func Test__NodeQueueWorker_ConcurrencyGroups(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeQueueWorker(r.Registry)
+	logger := log.NewEntry(log.New())
+
+	//
+	// Creates two canvases in the same organization,
+	// each with a node in the same concurrency group.
+	// The first canvas has an execution already running for the group.
+	//
+	setup := func(policy string) (*models.Canvas, *models.CanvasNodeExecution, *models.Canvas, *models.CanvasNode) {
+		group := &models.ConcurrencyGroup{Name: support.RandomName("prod-deploy"), Policy: policy}
+		nodes := []models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:            "deploy",
+				Type:              models.NodeTypeComponent,
+				Ref:               datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				ConcurrencyGroup:  &group.Name,
+				ConcurrencyPolicy: &group.Policy,
+			},
+		}
+
+		edges := []models.Edge{{SourceID: "trigger-1", TargetID: "deploy", Channel: "default"}}
+		runningCanvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, edges)
+		waitingCanvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, edges)
+
+		runningEvent := support.EmitCanvasEventForNode(t, runningCanvas.ID, "trigger-1", "default", nil)
+		running := support.CreateCanvasNodeExecution(t, runningCanvas.ID, "deploy", runningEvent.ID, runningEvent.ID, nil)
+		require.NoError(t, running.Start())
+
+		waitingEvent := support.EmitCanvasEventForNode(t, waitingCanvas.ID, "trigger-1", "default", nil)
+		support.CreateQueueItem(t, waitingCanvas.ID, "deploy", waitingEvent.ID, waitingEvent.ID)
+
+		node, err := models.FindCanvasNode(database.Conn(), waitingCanvas.ID, "deploy")
+		require.NoError(t, err)
+		return runningCanvas, running, waitingCanvas, node
+	}
+
+	t.Run("queue policy waits for the group to be free", func(t *testing.T) {
+		runningCanvas, running, waitingCanvas, node := setup(models.ConcurrencyPolicyQueue)
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, waitingCanvas.ID, 1)
+		support.VerifyNodeExecutionsCount(t, waitingCanvas.ID, 0)
+
+		//
+		// Once the running execution finishes, the queue item is processed.
+		//
+		_, err := running.Pass(map[string][]any{})
+		require.NoError(t, err)
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, waitingCanvas.ID, 0)
+		support.VerifyNodeExecutionsCount(t, waitingCanvas.ID, 1)
+		support.VerifyNodeExecutionsCount(t, runningCanvas.ID, 1)
+	})
+
+	t.Run("skip-if-running policy skips the queue item", func(t *testing.T) {
+		runningCanvas, running, waitingCanvas, node := setup(models.ConcurrencyPolicySkipIfRunning)
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, waitingCanvas.ID, 0)
+		support.VerifyNodeExecutionsCount(t, waitingCanvas.ID, 1)
+
+		executions, err := models.ListNodeExecutions(waitingCanvas.ID, "deploy", nil, nil, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, executions, 1)
+		assert.Equal(t, models.CanvasNodeExecutionResultCancelled, executions[0].Result)
+		assert.Equal(t, models.CanvasNodeExecutionResultReasonSkipped, executions[0].ResultReason)
+
+		execution, err := models.FindNodeExecution(runningCanvas.ID, running.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateStarted, execution.State)
+	})
+
+	//
+	// Creates a canvas with a node in a concurrency group,
+	// still processing an execution when a new event is queued for it.
+	//
+	setupSingleNode := func(policy string) (*models.Canvas, *models.CanvasNodeExecution, *models.CanvasNode) {
+		group := &models.ConcurrencyGroup{Name: support.RandomName("prod-deploy"), Policy: policy}
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "trigger-1",
+					Type:   models.NodeTypeTrigger,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+				},
+				{
+					NodeID:            "deploy",
+					Type:              models.NodeTypeComponent,
+					Ref:               datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+					ConcurrencyGroup:  &group.Name,
+					ConcurrencyPolicy: &group.Policy,
+				},
+			},
+			[]models.Edge{{SourceID: "trigger-1", TargetID: "deploy", Channel: "default"}},
+		)
+
+		runningEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		running := support.CreateCanvasNodeExecution(t, canvas.ID, "deploy", runningEvent.ID, runningEvent.ID, nil)
+		require.NoError(t, running.Start())
+
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "deploy")
+		require.NoError(t, err)
+		require.NoError(t, node.UpdateState(database.Conn(), models.CanvasNodeStateProcessing))
+
+		nextEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+		support.CreateQueueItem(t, canvas.ID, "deploy", nextEvent.ID, nextEvent.ID)
+
+		node, err = models.FindCanvasNode(database.Conn(), canvas.ID, "deploy")
+		require.NoError(t, err)
+		return canvas, running, node
+	}
+
+	listedAsReady := func(node *models.CanvasNode) bool {
+		nodes, err := models.ListCanvasNodesReady()
+		require.NoError(t, err)
+
+		for _, n := range nodes {
+			if n.WorkflowID == node.WorkflowID && n.NodeID == node.NodeID {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	t.Run("skip-if-running policy skips the queue item for a processing node", func(t *testing.T) {
+		canvas, running, node := setupSingleNode(models.ConcurrencyPolicySkipIfRunning)
+		assert.True(t, listedAsReady(node))
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, canvas.ID, 0)
+		support.VerifyNodeExecutionsCount(t, canvas.ID, 2)
+
+		execution, err := models.FindNodeExecution(canvas.ID, running.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateStarted, execution.State)
+
+		executions, err := models.ListNodeExecutions(canvas.ID, "deploy", nil, nil, 10, nil)
+		require.NoError(t, err)
+		skipped := 0
+		for _, execution := range executions {
+			if execution.ResultReason == models.CanvasNodeExecutionResultReasonSkipped {
+				assert.Equal(t, models.CanvasNodeExecutionResultCancelled, execution.Result)
+				skipped++
+			}
+		}
+
+		assert.Equal(t, 1, skipped)
+	})
+
+	t.Run("cancel-in-progress policy cancels the execution of a processing node", func(t *testing.T) {
+		canvas, running, node := setupSingleNode(models.ConcurrencyPolicyCancelInProgress)
+		assert.True(t, listedAsReady(node))
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+
+		execution, err := models.FindNodeExecution(canvas.ID, running.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultCancelled, execution.Result)
+		support.VerifyNodeRequestCount(t, canvas.ID, 1)
+
+		//
+		// The node is ready again, so the queue item is processed next.
+		//
+		node, err = models.FindCanvasNode(database.Conn(), canvas.ID, "deploy")
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeStateReady, node.State)
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, canvas.ID, 0)
+		support.VerifyNodeExecutionsCount(t, canvas.ID, 2)
+	})
+
+	t.Run("queue policy does not pick a processing node", func(t *testing.T) {
+		canvas, _, node := setupSingleNode(models.ConcurrencyPolicyQueue)
+		assert.False(t, listedAsReady(node))
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, canvas.ID, 1)
+		support.VerifyNodeExecutionsCount(t, canvas.ID, 1)
+	})
+
+	t.Run("cancel-in-progress policy cancels running executions", func(t *testing.T) {
+		runningCanvas, running, waitingCanvas, node := setup(models.ConcurrencyPolicyCancelInProgress)
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, waitingCanvas.ID, 0)
+		support.VerifyNodeExecutionsCount(t, waitingCanvas.ID, 1)
+
+		execution, err := models.FindNodeExecution(runningCanvas.ID, running.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultCancelled, execution.Result)
+
+		//
+		// Component is notified about the cancellation through a node request.
+		//
+		support.VerifyNodeRequestCount(t, runningCanvas.ID, 1)
+	})
+
+	t.Run("nodes in other organizations are not affected", func(t *testing.T) {
+		_, _, waitingCanvas, node := setup(models.ConcurrencyPolicyQueue)
+
+		otherOrg := support.CreateOrganization(t, r, r.User)
+		otherCanvas, _ := support.CreateCanvas(
+			t,
+			otherOrg.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID:            "deploy",
+					Type:              models.NodeTypeComponent,
+					Ref:               datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+					ConcurrencyGroup:  node.ConcurrencyGroup,
+					ConcurrencyPolicy: node.ConcurrencyPolicy,
+				},
+			},
+			[]models.Edge{},
+		)
+
+		otherEvent := support.EmitCanvasEventForNode(t, otherCanvas.ID, "deploy", "default", nil)
+		support.CreateQueueItem(t, otherCanvas.ID, "deploy", otherEvent.ID, otherEvent.ID)
+		otherNode, err := models.FindCanvasNode(database.Conn(), otherCanvas.ID, "deploy")
+		require.NoError(t, err)
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *otherNode))
+		support.VerifyNodeExecutionsCount(t, otherCanvas.ID, 1)
+		support.VerifyNodeQueueCount(t, waitingCanvas.ID, 1)
+	})
+}
+
+func Test__NodeQueueWorker_QueuePolicies(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeQueueWorker(r.Registry)
+	logger := log.NewEntry(log.New())
+
+	//
+	// Queues an item for the node, the way the event router does,
+	// so the key of the queue policy is stored in it.
+	//
+	enqueue := func(canvas *models.Canvas, node *models.CanvasNode, ref string, createdAt time.Time) *models.CanvasNodeQueueItem {
+		event := support.EmitCanvasEventForNodeWithData(t, canvas.ID, "trigger-1", "default", nil, map[string]any{"ref": ref})
+		queueItem := &models.CanvasNodeQueueItem{
+			WorkflowID:  canvas.ID,
+			NodeID:      node.NodeID,
+			RootEventID: event.ID,
+			EventID:     event.ID,
+			CreatedAt:   &createdAt,
+		}
+
+		require.NoError(t, contexts.CreateQueueItem(database.Conn(), node, queueItem))
+		return queueItem
+	}
+
+	//
+	// Creates a canvas with a node using the queue policy,
+	// and queues one item for each ref, the oldest first.
+	//
+	setup := func(policy models.QueuePolicy, refs ...string) (*models.Canvas, *models.CanvasNode, []*models.CanvasNodeQueueItem) {
+		queuePolicy := datatypes.NewJSONType(policy)
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "trigger-1",
+					Type:   models.NodeTypeTrigger,
+					Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+				},
+				{
+					NodeID:      "deploy",
+					Type:        models.NodeTypeComponent,
+					Ref:         datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+					QueuePolicy: &queuePolicy,
+				},
+			},
+			[]models.Edge{{SourceID: "trigger-1", TargetID: "deploy", Channel: "default"}},
+		)
+
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "deploy")
+		require.NoError(t, err)
+
+		queueItems := []*models.CanvasNodeQueueItem{}
+		for i, ref := range refs {
+			createdAt := time.Now().Add(time.Duration(i-len(refs)) * time.Minute)
+			queueItems = append(queueItems, enqueue(canvas, node, ref, createdAt))
+		}
+
+		return canvas, node, queueItems
+	}
+
+	findExecutions := func(canvas *models.Canvas) (processed []models.CanvasNodeExecution, skipped []models.CanvasNodeExecution) {
+		executions, err := models.ListNodeExecutions(canvas.ID, "deploy", nil, nil, 10, nil)
+		require.NoError(t, err)
+
+		for _, execution := range executions {
+			if execution.ResultReason == models.CanvasNodeExecutionResultReasonSkipped {
+				assert.Equal(t, models.CanvasNodeExecutionResultCancelled, execution.Result)
+				assert.NotEmpty(t, execution.ResultMessage)
+				skipped = append(skipped, execution)
+			} else {
+				processed = append(processed, execution)
+			}
+		}
+
+		return processed, skipped
+	}
+
+	t.Run("debounce waits for the queue to settle and keeps only the latest item", func(t *testing.T) {
+		canvas, node, queueItems := setup(models.QueuePolicy{Strategy: models.QueuePolicyStrategyDebounce, DebounceSeconds: 30}, "main", "main", "main")
+
+		//
+		// The latest item was queued a minute ago, so the queue has settled.
+		//
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, canvas.ID, 0)
+
+		processed, skipped := findExecutions(canvas)
+		require.Len(t, processed, 1)
+		assert.Equal(t, queueItems[2].EventID, processed[0].EventID)
+		assert.Len(t, skipped, 2)
+	})
+
+	t.Run("debounce does not process anything while items are still being queued", func(t *testing.T) {
+		canvas, node, _ := setup(models.QueuePolicy{Strategy: models.QueuePolicyStrategyDebounce, DebounceSeconds: 30}, "main")
+
+		enqueue(canvas, node, "main", time.Now())
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, canvas.ID, 2)
+		support.VerifyNodeExecutionsCount(t, canvas.ID, 0)
+	})
+
+	t.Run("debounce processes other keys while one is still being queued", func(t *testing.T) {
+		canvas, node, queueItems := setup(models.QueuePolicy{Strategy: models.QueuePolicyStrategyDebounce, DebounceSeconds: 30, Key: "{{ root().ref }}"}, "main", "dev")
+		enqueue(canvas, node, "main", time.Now())
+
+		//
+		// The main items are still being queued, so the dev one is processed.
+		//
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+		support.VerifyNodeQueueCount(t, canvas.ID, 2)
+		processed, skipped := findExecutions(canvas)
+		require.Len(t, processed, 1)
+		assert.Equal(t, queueItems[1].EventID, processed[0].EventID)
+		assert.Empty(t, skipped)
+	})
+
+	t.Run("coalesce keeps only the latest item with the same key", func(t *testing.T) {
+		canvas, node, queueItems := setup(models.QueuePolicy{Strategy: models.QueuePolicyStrategyCoalesce, Key: "{{ root().ref }}"}, "main", "dev", "main")
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+
+		//
+		// The dev item has a different key, so it stays in the queue.
+		//
+		support.VerifyNodeQueueCount(t, canvas.ID, 1)
+		processed, skipped := findExecutions(canvas)
+		require.Len(t, processed, 1)
+		assert.Equal(t, queueItems[2].EventID, processed[0].EventID)
+		require.Len(t, skipped, 1)
+		assert.Equal(t, queueItems[0].EventID, skipped[0].EventID)
+	})
+
+	t.Run("drop-duplicates keeps only the oldest item with the same key", func(t *testing.T) {
+		canvas, node, queueItems := setup(models.QueuePolicy{Strategy: models.QueuePolicyStrategyDropDuplicates, Key: "{{ root().ref }}"}, "main", "main", "dev")
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+
+		support.VerifyNodeQueueCount(t, canvas.ID, 1)
+		processed, skipped := findExecutions(canvas)
+		require.Len(t, processed, 1)
+		assert.Equal(t, queueItems[0].EventID, processed[0].EventID)
+		require.Len(t, skipped, 1)
+		assert.Equal(t, queueItems[1].EventID, skipped[0].EventID)
+	})
+
+	t.Run("drop-duplicates keeps the item dequeued first, by priority", func(t *testing.T) {
+		canvas, node, queueItems := setup(models.QueuePolicy{Strategy: models.QueuePolicyStrategyDropDuplicates, Key: "{{ root().ref }}"}, "main", "main")
+		require.NoError(t, models.UpdateNodeQueueItemPriority(canvas.ID, node.NodeID, queueItems[1].ID, 10))
+
+		require.NoError(t, worker.LockAndProcessNode(logger, *node))
+
+		support.VerifyNodeQueueCount(t, canvas.ID, 0)
+		processed, skipped := findExecutions(canvas)
+		require.Len(t, processed, 1)
+		assert.Equal(t, queueItems[1].EventID, processed[0].EventID)
+		require.Len(t, skipped, 1)
+		assert.Equal(t, queueItems[0].EventID, skipped[0].EventID)
+	})
+}
+
+func Test__NodeQueueWorker_RateLimit(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeQueueWorker(r.Registry)
+	logger := log.NewEntry(log.New())
+
+	rateLimit := datatypes.NewJSONType(models.RateLimit{Limit: 1, PeriodSeconds: 3600})
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:    "deploy",
+				Type:      models.NodeTypeComponent,
+				Ref:       datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				RateLimit: &rateLimit,
+			},
+		},
+		[]models.Edge{{SourceID: "trigger-1", TargetID: "deploy", Channel: "default"}},
+	)
+
+	for range 2 {
+		event := support.EmitCanvasEventForNodeWithData(t, canvas.ID, "trigger-1", "default", nil, map[string]any{})
+		support.CreateQueueItem(t, canvas.ID, "deploy", event.ID, event.ID)
+	}
+
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "deploy")
+	require.NoError(t, err)
+
+	//
+	// The first item takes the only token available,
+	// and the second one stays in the queue until the bucket is refilled.
+	//
+	require.NoError(t, worker.LockAndProcessNode(logger, *node))
+	require.NoError(t, worker.LockAndProcessNode(logger, *node))
+	support.VerifyNodeQueueCount(t, canvas.ID, 1)
+	support.VerifyNodeExecutionsCount(t, canvas.ID, 1)
+}
@@ -9,9 +9,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/semaphore"
 	"gorm.io/gorm"
 
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
@@ -27,12 +29,14 @@ type NodeRequestWorker struct {
 	semaphore *semaphore.Weighted
 	registry  *registry.Registry
 	encryptor crypto.Encryptor
+	artifacts artifacts.Storage
 }
 
-func NewNodeRequestWorker(encryptor crypto.Encryptor, registry *registry.Registry) *NodeRequestWorker {
+func NewNodeRequestWorker(encryptor crypto.Encryptor, registry *registry.Registry, artifactStorage artifacts.Storage) *NodeRequestWorker {
 	return &NodeRequestWorker{
 		encryptor: encryptor,
 		registry:  registry,
+		artifacts: artifactStorage,
 		semaphore: semaphore.NewWeighted(25),
 	}
 }
@@ -95,6 +99,16 @@ func (w *NodeRequestWorker) processRequest(tx *gorm.DB, request *models.CanvasNo
 	switch request.Type {
 	case models.NodeRequestTypeInvokeAction:
 		return w.invokeAction(tx, request)
+	case models.NodeRequestTypeCancelExecution:
+		return w.cancelExecution(tx, request)
+	case models.NodeRequestTypeRetryExecution:
+		return w.retryExecution(tx, request)
+	case models.NodeRequestTypeTimeoutExecution:
+		return w.timeoutExecution(tx, request)
+	case models.NodeRequestTypeCompleteCanvasInvocation:
+		return w.completeCanvasInvocation(tx, request)
+	case models.NodeRequestTypeBranchesStopped:
+		return w.branchesStopped(tx, request)
 	}
 
 	return fmt.Errorf("unsupported node execution request type %s", request.Type)
@@ -232,9 +246,19 @@ func (w *NodeRequestWorker) invokeNodeComponentAction(tx *gorm.DB, request *mode
 		logger = logging.WithIntegration(logger, *instance)
 		actionCtx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry)
 		actionCtx.Logger = logger
+		actionCtx.HTTP = contexts.NewRateLimitedHTTPContext(actionCtx.HTTP, instance)
+	}
+
+	err = tx.SavePoint(rateLimitSavePoint).Error
+	if err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
 	}
 
 	err = component.HandleAction(actionCtx)
+	if retryAfter := rateLimitRetryAfter(err); retryAfter > 0 {
+		return w.rescheduleRateLimitedRequest(tx, request, retryAfter, nil)
+	}
+
 	if err != nil {
 		return fmt.Errorf("action execution failed: %w", err)
 	}
@@ -248,7 +272,11 @@ func (w *NodeRequestWorker) invokeNodeComponentAction(tx *gorm.DB, request *mode
 }
 
 func (w *NodeRequestWorker) invokeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest) error {
-	execution, err := models.FindNodeExecutionInTransaction(tx, request.WorkflowID, *request.ExecutionID)
+	//
+	// Components update the execution state and metadata in their actions,
+	// so actions for the same execution must not run concurrently.
+	//
+	execution, err := models.FindAndLockNodeExecutionInTransaction(tx, request.WorkflowID, *request.ExecutionID)
 	if err != nil {
 		return fmt.Errorf("execution %s not found: %w", request.ExecutionID, err)
 	}
@@ -287,18 +315,28 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("workflow not found: %w", err)
 	}
 
+	spanCtx, span := telemetry.StartSpan(execution.Traceparent, "node.action",
+		attribute.String("canvas.id", execution.WorkflowID.String()),
+		attribute.String("node.id", execution.NodeID),
+		attribute.String("execution.id", execution.ID.String()),
+		attribute.String("action.name", actionName),
+	)
+	defer span.End()
+
 	logger := logging.ForExecution(execution, nil)
+	secretsCtx := contexts.NewSecretsContext(tx, workflow.OrganizationID, models.NewSecretAccessor(node).ForExecution(execution.ID), w.encryptor)
 	actionCtx := core.ActionContext{
 		Name:           actionName,
 		Configuration:  node.Configuration.Data(),
 		Parameters:     spec.InvokeAction.Parameters,
-		HTTP:           w.registry.HTTPContext(),
+		HTTP:           contexts.NewTracedHTTPContext(spanCtx, w.registry.HTTPContext()),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, node.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
-		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Secrets:        secretsCtx,
+		Artifacts:      contexts.NewArtifactsContext(tx, w.artifacts, execution),
 	}
 
 	if node.AppInstallationID != nil {
@@ -309,11 +347,26 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 
 		logger = logging.WithIntegration(logger, *instance)
 		actionCtx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry)
+		actionCtx.HTTP = contexts.NewRateLimitedHTTPContext(actionCtx.HTTP, instance)
+	}
+
+	executionLog := contexts.NewExecutionLogContext(execution)
+	defer executionLog.Close()
+
+	err = tx.SavePoint(rateLimitSavePoint).Error
+	if err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
 	}
 
-	actionCtx.Logger = logger
+	actionCtx.Logger = executionLog.Logger(logger)
+	actionCtx.Logs = executionLog
 	err = component.HandleAction(actionCtx)
+	if retryAfter := rateLimitRetryAfter(err); retryAfter > 0 {
+		return w.rescheduleRateLimitedRequest(tx, request, retryAfter, secretsCtx)
+	}
+
 	if err != nil {
+		telemetry.FailSpan(span, err)
 		return fmt.Errorf("action execution failed: %w", err)
 	}
 
@@ -347,6 +400,14 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("node not found: %w", err)
 	}
 
+	secretAccessor := models.SecretAccessor{
+		WorkflowID:   execution.WorkflowID,
+		NodeID:       execution.NodeID,
+		ParentNodeID: parentNode.NodeID,
+		Component:    childNode.Ref.Component.Name,
+		ExecutionID:  &execution.ID,
+	}
+
 	component, err := w.registry.GetComponent(childNode.Ref.Component.Name)
 	if err != nil {
 		return fmt.Errorf("component not found: %w", err)
@@ -368,22 +429,36 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("workflow not found: %w", err)
 	}
 
+	spanCtx, span := telemetry.StartSpan(execution.Traceparent, "node.action",
+		attribute.String("canvas.id", execution.WorkflowID.String()),
+		attribute.String("node.id", execution.NodeID),
+		attribute.String("execution.id", execution.ID.String()),
+		attribute.String("action.name", actionName),
+	)
+	defer span.End()
+
 	actionCtx := core.ActionContext{
 		Name:           actionName,
 		Configuration:  execution.Configuration.Data(),
 		Parameters:     spec.InvokeAction.Parameters,
-		Logger:         logging.ForExecution(execution, parentExecution),
-		HTTP:           w.registry.HTTPContext(),
+		HTTP:           contexts.NewTracedHTTPContext(spanCtx, w.registry.HTTPContext()),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
-		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, secretAccessor, w.encryptor),
+		Artifacts:      contexts.NewArtifactsContext(tx, w.artifacts, execution),
 	}
 
+	executionLog := contexts.NewExecutionLogContext(execution)
+	defer executionLog.Close()
+
+	actionCtx.Logger = executionLog.Logger(logging.ForExecution(execution, parentExecution))
+	actionCtx.Logs = executionLog
 	err = component.HandleAction(actionCtx)
 	if err != nil {
+		telemetry.FailSpan(span, err)
 		return fmt.Errorf("action execution failed: %w", err)
 	}
 
@@ -395,6 +470,277 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 	return request.Complete(tx)
 }
 
+// rescheduleRateLimitedRequest undoes what the action did before
+// hitting the rate limit of its integration, and runs it again later.
+// It is only used when the action failed with a RateLimitError,
+// see rateLimitRetryAfter. Secret reads made by the action stay recorded.
+func (w *NodeRequestWorker) rescheduleRateLimitedRequest(tx *gorm.DB, request *models.CanvasNodeRequest, retryAfter time.Duration, secrets *contexts.SecretsContext) error {
+	w.log("Integration rate limit reached - rescheduling request %s for %s", request.ID, retryAfter)
+	err := tx.RollbackTo(rateLimitSavePoint).Error
+	if err != nil {
+		return fmt.Errorf("failed to roll back rate limited request: %w", err)
+	}
+
+	if secrets != nil {
+		err = secrets.RestoreAccessEvents()
+		if err != nil {
+			return fmt.Errorf("failed to restore secret access events: %w", err)
+		}
+	}
+
+	return request.Reschedule(tx, time.Now().Add(retryAfter))
+}
+
+// cancelExecution handles executions cancelled by the engine itself, e.g. by concurrency groups.
+// Those executions are already marked as cancelled, so here we only give the component
+// a chance to clean up anything it started for the execution.
+func (w *NodeRequestWorker) cancelExecution(tx *gorm.DB, request *models.CanvasNodeRequest) error {
+	if request.ExecutionID == nil {
+		return fmt.Errorf("execution is not specified")
+	}
+
+	execution, err := models.FindNodeExecutionInTransaction(tx, request.WorkflowID, *request.ExecutionID)
+	if err != nil {
+		return fmt.Errorf("execution %s not found: %w", request.ExecutionID, err)
+	}
+
+	node, err := models.FindCanvasNode(tx, execution.WorkflowID, execution.NodeID)
+	if err != nil {
+		return fmt.Errorf("node not found: %w", err)
+	}
+
+	err = w.cancelComponentExecution(tx, execution, node)
+	if err != nil {
+		return err
+	}
+
+	return request.Complete(tx)
+}
+
+// timeoutExecution fails executions that are still running after their deadline.
+// The component is cancelled first, so it can stop anything it started for the execution,
+// e.g. a pipeline that will never report back.
+func (w *NodeRequestWorker) timeoutExecution(tx *gorm.DB, request *models.CanvasNodeRequest) error {
+	if request.ExecutionID == nil {
+		return fmt.Errorf("execution is not specified")
+	}
+
+	execution, err := models.FindNodeExecutionInTransaction(tx, request.WorkflowID, *request.ExecutionID)
+	if err != nil {
+		return fmt.Errorf("execution %s not found: %w", request.ExecutionID, err)
+	}
+
+	if execution.State != models.CanvasNodeExecutionStateStarted {
+		return request.Complete(tx)
+	}
+
+	node, err := models.FindCanvasNode(tx, execution.WorkflowID, execution.NodeID)
+	if err != nil {
+		return fmt.Errorf("node not found: %w", err)
+	}
+
+	err = w.cancelComponentExecution(tx, execution, node)
+	if err != nil {
+		return err
+	}
+
+	logging.ForExecution(execution, nil).Infof("Execution did not finish before its deadline - failing it")
+
+	err = execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonTimeout, "Execution did not finish before its deadline")
+	if err != nil {
+		return fmt.Errorf("failed to fail execution %s: %w", execution.ID, err)
+	}
+
+	return request.Complete(tx)
+}
+
+// completeCanvasInvocation checks if the invocation of a canvas
+// by the Run Canvas execution of the request is done,
+// after an execution in the invoked canvas finished.
+func (w *NodeRequestWorker) completeCanvasInvocation(tx *gorm.DB, request *models.CanvasNodeRequest) error {
+	spec := request.Spec.Data()
+	if spec.CompleteCanvasInvocation == nil {
+		return fmt.Errorf("spec is not specified")
+	}
+
+	_, err := contexts.CompleteCanvasInvocationIfNeeded(tx, spec.CompleteCanvasInvocation.RootEventID)
+	if err != nil {
+		return fmt.Errorf("failed to complete canvas invocation: %w", err)
+	}
+
+	return request.Complete(tx)
+}
+
+// branchesStopped notifies an execution waiting for branches of its run
+// that every other branch of the run stopped, so nothing else will reach it.
+// The run might have moved on since the request was scheduled,
+// so it is only done if the run is still stopped, and the execution is still the first one to notify.
+func (w *NodeRequestWorker) branchesStopped(tx *gorm.DB, request *models.CanvasNodeRequest) error {
+	if request.ExecutionID == nil {
+		return fmt.Errorf("execution is not specified")
+	}
+
+	execution, err := models.FindNodeExecutionInTransaction(tx, request.WorkflowID, *request.ExecutionID)
+	if err != nil {
+		return fmt.Errorf("execution %s not found: %w", request.ExecutionID, err)
+	}
+
+	waiting, err := models.FindNextWaitingExecutionInTransaction(tx, execution.RootEventID)
+	if err != nil {
+		return fmt.Errorf("failed to find waiting execution: %w", err)
+	}
+
+	if waiting == nil {
+		return request.Complete(tx)
+	}
+
+	if waiting.ID != execution.ID {
+		err = models.ScheduleBranchesCheck(tx, execution.RootEventID)
+		if err != nil {
+			return fmt.Errorf("failed to schedule branches check: %w", err)
+		}
+
+		return request.Complete(tx)
+	}
+
+	active, err := models.HasActiveBranchesForRootEvent(tx, execution.RootEventID)
+	if err != nil {
+		return fmt.Errorf("failed to check branches: %w", err)
+	}
+
+	if active {
+		return request.Complete(tx)
+	}
+
+	return w.invokeComponentAction(tx, request)
+}
+
+// cancelComponentExecution gives the component for the node
+// a chance to clean up anything it started for the execution.
+// Errors from the component itself are only logged.
+func (w *NodeRequestWorker) cancelComponentExecution(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+	ref := node.Ref.Data()
+	if node.Type != models.NodeTypeComponent || ref.Component == nil {
+		return nil
+	}
+
+	component, err := w.registry.GetComponent(ref.Component.Name)
+	if err != nil {
+		return fmt.Errorf("component not found: %w", err)
+	}
+
+	workflow, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, execution.WorkflowID)
+	if err != nil {
+		return fmt.Errorf("workflow not found: %w", err)
+	}
+
+	logger := logging.ForExecution(execution, nil)
+	ctx := core.ExecutionContext{
+		ID:             execution.ID,
+		WorkflowID:     execution.WorkflowID.String(),
+		OrganizationID: workflow.OrganizationID.String(),
+		NodeID:         execution.NodeID,
+		Configuration:  execution.Configuration.Data(),
+		HTTP:           w.registry.HTTPContext(),
+		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
+		NodeMetadata:   contexts.NewNodeMetadataContext(tx, node),
+		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
+		Requests:       contexts.NewExecutionRequestContext(tx, execution),
+		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
+		Notifications:  contexts.NewNotificationContext(tx, workflow.OrganizationID, execution.WorkflowID),
+		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, models.NewSecretAccessor(node).ForExecution(execution.ID), w.encryptor),
+		Artifacts:      contexts.NewArtifactsContext(tx, w.artifacts, execution),
+	}
+
+	if node.AppInstallationID != nil {
+		instance, err := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				w.log("integration %s not found - skipping component cancellation", *node.AppInstallationID)
+				return nil
+			}
+
+			return fmt.Errorf("failed to find integration: %v", err)
+		}
+
+		logger = logging.WithIntegration(logger, *instance)
+		ctx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry)
+	}
+
+	executionLog := contexts.NewExecutionLogContext(execution)
+	defer executionLog.Close()
+
+	logger = executionLog.Logger(logger)
+	ctx.Logger = logger
+	ctx.Logs = executionLog
+	if err := component.Cancel(ctx); err != nil {
+		logger.Errorf("failed to cancel component execution: %v", err)
+	}
+
+	return nil
+}
+
+// retryExecution creates the next attempt for a failed execution,
+// scheduled according to the node retry policy.
+// The new attempt is picked up by the NodeExecutor, like any other pending execution.
+func (w *NodeRequestWorker) retryExecution(tx *gorm.DB, request *models.CanvasNodeRequest) error {
+	if request.ExecutionID == nil {
+		return fmt.Errorf("execution is not specified")
+	}
+
+	execution, err := models.FindNodeExecutionInTransaction(tx, request.WorkflowID, *request.ExecutionID)
+	if err != nil {
+		return fmt.Errorf("execution %s not found: %w", request.ExecutionID, err)
+	}
+
+	//
+	// If the node was deleted or paused while the retry was pending,
+	// or the execution is part of a blueprint node execution
+	// that is no longer running, there is nothing to retry.
+	// The failure is then finished as if the node had no retry policy,
+	// so the node does not stay processing forever.
+	//
+	node, err := models.FindCanvasNode(tx, request.WorkflowID, execution.NodeID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("node %s not found: %w", execution.NodeID, err)
+	}
+
+	if node == nil || node.State == models.CanvasNodeStatePaused {
+		w.log("Node %s is deleted or paused - not retrying execution %s", execution.NodeID, execution.ID)
+		return w.finishRetryExecution(tx, request, execution, node)
+	}
+
+	if execution.ParentExecutionID != nil {
+		parent, err := models.FindNodeExecutionInTransaction(tx, request.WorkflowID, *execution.ParentExecutionID)
+		if err != nil {
+			return fmt.Errorf("parent execution %s not found: %w", execution.ParentExecutionID, err)
+		}
+
+		if parent.State == models.CanvasNodeExecutionStateFinished {
+			w.log("Parent execution %s is already finished - not retrying execution %s", parent.ID, execution.ID)
+			return w.finishRetryExecution(tx, request, execution, node)
+		}
+	}
+
+	retry, err := models.CreateRetryExecution(tx, execution)
+	if err != nil {
+		return fmt.Errorf("failed to create retry for execution %s: %w", execution.ID, err)
+	}
+
+	logging.ForExecution(retry, nil).Infof("Retrying execution %s - attempt %d", execution.ID, retry.Attempt)
+
+	return request.Complete(tx)
+}
+
+func (w *NodeRequestWorker) finishRetryExecution(tx *gorm.DB, request *models.CanvasNodeRequest, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+	err := execution.FinishFailureInTransaction(tx, node, execution.ResultReason, execution.ResultMessage)
+	if err != nil {
+		return fmt.Errorf("failed to finish execution %s: %w", execution.ID, err)
+	}
+
+	return request.Complete(tx)
+}
+
 func (w *NodeRequestWorker) log(format string, v ...any) {
 	log.Printf("[NodeRequestWorker] "+format, v...)
 }
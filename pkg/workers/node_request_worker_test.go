@@ -2,6 +2,7 @@ package workers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,7 +19,7 @@ import (
 func Test__NodeRequestWorker_InvokeTriggerAction(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -87,7 +88,7 @@ func Test__NodeRequestWorker_InvokeTriggerAction(t *testing.T) {
 func Test__NodeRequestWorker_InvokeNodeComponentActionWithoutExecution(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -131,6 +132,519 @@ func Test__NodeRequestWorker_InvokeNodeComponentActionWithoutExecution(t *testin
 	assert.False(t, executionConsumer.HasReceivedMessage())
 }
 
+func Test__NodeRequestWorker_CancelExecution(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
+
+	//
+	// Create a simple canvas with a trigger and a component node,
+	// and an execution that was cancelled by the engine.
+	//
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: "component-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "component-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+	require.NoError(t, execution.Cancel(nil))
+
+	request := models.CanvasNodeRequest{
+		ID:          uuid.New(),
+		WorkflowID:  canvas.ID,
+		NodeID:      "component-1",
+		ExecutionID: &execution.ID,
+		Type:        models.NodeRequestTypeCancelExecution,
+		Spec:        datatypes.NewJSONType(models.NodeExecutionRequestSpec{}),
+		State:       models.NodeExecutionRequestStatePending,
+	}
+	require.NoError(t, database.Conn().Create(&request).Error)
+
+	//
+	// Process the request and verify it completes successfully.
+	//
+	require.NoError(t, worker.LockAndProcessRequest(request))
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+}
+
+func Test__NodeRequestWorker_RetryExecution(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
+
+	//
+	// Create a simple canvas with a trigger and a component node
+	// with a retry policy allowing two attempts.
+	//
+	retryPolicy := datatypes.NewJSONType(models.RetryPolicy{MaxAttempts: 2, InitialDelaySeconds: 30})
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:      "component-1",
+				Type:        models.NodeTypeComponent,
+				Ref:         datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				RetryPolicy: &retryPolicy,
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "component-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+	require.Equal(t, 1, execution.Attempt)
+
+	//
+	// Failing the execution schedules a retry request,
+	// according to the backoff in the retry policy.
+	//
+	require.NoError(t, execution.Fail(models.CanvasNodeExecutionResultReasonError, "oops"))
+
+	var requests []models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("execution_id = ?", execution.ID).Find(&requests).Error)
+	require.Len(t, requests, 1)
+	request := requests[0]
+	assert.Equal(t, models.NodeRequestTypeRetryExecution, request.Type)
+	assert.True(t, request.RunAt.After(time.Now().Add(20*time.Second)))
+
+	//
+	// Processing the request creates a new pending execution
+	// for the second attempt, linked to the failed one.
+	//
+	require.NoError(t, worker.LockAndProcessRequest(request))
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+
+	var retry models.CanvasNodeExecution
+	require.NoError(t, database.Conn().Where("retry_of_execution_id = ?", execution.ID).First(&retry).Error)
+	assert.Equal(t, models.CanvasNodeExecutionStatePending, retry.State)
+	assert.Equal(t, 2, retry.Attempt)
+	assert.Equal(t, execution.EventID, retry.EventID)
+	assert.Equal(t, execution.RootEventID, retry.RootEventID)
+
+	//
+	// Failing the last attempt does not schedule another retry.
+	//
+	require.NoError(t, retry.Fail(models.CanvasNodeExecutionResultReasonError, "oops again"))
+
+	var count int64
+	require.NoError(t, database.Conn().Model(&models.CanvasNodeRequest{}).Where("execution_id = ?", retry.ID).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "component-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeStateReady, node.State)
+}
+
+func Test__NodeRequestWorker_RetryExecution_ParentFinishedDuringBackoff(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
+
+	retryPolicy := datatypes.NewJSONType(models.RetryPolicy{MaxAttempts: 2, InitialDelaySeconds: 30})
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: "parent-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID:      "component-1",
+				Type:        models.NodeTypeComponent,
+				Ref:         datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				RetryPolicy: &retryPolicy,
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "parent-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	parent := support.CreateCanvasNodeExecution(t, canvas.ID, "parent-1", rootEvent.ID, rootEvent.ID, nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, &parent.ID)
+
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "component-1")
+	require.NoError(t, err)
+	require.NoError(t, node.UpdateState(database.Conn(), models.CanvasNodeStateProcessing))
+
+	//
+	// Failing the execution schedules a retry, and leaves the node processing.
+	//
+	require.NoError(t, execution.Fail(models.CanvasNodeExecutionResultReasonError, "oops"))
+
+	var request models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("execution_id = ?", execution.ID).First(&request).Error)
+	assert.Equal(t, models.NodeRequestTypeRetryExecution, request.Type)
+
+	node, err = models.FindCanvasNode(database.Conn(), canvas.ID, "component-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeStateProcessing, node.State)
+
+	//
+	// The parent execution finishes while the retry is pending,
+	// so the retry is not created, and the node goes back to ready.
+	//
+	_, err = parent.Pass(map[string][]any{})
+	require.NoError(t, err)
+	require.NoError(t, worker.LockAndProcessRequest(request))
+
+	var count int64
+	require.NoError(t, database.Conn().Model(&models.CanvasNodeExecution{}).Where("retry_of_execution_id = ?", execution.ID).Count(&count).Error)
+	assert.Zero(t, count)
+
+	node, err = models.FindCanvasNode(database.Conn(), canvas.ID, "component-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeStateReady, node.State)
+
+	//
+	// The parent execution keeps its result.
+	//
+	parent, err = models.FindNodeExecution(canvas.ID, parent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionResultPassed, parent.Result)
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+}
+
+func Test__NodeRequestWorker_BranchesStopped(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
+
+	forEachConfiguration := map[string]any{"items": "$.items", "maxConcurrency": 1}
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:        "for-each-1",
+				Type:          models.NodeTypeComponent,
+				Ref:           datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "forEach"}}),
+				Configuration: datatypes.NewJSONType(forEachConfiguration),
+			},
+			{
+				NodeID: "component-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "for-each-1", Channel: "default"},
+			{SourceID: "for-each-1", TargetID: "component-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	require.NoError(t, database.Conn().Model(rootEvent).Update("state", models.CanvasEventStateRouted).Error)
+
+	//
+	// The For Each execution emitted the first of its two items,
+	// and waits for it before emitting the second one.
+	//
+	forEach := support.CreateCanvasNodeExecution(t, canvas.ID, "for-each-1", rootEvent.ID, rootEvent.ID, nil)
+	require.NoError(t, database.Conn().Model(forEach).Updates(map[string]any{
+		"state": models.CanvasNodeExecutionStateStarted,
+		"metadata": datatypes.NewJSONType(map[string]any{
+			"batch":          forEach.ID.String(),
+			"items":          []any{"a", "b"},
+			"maxConcurrency": 1,
+			"next":           1,
+			"released":       []int{},
+			"failed":         []int{},
+		}),
+	}).Error)
+
+	require.NoError(t, models.CreateNodeExecutionKVInTransaction(
+		database.Conn(),
+		canvas.ID,
+		"for-each-1",
+		forEach.ID,
+		models.ExecutionKVWaitingForBranches,
+		models.WaitingToEmit,
+	))
+
+	//
+	// The branch of the first item fails without reaching a Gather node,
+	// so the For Each execution is told that the branches of the run stopped.
+	//
+	item := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+	require.NoError(t, item.Fail(models.CanvasNodeExecutionResultReasonError, "oops"))
+
+	var request models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("execution_id = ?", forEach.ID).First(&request).Error)
+	assert.Equal(t, models.NodeRequestTypeBranchesStopped, request.Type)
+	require.NoError(t, worker.LockAndProcessRequest(request))
+
+	//
+	// The first item is counted as failed, and the second one is emitted,
+	// which finishes the For Each execution.
+	//
+	forEach, err := models.FindNodeExecution(canvas.ID, forEach.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, forEach.State)
+	assert.Equal(t, models.CanvasNodeExecutionResultPassed, forEach.Result)
+	assert.Equal(t, []any{float64(0)}, forEach.Metadata.Data()["failed"])
+
+	var events []models.CanvasEvent
+	require.NoError(t, database.Conn().Where("execution_id = ?", forEach.ID).Find(&events).Error)
+	require.Len(t, events, 1)
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+}
+
+func Test__NodeRequestWorker_TimeoutExecution(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
+
+	//
+	// Create a simple canvas with a trigger and an approval component node
+	// with an execution timeout. The approval component does not finish
+	// the execution on Execute(), so the execution stays started.
+	//
+	timeout := 60
+	approvalConfiguration := map[string]any{
+		"items": []any{
+			map[string]any{
+				"type": "user",
+				"user": r.User.String(),
+			},
+		},
+	}
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:                  "approval-1",
+				Type:                    models.NodeTypeComponent,
+				Ref:                     datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "approval"}}),
+				Configuration:           datatypes.NewJSONType(approvalConfiguration),
+				ExecutionTimeoutSeconds: &timeout,
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "approval-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	execution := support.CreateNodeExecutionWithConfiguration(t, canvas.ID, "approval-1", rootEvent.ID, rootEvent.ID, nil, approvalConfiguration)
+
+	//
+	// Starting the execution sets its deadline,
+	// and schedules the timeout request for it.
+	//
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, r.ArtifactStorage, "http://localhost", "http://localhost")
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	started, err := models.FindNodeExecution(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateStarted, started.State)
+	require.NotNil(t, started.DeadlineAt)
+	assert.True(t, started.DeadlineAt.After(time.Now().Add(50*time.Second)))
+
+	var requests []models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("execution_id = ? AND type = ?", execution.ID, models.NodeRequestTypeTimeoutExecution).Find(&requests).Error)
+	require.Len(t, requests, 1)
+	request := requests[0]
+	assert.WithinDuration(t, *started.DeadlineAt, request.RunAt, time.Second)
+
+	//
+	// Processing the request fails the execution with the timeout reason.
+	//
+	require.NoError(t, worker.LockAndProcessRequest(request))
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+
+	timedOut, err := models.FindNodeExecution(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, timedOut.State)
+	assert.Equal(t, models.CanvasNodeExecutionResultFailed, timedOut.Result)
+	assert.Equal(t, models.CanvasNodeExecutionResultReasonTimeout, timedOut.ResultReason)
+
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "approval-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeStateReady, node.State)
+}
+
+func Test__NodeRequestWorker_TimeoutExecutionIgnoresFinishedExecutions(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: "component-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "component-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+	require.NoError(t, execution.Start())
+	require.NoError(t, execution.ScheduleTimeout(database.Conn(), time.Minute))
+
+	_, err := execution.Pass(map[string][]any{"default": {map[string]any{}}})
+	require.NoError(t, err)
+
+	var request models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("execution_id = ?", execution.ID).First(&request).Error)
+	require.NoError(t, worker.LockAndProcessRequest(request))
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+
+	passed, err := models.FindNodeExecution(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionResultPassed, passed.Result)
+}
+
+func Test__NodeRequestWorker_CompleteCanvasInvocation(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
+
+	caller, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: "trigger-1", Type: models.NodeTypeTrigger},
+			{NodeID: "run-canvas-1", Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "run-canvas-1", Channel: "default"},
+		},
+	)
+
+	callerEvent := support.EmitCanvasEventForNode(t, caller.ID, "trigger-1", "default", nil)
+	invoker := support.CreateCanvasNodeExecution(t, caller.ID, "run-canvas-1", callerEvent.ID, callerEvent.ID, nil)
+	require.NoError(t, invoker.Start())
+
+	invoked, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: "trigger-1", Type: models.NodeTypeTrigger},
+			{NodeID: "component-1", Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "component-1", Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, invoked.ID, "trigger-1", "default", nil)
+	require.NoError(t, database.Conn().Model(rootEvent).Updates(map[string]any{
+		"invoked_by_execution_id": invoker.ID,
+		"state":                   models.CanvasEventStateRouted,
+	}).Error)
+
+	//
+	// Cancelling the only execution in the invoked canvas
+	// schedules a request to check the invocation on the invoking execution.
+	//
+	execution := support.CreateCanvasNodeExecution(t, invoked.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+	require.NoError(t, execution.Cancel(nil))
+
+	var requests []models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("execution_id = ?", invoker.ID).Find(&requests).Error)
+	require.Len(t, requests, 1)
+	assert.Equal(t, models.NodeRequestTypeCompleteCanvasInvocation, requests[0].Type)
+	assert.Equal(t, rootEvent.ID, requests[0].Spec.Data().CompleteCanvasInvocation.RootEventID)
+
+	require.NoError(t, worker.LockAndProcessRequest(requests[0]))
+
+	invoker, err := models.FindNodeExecution(caller.ID, invoker.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, invoker.State)
+
+	outputs, err := invoker.GetOutputs()
+	require.NoError(t, err)
+	require.Len(t, outputs, 1)
+	assert.Equal(t, models.CanvasInvocationChannelFailed, outputs[0].Channel)
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", requests[0].ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+}
+
 func Test__NodeRequestWorker_PreventsConcurrentProcessing(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
@@ -192,12 +706,12 @@ func Test__NodeRequestWorker_PreventsConcurrentProcessing(t *testing.T) {
 	// Create two workers and have them try to process the request concurrently.
 	//
 	go func() {
-		worker1 := NewNodeRequestWorker(r.Encryptor, r.Registry)
+		worker1 := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
 		results <- worker1.LockAndProcessRequest(request)
 	}()
 
 	go func() {
-		worker2 := NewNodeRequestWorker(r.Encryptor, r.Registry)
+		worker2 := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
 		results <- worker2.LockAndProcessRequest(request)
 	}()
 
@@ -229,7 +743,7 @@ func Test__NodeRequestWorker_PreventsConcurrentProcessing(t *testing.T) {
 func Test__NodeRequestWorker_UnsupportedRequestType(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -286,7 +800,7 @@ func Test__NodeRequestWorker_UnsupportedRequestType(t *testing.T) {
 func Test__NodeRequestWorker_MissingInvokeActionSpec(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -343,7 +857,7 @@ func Test__NodeRequestWorker_MissingInvokeActionSpec(t *testing.T) {
 func Test__NodeRequestWorker_NonExistentTrigger(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
@@ -399,7 +913,7 @@ func Test__NodeRequestWorker_NonExistentTrigger(t *testing.T) {
 func Test__NodeRequestWorker_NonExistentAction(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
-	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, r.ArtifactStorage)
 
 	amqpURL, _ := config.RabbitMQURL()
 	executionConsumer := testconsumer.New(amqpURL, messages.WorkflowExecutionRoutingKey)
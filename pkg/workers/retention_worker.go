@@ -0,0 +1,332 @@
+package workers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/semaphore"
+	"gorm.io/gorm"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+//
+// RetentionWorker enforces the retention policies of canvases.
+//
+// Runs past the policy are deleted as a whole: the root event,
+// all the executions created for it, and everything that belongs
+// to those executions. Deleting the executions cascades to their
+// output events, KVs, requests and artifact metadata. Logs have no
+// foreign key, so they are deleted explicitly, and artifact objects
+// are deleted from the storage once the transaction commits.
+//
+// If the policy asks for it, the runs are archived
+// to the storage before being deleted, as gzipped JSON lines.
+//
+
+type RetentionWorker struct {
+	semaphore      *semaphore.Weighted
+	logger         *log.Entry
+	storage        artifacts.Storage
+	maxRunsPerTick int
+}
+
+func NewRetentionWorker(storage artifacts.Storage) *RetentionWorker {
+	return &RetentionWorker{
+		semaphore:      semaphore.NewWeighted(25),
+		logger:         log.WithFields(log.Fields{"worker": "RetentionWorker"}),
+		storage:        storage,
+		maxRunsPerTick: 100,
+	}
+}
+
+func (w *RetentionWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			tickStart := time.Now()
+			policies, err := models.ListEffectiveRetentionPolicies()
+			if err != nil {
+				w.logger.Errorf("Error finding retention policies: %v", err)
+				continue
+			}
+
+			for _, policy := range policies {
+				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
+					w.logger.Errorf("Error acquiring semaphore: %v", err)
+					continue
+				}
+
+				go func(policy models.EffectiveRetentionPolicy) {
+					defer w.semaphore.Release(1)
+
+					if err := w.LockAndProcessCanvas(policy); err != nil {
+						w.logger.Errorf("Error enforcing retention policy for canvas %s: %v", policy.WorkflowID, err)
+					}
+				}(policy)
+			}
+
+			telemetry.RecordRetentionWorkerTickDuration(context.Background(), time.Since(tickStart))
+		}
+	}
+}
+
+func (w *RetentionWorker) LockAndProcessCanvas(policy models.EffectiveRetentionPolicy) error {
+	var storageKeys []string
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		stats, err := models.LockCanvasRetentionStatsInTransaction(tx, policy.WorkflowID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				w.logger.Infof("Canvas %s already being processed - skipping", policy.WorkflowID)
+				return nil
+			}
+
+			return err
+		}
+
+		storageKeys, err = w.processCanvas(tx, policy, stats)
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	//
+	// Artifact objects are only deleted after the rows referencing them are gone.
+	// If deleting one fails, the object is left behind, but nothing references it anymore.
+	//
+	for _, key := range storageKeys {
+		if err := w.storage.Delete(context.Background(), key); err != nil && !errors.Is(err, artifacts.ErrArtifactNotFound) {
+			w.logger.Errorf("Error deleting artifact %s for canvas %s: %v", key, policy.WorkflowID, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *RetentionWorker) processCanvas(tx *gorm.DB, policy models.EffectiveRetentionPolicy, stats *models.CanvasRetentionStats) ([]string, error) {
+	runs, err := models.ListExpiredCanvasRunsInTransaction(tx, policy, time.Now(), w.maxRunsPerTick)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find expired runs: %w", err)
+	}
+
+	if len(runs) == 0 {
+		return nil, stats.RecordInTransaction(tx, 0, 0, 0)
+	}
+
+	runIDs := make([]uuid.UUID, len(runs))
+	for i, run := range runs {
+		runIDs[i] = run.ID
+	}
+
+	var executions []models.CanvasNodeExecution
+	err = tx.Where("root_event_id IN ?", runIDs).Order("created_at ASC").Find(&executions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find executions: %w", err)
+	}
+
+	executionIDs := make([]uuid.UUID, len(executions))
+	for i, execution := range executions {
+		executionIDs[i] = execution.ID
+	}
+
+	archived := 0
+	if policy.Archive {
+		if err := w.archive(tx, policy, runs, executions); err != nil {
+			return nil, fmt.Errorf("failed to archive runs: %w", err)
+		}
+
+		archived = len(executions)
+	}
+
+	storageKeys, err := w.deleteExecutions(tx, executionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Where("id IN ?", runIDs).Delete(&models.CanvasEvent{}).Error; err != nil {
+		return nil, fmt.Errorf("failed to delete root events: %w", err)
+	}
+
+	if err := stats.RecordInTransaction(tx, len(runs), len(executions), archived); err != nil {
+		return nil, fmt.Errorf("failed to record retention stats: %w", err)
+	}
+
+	w.logger.Infof("Deleted %d runs and %d executions from canvas %s (%d archived)", len(runs), len(executions), policy.WorkflowID, archived)
+	return storageKeys, nil
+}
+
+// deleteExecutions deletes the executions, and everything that belongs to them,
+// returning the storage keys of their artifacts, to be deleted after the transaction commits.
+func (w *RetentionWorker) deleteExecutions(tx *gorm.DB, executionIDs []uuid.UUID) ([]string, error) {
+	if len(executionIDs) == 0 {
+		return nil, nil
+	}
+
+	var storageKeys []string
+	err := tx.
+		Model(&models.CanvasNodeExecutionArtifact{}).
+		Where("execution_id IN ?", executionIDs).
+		Where("storage = ?", w.storage.Name()).
+		Pluck("storage_key", &storageKeys).
+		Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find artifacts: %w", err)
+	}
+
+	if err := tx.Where("execution_id IN ?", executionIDs).Delete(&models.CanvasNodeExecutionLog{}).Error; err != nil {
+		return nil, fmt.Errorf("failed to delete execution logs: %w", err)
+	}
+
+	if err := tx.Where("id IN ?", executionIDs).Delete(&models.CanvasNodeExecution{}).Error; err != nil {
+		return nil, fmt.Errorf("failed to delete executions: %w", err)
+	}
+
+	return storageKeys, nil
+}
+
+type archivedRun struct {
+	OrganizationID uuid.UUID           `json:"organization_id"`
+	CanvasID       uuid.UUID           `json:"canvas_id"`
+	RootEvent      archivedEvent       `json:"root_event"`
+	Executions     []archivedExecution `json:"executions"`
+}
+
+type archivedEvent struct {
+	ID        uuid.UUID  `json:"id"`
+	NodeID    string     `json:"node_id"`
+	Channel   string     `json:"channel"`
+	Data      any        `json:"data"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+type archivedExecution struct {
+	ID                uuid.UUID       `json:"id"`
+	NodeID            string          `json:"node_id"`
+	ParentExecutionID *uuid.UUID      `json:"parent_execution_id,omitempty"`
+	Attempt           int             `json:"attempt"`
+	Result            string          `json:"result"`
+	ResultReason      string          `json:"result_reason"`
+	ResultMessage     string          `json:"result_message"`
+	Configuration     map[string]any  `json:"configuration"`
+	Metadata          map[string]any  `json:"metadata"`
+	Input             any             `json:"input"`
+	Outputs           []archivedEvent `json:"outputs"`
+	CreatedAt         *time.Time      `json:"created_at"`
+	FinishedAt        *time.Time      `json:"finished_at"`
+}
+
+// archive writes the runs to the storage, one JSON object per line,
+// under archives/<organization>/<canvas>/<time>-<id>.jsonl.gz.
+// This happens before the transaction commits, so if it fails,
+// the same runs are archived again on the next tick.
+func (w *RetentionWorker) archive(tx *gorm.DB, policy models.EffectiveRetentionPolicy, runs []models.CanvasEvent, executions []models.CanvasNodeExecution) error {
+	eventIDs := []uuid.UUID{}
+	executionIDs := []uuid.UUID{}
+	for _, execution := range executions {
+		eventIDs = append(eventIDs, execution.EventID)
+		executionIDs = append(executionIDs, execution.ID)
+	}
+
+	var events []models.CanvasEvent
+	err := tx.
+		Where("id IN ? OR execution_id IN ?", eventIDs, executionIDs).
+		Order("created_at ASC").
+		Find(&events).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	eventsByID := map[uuid.UUID]models.CanvasEvent{}
+	outputs := map[uuid.UUID][]archivedEvent{}
+	for _, event := range events {
+		eventsByID[event.ID] = event
+		if event.ExecutionID != nil {
+			outputs[*event.ExecutionID] = append(outputs[*event.ExecutionID], newArchivedEvent(event))
+		}
+	}
+
+	executionsByRun := map[uuid.UUID][]archivedExecution{}
+	for _, execution := range executions {
+		var input any
+		if event, ok := eventsByID[execution.EventID]; ok {
+			input = event.Data.Data()
+		}
+
+		executionsByRun[execution.RootEventID] = append(executionsByRun[execution.RootEventID], archivedExecution{
+			ID:                execution.ID,
+			NodeID:            execution.NodeID,
+			ParentExecutionID: execution.ParentExecutionID,
+			Attempt:           execution.Attempt,
+			Result:            execution.Result,
+			ResultReason:      execution.ResultReason,
+			ResultMessage:     execution.ResultMessage,
+			Configuration:     execution.Configuration.Data(),
+			Metadata:          execution.Metadata.Data(),
+			Input:             input,
+			Outputs:           outputs[execution.ID],
+			CreatedAt:         execution.CreatedAt,
+			FinishedAt:        execution.FinishedAt,
+		})
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(writer)
+	for _, run := range runs {
+		err := encoder.Encode(archivedRun{
+			OrganizationID: policy.OrganizationID,
+			CanvasID:       policy.WorkflowID,
+			RootEvent:      newArchivedEvent(run),
+			Executions:     executionsByRun[run.ID],
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf(
+		"archives/%s/%s/%s-%s.jsonl.gz",
+		policy.OrganizationID,
+		policy.WorkflowID,
+		time.Now().UTC().Format("20060102T150405Z"),
+		uuid.NewString(),
+	)
+
+	return w.storage.Put(context.Background(), key, &buf, int64(buf.Len()), "application/gzip")
+}
+
+func newArchivedEvent(event models.CanvasEvent) archivedEvent {
+	return archivedEvent{
+		ID:        event.ID,
+		NodeID:    event.NodeID,
+		Channel:   event.Channel,
+		Data:      event.Data.Data(),
+		CreatedAt: event.CreatedAt,
+	}
+}
@@ -0,0 +1,210 @@
+package workers
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/artifacts"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__RetentionWorker_DeletesRunsOlderThanMaxAge(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas := createRetentionTestCanvas(t, r)
+	worker := NewRetentionWorker(r.ArtifactStorage)
+
+	oldRun, oldExecution := createRetentionTestRun(t, canvas.ID, time.Now().AddDate(0, 0, -10), true)
+	recentRun, _ := createRetentionTestRun(t, canvas.ID, time.Now(), true)
+	runningRun, _ := createRetentionTestRun(t, canvas.ID, time.Now().AddDate(0, 0, -10), false)
+
+	require.NoError(t, models.CreateNodeExecutionLogs([]models.CanvasNodeExecutionLog{
+		{WorkflowID: canvas.ID, NodeID: "node-1", ExecutionID: oldExecution.ID, Stream: models.CanvasNodeExecutionLogStreamLog, Message: "hello"},
+	}))
+
+	require.NoError(t, models.CreateNodeExecutionKVInTransaction(database.Conn(), canvas.ID, "node-1", oldExecution.ID, "key", "value"))
+
+	_, err := models.SaveOrganizationRetentionPolicy(r.Organization.ID, 7, 0, false)
+	require.NoError(t, err)
+
+	require.NoError(t, worker.LockAndProcessCanvas(findEffectiveRetentionPolicy(t, canvas.ID)))
+
+	//
+	// Only the old, finished run is deleted, with everything that belongs to it.
+	//
+	assertEventExists(t, oldRun.ID, false)
+	assertEventExists(t, recentRun.ID, true)
+	assertEventExists(t, runningRun.ID, true)
+	support.VerifyNodeExecutionsCount(t, canvas.ID, 2)
+	support.VerifyNodeExecutionKVCount(t, canvas.ID, 0)
+
+	var logs int64
+	require.NoError(t, database.Conn().Model(&models.CanvasNodeExecutionLog{}).Where("execution_id = ?", oldExecution.ID).Count(&logs).Error)
+	assert.Zero(t, logs)
+
+	stats, err := models.FindCanvasRetentionStats(canvas.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.DeletedRuns)
+	assert.Equal(t, int64(1), stats.DeletedExecutions)
+	assert.Zero(t, stats.ArchivedExecutions)
+	assert.NotNil(t, stats.LastEnforcedAt)
+}
+
+func Test__RetentionWorker_KeepsNewestRunsAndArchivesTheRest(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas := createRetentionTestCanvas(t, r)
+	storage := &retentionTestStorage{objects: map[string][]byte{}}
+	worker := NewRetentionWorker(storage)
+
+	first, _ := createRetentionTestRun(t, canvas.ID, time.Now().Add(-3*time.Hour), true)
+	second, _ := createRetentionTestRun(t, canvas.ID, time.Now().Add(-2*time.Hour), true)
+	third, _ := createRetentionTestRun(t, canvas.ID, time.Now().Add(-time.Hour), true)
+
+	//
+	// The canvas policy takes precedence over the organization policy.
+	//
+	_, err := models.SaveOrganizationRetentionPolicy(r.Organization.ID, 0, 1, false)
+	require.NoError(t, err)
+	_, err = models.SaveCanvasRetentionPolicy(r.Organization.ID, canvas.ID, 0, 2, true)
+	require.NoError(t, err)
+
+	require.NoError(t, worker.LockAndProcessCanvas(findEffectiveRetentionPolicy(t, canvas.ID)))
+
+	assertEventExists(t, first.ID, false)
+	assertEventExists(t, second.ID, true)
+	assertEventExists(t, third.ID, true)
+
+	require.Len(t, storage.objects, 1)
+	for _, content := range storage.objects {
+		runs := readArchivedRuns(t, content)
+		require.Len(t, runs, 1)
+		assert.Equal(t, first.ID, runs[0].RootEvent.ID)
+		assert.Equal(t, canvas.ID, runs[0].CanvasID)
+		require.Len(t, runs[0].Executions, 1)
+		assert.Equal(t, map[string]any{"key": "value"}, runs[0].Executions[0].Input)
+	}
+
+	stats, err := models.FindCanvasRetentionStats(canvas.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.DeletedRuns)
+	assert.Equal(t, int64(1), stats.ArchivedExecutions)
+}
+
+func createRetentionTestCanvas(t *testing.T, r *support.ResourceRegistry) *models.Canvas {
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	return canvas
+}
+
+func createRetentionTestRun(t *testing.T, canvasID uuid.UUID, createdAt time.Time, finished bool) (*models.CanvasEvent, *models.CanvasNodeExecution) {
+	event := support.EmitCanvasEventForNode(t, canvasID, "node-1", "default", nil)
+	require.NoError(t, database.Conn().
+		Model(event).
+		Updates(map[string]any{"state": models.CanvasEventStateRouted, "created_at": createdAt}).
+		Error)
+
+	execution := support.CreateCanvasNodeExecution(t, canvasID, "node-1", event.ID, event.ID, nil)
+	if finished {
+		_, err := execution.Pass(map[string][]any{})
+		require.NoError(t, err)
+	}
+
+	return event, execution
+}
+
+func findEffectiveRetentionPolicy(t *testing.T, canvasID uuid.UUID) models.EffectiveRetentionPolicy {
+	policies, err := models.ListEffectiveRetentionPolicies()
+	require.NoError(t, err)
+
+	for _, policy := range policies {
+		if policy.WorkflowID == canvasID {
+			return policy
+		}
+	}
+
+	require.FailNow(t, "no retention policy for canvas")
+	return models.EffectiveRetentionPolicy{}
+}
+
+func assertEventExists(t *testing.T, eventID uuid.UUID, exists bool) {
+	var count int64
+	require.NoError(t, database.Conn().Model(&models.CanvasEvent{}).Where("id = ?", eventID).Count(&count).Error)
+	assert.Equal(t, exists, count > 0)
+}
+
+func readArchivedRuns(t *testing.T, content []byte) []archivedRun {
+	reader, err := gzip.NewReader(bytes.NewReader(content))
+	require.NoError(t, err)
+
+	runs := []archivedRun{}
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		var run archivedRun
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &run))
+		runs = append(runs, run)
+	}
+
+	require.NoError(t, scanner.Err())
+	return runs
+}
+
+type retentionTestStorage struct {
+	objects map[string][]byte
+}
+
+func (s *retentionTestStorage) Name() string {
+	return "test"
+}
+
+func (s *retentionTestStorage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+
+	s.objects[key] = data
+	return nil
+}
+
+func (s *retentionTestStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, artifacts.ErrArtifactNotFound
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *retentionTestStorage) Delete(ctx context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
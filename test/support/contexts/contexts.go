@@ -1,7 +1,11 @@
 package contexts
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -229,6 +233,21 @@ func (c *ExecutionStateContext) Emit(channel, payloadType string, payloads []any
 	return nil
 }
 
+func (c *ExecutionStateContext) Stream(channel, payloadType string, payloads []any) error {
+	c.Channel = channel
+	c.Type = payloadType
+
+	for _, payload := range payloads {
+		c.Payloads = append(c.Payloads, map[string]any{
+			"type":      payloadType,
+			"timestamp": time.Now(),
+			"data":      payload,
+		})
+	}
+
+	return nil
+}
+
 func (c *ExecutionStateContext) Fail(reason, message string) error {
 	c.Finished = true
 	c.Passed = false
@@ -238,6 +257,10 @@ func (c *ExecutionStateContext) Fail(reason, message string) error {
 }
 
 func (c *ExecutionStateContext) SetKV(key, value string) error {
+	if c.KVs == nil {
+		c.KVs = map[string]string{}
+	}
+
 	c.KVs[key] = value
 	return nil
 }
@@ -302,6 +325,26 @@ func (c *RequestContext) ScheduleActionCall(action string, params map[string]any
 	return nil
 }
 
+type CanvasesContext struct {
+	CanvasID string
+	NodeID   string
+	Payload  any
+	EventID  string
+	Err      error
+}
+
+func (c *CanvasesContext) Invoke(canvasID, nodeID string, payload any) (string, error) {
+	if c.Err != nil {
+		return "", c.Err
+	}
+
+	c.CanvasID = canvasID
+	c.NodeID = nodeID
+	c.Payload = payload
+	c.EventID = uuid.NewString()
+	return c.EventID, nil
+}
+
 type HTTPContext struct {
 	Requests  []*http.Request
 	Responses []*http.Response
@@ -318,3 +361,99 @@ func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
 	c.Responses = c.Responses[1:]
 	return response, nil
 }
+
+type VariablesContext struct {
+	Variables map[string]*core.Variable
+
+	//
+	// Conflicts is the number of writes that fail with a version conflict,
+	// before writes start to go through.
+	//
+	Conflicts int
+}
+
+func (c *VariablesContext) Get(name string) (*core.Variable, error) {
+	variable, ok := c.Variables[name]
+	if !ok {
+		return nil, core.ErrVariableNotFound
+	}
+
+	return variable, nil
+}
+
+func (c *VariablesContext) Set(name string, value any, expectedVersion *int) (*core.Variable, error) {
+	if c.Variables == nil {
+		c.Variables = map[string]*core.Variable{}
+	}
+
+	if c.Conflicts > 0 {
+		c.Conflicts--
+		return nil, core.ErrVariableVersionConflict
+	}
+
+	version := 0
+	if current, ok := c.Variables[name]; ok {
+		version = current.Version
+	}
+
+	if expectedVersion != nil && *expectedVersion != version {
+		return nil, core.ErrVariableVersionConflict
+	}
+
+	variable := &core.Variable{Name: name, Value: value, Version: version + 1}
+	c.Variables[name] = variable
+	return variable, nil
+}
+
+type ArtifactsContext struct {
+	Artifacts []core.Artifact
+	Contents  map[string][]byte
+}
+
+func (c *ArtifactsContext) Upload(name, contentType string, content io.Reader) (*core.Artifact, error) {
+	if _, ok := c.Contents[name]; ok {
+		return nil, core.ErrArtifactAlreadyExists
+	}
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Contents == nil {
+		c.Contents = map[string][]byte{}
+	}
+
+	sum := sha256.Sum256(data)
+	artifact := core.Artifact{
+		ID:          uuid.NewString(),
+		Name:        name,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		SHA256:      hex.EncodeToString(sum[:]),
+	}
+
+	c.Contents[name] = data
+	c.Artifacts = append(c.Artifacts, artifact)
+	return &artifact, nil
+}
+
+func (c *ArtifactsContext) List() ([]core.Artifact, error) {
+	return c.Artifacts, nil
+}
+
+type LogsContext struct {
+	Streams map[string]*bytes.Buffer
+}
+
+func (c *LogsContext) Writer(stream string) io.Writer {
+	if c.Streams == nil {
+		c.Streams = map[string]*bytes.Buffer{}
+	}
+
+	if _, ok := c.Streams[stream]; !ok {
+		c.Streams[stream] = &bytes.Buffer{}
+	}
+
+	return c.Streams[stream]
+}
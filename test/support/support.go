@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/artifacts"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
@@ -23,11 +24,17 @@ import (
 	// Import components, triggers, and integrations to register them via init()
 	_ "github.com/superplanehq/superplane/pkg/components/approval"
 	_ "github.com/superplanehq/superplane/pkg/components/filter"
+	_ "github.com/superplanehq/superplane/pkg/components/foreach"
+	_ "github.com/superplanehq/superplane/pkg/components/gather"
+	_ "github.com/superplanehq/superplane/pkg/components/getvariable"
 	_ "github.com/superplanehq/superplane/pkg/components/http"
 	_ "github.com/superplanehq/superplane/pkg/components/if"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
+	_ "github.com/superplanehq/superplane/pkg/components/runcanvas"
+	_ "github.com/superplanehq/superplane/pkg/components/setvariable"
 	_ "github.com/superplanehq/superplane/pkg/components/ssh"
+	_ "github.com/superplanehq/superplane/pkg/components/switch"
 	_ "github.com/superplanehq/superplane/pkg/components/wait"
 	_ "github.com/superplanehq/superplane/pkg/integrations/circleci"
 	_ "github.com/superplanehq/superplane/pkg/integrations/github"
@@ -38,13 +45,14 @@ import (
 )
 
 type ResourceRegistry struct {
-	User         uuid.UUID
-	UserModel    *models.User
-	Organization *models.Organization
-	Account      *models.Account
-	Encryptor    crypto.Encryptor
-	AuthService  *authorization.AuthService
-	Registry     *registry.Registry
+	User            uuid.UUID
+	UserModel       *models.User
+	Organization    *models.Organization
+	Account         *models.Account
+	Encryptor       crypto.Encryptor
+	AuthService     *authorization.AuthService
+	Registry        *registry.Registry
+	ArtifactStorage artifacts.Storage
 }
 
 func (r *ResourceRegistry) Close() {}
@@ -73,10 +81,14 @@ func SetupWithOptions(t *testing.T, options SetupOptions) *ResourceRegistry {
 	//
 	// Set up initial test resource registry
 	//
+	artifactStorage, err := artifacts.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
 	r := ResourceRegistry{
-		Encryptor:   encryptor,
-		Registry:    registry,
-		AuthService: AuthService(t),
+		Encryptor:       encryptor,
+		Registry:        registry,
+		AuthService:     AuthService(t),
+		ArtifactStorage: artifactStorage,
 	}
 
 	//
@@ -304,14 +316,19 @@ func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []model
 	inputNodes := make([]models.Node, len(nodes))
 	for i, node := range nodes {
 		inputNodes[i] = models.Node{
-			ID:            node.NodeID,
-			Name:          node.Name,
-			Type:          node.Type,
-			Ref:           node.Ref.Data(),
-			Configuration: node.Configuration.Data(),
-			Metadata:      node.Metadata.Data(),
-			Position:      node.Position.Data(),
-			IsCollapsed:   node.IsCollapsed,
+			ID:                      node.NodeID,
+			Name:                    node.Name,
+			Type:                    node.Type,
+			Ref:                     node.Ref.Data(),
+			Configuration:           node.Configuration.Data(),
+			Metadata:                node.Metadata.Data(),
+			Position:                node.Position.Data(),
+			IsCollapsed:             node.IsCollapsed,
+			ConcurrencyGroup:        node.GetConcurrencyGroup(),
+			RetryPolicy:             node.GetRetryPolicy(),
+			ExecutionTimeoutSeconds: int(node.GetExecutionTimeout().Seconds()),
+			QueuePolicy:             node.GetQueuePolicy(),
+			RateLimit:               node.GetRateLimit(),
 		}
 	}
 
@@ -332,6 +349,9 @@ func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []model
 
 	require.NoError(t, database.Conn().Create(workflow).Error)
 
+	_, err := models.CreateCanvasVersionInTransaction(database.Conn(), workflow, &userID, "Initial version")
+	require.NoError(t, err)
+
 	//
 	// Expand blueprint nodes (convert WorkflowNode to Node, expand, then back to WorkflowNode)
 	//
@@ -362,6 +382,11 @@ func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []model
 			UpdatedAt:     &now,
 		}
 
+		canvasNode.SetConcurrencyGroup(node.ConcurrencyGroup)
+		canvasNode.SetRetryPolicy(node.RetryPolicy)
+		canvasNode.SetExecutionTimeout(node.ExecutionTimeoutSeconds)
+		canvasNode.SetQueuePolicy(node.QueuePolicy)
+		canvasNode.SetRateLimit(node.RateLimit)
 		require.NoError(t, database.Conn().Clauses(clause.Returning{}).Create(&canvasNode).Error)
 		createdNodes = append(createdNodes, canvasNode)
 	}
@@ -1,32 +1,56 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
 export {
+  auditListAuditLogEntries,
   blueprintsCreateBlueprint,
   blueprintsDeleteBlueprint,
   blueprintsDescribeBlueprint,
   blueprintsListBlueprints,
   blueprintsUpdateBlueprint,
+  canvasesApproveCanvasChangeRequest,
   canvasesCancelExecution,
   canvasesCreateCanvas,
   canvasesDeleteCanvas,
+  canvasesDeleteCanvasVariable,
   canvasesDeleteNodeQueueItem,
   canvasesDescribeCanvas,
+  canvasesDescribeCanvasDraft,
+  canvasesDescribeCanvasRetention,
+  canvasesDescribeCanvasVersion,
+  canvasesDiffCanvasVersions,
+  canvasesDiscardCanvasDraft,
+  canvasesDownloadExecutionArtifact,
   canvasesEmitNodeEvent,
+  canvasesGetExecutionLogs,
   canvasesInvokeNodeExecutionAction,
   canvasesInvokeNodeTriggerAction,
+  canvasesListCanvasChangeRequests,
   canvasesListCanvases,
   canvasesListCanvasEvents,
+  canvasesListCanvasVariables,
+  canvasesListCanvasVersions,
   canvasesListChildExecutions,
   canvasesListEventExecutions,
+  canvasesListExecutionArtifacts,
   canvasesListNodeEvents,
   canvasesListNodeExecutions,
   canvasesListNodeQueueItems,
+  canvasesPublishCanvasDraft,
+  canvasesRejectCanvasChangeRequest,
+  canvasesRerunExecution,
   canvasesResolveExecutionErrors,
+  canvasesRestoreCanvasVersion,
+  canvasesSetCanvasVariable,
+  canvasesSimulateCanvas,
   canvasesUpdateCanvas,
+  canvasesUpdateCanvasDraft,
+  canvasesUpdateCanvasRetentionPolicy,
   canvasesUpdateNodePause,
+  canvasesUpdateNodeQueueItem,
   componentsDescribeComponent,
   componentsListComponentActions,
   componentsListComponents,
+  expressionsListExpressionFunctions,
   groupsAddUserToGroup,
   groupsCreateGroup,
   groupsDeleteGroup,
@@ -47,6 +71,7 @@ export {
   organizationsDescribeIntegration,
   organizationsDescribeOrganization,
   organizationsGetInviteLink,
+  organizationsGetRetentionPolicy,
   organizationsListIntegrationResources,
   organizationsListIntegrations,
   organizationsListInvitations,
@@ -56,6 +81,7 @@ export {
   organizationsUpdateIntegration,
   organizationsUpdateInviteLink,
   organizationsUpdateOrganization,
+  organizationsUpdateRetentionPolicy,
   rolesAssignRole,
   rolesCreateRole,
   rolesDeleteRole,
@@ -66,6 +92,7 @@ export {
   secretsDeleteSecret,
   secretsDeleteSecretKey,
   secretsDescribeSecret,
+  secretsListSecretAccessEvents,
   secretsListSecrets,
   secretsSetSecretKey,
   secretsUpdateSecret,
@@ -85,6 +112,15 @@ export {
   widgetsListWidgets,
 } from "./sdk.gen";
 export type {
+  ApiHttpBody,
+  AuditAuditActorType,
+  AuditAuditLogEntry,
+  AuditListAuditLogEntriesData,
+  AuditListAuditLogEntriesError,
+  AuditListAuditLogEntriesErrors,
+  AuditListAuditLogEntriesResponse,
+  AuditListAuditLogEntriesResponse2,
+  AuditListAuditLogEntriesResponses,
   AuthorizationDomainType,
   AuthorizationPermission,
   BlueprintsBlueprint,
@@ -120,6 +156,14 @@ export type {
   BlueprintsUpdateBlueprintResponse,
   BlueprintsUpdateBlueprintResponse2,
   BlueprintsUpdateBlueprintResponses,
+  CanvasDraftValidationError,
+  CanvasesApproveCanvasChangeRequestBody,
+  CanvasesApproveCanvasChangeRequestData,
+  CanvasesApproveCanvasChangeRequestError,
+  CanvasesApproveCanvasChangeRequestErrors,
+  CanvasesApproveCanvasChangeRequestResponse,
+  CanvasesApproveCanvasChangeRequestResponse2,
+  CanvasesApproveCanvasChangeRequestResponses,
   CanvasesCancelExecutionBody,
   CanvasesCancelExecutionData,
   CanvasesCancelExecutionError,
@@ -128,13 +172,22 @@ export type {
   CanvasesCancelExecutionResponse2,
   CanvasesCancelExecutionResponses,
   CanvasesCanvas,
+  CanvasesCanvasChangeRequest,
+  CanvasesCanvasChangeRequestState,
+  CanvasesCanvasDraft,
   CanvasesCanvasEvent,
   CanvasesCanvasEventWithExecutions,
   CanvasesCanvasMetadata,
   CanvasesCanvasNodeExecution,
   CanvasesCanvasNodeQueueItem,
+  CanvasesCanvasRetentionPolicy,
+  CanvasesCanvasRetentionStats,
+  CanvasesCanvasSimulationStep,
   CanvasesCanvasSpec,
   CanvasesCanvasStatus,
+  CanvasesCanvasVariable,
+  CanvasesCanvasVersion,
+  CanvasesCanvasVersionDiff,
   CanvasesCreateCanvasData,
   CanvasesCreateCanvasError,
   CanvasesCreateCanvasErrors,
@@ -148,6 +201,12 @@ export type {
   CanvasesDeleteCanvasResponse,
   CanvasesDeleteCanvasResponse2,
   CanvasesDeleteCanvasResponses,
+  CanvasesDeleteCanvasVariableData,
+  CanvasesDeleteCanvasVariableError,
+  CanvasesDeleteCanvasVariableErrors,
+  CanvasesDeleteCanvasVariableResponse,
+  CanvasesDeleteCanvasVariableResponse2,
+  CanvasesDeleteCanvasVariableResponses,
   CanvasesDeleteNodeQueueItemData,
   CanvasesDeleteNodeQueueItemError,
   CanvasesDeleteNodeQueueItemErrors,
@@ -155,11 +214,46 @@ export type {
   CanvasesDeleteNodeQueueItemResponse2,
   CanvasesDeleteNodeQueueItemResponses,
   CanvasesDescribeCanvasData,
+  CanvasesDescribeCanvasDraftData,
+  CanvasesDescribeCanvasDraftError,
+  CanvasesDescribeCanvasDraftErrors,
+  CanvasesDescribeCanvasDraftResponse,
+  CanvasesDescribeCanvasDraftResponse2,
+  CanvasesDescribeCanvasDraftResponses,
   CanvasesDescribeCanvasError,
   CanvasesDescribeCanvasErrors,
   CanvasesDescribeCanvasResponse,
   CanvasesDescribeCanvasResponse2,
   CanvasesDescribeCanvasResponses,
+  CanvasesDescribeCanvasRetentionData,
+  CanvasesDescribeCanvasRetentionError,
+  CanvasesDescribeCanvasRetentionErrors,
+  CanvasesDescribeCanvasRetentionResponse,
+  CanvasesDescribeCanvasRetentionResponse2,
+  CanvasesDescribeCanvasRetentionResponses,
+  CanvasesDescribeCanvasVersionData,
+  CanvasesDescribeCanvasVersionError,
+  CanvasesDescribeCanvasVersionErrors,
+  CanvasesDescribeCanvasVersionResponse,
+  CanvasesDescribeCanvasVersionResponse2,
+  CanvasesDescribeCanvasVersionResponses,
+  CanvasesDiffCanvasVersionsData,
+  CanvasesDiffCanvasVersionsError,
+  CanvasesDiffCanvasVersionsErrors,
+  CanvasesDiffCanvasVersionsResponse,
+  CanvasesDiffCanvasVersionsResponse2,
+  CanvasesDiffCanvasVersionsResponses,
+  CanvasesDiscardCanvasDraftData,
+  CanvasesDiscardCanvasDraftError,
+  CanvasesDiscardCanvasDraftErrors,
+  CanvasesDiscardCanvasDraftResponse,
+  CanvasesDiscardCanvasDraftResponse2,
+  CanvasesDiscardCanvasDraftResponses,
+  CanvasesDownloadExecutionArtifactData,
+  CanvasesDownloadExecutionArtifactError,
+  CanvasesDownloadExecutionArtifactErrors,
+  CanvasesDownloadExecutionArtifactResponse,
+  CanvasesDownloadExecutionArtifactResponses,
   CanvasesEmitNodeEventBody,
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventError,
@@ -167,6 +261,14 @@ export type {
   CanvasesEmitNodeEventResponse,
   CanvasesEmitNodeEventResponse2,
   CanvasesEmitNodeEventResponses,
+  CanvasesExecutionArtifact,
+  CanvasesExecutionLogLine,
+  CanvasesGetExecutionLogsData,
+  CanvasesGetExecutionLogsError,
+  CanvasesGetExecutionLogsErrors,
+  CanvasesGetExecutionLogsResponse,
+  CanvasesGetExecutionLogsResponse2,
+  CanvasesGetExecutionLogsResponses,
   CanvasesInvokeNodeExecutionActionBody,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionError,
@@ -181,6 +283,12 @@ export type {
   CanvasesInvokeNodeTriggerActionResponse,
   CanvasesInvokeNodeTriggerActionResponse2,
   CanvasesInvokeNodeTriggerActionResponses,
+  CanvasesListCanvasChangeRequestsData,
+  CanvasesListCanvasChangeRequestsError,
+  CanvasesListCanvasChangeRequestsErrors,
+  CanvasesListCanvasChangeRequestsResponse,
+  CanvasesListCanvasChangeRequestsResponse2,
+  CanvasesListCanvasChangeRequestsResponses,
   CanvasesListCanvasesData,
   CanvasesListCanvasesError,
   CanvasesListCanvasesErrors,
@@ -193,6 +301,18 @@ export type {
   CanvasesListCanvasEventsResponse,
   CanvasesListCanvasEventsResponse2,
   CanvasesListCanvasEventsResponses,
+  CanvasesListCanvasVariablesData,
+  CanvasesListCanvasVariablesError,
+  CanvasesListCanvasVariablesErrors,
+  CanvasesListCanvasVariablesResponse,
+  CanvasesListCanvasVariablesResponse2,
+  CanvasesListCanvasVariablesResponses,
+  CanvasesListCanvasVersionsData,
+  CanvasesListCanvasVersionsError,
+  CanvasesListCanvasVersionsErrors,
+  CanvasesListCanvasVersionsResponse,
+  CanvasesListCanvasVersionsResponse2,
+  CanvasesListCanvasVersionsResponses,
   CanvasesListChildExecutionsBody,
   CanvasesListChildExecutionsData,
   CanvasesListChildExecutionsError,
@@ -206,6 +326,12 @@ export type {
   CanvasesListEventExecutionsResponse,
   CanvasesListEventExecutionsResponse2,
   CanvasesListEventExecutionsResponses,
+  CanvasesListExecutionArtifactsData,
+  CanvasesListExecutionArtifactsError,
+  CanvasesListExecutionArtifactsErrors,
+  CanvasesListExecutionArtifactsResponse,
+  CanvasesListExecutionArtifactsResponse2,
+  CanvasesListExecutionArtifactsResponses,
   CanvasesListNodeEventsData,
   CanvasesListNodeEventsError,
   CanvasesListNodeEventsErrors,
@@ -224,6 +350,27 @@ export type {
   CanvasesListNodeQueueItemsResponse,
   CanvasesListNodeQueueItemsResponse2,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesPublishCanvasDraftBody,
+  CanvasesPublishCanvasDraftData,
+  CanvasesPublishCanvasDraftError,
+  CanvasesPublishCanvasDraftErrors,
+  CanvasesPublishCanvasDraftResponse,
+  CanvasesPublishCanvasDraftResponse2,
+  CanvasesPublishCanvasDraftResponses,
+  CanvasesRejectCanvasChangeRequestBody,
+  CanvasesRejectCanvasChangeRequestData,
+  CanvasesRejectCanvasChangeRequestError,
+  CanvasesRejectCanvasChangeRequestErrors,
+  CanvasesRejectCanvasChangeRequestResponse,
+  CanvasesRejectCanvasChangeRequestResponse2,
+  CanvasesRejectCanvasChangeRequestResponses,
+  CanvasesRerunExecutionBody,
+  CanvasesRerunExecutionData,
+  CanvasesRerunExecutionError,
+  CanvasesRerunExecutionErrors,
+  CanvasesRerunExecutionResponse,
+  CanvasesRerunExecutionResponse2,
+  CanvasesRerunExecutionResponses,
   CanvasesResolveExecutionErrorsBody,
   CanvasesResolveExecutionErrorsData,
   CanvasesResolveExecutionErrorsError,
@@ -231,13 +378,48 @@ export type {
   CanvasesResolveExecutionErrorsResponse,
   CanvasesResolveExecutionErrorsResponse2,
   CanvasesResolveExecutionErrorsResponses,
+  CanvasesRestoreCanvasVersionBody,
+  CanvasesRestoreCanvasVersionData,
+  CanvasesRestoreCanvasVersionError,
+  CanvasesRestoreCanvasVersionErrors,
+  CanvasesRestoreCanvasVersionResponse,
+  CanvasesRestoreCanvasVersionResponse2,
+  CanvasesRestoreCanvasVersionResponses,
+  CanvasesSetCanvasVariableBody,
+  CanvasesSetCanvasVariableData,
+  CanvasesSetCanvasVariableError,
+  CanvasesSetCanvasVariableErrors,
+  CanvasesSetCanvasVariableResponse,
+  CanvasesSetCanvasVariableResponse2,
+  CanvasesSetCanvasVariableResponses,
+  CanvasesSimulateCanvasBody,
+  CanvasesSimulateCanvasData,
+  CanvasesSimulateCanvasError,
+  CanvasesSimulateCanvasErrors,
+  CanvasesSimulateCanvasResponse,
+  CanvasesSimulateCanvasResponse2,
+  CanvasesSimulateCanvasResponses,
   CanvasesUpdateCanvasBody,
   CanvasesUpdateCanvasData,
+  CanvasesUpdateCanvasDraftBody,
+  CanvasesUpdateCanvasDraftData,
+  CanvasesUpdateCanvasDraftError,
+  CanvasesUpdateCanvasDraftErrors,
+  CanvasesUpdateCanvasDraftResponse,
+  CanvasesUpdateCanvasDraftResponse2,
+  CanvasesUpdateCanvasDraftResponses,
   CanvasesUpdateCanvasError,
   CanvasesUpdateCanvasErrors,
   CanvasesUpdateCanvasResponse,
   CanvasesUpdateCanvasResponse2,
   CanvasesUpdateCanvasResponses,
+  CanvasesUpdateCanvasRetentionPolicyBody,
+  CanvasesUpdateCanvasRetentionPolicyData,
+  CanvasesUpdateCanvasRetentionPolicyError,
+  CanvasesUpdateCanvasRetentionPolicyErrors,
+  CanvasesUpdateCanvasRetentionPolicyResponse,
+  CanvasesUpdateCanvasRetentionPolicyResponse2,
+  CanvasesUpdateCanvasRetentionPolicyResponses,
   CanvasesUpdateNodePauseBody,
   CanvasesUpdateNodePauseData,
   CanvasesUpdateNodePauseError,
@@ -245,9 +427,21 @@ export type {
   CanvasesUpdateNodePauseResponse,
   CanvasesUpdateNodePauseResponse2,
   CanvasesUpdateNodePauseResponses,
+  CanvasesUpdateNodeQueueItemBody,
+  CanvasesUpdateNodeQueueItemData,
+  CanvasesUpdateNodeQueueItemError,
+  CanvasesUpdateNodeQueueItemErrors,
+  CanvasesUpdateNodeQueueItemResponse,
+  CanvasesUpdateNodeQueueItemResponse2,
+  CanvasesUpdateNodeQueueItemResponses,
   CanvasNodeExecutionResult,
   CanvasNodeExecutionResultReason,
   CanvasNodeExecutionState,
+  CanvasSimulationStepMode,
+  CanvasSimulationStepOutput,
+  CanvasVersionDiffChangeType,
+  CanvasVersionDiffEdgeChange,
+  CanvasVersionDiffNodeChange,
   ClientOptions,
   ComponentsComponent,
   ComponentsComponentAction,
@@ -272,6 +466,7 @@ export type {
   ComponentsListComponentsResponse2,
   ComponentsListComponentsResponses,
   ComponentsNode,
+  ComponentsNodeRateLimit,
   ComponentsNodeType,
   ComponentsPosition,
   ConfigurationAnyPredicateListTypeOptions,
@@ -296,6 +491,13 @@ export type {
   ConfigurationTypeOptions,
   ConfigurationValidationRule,
   ConfigurationVisibilityCondition,
+  ExpressionsExpressionFunction,
+  ExpressionsListExpressionFunctionsData,
+  ExpressionsListExpressionFunctionsError,
+  ExpressionsListExpressionFunctionsErrors,
+  ExpressionsListExpressionFunctionsResponse,
+  ExpressionsListExpressionFunctionsResponse2,
+  ExpressionsListExpressionFunctionsResponses,
   GooglerpcStatus,
   GroupsAddUserToGroupBody,
   GroupsAddUserToGroupData,
@@ -373,6 +575,7 @@ export type {
   MeRegenerateTokenResponses,
   NodeBlueprintRef,
   NodeComponentRef,
+  NodeQueuePolicy,
   NodeTriggerRef,
   NodeWidgetRef,
   OrganizationsAcceptInviteLinkData,
@@ -425,8 +628,15 @@ export type {
   OrganizationsGetInviteLinkResponse,
   OrganizationsGetInviteLinkResponse2,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsGetRetentionPolicyData,
+  OrganizationsGetRetentionPolicyError,
+  OrganizationsGetRetentionPolicyErrors,
+  OrganizationsGetRetentionPolicyResponse,
+  OrganizationsGetRetentionPolicyResponse2,
+  OrganizationsGetRetentionPolicyResponses,
   OrganizationsIntegration,
   OrganizationsIntegrationMetadata,
+  OrganizationsIntegrationRateLimit,
   OrganizationsIntegrationResourceRef,
   OrganizationsIntegrationSpec,
   OrganizationsIntegrationStatus,
@@ -469,6 +679,7 @@ export type {
   OrganizationsResetInviteLinkResponse,
   OrganizationsResetInviteLinkResponse2,
   OrganizationsResetInviteLinkResponses,
+  OrganizationsRetentionPolicy,
   OrganizationsUpdateIntegrationBody,
   OrganizationsUpdateIntegrationData,
   OrganizationsUpdateIntegrationError,
@@ -490,8 +701,16 @@ export type {
   OrganizationsUpdateOrganizationResponse,
   OrganizationsUpdateOrganizationResponse2,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateRetentionPolicyBody,
+  OrganizationsUpdateRetentionPolicyData,
+  OrganizationsUpdateRetentionPolicyError,
+  OrganizationsUpdateRetentionPolicyErrors,
+  OrganizationsUpdateRetentionPolicyResponse,
+  OrganizationsUpdateRetentionPolicyResponse2,
+  OrganizationsUpdateRetentionPolicyResponses,
   ProtobufAny,
   ProtobufNullValue,
+  QueuePolicyStrategy,
   RolesAssignRoleBody,
   RolesAssignRoleData,
   RolesAssignRoleError,
@@ -534,8 +753,12 @@ export type {
   RolesUpdateRoleResponse,
   RolesUpdateRoleResponse2,
   RolesUpdateRoleResponses,
+  SecretAccessEventAction,
+  SecretAccessEventActorType,
+  SecretAccessPolicy,
   SecretLocal,
   SecretProvider,
+  SecretScope,
   SecretsCreateSecretData,
   SecretsCreateSecretError,
   SecretsCreateSecretErrors,
@@ -561,6 +784,12 @@ export type {
   SecretsDescribeSecretResponse,
   SecretsDescribeSecretResponse2,
   SecretsDescribeSecretResponses,
+  SecretsListSecretAccessEventsData,
+  SecretsListSecretAccessEventsError,
+  SecretsListSecretAccessEventsErrors,
+  SecretsListSecretAccessEventsResponse,
+  SecretsListSecretAccessEventsResponse2,
+  SecretsListSecretAccessEventsResponses,
   SecretsListSecretsData,
   SecretsListSecretsError,
   SecretsListSecretsErrors,
@@ -568,7 +797,9 @@ export type {
   SecretsListSecretsResponse2,
   SecretsListSecretsResponses,
   SecretsSecret,
+  SecretsSecretAccessEvent,
   SecretsSecretMetadata,
+  SecretsSecretReference,
   SecretsSecretSpec,
   SecretsSetSecretKeyBody,
   SecretsSetSecretKeyData,
@@ -591,6 +822,7 @@ export type {
   SecretsUpdateSecretResponse,
   SecretsUpdateSecretResponse2,
   SecretsUpdateSecretResponses,
+  SecretVault,
   ServiceAccountsCreateServiceAccountData,
   ServiceAccountsCreateServiceAccountError,
   ServiceAccountsCreateServiceAccountErrors,
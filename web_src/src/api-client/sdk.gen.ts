@@ -3,6 +3,9 @@
 import type { Client, Options as Options2, TDataShape } from "./client";
 import { client } from "./client.gen";
 import type {
+  AuditListAuditLogEntriesData,
+  AuditListAuditLogEntriesErrors,
+  AuditListAuditLogEntriesResponses,
   BlueprintsCreateBlueprintData,
   BlueprintsCreateBlueprintErrors,
   BlueprintsCreateBlueprintResponses,
@@ -18,6 +21,9 @@ import type {
   BlueprintsUpdateBlueprintData,
   BlueprintsUpdateBlueprintErrors,
   BlueprintsUpdateBlueprintResponses,
+  CanvasesApproveCanvasChangeRequestData,
+  CanvasesApproveCanvasChangeRequestErrors,
+  CanvasesApproveCanvasChangeRequestResponses,
   CanvasesCancelExecutionData,
   CanvasesCancelExecutionErrors,
   CanvasesCancelExecutionResponses,
@@ -27,33 +33,69 @@ import type {
   CanvasesDeleteCanvasData,
   CanvasesDeleteCanvasErrors,
   CanvasesDeleteCanvasResponses,
+  CanvasesDeleteCanvasVariableData,
+  CanvasesDeleteCanvasVariableErrors,
+  CanvasesDeleteCanvasVariableResponses,
   CanvasesDeleteNodeQueueItemData,
   CanvasesDeleteNodeQueueItemErrors,
   CanvasesDeleteNodeQueueItemResponses,
   CanvasesDescribeCanvasData,
+  CanvasesDescribeCanvasDraftData,
+  CanvasesDescribeCanvasDraftErrors,
+  CanvasesDescribeCanvasDraftResponses,
   CanvasesDescribeCanvasErrors,
   CanvasesDescribeCanvasResponses,
+  CanvasesDescribeCanvasRetentionData,
+  CanvasesDescribeCanvasRetentionErrors,
+  CanvasesDescribeCanvasRetentionResponses,
+  CanvasesDescribeCanvasVersionData,
+  CanvasesDescribeCanvasVersionErrors,
+  CanvasesDescribeCanvasVersionResponses,
+  CanvasesDiffCanvasVersionsData,
+  CanvasesDiffCanvasVersionsErrors,
+  CanvasesDiffCanvasVersionsResponses,
+  CanvasesDiscardCanvasDraftData,
+  CanvasesDiscardCanvasDraftErrors,
+  CanvasesDiscardCanvasDraftResponses,
+  CanvasesDownloadExecutionArtifactData,
+  CanvasesDownloadExecutionArtifactErrors,
+  CanvasesDownloadExecutionArtifactResponses,
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventErrors,
   CanvasesEmitNodeEventResponses,
+  CanvasesGetExecutionLogsData,
+  CanvasesGetExecutionLogsErrors,
+  CanvasesGetExecutionLogsResponses,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionErrors,
   CanvasesInvokeNodeExecutionActionResponses,
   CanvasesInvokeNodeTriggerActionData,
   CanvasesInvokeNodeTriggerActionErrors,
   CanvasesInvokeNodeTriggerActionResponses,
+  CanvasesListCanvasChangeRequestsData,
+  CanvasesListCanvasChangeRequestsErrors,
+  CanvasesListCanvasChangeRequestsResponses,
   CanvasesListCanvasesData,
   CanvasesListCanvasesErrors,
   CanvasesListCanvasesResponses,
   CanvasesListCanvasEventsData,
   CanvasesListCanvasEventsErrors,
   CanvasesListCanvasEventsResponses,
+  CanvasesListCanvasVariablesData,
+  CanvasesListCanvasVariablesErrors,
+  CanvasesListCanvasVariablesResponses,
+  CanvasesListCanvasVersionsData,
+  CanvasesListCanvasVersionsErrors,
+  CanvasesListCanvasVersionsResponses,
   CanvasesListChildExecutionsData,
   CanvasesListChildExecutionsErrors,
   CanvasesListChildExecutionsResponses,
   CanvasesListEventExecutionsData,
   CanvasesListEventExecutionsErrors,
   CanvasesListEventExecutionsResponses,
+  CanvasesListExecutionArtifactsData,
+  CanvasesListExecutionArtifactsErrors,
+  CanvasesListExecutionArtifactsResponses,
   CanvasesListNodeEventsData,
   CanvasesListNodeEventsErrors,
   CanvasesListNodeEventsResponses,
@@ -63,15 +105,42 @@ import type {
   CanvasesListNodeQueueItemsData,
   CanvasesListNodeQueueItemsErrors,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesPublishCanvasDraftData,
+  CanvasesPublishCanvasDraftErrors,
+  CanvasesPublishCanvasDraftResponses,
+  CanvasesRejectCanvasChangeRequestData,
+  CanvasesRejectCanvasChangeRequestErrors,
+  CanvasesRejectCanvasChangeRequestResponses,
+  CanvasesRerunExecutionData,
+  CanvasesRerunExecutionErrors,
+  CanvasesRerunExecutionResponses,
   CanvasesResolveExecutionErrorsData,
   CanvasesResolveExecutionErrorsErrors,
   CanvasesResolveExecutionErrorsResponses,
+  CanvasesRestoreCanvasVersionData,
+  CanvasesRestoreCanvasVersionErrors,
+  CanvasesRestoreCanvasVersionResponses,
+  CanvasesSetCanvasVariableData,
+  CanvasesSetCanvasVariableErrors,
+  CanvasesSetCanvasVariableResponses,
+  CanvasesSimulateCanvasData,
+  CanvasesSimulateCanvasErrors,
+  CanvasesSimulateCanvasResponses,
   CanvasesUpdateCanvasData,
+  CanvasesUpdateCanvasDraftData,
+  CanvasesUpdateCanvasDraftErrors,
+  CanvasesUpdateCanvasDraftResponses,
   CanvasesUpdateCanvasErrors,
   CanvasesUpdateCanvasResponses,
+  CanvasesUpdateCanvasRetentionPolicyData,
+  CanvasesUpdateCanvasRetentionPolicyErrors,
+  CanvasesUpdateCanvasRetentionPolicyResponses,
   CanvasesUpdateNodePauseData,
   CanvasesUpdateNodePauseErrors,
   CanvasesUpdateNodePauseResponses,
+  CanvasesUpdateNodeQueueItemData,
+  CanvasesUpdateNodeQueueItemErrors,
+  CanvasesUpdateNodeQueueItemResponses,
   ComponentsDescribeComponentData,
   ComponentsDescribeComponentErrors,
   ComponentsDescribeComponentResponses,
@@ -81,6 +150,9 @@ import type {
   ComponentsListComponentsData,
   ComponentsListComponentsErrors,
   ComponentsListComponentsResponses,
+  ExpressionsListExpressionFunctionsData,
+  ExpressionsListExpressionFunctionsErrors,
+  ExpressionsListExpressionFunctionsResponses,
   GroupsAddUserToGroupData,
   GroupsAddUserToGroupErrors,
   GroupsAddUserToGroupResponses,
@@ -138,6 +210,9 @@ import type {
   OrganizationsGetInviteLinkData,
   OrganizationsGetInviteLinkErrors,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsGetRetentionPolicyData,
+  OrganizationsGetRetentionPolicyErrors,
+  OrganizationsGetRetentionPolicyResponses,
   OrganizationsListIntegrationResourcesData,
   OrganizationsListIntegrationResourcesErrors,
   OrganizationsListIntegrationResourcesResponses,
@@ -165,6 +240,9 @@ import type {
   OrganizationsUpdateOrganizationData,
   OrganizationsUpdateOrganizationErrors,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateRetentionPolicyData,
+  OrganizationsUpdateRetentionPolicyErrors,
+  OrganizationsUpdateRetentionPolicyResponses,
   RolesAssignRoleData,
   RolesAssignRoleErrors,
   RolesAssignRoleResponses,
@@ -195,6 +273,9 @@ import type {
   SecretsDescribeSecretData,
   SecretsDescribeSecretErrors,
   SecretsDescribeSecretResponses,
+  SecretsListSecretAccessEventsData,
+  SecretsListSecretAccessEventsErrors,
+  SecretsListSecretAccessEventsResponses,
   SecretsListSecretsData,
   SecretsListSecretsErrors,
   SecretsListSecretsResponses,
@@ -265,6 +346,19 @@ export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends
   meta?: Record<string, unknown>;
 };
 
+/**
+ * List audit log entries
+ *
+ * Returns the mutating API calls made in the organization, most recent first
+ */
+export const auditListAuditLogEntries = <ThrowOnError extends boolean = true>(
+  options?: Options<AuditListAuditLogEntriesData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<AuditListAuditLogEntriesResponses, AuditListAuditLogEntriesErrors, ThrowOnError>({
+    url: "/api/v1/audit-log",
+    ...options,
+  });
+
 /**
  * List blueprints
  *
@@ -367,6 +461,133 @@ export const canvasesCreateCanvas = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * List canvas change requests
+ *
+ * Returns the change requests of a canvas, newest first
+ */
+export const canvasesListCanvasChangeRequests = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListCanvasChangeRequestsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesListCanvasChangeRequestsResponses,
+    CanvasesListCanvasChangeRequestsErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/change-requests",
+    ...options,
+  });
+
+/**
+ * Approve canvas change request
+ *
+ * Approves a pending change request, publishing its changes to the canvas
+ */
+export const canvasesApproveCanvasChangeRequest = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesApproveCanvasChangeRequestData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    CanvasesApproveCanvasChangeRequestResponses,
+    CanvasesApproveCanvasChangeRequestErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/change-requests/{changeRequestId}/approve",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Reject canvas change request
+ *
+ * Rejects a pending change request, leaving the canvas unchanged
+ */
+export const canvasesRejectCanvasChangeRequest = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesRejectCanvasChangeRequestData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    CanvasesRejectCanvasChangeRequestResponses,
+    CanvasesRejectCanvasChangeRequestErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/change-requests/{changeRequestId}/reject",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Describe canvas draft
+ *
+ * Returns the draft of a canvas, with its validation errors and the changes it makes
+ */
+export const canvasesDescribeCanvasDraft = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDescribeCanvasDraftData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesDescribeCanvasDraftResponses,
+    CanvasesDescribeCanvasDraftErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/draft",
+    ...options,
+  });
+
+/**
+ * Discard canvas draft
+ *
+ * Deletes the draft of a canvas
+ */
+export const canvasesDiscardCanvasDraft = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDiscardCanvasDraftData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    CanvasesDiscardCanvasDraftResponses,
+    CanvasesDiscardCanvasDraftErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/draft",
+    ...options,
+  });
+
+/**
+ * Update canvas draft
+ *
+ * Creates or updates the draft of a canvas, without changing the running canvas
+ */
+export const canvasesUpdateCanvasDraft = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateCanvasDraftData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<CanvasesUpdateCanvasDraftResponses, CanvasesUpdateCanvasDraftErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/draft",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Publish canvas draft
+ *
+ * Replaces the canvas with its draft. If an approval role is given, a change request is created instead, and the draft is published once it is approved.
+ */
+export const canvasesPublishCanvasDraft = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesPublishCanvasDraftData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<CanvasesPublishCanvasDraftResponses, CanvasesPublishCanvasDraftErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/draft/publish",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List canvas events
  *
@@ -434,6 +655,40 @@ export const canvasesInvokeNodeExecutionAction = <ThrowOnError extends boolean =
     },
   });
 
+/**
+ * List execution artifacts
+ *
+ * Returns the artifacts stored by a node execution
+ */
+export const canvasesListExecutionArtifacts = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListExecutionArtifactsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesListExecutionArtifactsResponses,
+    CanvasesListExecutionArtifactsErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts",
+    ...options,
+  });
+
+/**
+ * Download execution artifact
+ *
+ * Returns the content of an execution artifact
+ */
+export const canvasesDownloadExecutionArtifact = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDownloadExecutionArtifactData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesDownloadExecutionArtifactResponses,
+    CanvasesDownloadExecutionArtifactErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts/{artifactId}/download",
+    ...options,
+  });
+
 /**
  * Cancel execution
  *
@@ -472,6 +727,36 @@ export const canvasesListChildExecutions = <ThrowOnError extends boolean = true>
     },
   });
 
+/**
+ * Get execution logs
+ *
+ * Returns the log lines of a node execution, after the given line
+ */
+export const canvasesGetExecutionLogs = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetExecutionLogsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesGetExecutionLogsResponses, CanvasesGetExecutionLogsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs",
+    ...options,
+  });
+
+/**
+ * Rerun execution
+ *
+ * Re-runs a finished canvas node execution by queueing its input again on the node, continuing the execution chain from that node
+ */
+export const canvasesRerunExecution = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesRerunExecutionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<CanvasesRerunExecutionResponses, CanvasesRerunExecutionErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/rerun",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List node events
  *
@@ -559,6 +844,82 @@ export const canvasesDeleteNodeQueueItem = <ThrowOnError extends boolean = true>
     ThrowOnError
   >({ url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}", ...options });
 
+/**
+ * Update item in a node's queue
+ *
+ * Changes the priority of a specific item in a node's queue. Items with a higher priority are dequeued first
+ */
+export const canvasesUpdateNodeQueueItem = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateNodeQueueItemData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    CanvasesUpdateNodeQueueItemResponses,
+    CanvasesUpdateNodeQueueItemErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Describe canvas retention
+ *
+ * Returns the retention policy of a canvas, the policy in effect for it, and retention stats
+ */
+export const canvasesDescribeCanvasRetention = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDescribeCanvasRetentionData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesDescribeCanvasRetentionResponses,
+    CanvasesDescribeCanvasRetentionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/retention",
+    ...options,
+  });
+
+/**
+ * Update canvas retention policy
+ *
+ * Sets the retention policy of a canvas. Without a policy, the canvas uses the policy of its organization
+ */
+export const canvasesUpdateCanvasRetentionPolicy = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateCanvasRetentionPolicyData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    CanvasesUpdateCanvasRetentionPolicyResponses,
+    CanvasesUpdateCanvasRetentionPolicyErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/retention/policy",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Simulate canvas
+ *
+ * Walks the canvas from a node with a sample payload, returning the nodes it reaches and their resolved configurations, without side effects
+ */
+export const canvasesSimulateCanvas = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesSimulateCanvasData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<CanvasesSimulateCanvasResponses, CanvasesSimulateCanvasErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/simulate",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Invoke trigger action
  *
@@ -580,6 +941,121 @@ export const canvasesInvokeNodeTriggerAction = <ThrowOnError extends boolean = t
     },
   });
 
+/**
+ * List canvas variables
+ *
+ * Returns the variables of a canvas
+ */
+export const canvasesListCanvasVariables = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListCanvasVariablesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesListCanvasVariablesResponses,
+    CanvasesListCanvasVariablesErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/variables",
+    ...options,
+  });
+
+/**
+ * Delete canvas variable
+ *
+ * Deletes a canvas variable. If expected_version is set, the variable is only deleted if it is at that version
+ */
+export const canvasesDeleteCanvasVariable = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDeleteCanvasVariableData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    CanvasesDeleteCanvasVariableResponses,
+    CanvasesDeleteCanvasVariableErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/variables/{name}",
+    ...options,
+  });
+
+/**
+ * Set canvas variable
+ *
+ * Creates or updates a canvas variable. If expected_version is set, the variable is only written if it is at that version
+ */
+export const canvasesSetCanvasVariable = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesSetCanvasVariableData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<CanvasesSetCanvasVariableResponses, CanvasesSetCanvasVariableErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/variables/{name}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List canvas versions
+ *
+ * Returns the versions of a canvas, newest first
+ */
+export const canvasesListCanvasVersions = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListCanvasVersionsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesListCanvasVersionsResponses, CanvasesListCanvasVersionsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/versions",
+    ...options,
+  });
+
+/**
+ * Diff canvas versions
+ *
+ * Returns the changes between two canvas versions. If no base version is given, the previous version is used.
+ */
+export const canvasesDiffCanvasVersions = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDiffCanvasVersionsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesDiffCanvasVersionsResponses, CanvasesDiffCanvasVersionsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/versions/{toVersionId}/diff",
+    ...options,
+  });
+
+/**
+ * Describe canvas version
+ *
+ * Returns a canvas version, including its nodes and edges
+ */
+export const canvasesDescribeCanvasVersion = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesDescribeCanvasVersionData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesDescribeCanvasVersionResponses,
+    CanvasesDescribeCanvasVersionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/versions/{versionId}",
+    ...options,
+  });
+
+/**
+ * Restore canvas version
+ *
+ * Updates the canvas to the nodes and edges of a previous version, creating a new version
+ */
+export const canvasesRestoreCanvasVersion = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesRestoreCanvasVersionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    CanvasesRestoreCanvasVersionResponses,
+    CanvasesRestoreCanvasVersionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/versions/{versionId}/restore",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Delete canvas
  *
@@ -662,6 +1138,23 @@ export const componentsListComponentActions = <ThrowOnError extends boolean = tr
     ThrowOnError
   >({ url: "/api/v1/components/{name}/actions", ...options });
 
+/**
+ * List expression functions
+ *
+ * Returns the functions available in expressions
+ */
+export const expressionsListExpressionFunctions = <ThrowOnError extends boolean = true>(
+  options: Options<ExpressionsListExpressionFunctionsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    ExpressionsListExpressionFunctionsResponses,
+    ExpressionsListExpressionFunctionsErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/expressions/functions",
+    ...options,
+  });
+
 /**
  * List groups
  *
@@ -1075,6 +1568,44 @@ export const organizationsResetInviteLink = <ThrowOnError extends boolean = true
     ThrowOnError
   >({ url: "/api/v1/organizations/{id}/invite-link/reset", ...options });
 
+/**
+ * Get the organization retention policy
+ *
+ * Returns the retention policy applied to canvases of the organization without their own policy
+ */
+export const organizationsGetRetentionPolicy = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsGetRetentionPolicyData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsGetRetentionPolicyResponses,
+    OrganizationsGetRetentionPolicyErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/retention-policy",
+    ...options,
+  });
+
+/**
+ * Update the organization retention policy
+ *
+ * Sets the retention policy applied to canvases of the organization without their own policy
+ */
+export const organizationsUpdateRetentionPolicy = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsUpdateRetentionPolicyData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    OrganizationsUpdateRetentionPolicyResponses,
+    OrganizationsUpdateRetentionPolicyErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/retention-policy",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Remove a user from an organization
  *
@@ -1251,6 +1782,23 @@ export const secretsUpdateSecret = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * List secret access events
+ *
+ * Returns the reads and writes of a secret, most recent first. Deleted secrets can be referenced by name.
+ */
+export const secretsListSecretAccessEvents = <ThrowOnError extends boolean = true>(
+  options: Options<SecretsListSecretAccessEventsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    SecretsListSecretAccessEventsResponses,
+    SecretsListSecretAccessEventsErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/secrets/{idOrName}/access-events",
+    ...options,
+  });
+
 /**
  * Remove a key from a secret
  *
@@ -4,6 +4,34 @@ export type ClientOptions = {
   baseUrl: `http://${string}` | `https://${string}` | (string & {});
 };
 
+export type AuditAuditActorType = "ACTOR_TYPE_UNKNOWN" | "ACTOR_TYPE_USER" | "ACTOR_TYPE_SERVICE_ACCOUNT";
+
+export type AuditAuditLogEntry = {
+  id?: string;
+  actorType?: AuditAuditActorType;
+  actorId?: string;
+  method?: string;
+  resource?: string;
+  action?: string;
+  resourceId?: string;
+  /**
+   * The request of the call, with sensitive values redacted.
+   * Requests carry the changes being made, so this is the diff of the call.
+   */
+  request?: {
+    [key: string]: unknown;
+  };
+  result?: string;
+  error?: string;
+  createdAt?: string;
+};
+
+export type AuditListAuditLogEntriesResponse = {
+  entries?: Array<AuditAuditLogEntry>;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
+};
+
 /**
  * Enums
  */
@@ -62,15 +90,61 @@ export type BlueprintsUpdateBlueprintResponse = {
   blueprint?: BlueprintsBlueprint;
 };
 
+export type CanvasDraftValidationError = {
+  nodeId?: string;
+  message?: string;
+};
+
 export type CanvasNodeExecutionResult = "RESULT_UNKNOWN" | "RESULT_PASSED" | "RESULT_FAILED" | "RESULT_CANCELLED";
 
 export type CanvasNodeExecutionResultReason =
   | "RESULT_REASON_OK"
   | "RESULT_REASON_ERROR"
-  | "RESULT_REASON_ERROR_RESOLVED";
+  | "RESULT_REASON_ERROR_RESOLVED"
+  | "RESULT_REASON_TIMEOUT"
+  | "RESULT_REASON_SKIPPED";
 
 export type CanvasNodeExecutionState = "STATE_UNKNOWN" | "STATE_PENDING" | "STATE_STARTED" | "STATE_FINISHED";
 
+export type CanvasSimulationStepMode = "MODE_UNKNOWN" | "MODE_EXECUTED" | "MODE_EXAMPLE_OUTPUT" | "MODE_SKIPPED";
+
+export type CanvasSimulationStepOutput = {
+  channel?: string;
+  data?: {
+    [key: string]: unknown;
+  };
+};
+
+export type CanvasVersionDiffChangeType =
+  | "CHANGE_TYPE_UNKNOWN"
+  | "CHANGE_TYPE_ADDED"
+  | "CHANGE_TYPE_REMOVED"
+  | "CHANGE_TYPE_MODIFIED";
+
+export type CanvasVersionDiffEdgeChange = {
+  type?: CanvasVersionDiffChangeType;
+  edge?: ComponentsEdge;
+};
+
+export type CanvasVersionDiffNodeChange = {
+  nodeId?: string;
+  nodeName?: string;
+  type?: CanvasVersionDiffChangeType;
+  changedFields?: Array<string>;
+  before?: ComponentsNode;
+  after?: ComponentsNode;
+};
+
+export type CanvasesApproveCanvasChangeRequestBody = {
+  [key: string]: unknown;
+};
+
+export type CanvasesApproveCanvasChangeRequestResponse = {
+  canvas?: CanvasesCanvas;
+  version?: CanvasesCanvasVersion;
+  changeRequest?: CanvasesCanvasChangeRequest;
+};
+
 export type CanvasesCancelExecutionBody = {
   [key: string]: unknown;
 };
@@ -85,6 +159,38 @@ export type CanvasesCanvas = {
   status?: CanvasesCanvasStatus;
 };
 
+export type CanvasesCanvasChangeRequest = {
+  id?: string;
+  canvasId?: string;
+  name?: string;
+  description?: string;
+  message?: string;
+  approvalRole?: string;
+  state?: CanvasesCanvasChangeRequestState;
+  baseVersionId?: string;
+  publishedVersionId?: string;
+  requestedBy?: SuperplaneCanvasesUserRef;
+  reviewedBy?: SuperplaneCanvasesUserRef;
+  reviewedAt?: string;
+  createdAt?: string;
+  diff?: CanvasesCanvasVersionDiff;
+};
+
+export type CanvasesCanvasChangeRequestState = "STATE_UNKNOWN" | "STATE_PENDING" | "STATE_PUBLISHED" | "STATE_REJECTED";
+
+export type CanvasesCanvasDraft = {
+  canvasId?: string;
+  name?: string;
+  description?: string;
+  spec?: CanvasesCanvasSpec;
+  baseVersionId?: string;
+  updatedBy?: SuperplaneCanvasesUserRef;
+  createdAt?: string;
+  updatedAt?: string;
+  validationErrors?: Array<CanvasDraftValidationError>;
+  diff?: CanvasesCanvasVersionDiff;
+};
+
 export type CanvasesCanvasEvent = {
   id?: string;
   canvasId?: string;
@@ -148,6 +254,11 @@ export type CanvasesCanvasNodeExecution = {
   childExecutions?: Array<CanvasesCanvasNodeExecution>;
   rootEvent?: CanvasesCanvasEvent;
   cancelledBy?: SuperplaneCanvasesUserRef;
+  attempt?: number;
+  retryOfExecutionId?: string;
+  deadlineAt?: string;
+  canvasVersionId?: string;
+  traceId?: string;
 };
 
 export type CanvasesCanvasNodeQueueItem = {
@@ -159,6 +270,47 @@ export type CanvasesCanvasNodeQueueItem = {
   };
   rootEvent?: CanvasesCanvasEvent;
   createdAt?: string;
+  priority?: number;
+};
+
+/**
+ * Runs older than max_age_days, or beyond the newest max_runs, are deleted.
+ * Zero means no limit. If archive is set, runs are archived before being deleted.
+ * The effective policy is inherited when it comes from the organization.
+ */
+export type CanvasesCanvasRetentionPolicy = {
+  maxAgeDays?: number;
+  maxRuns?: number;
+  archive?: boolean;
+  inherited?: boolean;
+};
+
+export type CanvasesCanvasRetentionStats = {
+  runs?: string;
+  executions?: string;
+  oldestRunAt?: string;
+  lastEnforcedAt?: string;
+  deletedRuns?: string;
+  deletedExecutions?: string;
+  archivedExecutions?: string;
+};
+
+export type CanvasesCanvasSimulationStep = {
+  nodeId?: string;
+  nodeName?: string;
+  sourceNodeId?: string;
+  sourceChannel?: string;
+  depth?: number;
+  mode?: CanvasSimulationStepMode;
+  configuration?: {
+    [key: string]: unknown;
+  };
+  metadata?: {
+    [key: string]: unknown;
+  };
+  outputs?: Array<CanvasSimulationStepOutput>;
+  error?: string;
+  message?: string;
 };
 
 export type CanvasesCanvasSpec = {
@@ -172,6 +324,34 @@ export type CanvasesCanvasStatus = {
   lastEvents?: Array<CanvasesCanvasEvent>;
 };
 
+export type CanvasesCanvasVariable = {
+  name?: string;
+  value?: unknown;
+  version?: number;
+  updatedBy?: SuperplaneCanvasesUserRef;
+  updatedByExecutionId?: string;
+  createdAt?: string;
+  updatedAt?: string;
+};
+
+export type CanvasesCanvasVersion = {
+  id?: string;
+  canvasId?: string;
+  version?: number;
+  name?: string;
+  description?: string;
+  message?: string;
+  createdBy?: SuperplaneCanvasesUserRef;
+  createdAt?: string;
+  spec?: CanvasesCanvasSpec;
+};
+
+export type CanvasesCanvasVersionDiff = {
+  changedFields?: Array<string>;
+  nodes?: Array<CanvasVersionDiffNodeChange>;
+  edges?: Array<CanvasVersionDiffEdgeChange>;
+};
+
 export type CanvasesCreateCanvasRequest = {
   canvas?: CanvasesCanvas;
 };
@@ -184,14 +364,42 @@ export type CanvasesDeleteCanvasResponse = {
   [key: string]: unknown;
 };
 
+export type CanvasesDeleteCanvasVariableResponse = {
+  [key: string]: unknown;
+};
+
 export type CanvasesDeleteNodeQueueItemResponse = {
   [key: string]: unknown;
 };
 
+export type CanvasesDescribeCanvasDraftResponse = {
+  draft?: CanvasesCanvasDraft;
+};
+
 export type CanvasesDescribeCanvasResponse = {
   canvas?: CanvasesCanvas;
 };
 
+export type CanvasesDescribeCanvasRetentionResponse = {
+  policy?: CanvasesCanvasRetentionPolicy;
+  effectivePolicy?: CanvasesCanvasRetentionPolicy;
+  stats?: CanvasesCanvasRetentionStats;
+};
+
+export type CanvasesDescribeCanvasVersionResponse = {
+  version?: CanvasesCanvasVersion;
+};
+
+export type CanvasesDiffCanvasVersionsResponse = {
+  from?: CanvasesCanvasVersion;
+  to?: CanvasesCanvasVersion;
+  diff?: CanvasesCanvasVersionDiff;
+};
+
+export type CanvasesDiscardCanvasDraftResponse = {
+  [key: string]: unknown;
+};
+
 export type CanvasesEmitNodeEventBody = {
   channel?: string;
   data?: {
@@ -203,6 +411,30 @@ export type CanvasesEmitNodeEventResponse = {
   eventId?: string;
 };
 
+export type CanvasesExecutionArtifact = {
+  id?: string;
+  executionId?: string;
+  nodeId?: string;
+  name?: string;
+  contentType?: string;
+  size?: string;
+  sha256?: string;
+  createdAt?: string;
+};
+
+export type CanvasesExecutionLogLine = {
+  id?: string;
+  stream?: string;
+  level?: string;
+  message?: string;
+  createdAt?: string;
+};
+
+export type CanvasesGetExecutionLogsResponse = {
+  lines?: Array<CanvasesExecutionLogLine>;
+  hasMore?: boolean;
+};
+
 export type CanvasesInvokeNodeExecutionActionBody = {
   parameters?: {
     [key: string]: unknown;
@@ -225,6 +457,10 @@ export type CanvasesInvokeNodeTriggerActionResponse = {
   };
 };
 
+export type CanvasesListCanvasChangeRequestsResponse = {
+  changeRequests?: Array<CanvasesCanvasChangeRequest>;
+};
+
 export type CanvasesListCanvasEventsResponse = {
   events?: Array<CanvasesCanvasEventWithExecutions>;
   totalCount?: number;
@@ -232,6 +468,16 @@ export type CanvasesListCanvasEventsResponse = {
   lastTimestamp?: string;
 };
 
+export type CanvasesListCanvasVariablesResponse = {
+  variables?: Array<CanvasesCanvasVariable>;
+};
+
+export type CanvasesListCanvasVersionsResponse = {
+  versions?: Array<CanvasesCanvasVersion>;
+  totalCount?: number;
+  hasNextPage?: boolean;
+};
+
 export type CanvasesListCanvasesResponse = {
   canvases?: Array<CanvasesCanvas>;
 };
@@ -248,6 +494,10 @@ export type CanvasesListEventExecutionsResponse = {
   executions?: Array<CanvasesCanvasNodeExecution>;
 };
 
+export type CanvasesListExecutionArtifactsResponse = {
+  artifacts?: Array<CanvasesExecutionArtifact>;
+};
+
 export type CanvasesListNodeEventsResponse = {
   events?: Array<CanvasesCanvasEvent>;
   totalCount?: number;
@@ -269,6 +519,33 @@ export type CanvasesListNodeQueueItemsResponse = {
   lastTimestamp?: string;
 };
 
+export type CanvasesPublishCanvasDraftBody = {
+  message?: string;
+  approvalRole?: string;
+};
+
+export type CanvasesPublishCanvasDraftResponse = {
+  canvas?: CanvasesCanvas;
+  version?: CanvasesCanvasVersion;
+  changeRequest?: CanvasesCanvasChangeRequest;
+};
+
+export type CanvasesRejectCanvasChangeRequestBody = {
+  [key: string]: unknown;
+};
+
+export type CanvasesRejectCanvasChangeRequestResponse = {
+  changeRequest?: CanvasesCanvasChangeRequest;
+};
+
+export type CanvasesRerunExecutionBody = {
+  [key: string]: unknown;
+};
+
+export type CanvasesRerunExecutionResponse = {
+  queueItem?: CanvasesCanvasNodeQueueItem;
+};
+
 export type CanvasesResolveExecutionErrorsBody = {
   executionIds?: Array<string>;
 };
@@ -277,14 +554,63 @@ export type CanvasesResolveExecutionErrorsResponse = {
   [key: string]: unknown;
 };
 
+export type CanvasesRestoreCanvasVersionBody = {
+  [key: string]: unknown;
+};
+
+export type CanvasesRestoreCanvasVersionResponse = {
+  canvas?: CanvasesCanvas;
+  version?: CanvasesCanvasVersion;
+};
+
+export type CanvasesSetCanvasVariableBody = {
+  value?: unknown;
+  expectedVersion?: number;
+};
+
+export type CanvasesSetCanvasVariableResponse = {
+  variable?: CanvasesCanvasVariable;
+};
+
+export type CanvasesSimulateCanvasBody = {
+  nodeId?: string;
+  channel?: string;
+  payload?: {
+    [key: string]: unknown;
+  };
+};
+
+export type CanvasesSimulateCanvasResponse = {
+  steps?: Array<CanvasesCanvasSimulationStep>;
+  truncated?: boolean;
+};
+
 export type CanvasesUpdateCanvasBody = {
   canvas?: CanvasesCanvas;
+  versionMessage?: string;
+};
+
+export type CanvasesUpdateCanvasDraftBody = {
+  canvas?: CanvasesCanvas;
+};
+
+export type CanvasesUpdateCanvasDraftResponse = {
+  draft?: CanvasesCanvasDraft;
 };
 
 export type CanvasesUpdateCanvasResponse = {
   canvas?: CanvasesCanvas;
 };
 
+export type CanvasesUpdateCanvasRetentionPolicyBody = {
+  policy?: CanvasesCanvasRetentionPolicy;
+};
+
+export type CanvasesUpdateCanvasRetentionPolicyResponse = {
+  policy?: CanvasesCanvasRetentionPolicy;
+  effectivePolicy?: CanvasesCanvasRetentionPolicy;
+};
+
 export type CanvasesUpdateNodePauseBody = {
   paused?: boolean;
 };
@@ -293,6 +619,14 @@ export type CanvasesUpdateNodePauseResponse = {
   node?: ComponentsNode;
 };
 
+export type CanvasesUpdateNodeQueueItemBody = {
+  priority?: number;
+};
+
+export type CanvasesUpdateNodeQueueItemResponse = {
+  item?: CanvasesCanvasNodeQueueItem;
+};
+
 export type ComponentsComponent = {
   name?: string;
   label?: string;
@@ -355,6 +689,16 @@ export type ComponentsNode = {
   errorMessage?: string;
   warningMessage?: string;
   paused?: boolean;
+  concurrencyGroup?: NodeConcurrencyGroup;
+  retryPolicy?: NodeRetryPolicy;
+  executionTimeoutSeconds?: number;
+  queuePolicy?: NodeQueuePolicy;
+  rateLimit?: ComponentsNodeRateLimit;
+};
+
+export type ComponentsNodeRateLimit = {
+  limit?: number;
+  periodSeconds?: number;
 };
 
 export type ComponentsNodeType = "TYPE_COMPONENT" | "TYPE_BLUEPRINT" | "TYPE_TRIGGER" | "TYPE_WIDGET";
@@ -364,6 +708,8 @@ export type ComponentsPosition = {
   y?: number;
 };
 
+export type ConcurrencyGroupPolicy = "POLICY_QUEUE" | "POLICY_CANCEL_IN_PROGRESS" | "POLICY_SKIP_IF_RUNNING";
+
 export type ConfigurationAnyPredicateListTypeOptions = {
   operators?: Array<ConfigurationSelectOption>;
 };
@@ -494,6 +840,19 @@ export type ConfigurationVisibilityCondition = {
   values?: Array<string>;
 };
 
+export type ExpressionsExpressionFunction = {
+  name?: string;
+  category?: string;
+  signature?: string;
+  description?: string;
+  example?: string;
+  builtin?: boolean;
+};
+
+export type ExpressionsListExpressionFunctionsResponse = {
+  functions?: Array<ExpressionsExpressionFunction>;
+};
+
 export type GroupsAddUserToGroupBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -607,6 +966,26 @@ export type NodeComponentRef = {
   name?: string;
 };
 
+export type NodeConcurrencyGroup = {
+  name?: string;
+  policy?: ConcurrencyGroupPolicy;
+};
+
+export type NodeQueuePolicy = {
+  strategy?: QueuePolicyStrategy;
+  debounceSeconds?: number;
+  key?: string;
+};
+
+export type NodeRetryPolicy = {
+  maxAttempts?: number;
+  initialDelaySeconds?: number;
+  maxDelaySeconds?: number;
+  backoffMultiplier?: number;
+  jitter?: number;
+  retryOn?: Array<string>;
+};
+
 export type NodeTriggerRef = {
   name?: string;
 };
@@ -630,6 +1009,7 @@ export type OrganizationsCreateIntegrationBody = {
   configuration?: {
     [key: string]: unknown;
   };
+  rateLimit?: OrganizationsIntegrationRateLimit;
 };
 
 export type OrganizationsCreateIntegrationResponse = {
@@ -664,6 +1044,10 @@ export type OrganizationsGetInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
+export type OrganizationsGetRetentionPolicyResponse = {
+  policy?: OrganizationsRetentionPolicy;
+};
+
 export type OrganizationsIntegration = {
   metadata?: OrganizationsIntegrationMetadata;
   spec?: OrganizationsIntegrationSpec;
@@ -677,6 +1061,11 @@ export type OrganizationsIntegrationMetadata = {
   updatedAt?: string;
 };
 
+export type OrganizationsIntegrationRateLimit = {
+  limit?: number;
+  periodSeconds?: number;
+};
+
 export type OrganizationsIntegrationResourceRef = {
   type?: string;
   name?: string;
@@ -688,6 +1077,7 @@ export type OrganizationsIntegrationSpec = {
   configuration?: {
     [key: string]: unknown;
   };
+  rateLimit?: OrganizationsIntegrationRateLimit;
 };
 
 export type OrganizationsIntegrationStatus = {
@@ -749,11 +1139,23 @@ export type OrganizationsResetInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
+/**
+ * Runs older than max_age_days, or beyond the newest max_runs, are deleted.
+ * Zero means no limit. If archive is set, runs are archived before being deleted.
+ */
+export type OrganizationsRetentionPolicy = {
+  maxAgeDays?: number;
+  maxRuns?: number;
+  archive?: boolean;
+  updatedAt?: string;
+};
+
 export type OrganizationsUpdateIntegrationBody = {
   configuration?: {
     [key: string]: unknown;
   };
   name?: string;
+  rateLimit?: OrganizationsIntegrationRateLimit;
 };
 
 export type OrganizationsUpdateIntegrationResponse = {
@@ -776,6 +1178,20 @@ export type OrganizationsUpdateOrganizationResponse = {
   organization?: OrganizationsOrganization;
 };
 
+export type OrganizationsUpdateRetentionPolicyBody = {
+  policy?: OrganizationsRetentionPolicy;
+};
+
+export type OrganizationsUpdateRetentionPolicyResponse = {
+  policy?: OrganizationsRetentionPolicy;
+};
+
+export type QueuePolicyStrategy =
+  | "STRATEGY_NONE"
+  | "STRATEGY_DEBOUNCE"
+  | "STRATEGY_COALESCE"
+  | "STRATEGY_DROP_DUPLICATES";
+
 export type RolesAssignRoleBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -839,6 +1255,31 @@ export type RolesUpdateRoleResponse = {
   role?: RolesRole;
 };
 
+export type SecretAccessEventAction =
+  | "ACTION_UNKNOWN"
+  | "ACTION_READ"
+  | "ACTION_DENIED"
+  | "ACTION_DESCRIBE"
+  | "ACTION_CREATE"
+  | "ACTION_UPDATE"
+  | "ACTION_DELETE";
+
+export type SecretAccessEventActorType =
+  | "ACTOR_TYPE_UNKNOWN"
+  | "ACTOR_TYPE_USER"
+  | "ACTOR_TYPE_SERVICE_ACCOUNT"
+  | "ACTOR_TYPE_EXECUTION";
+
+/**
+ * Access policies restrict which canvases and components can read the secret.
+ * Components are referenced by name, e.g. ssh or github.runWorkflow.
+ * Empty lists allow all canvases or components.
+ */
+export type SecretAccessPolicy = {
+  canvasIds?: Array<string>;
+  components?: Array<string>;
+};
+
 /**
  * Local secrets are stored and managed by SuperPlane itself.
  */
@@ -848,7 +1289,25 @@ export type SecretLocal = {
   };
 };
 
-export type SecretProvider = "PROVIDER_UNKNOWN" | "PROVIDER_LOCAL";
+export type SecretProvider = "PROVIDER_UNKNOWN" | "PROVIDER_LOCAL" | "PROVIDER_VAULT";
+
+/**
+ * Scoped secrets can only be read by the nodes of a canvas,
+ * or by a single node of it, if node_id is also set.
+ */
+export type SecretScope = {
+  canvasId?: string;
+  nodeId?: string;
+};
+
+/**
+ * Vault secrets only hold a reference to a path in the KV v2 secrets engine
+ * of the configured Vault server. Values are read from Vault when used.
+ */
+export type SecretVault = {
+  path?: string;
+  mount?: string;
+};
 
 export type SecretsCreateSecretRequest = {
   secret?: SecretsSecret;
@@ -870,6 +1329,16 @@ export type SecretsDeleteSecretResponse = {
 
 export type SecretsDescribeSecretResponse = {
   secret?: SecretsSecret;
+  /**
+   * Canvas nodes with a configuration referencing the secret.
+   */
+  references?: Array<SecretsSecretReference>;
+};
+
+export type SecretsListSecretAccessEventsResponse = {
+  events?: Array<SecretsSecretAccessEvent>;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
 };
 
 export type SecretsListSecretsResponse = {
@@ -881,6 +1350,20 @@ export type SecretsSecret = {
   spec?: SecretsSecretSpec;
 };
 
+export type SecretsSecretAccessEvent = {
+  id?: string;
+  secretId?: string;
+  secretName?: string;
+  action?: SecretAccessEventAction;
+  keyName?: string;
+  actorType?: SecretAccessEventActorType;
+  actorId?: string;
+  canvasId?: string;
+  nodeId?: string;
+  executionId?: string;
+  createdAt?: string;
+};
+
 export type SecretsSecretMetadata = {
   id?: string;
   name?: string;
@@ -889,9 +1372,24 @@ export type SecretsSecretMetadata = {
   createdAt?: string;
 };
 
+export type SecretsSecretReference = {
+  canvasId?: string;
+  canvasName?: string;
+  nodeId?: string;
+  nodeName?: string;
+  key?: string;
+};
+
 export type SecretsSecretSpec = {
   provider?: SecretProvider;
   local?: SecretLocal;
+  vault?: SecretVault;
+  /**
+   * On updates, the scope and access policy
+   * are only changed if they are set.
+   */
+  scope?: SecretScope;
+  accessPolicy?: SecretAccessPolicy;
 };
 
 export type SecretsSetSecretKeyBody = {
@@ -1109,27 +1607,123 @@ export type WidgetsWidget = {
   configuration?: Array<ConfigurationField>;
 };
 
-export type GooglerpcStatus = {
-  code?: number;
-  message?: string;
-  details?: Array<ProtobufAny>;
-};
-
-export type ProtobufAny = {
-  "@type"?: string;
-  [key: string]: unknown | string | undefined;
-};
-
 /**
- * `NullValue` is a singleton enumeration to represent the null value for the
- * `Value` type union.
+ * Message that represents an arbitrary HTTP body. It should only be used for
+ * payload formats that can't be represented as JSON, such as raw binary or
+ * an HTML page.
  *
- * The JSON representation for `NullValue` is JSON `null`.
  *
- * - NULL_VALUE: Null value.
+ * This message can be used both in streaming and non-streaming API methods in
+ * the request as well as the response.
+ *
+ * It can be used as a top-level request field, which is convenient if one
+ * wants to extract parameters from either the URL or HTTP template into the
+ * request fields and also want access to the raw HTTP body.
+ *
+ * Example:
+ *
+ *     message GetResourceRequest {
+ *       // A unique request id.
+ *       string request_id = 1;
+ *
+ *       // The raw HTTP body is bound to this field.
+ *       google.api.HttpBody http_body = 2;
+ *
+ *     }
+ *
+ *     service ResourceService {
+ *       rpc GetResource(GetResourceRequest)
+ *         returns (google.api.HttpBody);
+ *       rpc UpdateResource(google.api.HttpBody)
+ *         returns (google.protobuf.Empty);
+ *
+ *     }
+ *
+ * Example with streaming methods:
+ *
+ *     service CaldavService {
+ *       rpc GetCalendar(stream google.api.HttpBody)
+ *         returns (stream google.api.HttpBody);
+ *       rpc UpdateCalendar(stream google.api.HttpBody)
+ *         returns (stream google.api.HttpBody);
+ *
+ *     }
+ *
+ * Use of this type only changes how the request and response bodies are
+ * handled, all other features will continue to work unchanged.
+ */
+export type ApiHttpBody = {
+  /**
+   * The HTTP Content-Type header value specifying the content type of the body.
+   */
+  contentType?: string;
+  /**
+   * The HTTP request/response body as raw binary.
+   */
+  data?: string;
+  /**
+   * Application specific response metadata. Must be set in the first response
+   * for streaming APIs.
+   */
+  extensions?: Array<ProtobufAny>;
+};
+
+export type GooglerpcStatus = {
+  code?: number;
+  message?: string;
+  details?: Array<ProtobufAny>;
+};
+
+export type ProtobufAny = {
+  "@type"?: string;
+  [key: string]: unknown | string | undefined;
+};
+
+/**
+ * `NullValue` is a singleton enumeration to represent the null value for the
+ * `Value` type union.
+ *
+ * The JSON representation for `NullValue` is JSON `null`.
+ *
+ * - NULL_VALUE: Null value.
  */
 export type ProtobufNullValue = "NULL_VALUE";
 
+export type AuditListAuditLogEntriesData = {
+  body?: never;
+  path?: never;
+  query?: {
+    limit?: number;
+    before?: string;
+    after?: string;
+    actorId?: string;
+    resource?: string;
+    action?: string;
+    method?: string;
+    result?: string;
+  };
+  url: "/api/v1/audit-log";
+};
+
+export type AuditListAuditLogEntriesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type AuditListAuditLogEntriesError = AuditListAuditLogEntriesErrors[keyof AuditListAuditLogEntriesErrors];
+
+export type AuditListAuditLogEntriesResponses = {
+  /**
+   * A successful response.
+   */
+  200: AuditListAuditLogEntriesResponse;
+};
+
+export type AuditListAuditLogEntriesResponse2 =
+  AuditListAuditLogEntriesResponses[keyof AuditListAuditLogEntriesResponses];
+
 export type BlueprintsListBlueprintsData = {
   body?: never;
   path?: never;
@@ -1319,6 +1913,210 @@ export type CanvasesCreateCanvasResponses = {
 
 export type CanvasesCreateCanvasResponse2 = CanvasesCreateCanvasResponses[keyof CanvasesCreateCanvasResponses];
 
+export type CanvasesListCanvasChangeRequestsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: {
+    states?: Array<"STATE_UNKNOWN" | "STATE_PENDING" | "STATE_PUBLISHED" | "STATE_REJECTED">;
+  };
+  url: "/api/v1/canvases/{canvasId}/change-requests";
+};
+
+export type CanvasesListCanvasChangeRequestsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListCanvasChangeRequestsError =
+  CanvasesListCanvasChangeRequestsErrors[keyof CanvasesListCanvasChangeRequestsErrors];
+
+export type CanvasesListCanvasChangeRequestsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListCanvasChangeRequestsResponse;
+};
+
+export type CanvasesListCanvasChangeRequestsResponse2 =
+  CanvasesListCanvasChangeRequestsResponses[keyof CanvasesListCanvasChangeRequestsResponses];
+
+export type CanvasesApproveCanvasChangeRequestData = {
+  body: CanvasesApproveCanvasChangeRequestBody;
+  path: {
+    canvasId: string;
+    changeRequestId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/change-requests/{changeRequestId}/approve";
+};
+
+export type CanvasesApproveCanvasChangeRequestErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesApproveCanvasChangeRequestError =
+  CanvasesApproveCanvasChangeRequestErrors[keyof CanvasesApproveCanvasChangeRequestErrors];
+
+export type CanvasesApproveCanvasChangeRequestResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesApproveCanvasChangeRequestResponse;
+};
+
+export type CanvasesApproveCanvasChangeRequestResponse2 =
+  CanvasesApproveCanvasChangeRequestResponses[keyof CanvasesApproveCanvasChangeRequestResponses];
+
+export type CanvasesRejectCanvasChangeRequestData = {
+  body: CanvasesRejectCanvasChangeRequestBody;
+  path: {
+    canvasId: string;
+    changeRequestId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/change-requests/{changeRequestId}/reject";
+};
+
+export type CanvasesRejectCanvasChangeRequestErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesRejectCanvasChangeRequestError =
+  CanvasesRejectCanvasChangeRequestErrors[keyof CanvasesRejectCanvasChangeRequestErrors];
+
+export type CanvasesRejectCanvasChangeRequestResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesRejectCanvasChangeRequestResponse;
+};
+
+export type CanvasesRejectCanvasChangeRequestResponse2 =
+  CanvasesRejectCanvasChangeRequestResponses[keyof CanvasesRejectCanvasChangeRequestResponses];
+
+export type CanvasesDescribeCanvasDraftData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/draft";
+};
+
+export type CanvasesDescribeCanvasDraftErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDescribeCanvasDraftError =
+  CanvasesDescribeCanvasDraftErrors[keyof CanvasesDescribeCanvasDraftErrors];
+
+export type CanvasesDescribeCanvasDraftResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesDescribeCanvasDraftResponse;
+};
+
+export type CanvasesDescribeCanvasDraftResponse2 =
+  CanvasesDescribeCanvasDraftResponses[keyof CanvasesDescribeCanvasDraftResponses];
+
+export type CanvasesDiscardCanvasDraftData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/draft";
+};
+
+export type CanvasesDiscardCanvasDraftErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDiscardCanvasDraftError = CanvasesDiscardCanvasDraftErrors[keyof CanvasesDiscardCanvasDraftErrors];
+
+export type CanvasesDiscardCanvasDraftResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesDiscardCanvasDraftResponse;
+};
+
+export type CanvasesDiscardCanvasDraftResponse2 =
+  CanvasesDiscardCanvasDraftResponses[keyof CanvasesDiscardCanvasDraftResponses];
+
+export type CanvasesUpdateCanvasDraftData = {
+  body: CanvasesUpdateCanvasDraftBody;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/draft";
+};
+
+export type CanvasesUpdateCanvasDraftErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateCanvasDraftError = CanvasesUpdateCanvasDraftErrors[keyof CanvasesUpdateCanvasDraftErrors];
+
+export type CanvasesUpdateCanvasDraftResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateCanvasDraftResponse;
+};
+
+export type CanvasesUpdateCanvasDraftResponse2 =
+  CanvasesUpdateCanvasDraftResponses[keyof CanvasesUpdateCanvasDraftResponses];
+
+export type CanvasesPublishCanvasDraftData = {
+  body: CanvasesPublishCanvasDraftBody;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/draft/publish";
+};
+
+export type CanvasesPublishCanvasDraftErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesPublishCanvasDraftError = CanvasesPublishCanvasDraftErrors[keyof CanvasesPublishCanvasDraftErrors];
+
+export type CanvasesPublishCanvasDraftResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesPublishCanvasDraftResponse;
+};
+
+export type CanvasesPublishCanvasDraftResponse2 =
+  CanvasesPublishCanvasDraftResponses[keyof CanvasesPublishCanvasDraftResponses];
+
 export type CanvasesListCanvasEventsData = {
   body?: never;
   path: {
@@ -1338,380 +2136,829 @@ export type CanvasesListCanvasEventsErrors = {
   default: GooglerpcStatus;
 };
 
-export type CanvasesListCanvasEventsError = CanvasesListCanvasEventsErrors[keyof CanvasesListCanvasEventsErrors];
+export type CanvasesListCanvasEventsError = CanvasesListCanvasEventsErrors[keyof CanvasesListCanvasEventsErrors];
+
+export type CanvasesListCanvasEventsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListCanvasEventsResponse;
+};
+
+export type CanvasesListCanvasEventsResponse2 =
+  CanvasesListCanvasEventsResponses[keyof CanvasesListCanvasEventsResponses];
+
+export type CanvasesListEventExecutionsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    eventId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/events/{eventId}/executions";
+};
+
+export type CanvasesListEventExecutionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListEventExecutionsError =
+  CanvasesListEventExecutionsErrors[keyof CanvasesListEventExecutionsErrors];
+
+export type CanvasesListEventExecutionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListEventExecutionsResponse;
+};
+
+export type CanvasesListEventExecutionsResponse2 =
+  CanvasesListEventExecutionsResponses[keyof CanvasesListEventExecutionsResponses];
+
+export type CanvasesResolveExecutionErrorsData = {
+  body: CanvasesResolveExecutionErrorsBody;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/resolve";
+};
+
+export type CanvasesResolveExecutionErrorsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesResolveExecutionErrorsError =
+  CanvasesResolveExecutionErrorsErrors[keyof CanvasesResolveExecutionErrorsErrors];
+
+export type CanvasesResolveExecutionErrorsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesResolveExecutionErrorsResponse;
+};
+
+export type CanvasesResolveExecutionErrorsResponse2 =
+  CanvasesResolveExecutionErrorsResponses[keyof CanvasesResolveExecutionErrorsResponses];
+
+export type CanvasesInvokeNodeExecutionActionData = {
+  body: CanvasesInvokeNodeExecutionActionBody;
+  path: {
+    canvasId: string;
+    executionId: string;
+    actionName: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/actions/{actionName}";
+};
+
+export type CanvasesInvokeNodeExecutionActionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesInvokeNodeExecutionActionError =
+  CanvasesInvokeNodeExecutionActionErrors[keyof CanvasesInvokeNodeExecutionActionErrors];
+
+export type CanvasesInvokeNodeExecutionActionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesInvokeNodeExecutionActionResponse;
+};
+
+export type CanvasesInvokeNodeExecutionActionResponse2 =
+  CanvasesInvokeNodeExecutionActionResponses[keyof CanvasesInvokeNodeExecutionActionResponses];
+
+export type CanvasesListExecutionArtifactsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts";
+};
+
+export type CanvasesListExecutionArtifactsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListExecutionArtifactsError =
+  CanvasesListExecutionArtifactsErrors[keyof CanvasesListExecutionArtifactsErrors];
+
+export type CanvasesListExecutionArtifactsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListExecutionArtifactsResponse;
+};
+
+export type CanvasesListExecutionArtifactsResponse2 =
+  CanvasesListExecutionArtifactsResponses[keyof CanvasesListExecutionArtifactsResponses];
+
+export type CanvasesDownloadExecutionArtifactData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+    artifactId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/artifacts/{artifactId}/download";
+};
+
+export type CanvasesDownloadExecutionArtifactErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDownloadExecutionArtifactError =
+  CanvasesDownloadExecutionArtifactErrors[keyof CanvasesDownloadExecutionArtifactErrors];
+
+export type CanvasesDownloadExecutionArtifactResponses = {
+  /**
+   * The content of the artifact.
+   */
+  200: Blob | File;
+};
+
+export type CanvasesDownloadExecutionArtifactResponse =
+  CanvasesDownloadExecutionArtifactResponses[keyof CanvasesDownloadExecutionArtifactResponses];
+
+export type CanvasesCancelExecutionData = {
+  body: CanvasesCancelExecutionBody;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/cancel";
+};
+
+export type CanvasesCancelExecutionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesCancelExecutionError = CanvasesCancelExecutionErrors[keyof CanvasesCancelExecutionErrors];
+
+export type CanvasesCancelExecutionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesCancelExecutionResponse;
+};
+
+export type CanvasesCancelExecutionResponse2 = CanvasesCancelExecutionResponses[keyof CanvasesCancelExecutionResponses];
+
+export type CanvasesListChildExecutionsData = {
+  body: CanvasesListChildExecutionsBody;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/children";
+};
+
+export type CanvasesListChildExecutionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListChildExecutionsError =
+  CanvasesListChildExecutionsErrors[keyof CanvasesListChildExecutionsErrors];
+
+export type CanvasesListChildExecutionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListChildExecutionsResponse;
+};
+
+export type CanvasesListChildExecutionsResponse2 =
+  CanvasesListChildExecutionsResponses[keyof CanvasesListChildExecutionsResponses];
+
+export type CanvasesGetExecutionLogsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: {
+    after?: string;
+    limit?: number;
+  };
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs";
+};
+
+export type CanvasesGetExecutionLogsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesGetExecutionLogsError = CanvasesGetExecutionLogsErrors[keyof CanvasesGetExecutionLogsErrors];
+
+export type CanvasesGetExecutionLogsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesGetExecutionLogsResponse;
+};
+
+export type CanvasesGetExecutionLogsResponse2 =
+  CanvasesGetExecutionLogsResponses[keyof CanvasesGetExecutionLogsResponses];
+
+export type CanvasesRerunExecutionData = {
+  body: CanvasesRerunExecutionBody;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/rerun";
+};
+
+export type CanvasesRerunExecutionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesRerunExecutionError = CanvasesRerunExecutionErrors[keyof CanvasesRerunExecutionErrors];
+
+export type CanvasesRerunExecutionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesRerunExecutionResponse;
+};
+
+export type CanvasesRerunExecutionResponse2 = CanvasesRerunExecutionResponses[keyof CanvasesRerunExecutionResponses];
+
+export type CanvasesListNodeEventsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: {
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/events";
+};
+
+export type CanvasesListNodeEventsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListNodeEventsError = CanvasesListNodeEventsErrors[keyof CanvasesListNodeEventsErrors];
+
+export type CanvasesListNodeEventsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListNodeEventsResponse;
+};
+
+export type CanvasesListNodeEventsResponse2 = CanvasesListNodeEventsResponses[keyof CanvasesListNodeEventsResponses];
+
+export type CanvasesEmitNodeEventData = {
+  body: CanvasesEmitNodeEventBody;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/events";
+};
+
+export type CanvasesEmitNodeEventErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesEmitNodeEventError = CanvasesEmitNodeEventErrors[keyof CanvasesEmitNodeEventErrors];
+
+export type CanvasesEmitNodeEventResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesEmitNodeEventResponse;
+};
+
+export type CanvasesEmitNodeEventResponse2 = CanvasesEmitNodeEventResponses[keyof CanvasesEmitNodeEventResponses];
+
+export type CanvasesListNodeExecutionsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: {
+    states?: Array<"STATE_UNKNOWN" | "STATE_PENDING" | "STATE_STARTED" | "STATE_FINISHED">;
+    results?: Array<"RESULT_UNKNOWN" | "RESULT_PASSED" | "RESULT_FAILED" | "RESULT_CANCELLED">;
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/executions";
+};
+
+export type CanvasesListNodeExecutionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListNodeExecutionsError = CanvasesListNodeExecutionsErrors[keyof CanvasesListNodeExecutionsErrors];
+
+export type CanvasesListNodeExecutionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListNodeExecutionsResponse;
+};
+
+export type CanvasesListNodeExecutionsResponse2 =
+  CanvasesListNodeExecutionsResponses[keyof CanvasesListNodeExecutionsResponses];
+
+export type CanvasesUpdateNodePauseData = {
+  body: CanvasesUpdateNodePauseBody;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/pause";
+};
+
+export type CanvasesUpdateNodePauseErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateNodePauseError = CanvasesUpdateNodePauseErrors[keyof CanvasesUpdateNodePauseErrors];
+
+export type CanvasesUpdateNodePauseResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateNodePauseResponse;
+};
+
+export type CanvasesUpdateNodePauseResponse2 = CanvasesUpdateNodePauseResponses[keyof CanvasesUpdateNodePauseResponses];
+
+export type CanvasesListNodeQueueItemsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: {
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue";
+};
+
+export type CanvasesListNodeQueueItemsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListNodeQueueItemsError = CanvasesListNodeQueueItemsErrors[keyof CanvasesListNodeQueueItemsErrors];
+
+export type CanvasesListNodeQueueItemsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListNodeQueueItemsResponse;
+};
+
+export type CanvasesListNodeQueueItemsResponse2 =
+  CanvasesListNodeQueueItemsResponses[keyof CanvasesListNodeQueueItemsResponses];
+
+export type CanvasesDeleteNodeQueueItemData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    nodeId: string;
+    itemId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}";
+};
+
+export type CanvasesDeleteNodeQueueItemErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDeleteNodeQueueItemError =
+  CanvasesDeleteNodeQueueItemErrors[keyof CanvasesDeleteNodeQueueItemErrors];
 
-export type CanvasesListCanvasEventsResponses = {
+export type CanvasesDeleteNodeQueueItemResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListCanvasEventsResponse;
+  200: CanvasesDeleteNodeQueueItemResponse;
 };
 
-export type CanvasesListCanvasEventsResponse2 =
-  CanvasesListCanvasEventsResponses[keyof CanvasesListCanvasEventsResponses];
+export type CanvasesDeleteNodeQueueItemResponse2 =
+  CanvasesDeleteNodeQueueItemResponses[keyof CanvasesDeleteNodeQueueItemResponses];
 
-export type CanvasesListEventExecutionsData = {
-  body?: never;
+export type CanvasesUpdateNodeQueueItemData = {
+  body: CanvasesUpdateNodeQueueItemBody;
   path: {
     canvasId: string;
-    eventId: string;
+    nodeId: string;
+    itemId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/events/{eventId}/executions";
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}";
 };
 
-export type CanvasesListEventExecutionsErrors = {
+export type CanvasesUpdateNodeQueueItemErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesListEventExecutionsError =
-  CanvasesListEventExecutionsErrors[keyof CanvasesListEventExecutionsErrors];
+export type CanvasesUpdateNodeQueueItemError =
+  CanvasesUpdateNodeQueueItemErrors[keyof CanvasesUpdateNodeQueueItemErrors];
 
-export type CanvasesListEventExecutionsResponses = {
+export type CanvasesUpdateNodeQueueItemResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListEventExecutionsResponse;
+  200: CanvasesUpdateNodeQueueItemResponse;
 };
 
-export type CanvasesListEventExecutionsResponse2 =
-  CanvasesListEventExecutionsResponses[keyof CanvasesListEventExecutionsResponses];
+export type CanvasesUpdateNodeQueueItemResponse2 =
+  CanvasesUpdateNodeQueueItemResponses[keyof CanvasesUpdateNodeQueueItemResponses];
 
-export type CanvasesResolveExecutionErrorsData = {
-  body: CanvasesResolveExecutionErrorsBody;
+export type CanvasesDescribeCanvasRetentionData = {
+  body?: never;
   path: {
     canvasId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/executions/resolve";
+  url: "/api/v1/canvases/{canvasId}/retention";
 };
 
-export type CanvasesResolveExecutionErrorsErrors = {
+export type CanvasesDescribeCanvasRetentionErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesResolveExecutionErrorsError =
-  CanvasesResolveExecutionErrorsErrors[keyof CanvasesResolveExecutionErrorsErrors];
+export type CanvasesDescribeCanvasRetentionError =
+  CanvasesDescribeCanvasRetentionErrors[keyof CanvasesDescribeCanvasRetentionErrors];
 
-export type CanvasesResolveExecutionErrorsResponses = {
+export type CanvasesDescribeCanvasRetentionResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesResolveExecutionErrorsResponse;
+  200: CanvasesDescribeCanvasRetentionResponse;
 };
 
-export type CanvasesResolveExecutionErrorsResponse2 =
-  CanvasesResolveExecutionErrorsResponses[keyof CanvasesResolveExecutionErrorsResponses];
+export type CanvasesDescribeCanvasRetentionResponse2 =
+  CanvasesDescribeCanvasRetentionResponses[keyof CanvasesDescribeCanvasRetentionResponses];
 
-export type CanvasesInvokeNodeExecutionActionData = {
-  body: CanvasesInvokeNodeExecutionActionBody;
+export type CanvasesUpdateCanvasRetentionPolicyData = {
+  body: CanvasesUpdateCanvasRetentionPolicyBody;
   path: {
     canvasId: string;
-    executionId: string;
-    actionName: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/actions/{actionName}";
+  url: "/api/v1/canvases/{canvasId}/retention/policy";
 };
 
-export type CanvasesInvokeNodeExecutionActionErrors = {
+export type CanvasesUpdateCanvasRetentionPolicyErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesInvokeNodeExecutionActionError =
-  CanvasesInvokeNodeExecutionActionErrors[keyof CanvasesInvokeNodeExecutionActionErrors];
+export type CanvasesUpdateCanvasRetentionPolicyError =
+  CanvasesUpdateCanvasRetentionPolicyErrors[keyof CanvasesUpdateCanvasRetentionPolicyErrors];
 
-export type CanvasesInvokeNodeExecutionActionResponses = {
+export type CanvasesUpdateCanvasRetentionPolicyResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesInvokeNodeExecutionActionResponse;
+  200: CanvasesUpdateCanvasRetentionPolicyResponse;
 };
 
-export type CanvasesInvokeNodeExecutionActionResponse2 =
-  CanvasesInvokeNodeExecutionActionResponses[keyof CanvasesInvokeNodeExecutionActionResponses];
+export type CanvasesUpdateCanvasRetentionPolicyResponse2 =
+  CanvasesUpdateCanvasRetentionPolicyResponses[keyof CanvasesUpdateCanvasRetentionPolicyResponses];
 
-export type CanvasesCancelExecutionData = {
-  body: CanvasesCancelExecutionBody;
+export type CanvasesSimulateCanvasData = {
+  body: CanvasesSimulateCanvasBody;
   path: {
     canvasId: string;
-    executionId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/cancel";
+  url: "/api/v1/canvases/{canvasId}/simulate";
 };
 
-export type CanvasesCancelExecutionErrors = {
+export type CanvasesSimulateCanvasErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesCancelExecutionError = CanvasesCancelExecutionErrors[keyof CanvasesCancelExecutionErrors];
+export type CanvasesSimulateCanvasError = CanvasesSimulateCanvasErrors[keyof CanvasesSimulateCanvasErrors];
 
-export type CanvasesCancelExecutionResponses = {
+export type CanvasesSimulateCanvasResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesCancelExecutionResponse;
+  200: CanvasesSimulateCanvasResponse;
 };
 
-export type CanvasesCancelExecutionResponse2 = CanvasesCancelExecutionResponses[keyof CanvasesCancelExecutionResponses];
+export type CanvasesSimulateCanvasResponse2 = CanvasesSimulateCanvasResponses[keyof CanvasesSimulateCanvasResponses];
 
-export type CanvasesListChildExecutionsData = {
-  body: CanvasesListChildExecutionsBody;
+export type CanvasesInvokeNodeTriggerActionData = {
+  body: CanvasesInvokeNodeTriggerActionBody;
   path: {
     canvasId: string;
-    executionId: string;
+    nodeId: string;
+    actionName: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/children";
+  url: "/api/v1/canvases/{canvasId}/triggers/{nodeId}/actions/{actionName}";
 };
 
-export type CanvasesListChildExecutionsErrors = {
+export type CanvasesInvokeNodeTriggerActionErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesListChildExecutionsError =
-  CanvasesListChildExecutionsErrors[keyof CanvasesListChildExecutionsErrors];
+export type CanvasesInvokeNodeTriggerActionError =
+  CanvasesInvokeNodeTriggerActionErrors[keyof CanvasesInvokeNodeTriggerActionErrors];
 
-export type CanvasesListChildExecutionsResponses = {
+export type CanvasesInvokeNodeTriggerActionResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListChildExecutionsResponse;
+  200: CanvasesInvokeNodeTriggerActionResponse;
 };
 
-export type CanvasesListChildExecutionsResponse2 =
-  CanvasesListChildExecutionsResponses[keyof CanvasesListChildExecutionsResponses];
+export type CanvasesInvokeNodeTriggerActionResponse2 =
+  CanvasesInvokeNodeTriggerActionResponses[keyof CanvasesInvokeNodeTriggerActionResponses];
 
-export type CanvasesListNodeEventsData = {
+export type CanvasesListCanvasVariablesData = {
   body?: never;
   path: {
     canvasId: string;
-    nodeId: string;
-  };
-  query?: {
-    limit?: number;
-    before?: string;
   };
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/events";
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/variables";
 };
 
-export type CanvasesListNodeEventsErrors = {
+export type CanvasesListCanvasVariablesErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesListNodeEventsError = CanvasesListNodeEventsErrors[keyof CanvasesListNodeEventsErrors];
+export type CanvasesListCanvasVariablesError =
+  CanvasesListCanvasVariablesErrors[keyof CanvasesListCanvasVariablesErrors];
 
-export type CanvasesListNodeEventsResponses = {
+export type CanvasesListCanvasVariablesResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListNodeEventsResponse;
+  200: CanvasesListCanvasVariablesResponse;
 };
 
-export type CanvasesListNodeEventsResponse2 = CanvasesListNodeEventsResponses[keyof CanvasesListNodeEventsResponses];
+export type CanvasesListCanvasVariablesResponse2 =
+  CanvasesListCanvasVariablesResponses[keyof CanvasesListCanvasVariablesResponses];
 
-export type CanvasesEmitNodeEventData = {
-  body: CanvasesEmitNodeEventBody;
+export type CanvasesDeleteCanvasVariableData = {
+  body?: never;
   path: {
     canvasId: string;
-    nodeId: string;
+    name: string;
   };
-  query?: never;
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/events";
+  query?: {
+    expectedVersion?: number;
+  };
+  url: "/api/v1/canvases/{canvasId}/variables/{name}";
 };
 
-export type CanvasesEmitNodeEventErrors = {
+export type CanvasesDeleteCanvasVariableErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesEmitNodeEventError = CanvasesEmitNodeEventErrors[keyof CanvasesEmitNodeEventErrors];
+export type CanvasesDeleteCanvasVariableError =
+  CanvasesDeleteCanvasVariableErrors[keyof CanvasesDeleteCanvasVariableErrors];
 
-export type CanvasesEmitNodeEventResponses = {
+export type CanvasesDeleteCanvasVariableResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesEmitNodeEventResponse;
+  200: CanvasesDeleteCanvasVariableResponse;
 };
 
-export type CanvasesEmitNodeEventResponse2 = CanvasesEmitNodeEventResponses[keyof CanvasesEmitNodeEventResponses];
+export type CanvasesDeleteCanvasVariableResponse2 =
+  CanvasesDeleteCanvasVariableResponses[keyof CanvasesDeleteCanvasVariableResponses];
 
-export type CanvasesListNodeExecutionsData = {
-  body?: never;
+export type CanvasesSetCanvasVariableData = {
+  body: CanvasesSetCanvasVariableBody;
   path: {
     canvasId: string;
-    nodeId: string;
-  };
-  query?: {
-    states?: Array<"STATE_UNKNOWN" | "STATE_PENDING" | "STATE_STARTED" | "STATE_FINISHED">;
-    results?: Array<"RESULT_UNKNOWN" | "RESULT_PASSED" | "RESULT_FAILED" | "RESULT_CANCELLED">;
-    limit?: number;
-    before?: string;
+    name: string;
   };
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/executions";
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/variables/{name}";
 };
 
-export type CanvasesListNodeExecutionsErrors = {
+export type CanvasesSetCanvasVariableErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesListNodeExecutionsError = CanvasesListNodeExecutionsErrors[keyof CanvasesListNodeExecutionsErrors];
+export type CanvasesSetCanvasVariableError = CanvasesSetCanvasVariableErrors[keyof CanvasesSetCanvasVariableErrors];
 
-export type CanvasesListNodeExecutionsResponses = {
+export type CanvasesSetCanvasVariableResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListNodeExecutionsResponse;
+  200: CanvasesSetCanvasVariableResponse;
 };
 
-export type CanvasesListNodeExecutionsResponse2 =
-  CanvasesListNodeExecutionsResponses[keyof CanvasesListNodeExecutionsResponses];
+export type CanvasesSetCanvasVariableResponse2 =
+  CanvasesSetCanvasVariableResponses[keyof CanvasesSetCanvasVariableResponses];
 
-export type CanvasesUpdateNodePauseData = {
-  body: CanvasesUpdateNodePauseBody;
+export type CanvasesListCanvasVersionsData = {
+  body?: never;
   path: {
     canvasId: string;
-    nodeId: string;
   };
-  query?: never;
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/pause";
+  query?: {
+    limit?: number;
+    before?: number;
+  };
+  url: "/api/v1/canvases/{canvasId}/versions";
 };
 
-export type CanvasesUpdateNodePauseErrors = {
+export type CanvasesListCanvasVersionsErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesUpdateNodePauseError = CanvasesUpdateNodePauseErrors[keyof CanvasesUpdateNodePauseErrors];
+export type CanvasesListCanvasVersionsError = CanvasesListCanvasVersionsErrors[keyof CanvasesListCanvasVersionsErrors];
 
-export type CanvasesUpdateNodePauseResponses = {
+export type CanvasesListCanvasVersionsResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesUpdateNodePauseResponse;
+  200: CanvasesListCanvasVersionsResponse;
 };
 
-export type CanvasesUpdateNodePauseResponse2 = CanvasesUpdateNodePauseResponses[keyof CanvasesUpdateNodePauseResponses];
+export type CanvasesListCanvasVersionsResponse2 =
+  CanvasesListCanvasVersionsResponses[keyof CanvasesListCanvasVersionsResponses];
 
-export type CanvasesListNodeQueueItemsData = {
+export type CanvasesDiffCanvasVersionsData = {
   body?: never;
   path: {
     canvasId: string;
-    nodeId: string;
+    toVersionId: string;
   };
   query?: {
-    limit?: number;
-    before?: string;
+    fromVersionId?: string;
   };
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue";
+  url: "/api/v1/canvases/{canvasId}/versions/{toVersionId}/diff";
 };
 
-export type CanvasesListNodeQueueItemsErrors = {
+export type CanvasesDiffCanvasVersionsErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesListNodeQueueItemsError = CanvasesListNodeQueueItemsErrors[keyof CanvasesListNodeQueueItemsErrors];
+export type CanvasesDiffCanvasVersionsError = CanvasesDiffCanvasVersionsErrors[keyof CanvasesDiffCanvasVersionsErrors];
 
-export type CanvasesListNodeQueueItemsResponses = {
+export type CanvasesDiffCanvasVersionsResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListNodeQueueItemsResponse;
+  200: CanvasesDiffCanvasVersionsResponse;
 };
 
-export type CanvasesListNodeQueueItemsResponse2 =
-  CanvasesListNodeQueueItemsResponses[keyof CanvasesListNodeQueueItemsResponses];
+export type CanvasesDiffCanvasVersionsResponse2 =
+  CanvasesDiffCanvasVersionsResponses[keyof CanvasesDiffCanvasVersionsResponses];
 
-export type CanvasesDeleteNodeQueueItemData = {
+export type CanvasesDescribeCanvasVersionData = {
   body?: never;
   path: {
     canvasId: string;
-    nodeId: string;
-    itemId: string;
+    versionId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}";
+  url: "/api/v1/canvases/{canvasId}/versions/{versionId}";
 };
 
-export type CanvasesDeleteNodeQueueItemErrors = {
+export type CanvasesDescribeCanvasVersionErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesDeleteNodeQueueItemError =
-  CanvasesDeleteNodeQueueItemErrors[keyof CanvasesDeleteNodeQueueItemErrors];
+export type CanvasesDescribeCanvasVersionError =
+  CanvasesDescribeCanvasVersionErrors[keyof CanvasesDescribeCanvasVersionErrors];
 
-export type CanvasesDeleteNodeQueueItemResponses = {
+export type CanvasesDescribeCanvasVersionResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesDeleteNodeQueueItemResponse;
+  200: CanvasesDescribeCanvasVersionResponse;
 };
 
-export type CanvasesDeleteNodeQueueItemResponse2 =
-  CanvasesDeleteNodeQueueItemResponses[keyof CanvasesDeleteNodeQueueItemResponses];
+export type CanvasesDescribeCanvasVersionResponse2 =
+  CanvasesDescribeCanvasVersionResponses[keyof CanvasesDescribeCanvasVersionResponses];
 
-export type CanvasesInvokeNodeTriggerActionData = {
-  body: CanvasesInvokeNodeTriggerActionBody;
+export type CanvasesRestoreCanvasVersionData = {
+  body: CanvasesRestoreCanvasVersionBody;
   path: {
     canvasId: string;
-    nodeId: string;
-    actionName: string;
+    versionId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/triggers/{nodeId}/actions/{actionName}";
+  url: "/api/v1/canvases/{canvasId}/versions/{versionId}/restore";
 };
 
-export type CanvasesInvokeNodeTriggerActionErrors = {
+export type CanvasesRestoreCanvasVersionErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesInvokeNodeTriggerActionError =
-  CanvasesInvokeNodeTriggerActionErrors[keyof CanvasesInvokeNodeTriggerActionErrors];
+export type CanvasesRestoreCanvasVersionError =
+  CanvasesRestoreCanvasVersionErrors[keyof CanvasesRestoreCanvasVersionErrors];
 
-export type CanvasesInvokeNodeTriggerActionResponses = {
+export type CanvasesRestoreCanvasVersionResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesInvokeNodeTriggerActionResponse;
+  200: CanvasesRestoreCanvasVersionResponse;
 };
 
-export type CanvasesInvokeNodeTriggerActionResponse2 =
-  CanvasesInvokeNodeTriggerActionResponses[keyof CanvasesInvokeNodeTriggerActionResponses];
+export type CanvasesRestoreCanvasVersionResponse2 =
+  CanvasesRestoreCanvasVersionResponses[keyof CanvasesRestoreCanvasVersionResponses];
 
 export type CanvasesDeleteCanvasData = {
   body?: never;
@@ -1878,6 +3125,35 @@ export type ComponentsListComponentActionsResponses = {
 export type ComponentsListComponentActionsResponse2 =
   ComponentsListComponentActionsResponses[keyof ComponentsListComponentActionsResponses];
 
+export type ExpressionsListExpressionFunctionsData = {
+  body?: never;
+  path?: never;
+  query?: {
+    category?: string;
+  };
+  url: "/api/v1/expressions/functions";
+};
+
+export type ExpressionsListExpressionFunctionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type ExpressionsListExpressionFunctionsError =
+  ExpressionsListExpressionFunctionsErrors[keyof ExpressionsListExpressionFunctionsErrors];
+
+export type ExpressionsListExpressionFunctionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: ExpressionsListExpressionFunctionsResponse;
+};
+
+export type ExpressionsListExpressionFunctionsResponse2 =
+  ExpressionsListExpressionFunctionsResponses[keyof ExpressionsListExpressionFunctionsResponses];
+
 export type GroupsListGroupsData = {
   body?: never;
   path?: never;
@@ -2658,6 +3934,64 @@ export type OrganizationsResetInviteLinkResponses = {
 export type OrganizationsResetInviteLinkResponse2 =
   OrganizationsResetInviteLinkResponses[keyof OrganizationsResetInviteLinkResponses];
 
+export type OrganizationsGetRetentionPolicyData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/retention-policy";
+};
+
+export type OrganizationsGetRetentionPolicyErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsGetRetentionPolicyError =
+  OrganizationsGetRetentionPolicyErrors[keyof OrganizationsGetRetentionPolicyErrors];
+
+export type OrganizationsGetRetentionPolicyResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsGetRetentionPolicyResponse;
+};
+
+export type OrganizationsGetRetentionPolicyResponse2 =
+  OrganizationsGetRetentionPolicyResponses[keyof OrganizationsGetRetentionPolicyResponses];
+
+export type OrganizationsUpdateRetentionPolicyData = {
+  body: OrganizationsUpdateRetentionPolicyBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/retention-policy";
+};
+
+export type OrganizationsUpdateRetentionPolicyErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsUpdateRetentionPolicyError =
+  OrganizationsUpdateRetentionPolicyErrors[keyof OrganizationsUpdateRetentionPolicyErrors];
+
+export type OrganizationsUpdateRetentionPolicyResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsUpdateRetentionPolicyResponse;
+};
+
+export type OrganizationsUpdateRetentionPolicyResponse2 =
+  OrganizationsUpdateRetentionPolicyResponses[keyof OrganizationsUpdateRetentionPolicyResponses];
+
 export type OrganizationsRemoveUserData = {
   body?: never;
   path: {
@@ -2993,6 +4327,41 @@ export type SecretsUpdateSecretResponses = {
 
 export type SecretsUpdateSecretResponse2 = SecretsUpdateSecretResponses[keyof SecretsUpdateSecretResponses];
 
+export type SecretsListSecretAccessEventsData = {
+  body?: never;
+  path: {
+    idOrName: string;
+  };
+  query?: {
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainId?: string;
+    limit?: number;
+    before?: string;
+    after?: string;
+  };
+  url: "/api/v1/secrets/{idOrName}/access-events";
+};
+
+export type SecretsListSecretAccessEventsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SecretsListSecretAccessEventsError =
+  SecretsListSecretAccessEventsErrors[keyof SecretsListSecretAccessEventsErrors];
+
+export type SecretsListSecretAccessEventsResponses = {
+  /**
+   * A successful response.
+   */
+  200: SecretsListSecretAccessEventsResponse;
+};
+
+export type SecretsListSecretAccessEventsResponse2 =
+  SecretsListSecretAccessEventsResponses[keyof SecretsListSecretAccessEventsResponses];
+
 export type SecretsDeleteSecretKeyData = {
   body?: never;
   path: {
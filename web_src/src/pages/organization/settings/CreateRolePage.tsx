@@ -324,6 +324,20 @@ const ORGANIZATION_PERMISSIONS: PermissionCategory[] = [
       },
     ],
   },
+  {
+    category: "Audit Log",
+    icon: "history",
+    permissions: [
+      {
+        id: "audit_log.read",
+        name: "View Audit Log",
+        description: "View the changes made in the organization",
+        category: "Audit Log",
+        resource: "audit_log",
+        action: "read",
+      },
+    ],
+  },
 ];
 
 const DEFAULT_ROLE_NAMES = ["org_viewer", "org_admin", "org_owner"];
@@ -94,6 +94,7 @@ import {
   buildComponentDefinition,
   buildExecutionInfo,
   buildQueueItemInfo,
+  getComponentOutputChannels,
 } from "./utils";
 import { SidebarEvent } from "@/ui/componentSidebar/types";
 import { LogEntry, LogRunItem } from "@/ui/CanvasLogSidebar";
@@ -3415,7 +3416,7 @@ function prepareComponentBaseNode(
       type: "component",
       label: displayLabel,
       state: "pending" as const,
-      outputChannels: metadata?.outputChannels?.map((channel) => channel.name) || ["default"],
+      outputChannels: getComponentOutputChannels(node, metadata),
       component: {
         ...componentBaseProps,
         emptyStateProps,
@@ -1188,3 +1188,15 @@ export function buildNodeInfo(node: ComponentsNode): NodeInfo {
     metadata: node.metadata,
   };
 }
+
+// Switch nodes have one output channel per configured case,
+// so their channels come from the node configuration, not the component.
+export function getComponentOutputChannels(node: ComponentsNode, component?: ComponentsComponent): string[] {
+  if (node.component?.name === "switch") {
+    const cases = (node.configuration?.cases as Array<{ name?: string }> | undefined) || [];
+    const names = cases.map((c) => c?.name).filter((name): name is string => !!name && name !== "default");
+    return [...names, "default"];
+  }
+
+  return component?.outputChannels?.map((channel) => channel.name!) || ["default"];
+}